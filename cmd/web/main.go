@@ -1,11 +1,24 @@
 package main
 
 import (
+	"context"
+	"errors"
+	eventCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/event/handlers"
+	notificationCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/notification/handlers"
+	recommendationCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/recommendation/handlers"
+	trendCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/trend/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/jobs"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
 	"github.com/carlosclavijo/Pinterest-Services/internal/web"
 	"go.uber.org/zap"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
@@ -14,6 +27,7 @@ const (
 	vaultConnection = "http://127.0.0.1:8200"
 	token           = "root"
 	environment     = "development"
+	shutdownTimeout = 15 * time.Second
 )
 
 func main() {
@@ -29,6 +43,10 @@ func main() {
 	// Load configuration (from Vault or env)
 	cfg := infrastructure.LoadConfig(vaultClient)
 
+	// Stopped on SIGINT or SIGTERM, which shuts the server and the jobs down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Database
 	db, err := cfg.DBConfig.NewPostgresDB()
 	if err != nil {
@@ -38,15 +56,79 @@ func main() {
 	// Redis + JWT + Routes
 	rdb := services.NewRedisClient()
 	blacklistRepo := services.NewTokenBlacklistRepository(rdb)
-	jwtService := services.NewJWTService(cfg.JWTSecret, time.Hour*24)
-	routes := web.NewRoutes(db, jwtService, blacklistRepo, &cfg.EmailService)
+	jwtService, err := services.NewJWTService(cfg.JWT, cfg.Auth.AccessTTL)
+	if err != nil {
+		log.Fatal("error initializing JWT", zap.Error(err))
+	}
+	broker := services.NewNotificationBroker(rdb)
+	feedStore := services.NewFeedStore(rdb, cfg.Feed.MaxSize, cfg.Feed.TTL)
+	relatedCache := services.NewRelatedCache(rdb, cfg.Related.TTL)
+	trendStore := services.NewTrendStore(rdb, 3*cfg.Trends.Interval)
+	eventRepo := repositories.NewEventRepository(db)
+	eventWriter := services.NewEventWriter(eventRepo, services.NewZapAdapter(), cfg.Events.BufferSize, cfg.Events.BatchSize)
+	analyticsCache := services.NewAnalyticsCache(rdb, cfg.Analytics.CacheTTL)
+	challengeStore := services.NewChallengeStore(rdb)
+	ceremonyStore := services.NewCeremonyStore(rdb)
+	authorizationStore := services.NewAuthorizationStore(rdb)
+	signupStore := services.NewSignupStore(rdb)
+	attemptStore := services.NewAttemptStore(rdb)
+	unlockStore := services.NewUnlockStore(rdb)
+	routes := web.NewRoutes(db, jwtService, blacklistRepo, &cfg.EmailService, broker, &cfg.Streams, feedStore, &cfg.Feed, relatedCache, &cfg.Related, trendStore, &cfg.Trends, eventWriter, analyticsCache, challengeStore, &cfg.MFA, ceremonyStore, &cfg.Passkeys, authorizationStore, signupStore, &cfg.OIDC, attemptStore, unlockStore, &cfg.Login, &cfg.Auth, &cfg.Verification)
+
+	// Bootstrap admins: ADMIN_USER_IDS get the admin role on every start
+	roleRepo := repositories.NewRoleRepository(db)
+	for _, id := range cfg.Admins {
+		if err := roleRepo.ChangeRole(ctx, id, users.RoleAdmin); err != nil {
+			log.Error("error granting admin role", zap.String("user_id", id.String()), zap.Error(err))
+		}
+	}
+
+	// Notification retention
+	retention := cfg.NotificationRetention
+	pruner := notificationCommand.NewNotificationHandler(repositories.NewNotificationRepository(db), notifications.NewNotificationFactory(), broker, services.NewZapAdapter())
+	go jobs.PruneNotifications(ctx, pruner, retention.MaxAge, retention.KeepPerUser, retention.Interval)
+
+	// Related pins
+	recommender := recommendationCommand.NewRecommendationHandler(repositories.NewRelatedRepository(db), relatedCache, cfg.Related.Size, services.NewZapAdapter())
+	go jobs.RefreshRelatedPins(ctx, recommender, cfg.Related.Interval)
+
+	// Trending pins and tags
+	trender := trendCommand.NewTrendHandler(repositories.NewTrendRepository(db), trendStore, repositories.NewViewRepository(db), cfg.Trends.HalfLife, cfg.Trends.Window, cfg.Trends.Size, services.NewZapAdapter())
+	go jobs.RefreshTrends(ctx, trender, cfg.Trends.Interval)
+
+	// Pin events, written until the server is done with its requests
+	writerCtx, stopWriter := context.WithCancel(context.Background())
+	flushed := make(chan struct{})
+	go func() {
+		eventWriter.Run(writerCtx, cfg.Events.FlushInterval)
+		close(flushed)
+	}()
+	rollup := eventCommand.NewEventHandler(eventRepo, eventWriter, services.NewZapAdapter())
+	go jobs.RollupEvents(ctx, rollup, cfg.Events.RollupInterval)
 
 	// Start server
 	log.Info("Server starting", zap.String("connection", connection), zap.String("environment", environment))
 
-	if err := http.ListenAndServe(connection, routes.Router()); err != nil {
-		log.Fatal("server error", zap.Error(err))
+	server := &http.Server{Addr: connection, Handler: routes.Router()}
+	server.RegisterOnShutdown(routes.Shutdown)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("server error", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("Server shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error("server shutdown error", zap.Error(err))
 	}
+
+	// The buffered events are written before exiting
+	stopWriter()
+	<-flushed
 }
 
 //go test ./... -coverprofile=coverage.out
@@ -15,148 +15,177 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/users/": {
-            "put": {
-                "description": "Updates the authenticated user's information",
-                "consumes": [
-                    "application/json"
-                ],
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Lists the public keys access tokens are verified with, as a JSON Web Key Set. Tokens name the key that signed them in their kid header. Keys being rotated out stay listed until the tokens they signed expire",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Update a user",
-                "parameters": [
-                    {
-                        "description": "User update payload",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/commands.UpdateUserCommand"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "Get the token keys",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request body",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden: cannot update another user",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Server error",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/services.JWKSet"
                         }
                     }
                 }
             }
         },
-        "/users/all": {
+        "/admin/categories/": {
             "get": {
-                "description": "Returns a list of all registered users",
+                "description": "Returns the whole interest taxonomy, root categories first, each with its subcategories nested in name order. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get all users",
+                "summary": "Get the category tree",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetCategoryTreeResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetCategoryTreeResponse"
                         }
                     }
                 }
-            }
-        },
-        "/users/countries": {
-            "get": {
-                "description": "Returns a list of available countries",
+            },
+            "post": {
+                "description": "Adds a category at the root or under parent_id, at most five levels deep. The slug defaults to one made from the name and must be unique. Admins only",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
+                ],
+                "summary": "Create a category",
+                "parameters": [
+                    {
+                        "description": "Category payload",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CreateCategoryCommand"
+                        }
+                    }
                 ],
-                "summary": "List all countries",
                 "responses": {
-                    "200": {
-                        "description": "List of countries",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetCountriesList"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, name, slug or description, or too deep",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent category not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     }
                 }
             }
         },
-        "/users/country/{country}": {
-            "get": {
-                "description": "Returns a list of users filtered by country",
+        "/admin/categories/{id}": {
+            "delete": {
+                "description": "Deletes a category and its tag mappings. Categories with subcategories must be emptied first. The tags and pins stay. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get users by country",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Country name",
-                        "name": "country",
+                        "description": "Category ID (UUID)",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Category deleted"
+                    },
+                    "400": {
+                        "description": "Invalid id",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid country",
+                    "403": {
+                        "description": "Not an admin",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Category has subcategories",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     }
                 }
-            }
-        },
-        "/users/create": {
-            "post": {
-                "description": "Creates a new user in the system",
+            },
+            "patch": {
+                "description": "Changes the fields given. parent_id moves the category, with its subcategories, under another one and root moves it to the root; it cannot go under itself or its own subcategories, nor past five levels. An empty description clears it. Admins only",
                 "consumes": [
                     "application/json"
                 ],
@@ -164,57 +193,82 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Create a new user",
+                "summary": "Update a category",
                 "parameters": [
                     {
-                        "description": "User creation payload",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Category ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/commands.CreateUserCommand"
+                            "$ref": "#/definitions/commands.UpdateCategoryCommand"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid id, request body, name, slug, description or move",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Category or parent not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already taken",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.GetCategoryResponse"
                         }
                     }
                 }
             }
         },
-        "/users/email/{email}": {
+        "/admin/categories/{id}/tags": {
             "get": {
-                "description": "Returns a single user by email address",
+                "description": "Returns the tags mapped directly into a category, by name. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get user by email",
+                "summary": "Get the tags of a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User email",
-                        "name": "email",
+                        "description": "Category ID (UUID)",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -223,232 +277,238 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetListTagsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid email",
+                        "description": "Invalid id",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetListTagsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListTagsResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetListTagsResponse"
                         }
                     }
                 }
             }
         },
-        "/users/id/{id}": {
-            "get": {
-                "description": "Returns a single user by UUID",
+        "/admin/categories/{id}/tags/{tag}": {
+            "put": {
+                "description": "Maps a hashtag into a category, so pins with it show up when browsing the category and its ancestors. A tag may map into several categories. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Map a tag into a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
+                        "description": "Category ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hashtag, with or without #",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid id",
+                        "description": "Invalid id or hashtag",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetTagResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already mapped",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     }
                 }
-            }
-        },
-        "/users/language/{language}": {
-            "get": {
-                "description": "Returns a list of users filtered by language",
+            },
+            "delete": {
+                "description": "Removes a hashtag from a category. The tag and its pins stay. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get users by language",
+                "summary": "Unmap a tag from a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Country name",
-                        "name": "country",
+                        "description": "Category ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hashtag, with or without #",
+                        "name": "tag",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Tag unmapped"
+                    },
+                    "400": {
+                        "description": "Invalid id or hashtag",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid country",
+                    "403": {
+                        "description": "Not an admin",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found or tag not mapped",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                            "$ref": "#/definitions/helpers.GetTagResponse"
                         }
                     }
                 }
             }
         },
-        "/users/languages": {
+        "/admin/users/": {
             "get": {
-                "description": "Returns a list of available languages",
+                "description": "Returns a list of all registered users, the deleted ones included. Admins only",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "List all languages",
+                "summary": "Get all users",
                 "responses": {
                     "200": {
-                        "description": "List of languages",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetLanguagesList"
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
                         }
                     }
                 }
             }
         },
-        "/users/like-username/{username}": {
-            "get": {
-                "description": "Returns a list of users whose usernames match the provided pattern",
-                "produces": [
+        "/admin/users/{id}/role": {
+            "put": {
+                "description": "Makes a user a user, moderator or admin. Admins cannot change their own role. The new role is in the tokens the user gets from then on; a demoted user is logged out of every session. Admins only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get users by partial username",
+                "summary": "Change the role of a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username pattern",
-                        "name": "username",
+                        "description": "User ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid username",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
-                        }
-                    },
-                    "500": {
-                        "description": "Server error",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
-                        }
-                    }
-                }
-            }
-        },
-        "/users/list": {
-            "get": {
-                "description": "Returns all users where deleted_at IS NULL",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Get list of active users",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
-                        }
                     },
-                    "500": {
-                        "description": "Server error",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.GetListUsersDTO"
-                        }
-                    }
-                }
-            }
-        },
-        "/users/login": {
-            "post": {
-                "description": "Authenticates a user and returns a JWT token",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "users"
-                ],
-                "summary": "Login a user",
-                "parameters": [
                     {
-                        "description": "User login payload",
-                        "name": "credentials",
+                        "description": "New role",
+                        "name": "role",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/commands.LoginUserCommand"
+                            "$ref": "#/definitions/commands.ChangeRoleCommand"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User data and token",
+                    "204": {
+                        "description": "Role changed"
+                    },
+                    "400": {
+                        "description": "Invalid id, body or role",
                         "schema": {
-                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
+                            "$ref": "#/definitions/helpers.GetUserResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "403": {
+                        "description": "Not an admin, or own role",
                         "schema": {
                             "$ref": "#/definitions/helpers.GetUserResponse"
                         }
                     },
-                    "401": {
-                        "description": "Authentication failed",
+                    "404": {
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/helpers.GetUserResponse"
                         }
@@ -462,101 +522,120 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/logout": {
-            "post": {
-                "description": "Revokes the current JWT token",
+        "/analytics/me": {
+            "get": {
+                "description": "Returns the impressions, closeups, outbound clicks, saves, shares and engagement rate of the authenticated user's pins over a range of UTC days, both included, with a daily series, the top pins and boards, and the countries and languages of the users who came across them. Without dates the last 30 days are returned; ranges span at most 366 days. Stats are rolled up hourly and cached for a few minutes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Logout user",
+                "summary": "Get my analytics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bearer token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
+                        "description": "First day, as YYYY-MM-DD or an RFC3339 timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, as YYYY-MM-DD or an RFC3339 timestamp",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Logout successful",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     },
-                    "401": {
-                        "description": "Missing or invalid token",
+                    "400": {
+                        "description": "Invalid range",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     },
                     "500": {
-                        "description": "Logout failed",
+                        "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     }
                 }
             }
         },
-        "/users/profilepic/{id}": {
-            "patch": {
-                "description": "Uploads a profile picture for the given user",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/analytics/me/pins/{id}": {
+            "get": {
+                "description": "Returns the impressions, closeups, outbound clicks, saves, shares and engagement rate of a pin of the authenticated user over a range of UTC days, both included, with a daily series and the countries and languages of the users who came across it. Without dates the last 30 days are returned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "analytics"
                 ],
-                "summary": "Upload a user's profile picture",
+                "summary": "Get analytics of one of my pins",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Pin ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Profile picture file",
-                        "name": "profile_pic",
-                        "in": "formData",
-                        "required": true
+                        "type": "string",
+                        "description": "First day, as YYYY-MM-DD or an RFC3339 timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, as YYYY-MM-DD or an RFC3339 timestamp",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Uploaded file info",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request / missing file",
+                        "description": "Invalid id or range",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the pin",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetReportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.GetReportResponse"
                         }
                     }
                 }
             }
         },
-        "/users/restore/{id}": {
-            "patch": {
-                "description": "Restores a user by ID that was previously soft-deleted",
+        "/auth/oidc/complete": {
+            "post": {
+                "description": "Creates the user of a provider account with the fields the provider did not give, and returns the same user data and tokens /users/login returns. The email counts as verified. The names default to the ones the provider gave",
                 "consumes": [
                     "application/json"
                 ],
@@ -564,27 +643,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Restore a deleted user",
+                "summary": "Complete a signup with an identity provider",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Signup token and the missing fields",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CompleteSignupCommand"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Restored user",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID",
+                        "description": "Invalid request body, signup token or field",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Username or email taken",
                         "schema": {
                             "$ref": "#/definitions/helpers.GetUserResponse"
                         }
@@ -598,21 +685,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/username/{username}": {
+        "/auth/oidc/{provider}": {
             "get": {
-                "description": "Returns a single user by username",
+                "description": "Returns the page of the provider to send the user to. The provider sends them back to its callback once they consent, before the login expires",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Get user by username",
+                "summary": "Start a login with an identity provider",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Provider name, like google or apple",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     }
@@ -621,55 +708,110 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.AuthorizationResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid username",
+                    "404": {
+                        "description": "Unknown provider",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.AuthorizationResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserDTO"
+                            "$ref": "#/definitions/helpers.AuthorizationResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Provider unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.AuthorizationResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{id}": {
-            "delete": {
-                "description": "Deletes a user by ID",
+        "/auth/oidc/{provider}/callback": {
+            "get": {
+                "description": "Where the provider sends the user back, in the query or, for providers like Apple, as a form post. A provider account seen before, or whose verified email belongs to a user who verified it too, gets the same user data and tokens /users/login returns, or the two-factor challenge when the user turned it on. Any other account gets a signup token to complete at /auth/oidc/complete",
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Delete a user",
+                "summary": "Finish a login with an identity provider",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Provider name, like google or apple",
+                        "name": "provider",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State of the login",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Error the provider answered with",
+                        "name": "error",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Deleted user",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helpers.GetUserResponse"
+                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Signup to complete",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.SignupResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID",
+                        "description": "Invalid state or id token",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Login denied or email not verified by the provider",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Email not verified",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown provider",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email belongs to a user who has not verified it",
                         "schema": {
                             "$ref": "#/definitions/helpers.GetUserResponse"
                         }
@@ -679,48 +821,5348 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/helpers.GetUserResponse"
                         }
+                    },
+                    "502": {
+                        "description": "Provider unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/verify-email": {
-            "get": {
-                "description": "Verifies the email of a user using a token",
+            },
+            "post": {
+                "description": "Where the provider sends the user back, in the query or, for providers like Apple, as a form post. A provider account seen before, or whose verified email belongs to a user who verified it too, gets the same user data and tokens /users/login returns, or the two-factor challenge when the user turned it on. Any other account gets a signup token to complete at /auth/oidc/complete",
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Verify user's email",
+                "summary": "Finish a login with an identity provider",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Verification token",
-                        "name": "token",
+                        "description": "Provider name, like google or apple",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State of the login",
+                        "name": "state",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Error the provider answered with",
+                        "name": "error",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "303": {
-                        "description": "Redirects to login page with verification success"
-                    },
-                    "400": {
-                        "description": "Missing or invalid token",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
                         }
+                    },
+                    "202": {
+                        "description": "Signup to complete",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.SignupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid state or id token",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Login denied or email not verified by the provider",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Email not verified",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown provider",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email belongs to a user who has not verified it",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Provider unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password/forgot": {
+            "post": {
+                "description": "Emails a link to reset the password to the account of the address. Links are sent at most once a minute and a few times a day per account. It answers the same whether there is such an account or a link was sent or not",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Request a password reset",
+                "parameters": [
+                    {
+                        "description": "Email of the account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.RequestPasswordResetCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or email",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password/reset": {
+            "post": {
+                "description": "Sets a new password with the token of an emailed reset link. Each link works once and for a limited time. Every session of the user is logged out",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Reset the password",
+                "parameters": [
+                    {
+                        "description": "Reset token and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.ResetPasswordCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, token or password",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Trades a refresh token for a new access token and a new refresh token. Each refresh token works once; presenting one again revokes every token of its session, which then has to log in again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.RefreshTokensCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid, expired, revoked or reused refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/revoke": {
+            "post": {
+                "description": "Revokes a refresh token and every other token of its session, so it cannot be refreshed anymore. Revoking an unknown token succeeds as well",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke a refresh token",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.RevokeTokensCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sessions": {
+            "get": {
+                "description": "Returns the sessions of the authenticated user that can still refresh, most recently seen first, marking the one of the request as current. Sessions are seen when they log in and each time they refresh",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "List sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.SessionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.SessionsResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Logs the authenticated user out of every session but the current one, and returns how many were revoked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke every other session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RevokedSessionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RevokedSessionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sessions/{id}": {
+            "delete": {
+                "description": "Logs the authenticated user out of one of their sessions, which may be the current one. Its access tokens stop working at once and its refresh token can no longer refresh",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke a session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/autocomplete/": {
+            "get": {
+                "description": "Suggests users by username or display name, tags and the authenticated user's own boards starting with what was typed, most popular first. A leading @ suggests only users and a leading # only tags. Responses may be cached by the client for a short while",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Autocomplete usernames, tags and boards",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text typed so far",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated kinds to suggest: users, tags, boards (default all)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Suggestions per kind (default 5, max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetAutocompleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid text, type or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetAutocompleteResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetAutocompleteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conversations/": {
+            "get": {
+                "description": "Returns the authenticated user's conversations, most recently active first, with how many messages each has unread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Get conversations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListConversationsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListConversationsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Starts a conversation between the authenticated user and up to nine others. Starting a one to one conversation that already exists returns it. Groups may have a title",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Start a conversation",
+                "parameters": [
+                    {
+                        "description": "Conversation payload",
+                        "name": "conversation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CreateConversationCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "A member is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conversations/{id}/messages": {
+            "get": {
+                "description": "Returns a page of messages, newest first. Pass next_before as before and next_before_id as before_id to get older messages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Get the messages of a conversation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only messages sent before this RFC3339 timestamp",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Id of the last message read, for the ones sent at before",
+                        "name": "before_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessagesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id, limit, before or before_id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessagesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conversation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessagesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessagesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Sends a message to a conversation of the authenticated user. A message carries text, a shared pin or board, or text with one of them. Members who block each other cannot message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Send a message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message payload",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.SendMessageCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "A member is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conversation, pin or board not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conversations/{id}/read": {
+            "patch": {
+                "description": "Moves the authenticated user's read marker to now",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Mark a conversation as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Marked as read"
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Conversation not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetConversationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/": {
+            "post": {
+                "description": "Records a batch of up to 100 impressions, closeups, outbound clicks, saves and shares seen by the authenticated session. Each pin and kind counts once per session, events of pins the user cannot see are dropped and events older than a day are rejected. Events without a time happened now. They are written shortly after and show up in the hourly pin and board stats",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Track pin events",
+                "parameters": [
+                    {
+                        "description": "Event batch",
+                        "name": "events",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.TrackEventsCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TrackEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or event",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TrackEventsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TrackEventsResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Too many events, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TrackEventsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/explore/categories": {
+            "get": {
+                "description": "Returns the interest taxonomy, root categories first, each with its subcategories nested in name order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "explore"
+                ],
+                "summary": "Get the category tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryTreeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryTreeResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/explore/categories/{slug}": {
+            "get": {
+                "description": "Returns a category with its path from the root and its subcategories, and a page of the pins tagged with any tag mapped into it or its subcategories. Pins rank by saves, comments and likes, newer pins weighing more. Pins the authenticated user cannot see are left out. Pass next_cursor as cursor to get the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "explore"
+                ],
+                "summary": "Browse a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 25, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryPageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryPageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCategoryPageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/explore/trending/pins": {
+            "get": {
+                "description": "Returns the pins with the most saves, comments, likes and views lately, newer engagement weighing more. Pass a country and/or a language, by code or name, to see what is hot among those users. Pins the authenticated user cannot see are left out",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "explore"
+                ],
+                "summary": "Get trending pins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Country code or name",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language code or name",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of pins (default 25, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid country, language or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/explore/trending/tags": {
+            "get": {
+                "description": "Returns the tags of the pins with the most saves, comments, likes and views lately, newer engagement weighing more. Pass a country and/or a language, by code or name, to see what is hot among those users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "explore"
+                ],
+                "summary": "Get trending tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Country code or name",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language code or name",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of tags (default 25, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTrendingTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid country, language or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTrendingTagsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTrendingTagsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed/": {
+            "get": {
+                "description": "Returns the newest pins of the users, boards and tags the authenticated user follows, without duplicates and leaving out blocked and muted users. Each page is ranked for the user, from the tags of the pins they saved and liked, the tags they follow and the language and country of their profile. Pass next_cursor as cursor to get the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Get the home feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 25, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add the ranking score and the reason behind it to each pin",
+                        "name": "explain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFeedPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit, cursor or explain",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFeedPageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFeedPageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed/boards/{id}/follow": {
+            "post": {
+                "description": "Adds the pins of another user's public board to the authenticated user's home feed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Follow a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id or own board",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Board owner is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already following",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops following a board. Its pins leave the home feed unless another followed user or tag still brings them in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Unfollow a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unfollowed"
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not following this board",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBoardFollowResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed/tags/{tag}/follow": {
+            "post": {
+                "description": "Adds pins tagged with a hashtag, with or without the leading #, to the authenticated user's home feed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Follow a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already following",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops following a hashtag. Its pins leave the home feed unless another followed user or board still brings them in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Unfollow a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unfollowed"
+                    },
+                    "400": {
+                        "description": "Invalid tag",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not following this tag",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetTagFollowResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/totp": {
+            "post": {
+                "description": "Returns a new TOTP secret and its otpauth URI to set an authenticator app up with, usually by scanning it as a QR code. Two-factor authentication is off until it is confirmed with a first code",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Set up two-factor authentication",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.EnrollmentResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Two-factor authentication is already on",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.EnrollmentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.EnrollmentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/totp/confirm": {
+            "post": {
+                "description": "Confirms the secret with the code the app shows and returns ten recovery codes, each of which logs in once in place of a code. They are not shown again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Turn two-factor authentication on",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Code from the app",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.ConfirmFactorCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RecoveryCodesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or code",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RecoveryCodesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Two-factor authentication was not set up",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RecoveryCodesResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Two-factor authentication is already on",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RecoveryCodesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RecoveryCodesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/totp/disable": {
+            "post": {
+                "description": "Turns two-factor authentication off and drops the recovery codes. It takes the password along with a code from the app or a recovery code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Turn two-factor authentication off",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Password and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.DisableFactorCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Wrong password or code",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Two-factor authentication was not set up",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mfa/verify": {
+            "post": {
+                "description": "Trades the challenge token a login returned, along with a code from the app or a recovery code, for an access token and a refresh token. A challenge is dropped after five wrong codes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mfa"
+                ],
+                "summary": "Finish a two-factor login",
+                "parameters": [
+                    {
+                        "description": "Challenge token and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.VerifyChallengeCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired challenge, or wrong code",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.TokenPairResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/": {
+            "get": {
+                "description": "Returns the authenticated user's notifications, newest first, with the unread count. Pass next_before as before and next_before_id as before_id to get the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get the notification inbox",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only notifications created before this RFC3339 timestamp",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Id of the last notification read, for the ones created at before",
+                        "name": "before_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetInboxResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit, before or before_id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetInboxResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetInboxResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read-all": {
+            "patch": {
+                "description": "Marks all of the authenticated user's unread notifications as read and returns how many changed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark every notification as read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMarkAllReadResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMarkAllReadResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/stream": {
+            "get": {
+                "description": "Pushes notification and unread_count events to the authenticated user. Notification events carry the notification id; on reconnect send it back as the Last-Event-ID header (or last_event_id query) to receive what was missed. The token may be passed as access_token for EventSource clients",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Stream notifications (Server-Sent Events)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT, for clients that cannot set headers",
+                        "name": "access_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last notification id received",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "Streaming unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "description": "Returns how many of the authenticated user's notifications are unread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get the unread notification count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUnreadCountResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUnreadCountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/ws": {
+            "get": {
+                "description": "Same events as /notifications/stream, sent as JSON messages {id, type, data}. Heartbeats are sent as {\"type\":\"heartbeat\"}. Only the pages of the allowed origins may connect",
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Stream notifications (WebSocket)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT, for clients that cannot set headers",
+                        "name": "access_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last notification id received",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Origin not allowed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "patch": {
+                "description": "Marks one of the authenticated user's notifications as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetNotificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetNotificationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetNotificationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetNotificationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys": {
+            "get": {
+                "description": "Returns the passkeys of the authenticated user, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "List passkeys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeysResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeysResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys/login/begin": {
+            "post": {
+                "description": "Returns the options to call navigator.credentials.get with, its challenge base64url encoded. The challenge is answered once, before it expires, at /passkeys/login/finish",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "Start a passkey login",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RequestOptionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.RequestOptionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys/login/finish": {
+            "post": {
+                "description": "Trades the assertion navigator.credentials.get returned, serialized with toJSON(), for the same user data and tokens /users/login returns. A passkey verifies the user itself, so no two-factor code is asked for",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "Log in with a passkey",
+                "parameters": [
+                    {
+                        "description": "Assertion of the passkey",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.FinishLoginCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid challenge, unknown passkey or wrong signature",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Email not verified",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys/register/begin": {
+            "post": {
+                "description": "Returns the options to call navigator.credentials.create with, its buffers base64url encoded. The challenge is answered once, before it expires, at /passkeys/register/finish",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "Start adding a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.CreationOptionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.CreationOptionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.CreationOptionsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys/register/finish": {
+            "post": {
+                "description": "Keeps the passkey navigator.credentials.create returned, serialized with toJSON(). Only attestation of format none is accepted, and the authenticator must have verified the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "Add a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Credential created and an optional name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.FinishRegistrationCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, challenge or credential",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeyResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Passkey already registered",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.PasskeyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/passkeys/{id}": {
+            "delete": {
+                "description": "Removes a passkey of the authenticated user, which can no longer log in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "passkeys"
+                ],
+                "summary": "Remove a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passkey id, base64url encoded",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid passkey id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/": {
+            "put": {
+                "description": "Updates a pin owned by the authenticated user and re-resolves its mentions and hashtags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Update a pin",
+                "parameters": [
+                    {
+                        "description": "Pin update payload",
+                        "name": "pin",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.UpdatePinCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden: cannot update another user's pin",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/create": {
+            "post": {
+                "description": "Creates a pin for the authenticated user. @mentions in the description are linked to users and #hashtags become tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Create a pin",
+                "parameters": [
+                    {
+                        "description": "Pin payload",
+                        "name": "pin",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CreatePinCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Board belongs to another user",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/id/{id}": {
+            "get": {
+                "description": "Returns a single pin with the offsets of the mentions and hashtags in its description",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Get pin by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/tag/{tag}": {
+            "get": {
+                "description": "Returns the active pins linked to a tag, with or without the leading #",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Get pins by tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/{id}/comments": {
+            "get": {
+                "description": "Returns the active comments of a pin, oldest first, with the offsets of their mentions and hashtags",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Get the comments of a pin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListCommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListCommentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListCommentsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListCommentsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a comment from the authenticated user. @mentions are linked to users and #hashtags to the tags the pin already has; comments never change the tags of the pin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Comment on a pin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment payload",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CreateCommentCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCommentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Blocked by the pin owner",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCommentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCommentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCommentResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/{id}/like": {
+            "post": {
+                "description": "Marks a pin as liked by the authenticated user and increases its like count",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Like a pin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Blocked by the pin owner",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pin already liked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the authenticated user's like from a pin and decreases its like count",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Unlike a pin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not liked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/{id}/related": {
+            "get": {
+                "description": "Returns the pins most like this one, ranked by shared tags, boards that saved both pins and similar board names. The authenticated user's own pins and pins they cannot see are left out",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Get related pins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of pins (default 25, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id or limit",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListPinsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/pins/{id}/save": {
+            "post": {
+                "description": "Saves a pin to one of the authenticated user's boards and notifies the pin owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pins"
+                ],
+                "summary": "Save a pin to a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Board to save the pin to",
+                        "name": "save",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.SavePinCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Board belongs to another user or blocked by the pin owner",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pin or board not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pin already saved to this board",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetPinResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/": {
+            "get": {
+                "description": "Full-text search over pin titles, descriptions and tags, board names and descriptions, and usernames and names, best match first. Words are stemmed in the given language, or the authenticated user's, and also matched as typed. Supports \"quoted phrases\", OR and -excluded words. Snippets are escaped HTML with the matched words wrapped in \u003cmark\u003e tags. Pass next_cursor as cursor to get the next page. Filters combine with AND; tag, board and has_image only match pins. The first page also returns facet counts for the filtered results: top tags and boards, creator countries and languages, results created in the last day, week, month and year, and pins with and without an image",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search pins, boards and users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated kinds to search: pins, boards, users (default all)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language code or name used for stemming; also keeps only content whose creator writes in it",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only pins with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only pins on this board",
+                        "name": "board",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Creator country code or name",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after, RFC3339 timestamp or YYYY-MM-DD date",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before, RFC3339 timestamp or YYYY-MM-DD date",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only pins with (true) or without (false) an image",
+                        "name": "has_image",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 25, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetSearchPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query, type, language, filter, limit or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetSearchPageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetSearchPageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/": {
+            "put": {
+                "description": "Updates the authenticated user's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "description": "User update payload",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.UpdateUserCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden: cannot update another user",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/blocked": {
+            "get": {
+                "description": "Returns the users the authenticated user has blocked, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get blocked users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListBlocksDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListBlocksDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/countries": {
+            "get": {
+                "description": "Returns a list of available countries",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List all countries",
+                "responses": {
+                    "200": {
+                        "description": "List of countries",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetCountriesList"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/country/{country}": {
+            "get": {
+                "description": "Returns a list of users filtered by country",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users by country",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Country name",
+                        "name": "country",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid country",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/create": {
+            "post": {
+                "description": "Creates a new user in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation payload",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.CreateUserCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/email/{email}": {
+            "get": {
+                "description": "Returns a single user by email address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User email",
+                        "name": "email",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid email",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/id/{id}": {
+            "get": {
+                "description": "Returns a single user by UUID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/language/{language}": {
+            "get": {
+                "description": "Returns a list of users filtered by language",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users by language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Country name",
+                        "name": "country",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid country",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/languages": {
+            "get": {
+                "description": "Returns a list of available languages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List all languages",
+                "responses": {
+                    "200": {
+                        "description": "List of languages",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetLanguagesList"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/like-username/{username}": {
+            "get": {
+                "description": "Returns a list of users whose usernames match the provided pattern",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users by partial username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username pattern",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid username",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/list": {
+            "get": {
+                "description": "Returns all users where deleted_at IS NULL",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get list of active users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListUsersDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Authenticates a user and returns a short-lived JWT token along with a refresh token to get new ones at /auth/refresh. Users with two-factor authentication on get a challenge token instead, to trade for the tokens at /mfa/verify along with a code. A wrong email or password answer alike. After a few failures an account or an address has to wait between attempts, as Retry-After tells, and an account failing too often is locked until the link emailed to its owner is followed or the lock expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "User login payload",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.LoginUserCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User data and token, or mfa_required and challenge_token when a code is needed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LoginSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed logins or account locked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/muted": {
+            "get": {
+                "description": "Returns the users the authenticated user has muted, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get muted users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListMutesDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListMutesDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/out": {
+            "post": {
+                "description": "Logs the current session out, like revoking it at /auth/sessions/{id}: its refresh tokens and every access token of it stop working. Tokens of older logins with no session revoke the JWT token, and the refresh token sent as {\"refresh_token\": \"...\"} in the body",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Logout user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Logout successful",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Logout failed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profilepic/{id}": {
+            "patch": {
+                "description": "Uploads a profile picture for the given user. Users may only change their own; admins may change anyone's",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Upload a user's profile picture",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Profile picture file",
+                        "name": "profile_pic",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uploaded file info",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request / missing file",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden: cannot change another user's picture",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/restore/{id}": {
+            "patch": {
+                "description": "Restores a user by ID that was previously soft-deleted. Users may only restore themselves; moderators may restore plain users too, and admins anyone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Restore a deleted user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored user",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden: cannot restore another user, or one of an equal or higher role",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/unlock": {
+            "post": {
+                "description": "Lifts the lock that too many failed logins put on an account, using the token of the link emailed to its owner when it was locked, and forgets those failures. A link works once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unlock an account",
+                "parameters": [
+                    {
+                        "description": "Token of the emailed link",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.UnlockAccountCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, or invalid, expired or used link",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/username/{username}": {
+            "get": {
+                "description": "Returns a single user by username",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid username",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify-email/resend": {
+            "post": {
+                "description": "Emails a new verification link to the account of the address if it is not verified yet. Links are sent at most once a minute and a few times a day per account. It answers the same whether a link was sent or not",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Resend the verification email",
+                "parameters": [
+                    {
+                        "description": "Email of the account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/commands.ResendVerificationCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or email",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.LogoutSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "delete": {
+                "description": "Deletes a user by ID and logs them out of every session. Users may only delete themselves; moderators may delete plain users too, and admins anyone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted user",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden: cannot delete another user, or one of an equal or higher role",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/block": {
+            "post": {
+                "description": "Blocks another user for the authenticated user. Both stop seeing each other's pins, comments and profiles in search, cannot follow, comment on, mention or message each other, and any follows between them are removed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Block a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id or self block",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Already blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a block the authenticated user created. Follows removed by the block are not restored",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unblock a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unblocked"
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User is not blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetBlockDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/follow": {
+            "post": {
+                "description": "The authenticated user starts following another user, who gets a notification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Follow a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id or self follow",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Already following",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "The authenticated user stops following another user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unfollow a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unfollowed"
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Not following this user",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetFollowDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/followers": {
+            "get": {
+                "description": "Returns who follows the user, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the followers of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/following": {
+            "get": {
+                "description": "Returns who the user follows, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the users a user follows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetListFollowsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/mute": {
+            "post": {
+                "description": "Hides another user's pins from the authenticated user's feed. The muted user is not told and nothing else changes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Mute a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid id or self mute",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Already muted",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Shows a muted user's pins in the authenticated user's feed again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unmute a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unmuted"
+                    },
+                    "400": {
+                        "description": "Invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User is not muted",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.GetMuteDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/verify-email": {
+            "get": {
+                "description": "Verifies the email of a user using the token of the emailed link, then redirects to the configured page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify user's email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Verification token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "Redirects to login page with verification success"
+                    },
+                    "400": {
+                        "description": "Missing, invalid, expired or used token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "commands.AssertionResponse": {
+            "type": "object",
+            "properties": {
+                "authenticatorData": {
+                    "type": "string"
+                },
+                "clientDataJSON": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "userHandle": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.ChangeRoleCommand": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CompleteSignupCommand": {
+            "type": "object",
+            "properties": {
+                "birth": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "signup_token": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.ConfirmFactorCommand": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CreateCategoryCommand": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CreateCommentCommand": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CreateConversationCommand": {
+            "type": "object",
+            "properties": {
+                "member_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CreatePinCommand": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.CreateUserCommand": {
+            "type": "object",
+            "properties": {
+                "birth": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.DisableFactorCommand": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.FinishLoginCommand": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "response": {
+                    "$ref": "#/definitions/commands.AssertionResponse"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.FinishRegistrationCommand": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "response": {
+                    "$ref": "#/definitions/commands.RegistrationResponse"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.LoginUserCommand": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.RefreshTokensCommand": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.RegistrationResponse": {
+            "type": "object",
+            "properties": {
+                "attestationObject": {
+                    "type": "string"
+                },
+                "clientDataJSON": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.RequestPasswordResetCommand": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.ResendVerificationCommand": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.ResetPasswordCommand": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.RevokeTokensCommand": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.SavePinCommand": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.SendMessageCommand": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "conversation_id": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.TrackEventCommand": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.TrackEventsCommand": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/commands.TrackEventCommand"
+                    }
+                }
+            }
+        },
+        "commands.UnlockAccountCommand": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.UpdateCategoryCommand": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "root": {
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.UpdatePinCommand": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "commands.UpdateUserCommand": {
+            "type": "object",
+            "properties": {
+                "birth": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "information": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "profile_pic": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "boolean"
+                },
+                "web_site": {
+                    "type": "string"
+                }
+            }
+        },
+        "commands.VerifyChallengeCommand": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AudienceDTO": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SegmentDTO"
+                    }
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SegmentDTO"
+                    }
+                }
+            }
+        },
+        "dto.AuthenticatorSelectionDTO": {
+            "type": "object",
+            "properties": {
+                "requireResidentKey": {
+                    "type": "boolean"
+                },
+                "residentKey": {
+                    "type": "string"
+                },
+                "userVerification": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuthorizationDTO": {
+            "type": "object",
+            "properties": {
+                "authorization_url": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AutocompleteDTO": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SuggestionDTO"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SuggestionDTO"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SuggestionDTO"
+                    }
+                }
+            }
+        },
+        "dto.BlockDTO": {
+            "type": "object",
+            "properties": {
+                "blocked_id": {
+                    "type": "string"
+                },
+                "blocker_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BoardFollowDTO": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BoardStatsDTO": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/dto.StatsDTO"
+                }
+            }
+        },
+        "dto.CategoryDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategoryNodeDTO": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryNodeDTO"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategoryPageDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/dto.CategoryDTO"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryDTO"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryDTO"
+                    }
+                },
+                "pins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PinDTO"
+                    }
+                }
+            }
+        },
+        "dto.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TextEntityDTO"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConversationDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_group": {
+                    "type": "boolean"
+                },
+                "last_message_at": {
+                    "type": "string"
+                },
+                "member_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreationOptionsDTO": {
+            "type": "object",
+            "properties": {
+                "attestation": {
+                    "type": "string"
+                },
+                "authenticatorSelection": {
+                    "$ref": "#/definitions/dto.AuthenticatorSelectionDTO"
+                },
+                "challenge": {
+                    "type": "string"
+                },
+                "excludeCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CredentialDescriptorDTO"
+                    }
+                },
+                "pubKeyCredParams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CredentialParameterDTO"
+                    }
+                },
+                "rp": {
+                    "$ref": "#/definitions/dto.RelyingPartyDTO"
+                },
+                "timeout": {
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserEntityDTO"
+                }
+            }
+        },
+        "dto.CredentialDescriptorDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CredentialParameterDTO": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DayDTO": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/dto.StatsDTO"
+                }
+            }
+        },
+        "dto.EnrollmentDTO": {
+            "type": "object",
+            "properties": {
+                "otpauth_uri": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FacetBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FeedPageDTO": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "pins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FeedPinDTO"
+                    }
+                }
+            }
+        },
+        "dto.FeedPinDTO": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TextEntityDTO"
+                    }
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "save_count": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagDTO"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.FollowDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "followee_id": {
+                    "type": "string"
+                },
+                "follower_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InboxDTO": {
+            "type": "object",
+            "properties": {
+                "next_before": {
+                    "type": "string"
+                },
+                "next_before_id": {
+                    "type": "string"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationResponse"
+                    }
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.MessageDTO": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "conversation_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "sender_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MessagesPageDTO": {
+            "type": "object",
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MessageDTO"
+                    }
+                },
+                "next_before": {
+                    "type": "string"
+                },
+                "next_before_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MuteDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "muted_id": {
+                    "type": "string"
+                },
+                "muter_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationResponse": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "comment_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "pin_id": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "recipient_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasskeyDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PinDTO": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TextEntityDTO"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "save_count": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagDTO"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.PinResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TextEntityDTO"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "save_count": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagDTO"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.PinStatsDTO": {
+            "type": "object",
+            "properties": {
+                "pin_id": {
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/dto.StatsDTO"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RecoveryCodesDTO": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.RelyingPartyDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReportDTO": {
+            "type": "object",
+            "properties": {
+                "audience": {
+                    "$ref": "#/definitions/dto.AudienceDTO"
+                },
+                "daily": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DayDTO"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "top_boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BoardStatsDTO"
+                    }
+                },
+                "top_pins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PinStatsDTO"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/dto.StatsDTO"
+                }
+            }
+        },
+        "dto.RequestOptionsDTO": {
+            "type": "object",
+            "properties": {
+                "challenge": {
+                    "type": "string"
+                },
+                "rpId": {
+                    "type": "string"
+                },
+                "timeout": {
+                    "type": "integer"
+                },
+                "userVerification": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SearchFacetsDTO": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "has_image": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetBucketDTO"
+                    }
+                }
+            }
+        },
+        "dto.SearchPageDTO": {
+            "type": "object",
+            "properties": {
+                "facets": {
+                    "$ref": "#/definitions/dto.SearchFacetsDTO"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SearchResultDTO"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "commands.CreateUserCommand": {
+        },
+        "dto.SearchResultDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SegmentDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SessionDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SignupDTO": {
+            "type": "object",
+            "properties": {
+                "completion_required": {
+                    "type": "boolean"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "signup_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StatsDTO": {
+            "type": "object",
+            "properties": {
+                "closeups": {
+                    "type": "integer"
+                },
+                "engagement_rate": {
+                    "type": "number"
+                },
+                "engagements": {
+                    "type": "integer"
+                },
+                "impressions": {
+                    "type": "integer"
+                },
+                "outbound_clicks": {
+                    "type": "integer"
+                },
+                "saves": {
+                    "type": "integer"
+                },
+                "shares": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SuggestionDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "popularity": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagFollowDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TextEntityDTO": {
+            "type": "object",
+            "properties": {
+                "length": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "tag_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TokenPairDTO": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "refresh_expires_in": {
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TrackEventsDTO": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "duplicates": {
+                    "type": "integer"
+                },
+                "hidden": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.TrendingTagDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.UserDTO": {
+            "type": "object",
+            "properties": {
+                "birth": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "information": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "profilePic": {
+                    "type": "string"
+                },
+                "profilePicURL": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "verified": {
+                    "type": "boolean"
+                },
+                "visibility": {
+                    "type": "boolean"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserEntityDTO": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserResponse": {
             "type": "object",
             "properties": {
                 "birth": {
@@ -729,6 +6171,12 @@ const docTemplate = `{
                 "country": {
                     "type": "string"
                 },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -738,245 +6186,595 @@ const docTemplate = `{
                 "gender": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
+                "information": {
+                    "type": "string"
+                },
                 "language": {
                     "type": "string"
                 },
-                "last_name": {
+                "last_login_at": {
                     "type": "string"
                 },
-                "password": {
+                "last_name": {
                     "type": "string"
                 },
                 "phone": {
                     "type": "string"
                 },
+                "profilePic": {
+                    "type": "string"
+                },
+                "profilePicURL": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
+                },
+                "verified": {
+                    "type": "boolean"
+                },
+                "visibility": {
+                    "type": "boolean"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "helpers.AuthorizationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.AuthorizationDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.CreationOptionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CreationOptionsDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.EnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.EnrollmentDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.Error": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "err": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "helpers.GetAutocompleteResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.AutocompleteDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetBlockDTO": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.BlockDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetBoardFollowResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.BoardFollowDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetCategoryPageResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CategoryPageDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CategoryDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetCategoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryNodeDTO"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetCommentResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CommentResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetConversationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ConversationDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetCountriesList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/shared.Country"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "commands.LoginUserCommand": {
+        "helpers.GetFeedPageResponse": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/dto.FeedPageDTO"
                 },
-                "password": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "commands.UpdateUserCommand": {
+        "helpers.GetFollowDTO": {
             "type": "object",
             "properties": {
-                "birth": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/dto.FollowDTO"
                 },
-                "country": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "email": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetInboxResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.InboxDTO"
                 },
-                "first_name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "gender": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetLanguagesList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/shared.Language"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListBlocksDTO": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BlockDTO"
+                    }
                 },
-                "information": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "language": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "last_name": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentResponse"
+                    }
                 },
-                "password": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "phone": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "profile_pic": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListConversationsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConversationDTO"
+                    }
                 },
-                "user_name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "visibility": {
+                "success": {
                     "type": "boolean"
-                },
-                "web_site": {
-                    "type": "string"
                 }
             }
         },
-        "dto.UserDTO": {
+        "helpers.GetListFollowsDTO": {
             "type": "object",
             "properties": {
-                "birth": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FollowDTO"
+                    }
                 },
-                "email": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "first_name": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "gender": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListMutesDTO": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MuteDTO"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "information": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "language": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListPinsDTO": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PinDTO"
+                    }
                 },
-                "last_name": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "phone": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "profilePic": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetListTagsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagDTO"
+                    }
                 },
-                "profilePicURL": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "username": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "visibility": {
+                "success": {
                     "type": "boolean"
-                },
-                "website": {
-                    "type": "string"
                 }
             }
         },
-        "dto.UserResponse": {
+        "helpers.GetListUsersDTO": {
             "type": "object",
             "properties": {
-                "birth": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserDTO"
+                    }
                 },
-                "country": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "created_at": {
-                    "type": "string"
+                "length": {
+                    "type": "integer"
                 },
-                "deleted_at": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetMarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "integer"
                 },
-                "email": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "first_name": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetMessageResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.MessageDTO"
                 },
-                "gender": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "id": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetMessagesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.MessagesPageDTO"
                 },
-                "information": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "language": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetMuteDTO": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.MuteDTO"
                 },
-                "last_login_at": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "last_name": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetNotificationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.NotificationResponse"
                 },
-                "phone": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "profilePic": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetPinResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.PinResponse"
                 },
-                "profilePicURL": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "updated_at": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.GetReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ReportDTO"
                 },
-                "username": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "visibility": {
+                "success": {
                     "type": "boolean"
-                },
-                "website": {
-                    "type": "string"
                 }
             }
         },
-        "helpers.Error": {
+        "helpers.GetSearchPageResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/dto.SearchPageDTO"
                 },
-                "err": {
-                    "type": "string"
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
-                "message": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "helpers.GetCountriesList": {
+        "helpers.GetTagFollowResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/shared.Country"
-                    }
+                    "$ref": "#/definitions/dto.TagFollowDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "helpers.GetLanguagesList": {
+        "helpers.GetTagResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/shared.Language"
-                    }
+                    "$ref": "#/definitions/dto.TagDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "helpers.GetListUsersDTO": {
+        "helpers.GetTrendingTagsResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.UserDTO"
+                        "$ref": "#/definitions/dto.TrendingTagDTO"
                     }
                 },
                 "error": {
@@ -990,6 +6788,20 @@ const docTemplate = `{
                 }
             }
         },
+        "helpers.GetUnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "integer"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "helpers.GetUserDTO": {
             "type": "object",
             "properties": {
@@ -1030,6 +6842,12 @@ const docTemplate = `{
                 "data": {
                     "type": "object",
                     "properties": {
+                        "expires_in": {
+                            "type": "integer"
+                        },
+                        "refresh_token": {
+                            "type": "string"
+                        },
                         "token": {
                             "type": "string"
                         },
@@ -1057,6 +6875,181 @@ const docTemplate = `{
                 }
             }
         },
+        "helpers.PasskeyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.PasskeyDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.PasskeysResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PasskeyDTO"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.RecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.RecoveryCodesDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.RequestOptionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.RequestOptionsDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.RevokedSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "integer"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.SessionsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionDTO"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.SignupResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SignupDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.TokenPairResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TokenPairDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "helpers.TrackEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TrackEventsDTO"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.Error"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "services.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "crv": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "string"
+                },
+                "y": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.JWKSet": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.JWK"
+                    }
+                }
+            }
+        },
         "shared.Country": {
             "type": "string",
             "enum": [
@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/google/uuid"
+
+type StatsDTO struct {
+	Impressions    int     `json:"impressions"`
+	Closeups       int     `json:"closeups"`
+	OutboundClicks int     `json:"outbound_clicks"`
+	Saves          int     `json:"saves"`
+	Shares         int     `json:"shares"`
+	Engagements    int     `json:"engagements"`
+	EngagementRate float64 `json:"engagement_rate"`
+}
+
+type DayDTO struct {
+	Date  string    `json:"date"`
+	Stats *StatsDTO `json:"stats"`
+}
+
+type PinStatsDTO struct {
+	PinId uuid.UUID `json:"pin_id"`
+	Title string    `json:"title"`
+	Stats *StatsDTO `json:"stats"`
+}
+
+type BoardStatsDTO struct {
+	BoardId uuid.UUID `json:"board_id"`
+	Name    string    `json:"name"`
+	Stats   *StatsDTO `json:"stats"`
+}
+
+type SegmentDTO struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+	Users int    `json:"users"`
+}
+
+type AudienceDTO struct {
+	Countries []*SegmentDTO `json:"countries"`
+	Languages []*SegmentDTO `json:"languages"`
+}
+
+type ReportDTO struct {
+	From      string           `json:"from"`
+	To        string           `json:"to"`
+	Totals    *StatsDTO        `json:"totals"`
+	Daily     []*DayDTO        `json:"daily"`
+	TopPins   []*PinStatsDTO   `json:"top_pins,omitempty"`
+	TopBoards []*BoardStatsDTO `json:"top_boards,omitempty"`
+	Audience  *AudienceDTO     `json:"audience"`
+}
@@ -0,0 +1,137 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"time"
+)
+
+func MapToStatsDTO(stats analytics.Stats) *dto.StatsDTO {
+	return &dto.StatsDTO{
+		Impressions:    stats.Impressions(),
+		Closeups:       stats.Closeups(),
+		OutboundClicks: stats.OutboundClicks(),
+		Saves:          stats.Saves(),
+		Shares:         stats.Shares(),
+		Engagements:    stats.Engagements(),
+		EngagementRate: stats.EngagementRate(),
+	}
+}
+
+func MapToSegmentDTO(segment analytics.Segment, dimension analytics.Dimension) *dto.SegmentDTO {
+	label := shared.Country(segment.Code()).String()
+	if dimension == analytics.LanguageDimension {
+		label = shared.Language(segment.Code()).String()
+	}
+
+	return &dto.SegmentDTO{
+		Code:  segment.Code(),
+		Label: label,
+		Users: segment.Users(),
+	}
+}
+
+// MapToReportDTO lists the top pins and boards only when the report ranks
+// any, so pin reports leave them out.
+func MapToReportDTO(report *analytics.Report) *dto.ReportDTO {
+	reportDto := &dto.ReportDTO{
+		From:   report.Range().From().Format(time.DateOnly),
+		To:     report.Range().To().Format(time.DateOnly),
+		Totals: MapToStatsDTO(report.Totals()),
+		Daily:  make([]*dto.DayDTO, 0, len(report.Daily())),
+		Audience: &dto.AudienceDTO{
+			Countries: make([]*dto.SegmentDTO, 0, len(report.Countries())),
+			Languages: make([]*dto.SegmentDTO, 0, len(report.Languages())),
+		},
+	}
+
+	for _, d := range report.Daily() {
+		reportDto.Daily = append(reportDto.Daily, &dto.DayDTO{
+			Date:  d.Date().Format(time.DateOnly),
+			Stats: MapToStatsDTO(d.Stats()),
+		})
+	}
+
+	for _, p := range report.TopPins() {
+		reportDto.TopPins = append(reportDto.TopPins, &dto.PinStatsDTO{
+			PinId: p.PinId(),
+			Title: p.Title(),
+			Stats: MapToStatsDTO(p.Stats()),
+		})
+	}
+
+	for _, b := range report.TopBoards() {
+		reportDto.TopBoards = append(reportDto.TopBoards, &dto.BoardStatsDTO{
+			BoardId: b.BoardId(),
+			Name:    b.Name(),
+			Stats:   MapToStatsDTO(b.Stats()),
+		})
+	}
+
+	for _, s := range report.Countries() {
+		reportDto.Audience.Countries = append(reportDto.Audience.Countries, MapToSegmentDTO(s, analytics.CountryDimension))
+	}
+
+	for _, s := range report.Languages() {
+		reportDto.Audience.Languages = append(reportDto.Audience.Languages, MapToSegmentDTO(s, analytics.LanguageDimension))
+	}
+
+	return reportDto
+}
+
+func MapToStats(statsDto *dto.StatsDTO) analytics.Stats {
+	if statsDto == nil {
+		return analytics.Stats{}
+	}
+	return analytics.NewStats(statsDto.Impressions, statsDto.Closeups, statsDto.OutboundClicks, statsDto.Saves, statsDto.Shares)
+}
+
+// MapToReport reads back a report mapped by MapToReportDTO.
+func MapToReport(reportDto *dto.ReportDTO) (*analytics.Report, error) {
+	from, err := time.Parse(time.DateOnly, reportDto.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := time.Parse(time.DateOnly, reportDto.To)
+	if err != nil {
+		return nil, err
+	}
+
+	rng, err := analytics.NewRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]analytics.Day, 0, len(reportDto.Daily))
+	for _, d := range reportDto.Daily {
+		date, err := time.Parse(time.DateOnly, d.Date)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, analytics.NewDay(date, MapToStats(d.Stats)))
+	}
+
+	topPins := make([]analytics.PinStats, 0, len(reportDto.TopPins))
+	for _, p := range reportDto.TopPins {
+		topPins = append(topPins, analytics.NewPinStats(p.PinId, p.Title, MapToStats(p.Stats)))
+	}
+
+	topBoards := make([]analytics.BoardStats, 0, len(reportDto.TopBoards))
+	for _, b := range reportDto.TopBoards {
+		topBoards = append(topBoards, analytics.NewBoardStats(b.BoardId, b.Name, MapToStats(b.Stats)))
+	}
+
+	var countries, languages []analytics.Segment
+	if reportDto.Audience != nil {
+		for _, s := range reportDto.Audience.Countries {
+			countries = append(countries, analytics.NewSegment(s.Code, s.Users))
+		}
+		for _, s := range reportDto.Audience.Languages {
+			languages = append(languages, analytics.NewSegment(s.Code, s.Users))
+		}
+	}
+
+	return analytics.NewReport(rng, days, topPins, topBoards, countries, languages), nil
+}
@@ -0,0 +1,13 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type GetReportQuery struct {
+	OwnerId uuid.UUID  `json:"owner_id"`
+	PinId   *uuid.UUID `json:"pin_id,omitempty"`
+	From    *time.Time `json:"from,omitempty"`
+	To      *time.Time `json:"to,omitempty"`
+}
@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+type CreateCategoryCommand struct {
+	ParentId    *uuid.UUID `json:"parent_id,omitempty"`
+	Slug        *string    `json:"slug,omitempty"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+}
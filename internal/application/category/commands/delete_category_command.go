@@ -0,0 +1,7 @@
+package commands
+
+import "github.com/google/uuid"
+
+type DeleteCategoryCommand struct {
+	Id uuid.UUID `json:"id"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type MapTagCommand struct {
+	CategoryId uuid.UUID `json:"category_id"`
+	Tag        string    `json:"tag"`
+}
@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/google/uuid"
+
+// UpdateCategoryCommand changes the fields given. ParentId moves the category
+// under another one and Root makes it a root; an empty description clears it.
+type UpdateCategoryCommand struct {
+	Id          uuid.UUID  `json:"id"`
+	ParentId    *uuid.UUID `json:"parent_id,omitempty"`
+	Root        bool       `json:"root,omitempty"`
+	Slug        *string    `json:"slug,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+}
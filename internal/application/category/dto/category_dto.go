@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/google/uuid"
+)
+
+type CategoryDTO struct {
+	Id          uuid.UUID  `json:"id"`
+	ParentId    *uuid.UUID `json:"parent_id,omitempty"`
+	Slug        string     `json:"slug"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+}
+
+type CategoryNodeDTO struct {
+	*CategoryDTO
+	Children []*CategoryNodeDTO `json:"children"`
+}
+
+// CategoryPageDTO is a category as browsed: where it sits in the taxonomy,
+// its subcategories and a page of its pins, best first.
+type CategoryPageDTO struct {
+	Category   *CategoryDTO   `json:"category"`
+	Path       []*CategoryDTO `json:"path"`
+	Children   []*CategoryDTO `json:"children"`
+	Pins       []*dto.PinDTO  `json:"pins"`
+	NextCursor *string        `json:"next_cursor,omitempty"`
+}
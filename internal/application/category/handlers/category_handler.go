@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+// CategoryHandler maintains the interest taxonomy and the tags mapped into
+// it.
+type CategoryHandler struct {
+	repository categories.CategoryRepository
+	tagRepo    pins.TagRepository
+	factory    categories.CategoryFactory
+	logger     application.Logger
+}
+
+func NewCategoryHandler(repository categories.CategoryRepository, tagRepo pins.TagRepository, factory categories.CategoryFactory, logger application.Logger) *CategoryHandler {
+	return &CategoryHandler{
+		repository: repository,
+		tagRepo:    tagRepo,
+		factory:    factory,
+		logger:     logger,
+	}
+}
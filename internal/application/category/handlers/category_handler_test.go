@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func TestNewCategoryHandler(t *testing.T) {
+	repository, tagRepo, factory, logger := new(MockCategoryRepository), new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger)
+	handler := NewCategoryHandler(repository, tagRepo, factory, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, tagRepo, handler.tagRepo)
+	require.Exactly(t, factory, handler.factory)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestCategoryHandler_HandleDelete(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "home", "Home", nil)
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	repository.On("HasChildren", ctx, category.Id()).Return(false, nil)
+	repository.On("Delete", ctx, category).Return(nil)
+
+	err := handler.HandleDelete(ctx, commands.DeleteCategoryCommand{Id: category.Id()})
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleDelete_HasChildren(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "home", "Home", nil)
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	repository.On("HasChildren", ctx, category.Id()).Return(true, nil)
+
+	err := handler.HandleDelete(ctx, commands.DeleteCategoryCommand{Id: category.Id()})
+
+	assert.ErrorIs(t, err, categories.ErrHasChildrenCategory)
+	repository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleMapTag(t *testing.T) {
+	ctx := context.Background()
+	repository, tagRepo := new(MockCategoryRepository), new(MockTagRepository)
+	handler := NewCategoryHandler(repository, tagRepo, categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	tag := pins.NewTag("cabinets")
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	tagRepo.On("GetOrCreate", ctx, "cabinets").Return(tag, nil)
+	repository.On("ExistsTag", ctx, category.Id(), tag.Id()).Return(false, nil)
+	repository.On("AddTag", ctx, category.Id(), tag.Id()).Return(nil)
+
+	tagDto, err := handler.HandleMapTag(ctx, commands.MapTagCommand{CategoryId: category.Id(), Tag: "#Cabinets"})
+
+	require.NoError(t, err)
+	assert.Equal(t, tag.Id(), tagDto.Id)
+	assert.Equal(t, "cabinets", tagDto.Name)
+	repository.AssertExpectations(t)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleMapTag_Exists(t *testing.T) {
+	ctx := context.Background()
+	repository, tagRepo := new(MockCategoryRepository), new(MockTagRepository)
+	handler := NewCategoryHandler(repository, tagRepo, categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	tag := pins.NewTag("cabinets")
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	tagRepo.On("GetOrCreate", ctx, "cabinets").Return(tag, nil)
+	repository.On("ExistsTag", ctx, category.Id(), tag.Id()).Return(true, nil)
+
+	tagDto, err := handler.HandleMapTag(ctx, commands.MapTagCommand{CategoryId: category.Id(), Tag: "cabinets"})
+
+	assert.Nil(t, tagDto)
+	assert.ErrorIs(t, err, categories.ErrExistsTagCategory)
+	repository.AssertNotCalled(t, "AddTag", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCategoryHandler_HandleMapTag_InvalidTag(t *testing.T) {
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	tagDto, err := handler.HandleMapTag(context.Background(), commands.MapTagCommand{CategoryId: uuid.New(), Tag: "#"})
+
+	assert.Nil(t, tagDto)
+	assert.ErrorIs(t, err, shared.ErrEmptyHashtag)
+	repository.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+}
+
+func TestCategoryHandler_HandleUnmapTag(t *testing.T) {
+	ctx := context.Background()
+	repository, tagRepo := new(MockCategoryRepository), new(MockTagRepository)
+	handler := NewCategoryHandler(repository, tagRepo, categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	tag := pins.NewTag("cabinets")
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	tagRepo.On("GetByName", ctx, "cabinets").Return(tag, nil)
+	repository.On("ExistsTag", ctx, category.Id(), tag.Id()).Return(true, nil)
+	repository.On("RemoveTag", ctx, category.Id(), tag.Id()).Return(nil)
+
+	err := handler.HandleUnmapTag(ctx, commands.UnmapTagCommand{CategoryId: category.Id(), Tag: "cabinets"})
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleUnmapTag_UnknownTag(t *testing.T) {
+	ctx := context.Background()
+	repository, tagRepo := new(MockCategoryRepository), new(MockTagRepository)
+	handler := NewCategoryHandler(repository, tagRepo, categories.NewCategoryFactory(), new(MockLogger))
+
+	category := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	tagRepo.On("GetByName", ctx, "cabinets").Return(nil, pins.ErrNotFoundTag)
+
+	err := handler.HandleUnmapTag(ctx, commands.UnmapTagCommand{CategoryId: category.Id(), Tag: "cabinets"})
+
+	assert.ErrorIs(t, err, categories.ErrNotFoundTagCategory)
+	repository.AssertNotCalled(t, "RemoveTag", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *MockCategoryRepository) GetAll(ctx context.Context) ([]*categories.Category, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetById(ctx context.Context, id uuid.UUID) (*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetBySlug(ctx context.Context, slug string) (*categories.Category, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetPath(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetChildren(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetHeight(ctx context.Context, id uuid.UUID) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	args := m.Called(ctx, slug)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetTags(ctx context.Context, id uuid.UUID) ([]pins.Tag, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pins.Tag), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ExistsTag(ctx context.Context, id, tagId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id, tagId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) AddTag(ctx context.Context, id, tagId uuid.UUID) error {
+	args := m.Called(ctx, id, tagId)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) RemoveTag(ctx context.Context, id, tagId uuid.UUID) error {
+	args := m.Called(ctx, id, tagId)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) GetRankedPins(ctx context.Context, id, viewerId uuid.UUID, after *categories.Cursor, limit int) ([]categories.RankedPin, error) {
+	args := m.Called(ctx, id, viewerId, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]categories.RankedPin), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, c *categories.Category) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, c *categories.Category) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, c *categories.Category) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) GetByName(ctx context.Context, name string) (*pins.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) GetListByPinId(ctx context.Context, pinId uuid.UUID) ([]pins.Tag, error) {
+	args := m.Called(ctx, pinId)
+	return args.Get(0).([]pins.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) GetOrCreate(ctx context.Context, name string) (*pins.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Tag), args.Error(1)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
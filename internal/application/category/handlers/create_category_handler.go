@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/google/uuid"
+)
+
+func (h *CategoryHandler) HandleCreate(ctx context.Context, cmd commands.CreateCategoryCommand) (*dto.CategoryDTO, error) {
+	category, err := h.factory.Create(cmd.ParentId, cmd.Slug, cmd.Name, cmd.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ParentId != nil {
+		path, err := h.parentPath(ctx, *cmd.ParentId)
+		if err != nil {
+			return nil, err
+		} else if len(path)+1 > categories.MaxDepth {
+			return nil, categories.ErrDeepCategory
+		}
+	}
+
+	exist, err := h.repository.ExistsBySlug(ctx, category.Slug())
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, categories.ErrExistsSlugCategory
+	}
+
+	if err = h.repository.Create(ctx, category); err != nil {
+		h.logger.Error("Could not create category %s: %v", category.Slug(), err)
+		return nil, err
+	}
+
+	return mappers.MapToCategoryDTO(category), nil
+}
+
+// parentPath returns the path down to the category a node goes under.
+func (h *CategoryHandler) parentPath(ctx context.Context, parentId uuid.UUID) ([]*categories.Category, error) {
+	if _, err := h.repository.GetById(ctx, parentId); errors.Is(err, categories.ErrNotFoundCategory) {
+		return nil, categories.ErrNotFoundParentCategory
+	} else if err != nil {
+		return nil, err
+	}
+
+	return h.repository.GetPath(ctx, parentId)
+}
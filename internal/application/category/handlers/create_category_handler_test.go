@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCategoryHandler_HandleCreate(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	repository.On("GetById", ctx, homeId).Return(home, nil)
+	repository.On("GetPath", ctx, homeId).Return([]*categories.Category{home}, nil)
+	repository.On("ExistsBySlug", ctx, "kitchen").Return(false, nil)
+	repository.On("Create", ctx, mock.AnythingOfType("*categories.Category")).Return(nil)
+
+	category, err := handler.HandleCreate(ctx, commands.CreateCategoryCommand{ParentId: &homeId, Name: "Kitchen"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "kitchen", category.Slug)
+	assert.Equal(t, "Kitchen", category.Name)
+	assert.Equal(t, &homeId, category.ParentId)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleCreate_Errors(t *testing.T) {
+	ctx := context.Background()
+	parentId := uuid.New()
+
+	cases := []struct {
+		name  string
+		setup func(repository *MockCategoryRepository)
+		err   error
+	}{
+		{
+			name: "parent not found",
+			setup: func(repository *MockCategoryRepository) {
+				repository.On("GetById", ctx, parentId).Return(nil, categories.ErrNotFoundCategory)
+			},
+			err: categories.ErrNotFoundParentCategory,
+		},
+		{
+			name: "too deep",
+			setup: func(repository *MockCategoryRepository) {
+				parent := categories.NewCategory(nil, "parent", "Parent", nil)
+				path := make([]*categories.Category, categories.MaxDepth)
+				for i := range path {
+					path[i] = parent
+				}
+				repository.On("GetById", ctx, parentId).Return(parent, nil)
+				repository.On("GetPath", ctx, parentId).Return(path, nil)
+			},
+			err: categories.ErrDeepCategory,
+		},
+		{
+			name: "slug taken",
+			setup: func(repository *MockCategoryRepository) {
+				parent := categories.NewCategory(nil, "parent", "Parent", nil)
+				repository.On("GetById", ctx, parentId).Return(parent, nil)
+				repository.On("GetPath", ctx, parentId).Return([]*categories.Category{parent}, nil)
+				repository.On("ExistsBySlug", ctx, "kitchen").Return(true, nil)
+			},
+			err: categories.ErrExistsSlugCategory,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository := new(MockCategoryRepository)
+			handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+			tc.setup(repository)
+
+			category, err := handler.HandleCreate(ctx, commands.CreateCategoryCommand{ParentId: &parentId, Name: "Kitchen"})
+
+			assert.Nil(t, category)
+			assert.ErrorIs(t, err, tc.err)
+			repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/google/uuid"
+)
+
+// HandleDelete removes a category and its tag mappings. Subcategories must be
+// moved or removed first.
+func (h *CategoryHandler) HandleDelete(ctx context.Context, cmd commands.DeleteCategoryCommand) error {
+	if cmd.Id == uuid.Nil {
+		return categories.ErrNilIdCategory
+	}
+
+	category, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	children, err := h.repository.HasChildren(ctx, category.Id())
+	if err != nil {
+		return err
+	} else if children {
+		return categories.ErrHasChildrenCategory
+	}
+
+	if err = h.repository.Delete(ctx, category); err != nil {
+		h.logger.Error("Could not delete category %s: %v", category.Id(), err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+// HandleMapTag maps a hashtag into a category. Tags nobody has used yet are
+// created, so categories can be curated ahead of their pins.
+func (h *CategoryHandler) HandleMapTag(ctx context.Context, cmd commands.MapTagCommand) (*dto.TagDTO, error) {
+	name, err := shared.NewHashtag(cmd.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := h.repository.GetById(ctx, cmd.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := h.tagRepo.GetOrCreate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.repository.ExistsTag(ctx, category.Id(), tag.Id())
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, categories.ErrExistsTagCategory
+	}
+
+	if err = h.repository.AddTag(ctx, category.Id(), tag.Id()); err != nil {
+		h.logger.Error("Could not map tag %s into category %s: %v", name, category.Id(), err)
+		return nil, err
+	}
+
+	return mappers.MapToTagDTO(tag), nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+func (h *CategoryHandler) HandleUnmapTag(ctx context.Context, cmd commands.UnmapTagCommand) error {
+	name, err := shared.NewHashtag(cmd.Tag)
+	if err != nil {
+		return err
+	}
+
+	category, err := h.repository.GetById(ctx, cmd.CategoryId)
+	if err != nil {
+		return err
+	}
+
+	tag, err := h.tagRepo.GetByName(ctx, name)
+	if errors.Is(err, pins.ErrNotFoundTag) {
+		return categories.ErrNotFoundTagCategory
+	} else if err != nil {
+		return err
+	}
+
+	exist, err := h.repository.ExistsTag(ctx, category.Id(), tag.Id())
+	if err != nil {
+		return err
+	} else if !exist {
+		return categories.ErrNotFoundTagCategory
+	}
+
+	return h.repository.RemoveTag(ctx, category.Id(), tag.Id())
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/google/uuid"
+)
+
+// HandleUpdate renames, re-slugs, describes or moves a category. A move keeps
+// the whole subtree within MaxDepth and never puts a category under one of
+// its own subcategories.
+func (h *CategoryHandler) HandleUpdate(ctx context.Context, cmd commands.UpdateCategoryCommand) (*dto.CategoryDTO, error) {
+	if cmd.Id == uuid.Nil {
+		return nil, categories.ErrNilIdCategory
+	}
+
+	category, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Name != nil {
+		if err = category.ChangeName(*cmd.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Description != nil {
+		if *cmd.Description != "" {
+			err = category.ChangeDescription(cmd.Description)
+		} else {
+			err = category.ChangeDescription(nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Slug != nil {
+		previous := category.Slug()
+		if err = category.ChangeSlug(*cmd.Slug); err != nil {
+			return nil, err
+		}
+
+		if category.Slug() != previous {
+			exist, err := h.repository.ExistsBySlug(ctx, category.Slug())
+			if err != nil {
+				return nil, err
+			} else if exist {
+				return nil, categories.ErrExistsSlugCategory
+			}
+		}
+	}
+
+	if cmd.Root {
+		_ = category.Move(nil)
+	} else if cmd.ParentId != nil {
+		if err = category.Move(cmd.ParentId); err != nil {
+			return nil, err
+		}
+
+		path, err := h.parentPath(ctx, *cmd.ParentId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range path {
+			if ancestor.Id() == category.Id() {
+				return nil, categories.ErrCycleCategory
+			}
+		}
+
+		height, err := h.repository.GetHeight(ctx, category.Id())
+		if err != nil {
+			return nil, err
+		} else if len(path)+height > categories.MaxDepth {
+			return nil, categories.ErrDeepCategory
+		}
+	}
+
+	category.Update()
+
+	if err = h.repository.Update(ctx, category); err != nil {
+		h.logger.Error("Could not update category %s: %v", category.Id(), err)
+		return nil, err
+	}
+
+	return mappers.MapToCategoryDTO(category), nil
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCategoryHandler_HandleUpdate(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	name, slug, description := "Kitchens", "kitchens", ""
+
+	repository.On("GetById", ctx, kitchen.Id()).Return(kitchen, nil)
+	repository.On("ExistsBySlug", ctx, "kitchens").Return(false, nil)
+	repository.On("GetById", ctx, homeId).Return(home, nil)
+	repository.On("GetPath", ctx, homeId).Return([]*categories.Category{home}, nil)
+	repository.On("GetHeight", ctx, kitchen.Id()).Return(2, nil)
+	repository.On("Update", ctx, kitchen).Return(nil)
+
+	category, err := handler.HandleUpdate(ctx, commands.UpdateCategoryCommand{
+		Id:          kitchen.Id(),
+		ParentId:    &homeId,
+		Slug:        &slug,
+		Name:        &name,
+		Description: &description,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kitchens", category.Name)
+	assert.Equal(t, "kitchens", category.Slug)
+	assert.Nil(t, category.Description)
+	assert.Equal(t, &homeId, category.ParentId)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleUpdate_Root(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := categories.NewCategory(&homeId, "kitchen", "Kitchen", nil)
+	repository.On("GetById", ctx, kitchen.Id()).Return(kitchen, nil)
+	repository.On("Update", ctx, kitchen).Return(nil)
+
+	category, err := handler.HandleUpdate(ctx, commands.UpdateCategoryCommand{Id: kitchen.Id(), Root: true})
+
+	require.NoError(t, err)
+	assert.Nil(t, category.ParentId)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleUpdate_Cycle(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := categories.NewCategory(&homeId, "kitchen", "Kitchen", nil)
+	kitchenId := kitchen.Id()
+
+	repository.On("GetById", ctx, homeId).Return(home, nil)
+	repository.On("GetById", ctx, kitchenId).Return(kitchen, nil)
+	repository.On("GetPath", ctx, kitchenId).Return([]*categories.Category{home, kitchen}, nil)
+
+	category, err := handler.HandleUpdate(ctx, commands.UpdateCategoryCommand{Id: homeId, ParentId: &kitchenId})
+
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, categories.ErrCycleCategory)
+	repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestCategoryHandler_HandleUpdate_TooDeep(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockTagRepository), categories.NewCategoryFactory(), new(MockLogger))
+
+	parent := categories.NewCategory(nil, "parent", "Parent", nil)
+	parentId := parent.Id()
+	moved := categories.NewCategory(nil, "moved", "Moved", nil)
+
+	repository.On("GetById", ctx, moved.Id()).Return(moved, nil)
+	repository.On("GetById", ctx, parentId).Return(parent, nil)
+	repository.On("GetPath", ctx, parentId).Return([]*categories.Category{parent, parent, parent}, nil)
+	repository.On("GetHeight", ctx, moved.Id()).Return(3, nil)
+
+	category, err := handler.HandleUpdate(ctx, commands.UpdateCategoryCommand{Id: moved.Id(), ParentId: &parentId})
+
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, categories.ErrDeepCategory)
+	repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
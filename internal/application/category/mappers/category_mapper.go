@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+)
+
+func MapToCategoryDTO(category *categories.Category) *dto.CategoryDTO {
+	return &dto.CategoryDTO{
+		Id:          category.Id(),
+		ParentId:    category.ParentId(),
+		Slug:        category.Slug(),
+		Name:        category.Name(),
+		Description: category.Description(),
+	}
+}
+
+func MapToCategoryDTOs(list []*categories.Category) []*dto.CategoryDTO {
+	categoriesDto := make([]*dto.CategoryDTO, 0, len(list))
+	for _, category := range list {
+		categoriesDto = append(categoriesDto, MapToCategoryDTO(category))
+	}
+	return categoriesDto
+}
+
+func MapToCategoryNodeDTOs(nodes []*categories.Node) []*dto.CategoryNodeDTO {
+	nodesDto := make([]*dto.CategoryNodeDTO, 0, len(nodes))
+	for _, node := range nodes {
+		nodesDto = append(nodesDto, &dto.CategoryNodeDTO{
+			CategoryDTO: MapToCategoryDTO(node.Category()),
+			Children:    MapToCategoryNodeDTOs(node.Children()),
+		})
+	}
+	return nodesDto
+}
@@ -0,0 +1,10 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetCategoryBySlugQuery struct {
+	ViewerId uuid.UUID `json:"viewer_id"`
+	Slug     string    `json:"slug"`
+	Cursor   string    `json:"cursor,omitempty"`
+	Limit    int       `json:"limit"`
+}
@@ -0,0 +1,7 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetCategoryTagsQuery struct {
+	Id uuid.UUID `json:"id"`
+}
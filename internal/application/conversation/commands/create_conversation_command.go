@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type CreateConversationCommand struct {
+	UserId    uuid.UUID   `json:"user_id"`
+	MemberIds []uuid.UUID `json:"member_ids"`
+	Title     *string     `json:"title,omitempty"`
+}
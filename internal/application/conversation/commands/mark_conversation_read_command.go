@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type MarkConversationReadCommand struct {
+	ConversationId uuid.UUID `json:"conversation_id"`
+	UserId         uuid.UUID `json:"user_id"`
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SendMessageCommand struct {
+	ConversationId uuid.UUID  `json:"conversation_id"`
+	UserId         uuid.UUID  `json:"user_id"`
+	Body           *string    `json:"body,omitempty"`
+	PinId          *uuid.UUID `json:"pin_id,omitempty"`
+	BoardId        *uuid.UUID `json:"board_id,omitempty"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ConversationDTO struct {
+	Id            uuid.UUID   `json:"id"`
+	CreatorId     uuid.UUID   `json:"creator_id"`
+	Title         *string     `json:"title,omitempty"`
+	IsGroup       bool        `json:"is_group"`
+	MemberIds     []uuid.UUID `json:"member_ids"`
+	UnreadCount   int         `json:"unread_count"`
+	LastMessageAt *time.Time  `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type MessageDTO struct {
+	Id             uuid.UUID  `json:"id"`
+	ConversationId uuid.UUID  `json:"conversation_id"`
+	SenderId       uuid.UUID  `json:"sender_id"`
+	Body           *string    `json:"body,omitempty"`
+	PinId          *uuid.UUID `json:"pin_id,omitempty"`
+	BoardId        *uuid.UUID `json:"board_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// MessagesPageDTO is a page of messages, newest first. NextBefore and
+// NextBeforeId are the values to send as before and before_id to get older
+// messages, and are nil on the last page.
+type MessagesPageDTO struct {
+	Messages     []*MessageDTO `json:"messages"`
+	NextBefore   *time.Time    `json:"next_before,omitempty"`
+	NextBeforeId *uuid.UUID    `json:"next_before_id,omitempty"`
+}
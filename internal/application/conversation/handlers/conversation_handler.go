@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type ConversationHandler struct {
+	repository     conversations.ConversationRepository
+	messageRepo    conversations.MessageRepository
+	blockRepo      blocks.BlockRepository
+	userRepo       users.UserRepository
+	pinRepo        pins.PinRepository
+	boardRepo      boards.BoardRepository
+	factory        conversations.ConversationFactory
+	messageFactory conversations.MessageFactory
+	logger         application.Logger
+}
+
+func NewConversationHandler(repository conversations.ConversationRepository, messageRepo conversations.MessageRepository, blockRepo blocks.BlockRepository, userRepo users.UserRepository, pinRepo pins.PinRepository, boardRepo boards.BoardRepository, factory conversations.ConversationFactory, messageFactory conversations.MessageFactory, logger application.Logger) *ConversationHandler {
+	return &ConversationHandler{
+		repository:     repository,
+		messageRepo:    messageRepo,
+		blockRepo:      blockRepo,
+		userRepo:       userRepo,
+		pinRepo:        pinRepo,
+		boardRepo:      boardRepo,
+		factory:        factory,
+		messageFactory: messageFactory,
+		logger:         logger,
+	}
+}
+
+// memberConversation loads a conversation the user belongs to. Conversations
+// the user is not part of are reported as not found so their ids leak nothing.
+func (h *ConversationHandler) memberConversation(ctx context.Context, conversationId, userId uuid.UUID) (*conversations.Conversation, error) {
+	conversation, err := h.repository.GetById(ctx, conversationId)
+	if err != nil {
+		return nil, err
+	} else if !conversation.IsMember(userId) {
+		return nil, conversations.ErrNotFoundConversation
+	}
+
+	return conversation, nil
+}
+
+// ensureNotBlocked fails when any two of the users block each other.
+func (h *ConversationHandler) ensureNotBlocked(ctx context.Context, userIds []uuid.UUID) error {
+	blocked, err := h.blockRepo.ExistsAmong(ctx, userIds)
+	if err != nil {
+		return err
+	} else if blocked {
+		return blocks.ErrBlockedUser
+	}
+
+	return nil
+}
+
+// visiblePin loads a pin the user may share: one of their own, or a public one
+// of a user not in a block with them. Any other is reported as not found.
+func (h *ConversationHandler) visiblePin(ctx context.Context, id, userId uuid.UUID) (*pins.Pin, error) {
+	exist, err := h.pinRepo.ExistById(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	pin, err := h.pinRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if pin.UserId() == userId {
+		return pin, nil
+	} else if !pin.Visibility() {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{userId, pin.UserId()})
+	if err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	return pin, nil
+}
+
+// visibleBoard loads a board the user may share, like visiblePin.
+func (h *ConversationHandler) visibleBoard(ctx context.Context, id, userId uuid.UUID) (*boards.Board, error) {
+	exist, err := h.boardRepo.ExistById(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, boards.ErrNotFoundBoard
+	}
+
+	board, err := h.boardRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if board.UserId() == userId {
+		return board, nil
+	} else if !board.Visibility() {
+		return nil, boards.ErrNotFoundBoard
+	}
+
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{userId, board.UserId()})
+	if err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, boards.ErrNotFoundBoard
+	}
+
+	return board, nil
+}
@@ -0,0 +1,663 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockConversationRepository struct {
+	mock.Mock
+}
+
+type MockMessageRepository struct {
+	mock.Mock
+}
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+type MockBoardRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+var ErrDbFailureConversation = errors.New("db failure")
+
+type conversationMocks struct {
+	repository  *MockConversationRepository
+	messageRepo *MockMessageRepository
+	blockRepo   *MockBlockRepository
+	userRepo    *MockUserRepository
+	pinRepo     *MockPinRepository
+	boardRepo   *MockBoardRepository
+}
+
+func newTestConversationHandler() (*ConversationHandler, conversationMocks) {
+	m := conversationMocks{
+		repository:  new(MockConversationRepository),
+		messageRepo: new(MockMessageRepository),
+		blockRepo:   new(MockBlockRepository),
+		userRepo:    new(MockUserRepository),
+		pinRepo:     new(MockPinRepository),
+		boardRepo:   new(MockBoardRepository),
+	}
+
+	handler := NewConversationHandler(m.repository, m.messageRepo, m.blockRepo, m.userRepo, m.pinRepo, m.boardRepo, conversations.NewConversationFactory(), conversations.NewMessageFactory(), new(MockLogger))
+	return handler, m
+}
+
+func TestNewConversationHandler(t *testing.T) {
+	handler, m := newTestConversationHandler()
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, m.repository, handler.repository)
+	require.Exactly(t, m.messageRepo, handler.messageRepo)
+	require.Exactly(t, m.blockRepo, handler.blockRepo)
+	require.Exactly(t, m.userRepo, handler.userRepo)
+	require.Exactly(t, m.pinRepo, handler.pinRepo)
+	require.Exactly(t, m.boardRepo, handler.boardRepo)
+}
+
+func TestConversationHandler_HandleCreate_Group(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	title := "Wedding ideas"
+	cmd := commands.CreateConversationCommand{
+		UserId:    uuid.New(),
+		MemberIds: []uuid.UUID{uuid.New(), uuid.New()},
+		Title:     &title,
+	}
+
+	m.userRepo.On("ExistsById", ctx, mock.Anything).Return(true, nil).Twice()
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, cmd.MemberIds[0], cmd.MemberIds[1]}).Return(false, nil)
+	m.repository.On("Create", ctx, mock.AnythingOfType("*conversations.Conversation")).Return(func(c *conversations.Conversation) *conversations.Conversation { return c }, nil)
+
+	conversation, err := handler.HandleCreate(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, conversation.IsGroup)
+	assert.Equal(t, &title, conversation.Title)
+	assert.Len(t, conversation.MemberIds, 3)
+	m.repository.AssertNotCalled(t, "GetDirect", mock.Anything, mock.Anything, mock.Anything)
+	m.userRepo.AssertExpectations(t)
+	m.blockRepo.AssertExpectations(t)
+	m.repository.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleCreate_ReusesDirect(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId, otherId := uuid.New(), uuid.New()
+	existing := conversations.NewConversation(userId, []uuid.UUID{otherId}, nil)
+	cmd := commands.CreateConversationCommand{
+		UserId:    userId,
+		MemberIds: []uuid.UUID{otherId},
+	}
+
+	m.userRepo.On("ExistsById", ctx, otherId).Return(true, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, otherId}).Return(false, nil)
+	m.repository.On("GetDirect", ctx, userId, otherId).Return(existing, nil)
+
+	conversation, err := handler.HandleCreate(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.Id(), conversation.Id)
+	m.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	m.repository.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleCreate_Blocked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	cmd := commands.CreateConversationCommand{
+		UserId:    uuid.New(),
+		MemberIds: []uuid.UUID{uuid.New()},
+	}
+
+	m.userRepo.On("ExistsById", ctx, cmd.MemberIds[0]).Return(true, nil)
+	m.blockRepo.On("ExistsAmong", ctx, mock.Anything).Return(true, nil)
+
+	conversation, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, conversation)
+	assert.ErrorIs(t, err, blocks.ErrBlockedUser)
+	m.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestConversationHandler_HandleCreate_UnknownMember(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	cmd := commands.CreateConversationCommand{
+		UserId:    uuid.New(),
+		MemberIds: []uuid.UUID{uuid.New()},
+	}
+
+	m.userRepo.On("ExistsById", ctx, cmd.MemberIds[0]).Return(false, nil)
+
+	conversation, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, conversation)
+	assert.ErrorIs(t, err, users.ErrNotFoundUser)
+	m.blockRepo.AssertNotCalled(t, "ExistsAmong", mock.Anything, mock.Anything)
+}
+
+func TestConversationHandler_HandleCreate_Invalid(t *testing.T) {
+	handler, _ := newTestConversationHandler()
+
+	conversation, err := handler.HandleCreate(context.Background(), commands.CreateConversationCommand{UserId: uuid.New()})
+
+	assert.Nil(t, conversation)
+	assert.ErrorIs(t, err, conversations.ErrFewMembersConversation)
+}
+
+func newTestPin(id, userId uuid.UUID, visibility bool) *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(id, userId, uuid.New(), "Kitchen", nil, nil, 0, 0, 0, visibility, nil, now, now, nil)
+}
+
+func TestConversationHandler_HandleSendMessage(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId, otherId, ownerId, pinId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{otherId}, nil)
+	body := "this one"
+	cmd := commands.SendMessageCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+		Body:           &body,
+		PinId:          &pinId,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, otherId}).Return(false, nil)
+	m.pinRepo.On("ExistById", ctx, pinId).Return(true, nil)
+	m.pinRepo.On("GetById", ctx, pinId).Return(newTestPin(pinId, ownerId, true), nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, ownerId}).Return(false, nil)
+	m.messageRepo.On("Create", ctx, mock.AnythingOfType("*conversations.Message")).Return(func(msg *conversations.Message) *conversations.Message { return msg }, nil)
+
+	message, err := handler.HandleSendMessage(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, conversation.Id(), message.ConversationId)
+	assert.Equal(t, userId, message.SenderId)
+	assert.Equal(t, &body, message.Body)
+	assert.Equal(t, &pinId, message.PinId)
+	m.repository.AssertExpectations(t)
+	m.blockRepo.AssertExpectations(t)
+	m.pinRepo.AssertExpectations(t)
+	m.messageRepo.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleSendMessage_NotMember(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	conversation := conversations.NewConversation(uuid.New(), []uuid.UUID{uuid.New()}, nil)
+	body := "hi"
+	cmd := commands.SendMessageCommand{
+		ConversationId: conversation.Id(),
+		UserId:         uuid.New(),
+		Body:           &body,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+
+	message, err := handler.HandleSendMessage(ctx, cmd)
+
+	assert.Nil(t, message)
+	assert.ErrorIs(t, err, conversations.ErrNotFoundConversation)
+	m.messageRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestConversationHandler_HandleSendMessage_Blocked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId := uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	body := "hi"
+	cmd := commands.SendMessageCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+		Body:           &body,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	m.blockRepo.On("ExistsAmong", ctx, conversation.MemberIds()).Return(true, nil)
+
+	message, err := handler.HandleSendMessage(ctx, cmd)
+
+	assert.Nil(t, message)
+	assert.ErrorIs(t, err, blocks.ErrBlockedUser)
+	m.messageRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestConversationHandler_HandleSendMessage_UnknownBoard(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId, boardId := uuid.New(), uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	cmd := commands.SendMessageCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+		BoardId:        &boardId,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	m.blockRepo.On("ExistsAmong", ctx, conversation.MemberIds()).Return(false, nil)
+	m.boardRepo.On("ExistById", ctx, boardId).Return(false, nil)
+
+	message, err := handler.HandleSendMessage(ctx, cmd)
+
+	assert.Nil(t, message)
+	assert.ErrorIs(t, err, boards.ErrNotFoundBoard)
+}
+
+func TestConversationHandler_HandleSendMessage_HiddenShare(t *testing.T) {
+	userId, ownerId, pinId, boardId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	cases := []struct {
+		name  string
+		cmd   commands.SendMessageCommand
+		setup func(m conversationMocks)
+		err   error
+	}{
+		{"Private pin", commands.SendMessageCommand{PinId: &pinId}, func(m conversationMocks) {
+			m.pinRepo.On("ExistById", mock.Anything, pinId).Return(true, nil)
+			m.pinRepo.On("GetById", mock.Anything, pinId).Return(newTestPin(pinId, ownerId, false), nil)
+		}, pins.ErrNotFoundPin},
+		{"Pin of a blocked user", commands.SendMessageCommand{PinId: &pinId}, func(m conversationMocks) {
+			m.pinRepo.On("ExistById", mock.Anything, pinId).Return(true, nil)
+			m.pinRepo.On("GetById", mock.Anything, pinId).Return(newTestPin(pinId, ownerId, true), nil)
+			m.blockRepo.On("ExistsAmong", mock.Anything, []uuid.UUID{userId, ownerId}).Return(true, nil)
+		}, pins.ErrNotFoundPin},
+		{"Private board", commands.SendMessageCommand{BoardId: &boardId}, func(m conversationMocks) {
+			m.boardRepo.On("ExistById", mock.Anything, boardId).Return(true, nil)
+			m.boardRepo.On("GetById", mock.Anything, boardId).Return(boards.NewBoardFromDB(boardId, ownerId, "Secret", nil, false, 0, nil, now, now, nil), nil)
+		}, boards.ErrNotFoundBoard},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			handler, m := newTestConversationHandler()
+
+			conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+			tc.cmd.ConversationId, tc.cmd.UserId = conversation.Id(), userId
+
+			m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+			m.blockRepo.On("ExistsAmong", ctx, conversation.MemberIds()).Return(false, nil)
+			tc.setup(m)
+
+			message, err := handler.HandleSendMessage(ctx, tc.cmd)
+
+			assert.Nil(t, message)
+			assert.ErrorIs(t, err, tc.err)
+			m.messageRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestConversationHandler_HandleSendMessage_OwnPrivateBoard(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId, boardId := uuid.New(), uuid.New()
+	now := time.Now()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	cmd := commands.SendMessageCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+		BoardId:        &boardId,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	m.blockRepo.On("ExistsAmong", ctx, conversation.MemberIds()).Return(false, nil)
+	m.boardRepo.On("ExistById", ctx, boardId).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, boardId).Return(boards.NewBoardFromDB(boardId, userId, "Mine", nil, false, 0, nil, now, now, nil), nil)
+	m.messageRepo.On("Create", ctx, mock.AnythingOfType("*conversations.Message")).Return(func(msg *conversations.Message) *conversations.Message { return msg }, nil)
+
+	message, err := handler.HandleSendMessage(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, &boardId, message.BoardId)
+	m.blockRepo.AssertNumberOfCalls(t, "ExistsAmong", 1)
+}
+
+func TestConversationHandler_HandleSendMessage_Empty(t *testing.T) {
+	handler, _ := newTestConversationHandler()
+
+	blank := "   "
+	message, err := handler.HandleSendMessage(context.Background(), commands.SendMessageCommand{
+		ConversationId: uuid.New(),
+		UserId:         uuid.New(),
+		Body:           &blank,
+	})
+
+	assert.Nil(t, message)
+	assert.ErrorIs(t, err, conversations.ErrEmptyMessage)
+}
+
+func TestConversationHandler_HandleMarkRead(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId := uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	conversation.Touch(time.Now())
+	cmd := commands.MarkConversationReadCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	m.repository.On("MarkRead", ctx, conversation.Id(), userId, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := handler.HandleMarkRead(ctx, cmd)
+
+	require.NoError(t, err)
+	m.repository.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleMarkRead_NothingUnread(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	userId := uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	cmd := commands.MarkConversationReadCommand{
+		ConversationId: conversation.Id(),
+		UserId:         userId,
+	}
+
+	m.repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+
+	err := handler.HandleMarkRead(ctx, cmd)
+
+	require.NoError(t, err)
+	m.repository.AssertNotCalled(t, "MarkRead", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestConversationHandler_HandleMarkRead_Error(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestConversationHandler()
+
+	id := uuid.New()
+	m.repository.On("GetById", ctx, id).Return(nil, ErrDbFailureConversation)
+
+	err := handler.HandleMarkRead(ctx, commands.MarkConversationReadCommand{ConversationId: id, UserId: uuid.New()})
+
+	assert.ErrorIs(t, err, ErrDbFailureConversation)
+}
+
+func (m *MockConversationRepository) GetListByUserId(ctx context.Context, userId uuid.UUID) ([]*conversations.Conversation, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockConversationRepository) GetById(ctx context.Context, id uuid.UUID) (*conversations.Conversation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockConversationRepository) GetDirect(ctx context.Context, userId, otherId uuid.UUID) (*conversations.Conversation, error) {
+	args := m.Called(ctx, userId, otherId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockConversationRepository) Create(ctx context.Context, c *conversations.Conversation) (*conversations.Conversation, error) {
+	args := m.Called(ctx, c)
+	if fn, ok := args.Get(0).(func(*conversations.Conversation) *conversations.Conversation); ok {
+		return fn(c), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockConversationRepository) MarkRead(ctx context.Context, conversationId, userId uuid.UUID, readAt time.Time) error {
+	args := m.Called(ctx, conversationId, userId, readAt)
+	return args.Error(0)
+}
+
+func (m *MockMessageRepository) GetListByConversationId(ctx context.Context, conversationId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*conversations.Message, error) {
+	args := m.Called(ctx, conversationId, before, beforeId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*conversations.Message), args.Error(1)
+}
+
+func (m *MockMessageRepository) CountUnreadByUserId(ctx context.Context, userId uuid.UUID) (map[uuid.UUID]int, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]int), args.Error(1)
+}
+
+func (m *MockMessageRepository) Create(ctx context.Context, msg *conversations.Message) (*conversations.Message, error) {
+	args := m.Called(ctx, msg)
+	if fn, ok := args.Get(0).(func(*conversations.Message) *conversations.Message); ok {
+		return fn(msg), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*conversations.Message), args.Error(1)
+}
+
+func (m *MockBlockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	return nil, nil
+}
+
+func (m *MockBlockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockBlockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockBlockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockBlockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userIds)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockBoardRepository) GetAll(ctx context.Context) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetList(ctx context.Context) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetListByName(ctx context.Context, name string) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetById(ctx context.Context, id uuid.UUID) (*boards.Board, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBoardRepository) Create(ctx context.Context, b *boards.Board) (*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) Update(ctx context.Context, b *boards.Board) error {
+	return nil
+}
+
+func (m *MockBoardRepository) Delete(ctx context.Context, b *boards.Board) error {
+	return nil
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+func (h *ConversationHandler) HandleCreate(ctx context.Context, cmd commands.CreateConversationCommand) (*dto.ConversationDTO, error) {
+	conversation, err := h.factory.Create(cmd.UserId, cmd.MemberIds, cmd.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIds := conversation.MemberIds()
+	for _, id := range memberIds[1:] {
+		exist, err := h.userRepo.ExistsById(ctx, id)
+		if err != nil {
+			return nil, err
+		} else if !exist {
+			return nil, users.ErrNotFoundUser
+		}
+	}
+
+	if err = h.ensureNotBlocked(ctx, memberIds); err != nil {
+		return nil, err
+	}
+
+	if !conversation.IsGroup() {
+		direct, err := h.repository.GetDirect(ctx, cmd.UserId, memberIds[1])
+		if err != nil {
+			return nil, err
+		} else if direct != nil {
+			return mappers.MapToConversationDTO(direct, 0), nil
+		}
+	}
+
+	conversation, err = h.repository.Create(ctx, conversation)
+	if err != nil {
+		h.logger.Error("Could not create conversation for %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return mappers.MapToConversationDTO(conversation, 0), nil
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/commands"
+	"time"
+)
+
+func (h *ConversationHandler) HandleMarkRead(ctx context.Context, cmd commands.MarkConversationReadCommand) error {
+	conversation, err := h.memberConversation(ctx, cmd.ConversationId, cmd.UserId)
+	if err != nil {
+		return err
+	}
+
+	if !conversation.Unread(cmd.UserId) {
+		return nil
+	}
+
+	if err = h.repository.MarkRead(ctx, cmd.ConversationId, cmd.UserId, time.Now()); err != nil {
+		h.logger.Error("Could not mark conversation %s as read: %v", cmd.ConversationId, err)
+		return err
+	}
+
+	return nil
+}
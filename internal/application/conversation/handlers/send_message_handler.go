@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/mappers"
+)
+
+// HandleSendMessage sends a message to a conversation of the user. The pin or
+// board it shares has to be one the user can see.
+func (h *ConversationHandler) HandleSendMessage(ctx context.Context, cmd commands.SendMessageCommand) (*dto.MessageDTO, error) {
+	message, err := h.messageFactory.Create(cmd.ConversationId, cmd.UserId, cmd.Body, cmd.PinId, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := h.memberConversation(ctx, cmd.ConversationId, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.ensureNotBlocked(ctx, conversation.MemberIds()); err != nil {
+		return nil, err
+	}
+
+	if cmd.PinId != nil {
+		if _, err = h.visiblePin(ctx, *cmd.PinId, cmd.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.BoardId != nil {
+		if _, err = h.visibleBoard(ctx, *cmd.BoardId, cmd.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	message, err = h.messageRepo.Create(ctx, message)
+	if err != nil {
+		h.logger.Error("Could not send message to conversation %s: %v", cmd.ConversationId, err)
+		return nil, err
+	}
+
+	return mappers.MapToMessageDTO(message), nil
+}
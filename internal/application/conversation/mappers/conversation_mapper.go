@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+)
+
+func MapToConversationDTO(conversation *conversations.Conversation, unread int) *dto.ConversationDTO {
+	return &dto.ConversationDTO{
+		Id:            conversation.Id(),
+		CreatorId:     conversation.CreatorId(),
+		Title:         conversation.Title(),
+		IsGroup:       conversation.IsGroup(),
+		MemberIds:     conversation.MemberIds(),
+		UnreadCount:   unread,
+		LastMessageAt: conversation.LastMessageAt(),
+		CreatedAt:     conversation.CreatedAt(),
+	}
+}
+
+func MapToMessageDTO(message *conversations.Message) *dto.MessageDTO {
+	return &dto.MessageDTO{
+		Id:             message.Id(),
+		ConversationId: message.ConversationId(),
+		SenderId:       message.SenderId(),
+		Body:           message.Body(),
+		PinId:          message.PinId(),
+		BoardId:        message.BoardId(),
+		CreatedAt:      message.CreatedAt(),
+	}
+}
@@ -0,0 +1,14 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type GetMessagesQuery struct {
+	ConversationId uuid.UUID  `json:"conversation_id"`
+	UserId         uuid.UUID  `json:"user_id"`
+	Before         *time.Time `json:"before,omitempty"`
+	BeforeId       uuid.UUID  `json:"before_id,omitempty"`
+	Limit          int        `json:"limit"`
+}
@@ -0,0 +1,7 @@
+package commands
+
+import "time"
+
+type RollupEventsCommand struct {
+	Now time.Time `json:"now"`
+}
@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TrackEventsCommand struct {
+	UserId    uuid.UUID           `json:"-"`
+	SessionId uuid.UUID           `json:"-"`
+	Events    []TrackEventCommand `json:"events"`
+}
+
+type TrackEventCommand struct {
+	PinId      uuid.UUID  `json:"pin_id"`
+	Kind       string     `json:"kind"`
+	OccurredAt *time.Time `json:"occurred_at"`
+}
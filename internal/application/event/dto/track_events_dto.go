@@ -0,0 +1,9 @@
+package dto
+
+// TrackEventsDTO counts what became of a batch. Hidden are the events of pins
+// that do not exist or the user cannot see.
+type TrackEventsDTO struct {
+	Accepted   int `json:"accepted"`
+	Duplicates int `json:"duplicates"`
+	Hidden     int `json:"hidden"`
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/google/uuid"
+	"time"
+)
+
+type EventHandler struct {
+	repository events.EventRepository
+	writer     events.Writer
+	logger     application.Logger
+}
+
+func NewEventHandler(repository events.EventRepository, writer events.Writer, logger application.Logger) *EventHandler {
+	return &EventHandler{
+		repository: repository,
+		writer:     writer,
+		logger:     logger,
+	}
+}
+
+// HandleTrack validates a batch of client events and hands it to the writer.
+// An event without a time happened when it was received. One invalid event
+// rejects the whole batch; repeats within the session and events of pins the
+// user cannot see are dropped instead.
+func (h *EventHandler) HandleTrack(ctx context.Context, cmd commands.TrackEventsCommand) (*dto.TrackEventsDTO, error) {
+	if len(cmd.Events) == 0 {
+		return nil, events.ErrEmptyBatch
+	} else if len(cmd.Events) > events.MaxBatch {
+		return nil, events.ErrLargeBatch
+	}
+
+	now := time.Now()
+	list := make([]*events.Event, 0, len(cmd.Events))
+	for _, c := range cmd.Events {
+		kind, err := events.ParseKind(c.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		occurredAt := now
+		if c.OccurredAt != nil {
+			occurredAt = *c.OccurredAt
+		}
+
+		event, err := events.NewEvent(cmd.UserId, cmd.SessionId, c.PinId, kind, occurredAt, now)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, event)
+	}
+
+	visible, err := h.visibleEvents(ctx, cmd.UserId, list)
+	if err != nil {
+		h.logger.Error("Could not check the pins of the events for session %s: %v", cmd.SessionId, err)
+		return nil, err
+	}
+
+	unique := events.Dedupe(visible)
+	if err := h.writer.Write(ctx, unique); err != nil {
+		h.logger.Error("Could not buffer %d events for session %s: %v", len(unique), cmd.SessionId, err)
+		return nil, err
+	}
+
+	return &dto.TrackEventsDTO{
+		Accepted:   len(unique),
+		Duplicates: len(visible) - len(unique),
+		Hidden:     len(list) - len(visible),
+	}, nil
+}
+
+// visibleEvents keeps the events of the pins the user can see.
+func (h *EventHandler) visibleEvents(ctx context.Context, userId uuid.UUID, list []*events.Event) ([]*events.Event, error) {
+	pinIds := make([]uuid.UUID, 0, len(list))
+	seen := make(map[uuid.UUID]bool, len(list))
+	for _, e := range list {
+		if !seen[e.PinId()] {
+			seen[e.PinId()] = true
+			pinIds = append(pinIds, e.PinId())
+		}
+	}
+
+	ids, err := h.repository.GetListVisiblePins(ctx, userId, pinIds)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		visible[id] = true
+	}
+
+	kept := make([]*events.Event, 0, len(list))
+	for _, e := range list {
+		if visible[e.PinId()] {
+			kept = append(kept, e)
+		}
+	}
+
+	return kept, nil
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockEventRepository struct {
+	mock.Mock
+}
+
+type MockWriter struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func TestNewEventHandler(t *testing.T) {
+	repository, writer, logger := new(MockEventRepository), new(MockWriter), new(MockLogger)
+	handler := NewEventHandler(repository, writer, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, writer, handler.writer)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestEventHandler_HandleTrack(t *testing.T) {
+	ctx := context.Background()
+	repository, writer := new(MockEventRepository), new(MockWriter)
+	handler := NewEventHandler(repository, writer, new(MockLogger))
+
+	userId, sessionId, pinId, hiddenId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	occurredAt := time.Now().Add(-time.Minute)
+	cmd := commands.TrackEventsCommand{
+		UserId:    userId,
+		SessionId: sessionId,
+		Events: []commands.TrackEventCommand{
+			{PinId: pinId, Kind: "impression", OccurredAt: &occurredAt},
+			{PinId: pinId, Kind: "impression"},
+			{PinId: hiddenId, Kind: "impression"},
+			{PinId: pinId, Kind: "outbound_click"},
+			{PinId: hiddenId, Kind: "save"},
+		},
+	}
+
+	repository.On("GetListVisiblePins", ctx, userId, []uuid.UUID{pinId, hiddenId}).Return([]uuid.UUID{pinId}, nil)
+
+	var written []*events.Event
+	writer.On("Write", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		written = args.Get(1).([]*events.Event)
+	}).Return(nil)
+
+	result, err := handler.HandleTrack(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Accepted)
+	assert.Equal(t, 1, result.Duplicates)
+	assert.Equal(t, 2, result.Hidden)
+	require.Len(t, written, 2)
+	assert.Equal(t, userId, written[0].UserId())
+	assert.Equal(t, sessionId, written[0].SessionId())
+	assert.Equal(t, events.ImpressionKind, written[0].Kind())
+	assert.Equal(t, occurredAt, written[0].OccurredAt())
+	assert.Equal(t, events.OutboundClickKind, written[1].Kind())
+	assert.Equal(t, written[1].ReceivedAt(), written[1].OccurredAt())
+	repository.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleTrack_Errors(t *testing.T) {
+	ctx := context.Background()
+	sessionId, pinId := uuid.New(), uuid.New()
+	stale := time.Now().Add(-events.MaxAge - time.Hour)
+
+	cases := []struct {
+		name   string
+		events []commands.TrackEventCommand
+		err    error
+	}{
+		{"Empty", nil, events.ErrEmptyBatch},
+		{"Large", make([]commands.TrackEventCommand, events.MaxBatch+1), events.ErrLargeBatch},
+		{"Kind", []commands.TrackEventCommand{{PinId: pinId, Kind: "hover"}}, events.ErrInvalidKindEvent},
+		{"Pin", []commands.TrackEventCommand{{Kind: "save"}}, events.ErrNilPinIdEvent},
+		{"Stale", []commands.TrackEventCommand{{PinId: pinId, Kind: "save", OccurredAt: &stale}}, events.ErrStaleEvent},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			writer := new(MockWriter)
+			handler := NewEventHandler(new(MockEventRepository), writer, new(MockLogger))
+
+			result, err := handler.HandleTrack(ctx, commands.TrackEventsCommand{UserId: uuid.New(), SessionId: sessionId, Events: c.events})
+
+			assert.ErrorIs(t, err, c.err)
+			assert.Nil(t, result)
+			writer.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestEventHandler_HandleTrack_VisiblePinsError(t *testing.T) {
+	ctx := context.Background()
+	repository, writer := new(MockEventRepository), new(MockWriter)
+	handler := NewEventHandler(repository, writer, new(MockLogger))
+
+	dbErr := errors.New("database error")
+	repository.On("GetListVisiblePins", ctx, mock.Anything, mock.Anything).Return(nil, dbErr)
+
+	result, err := handler.HandleTrack(ctx, commands.TrackEventsCommand{
+		UserId:    uuid.New(),
+		SessionId: uuid.New(),
+		Events:    []commands.TrackEventCommand{{PinId: uuid.New(), Kind: "closeup"}},
+	})
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Nil(t, result)
+	writer.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
+}
+
+func TestEventHandler_HandleTrack_FullBuffer(t *testing.T) {
+	ctx := context.Background()
+	repository, writer := new(MockEventRepository), new(MockWriter)
+	handler := NewEventHandler(repository, writer, new(MockLogger))
+
+	pinId := uuid.New()
+	repository.On("GetListVisiblePins", ctx, mock.Anything, []uuid.UUID{pinId}).Return([]uuid.UUID{pinId}, nil)
+	writer.On("Write", ctx, mock.Anything).Return(events.ErrFullBuffer)
+
+	result, err := handler.HandleTrack(ctx, commands.TrackEventsCommand{
+		UserId:    uuid.New(),
+		SessionId: uuid.New(),
+		Events:    []commands.TrackEventCommand{{PinId: pinId, Kind: "share"}},
+	})
+
+	assert.ErrorIs(t, err, events.ErrFullBuffer)
+	assert.Nil(t, result)
+	writer.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleRollup(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockEventRepository)
+	handler := NewEventHandler(repository, new(MockWriter), new(MockLogger))
+
+	now := time.Date(2025, 11, 15, 10, 42, 0, 0, time.UTC)
+	from := time.Date(2025, 11, 14, 10, 0, 0, 0, time.UTC)
+
+	repository.On("Rollup", ctx, from, now).Return(nil)
+
+	err := handler.HandleRollup(ctx, commands.RollupEventsCommand{Now: now})
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestEventHandler_HandleRollup_Error(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockEventRepository)
+	handler := NewEventHandler(repository, new(MockWriter), new(MockLogger))
+
+	dbErr := errors.New("database error")
+	repository.On("Rollup", ctx, mock.Anything, mock.Anything).Return(dbErr)
+
+	err := handler.HandleRollup(ctx, commands.RollupEventsCommand{Now: time.Now()})
+
+	assert.ErrorIs(t, err, dbErr)
+	repository.AssertExpectations(t)
+}
+
+func (m *MockEventRepository) CreateBatch(ctx context.Context, list []*events.Event) (int64, error) {
+	args := m.Called(ctx, list)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEventRepository) GetListVisiblePins(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockEventRepository) Rollup(ctx context.Context, from, to time.Time) error {
+	args := m.Called(ctx, from, to)
+	return args.Error(0)
+}
+
+func (m *MockWriter) Write(ctx context.Context, list []*events.Event) error {
+	args := m.Called(ctx, list)
+	return args.Error(0)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"time"
+)
+
+// HandleRollup recounts every hour an event may still arrive in, from the
+// start of the hour MaxAge before cmd.Now, so late events are not missed.
+func (h *EventHandler) HandleRollup(ctx context.Context, cmd commands.RollupEventsCommand) error {
+	from := cmd.Now.Add(-events.MaxAge).Truncate(time.Hour)
+
+	if err := h.repository.Rollup(ctx, from, cmd.Now); err != nil {
+		h.logger.Error("Could not roll up events since %v: %v", from, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ConfirmFactorCommand struct {
+	UserId uuid.UUID `json:"user_id"`
+	Code   string    `json:"code"`
+}
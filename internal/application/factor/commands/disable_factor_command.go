@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+// DisableFactorCommand needs the password of the user along with a code from
+// their app or one of their recovery codes.
+type DisableFactorCommand struct {
+	UserId   uuid.UUID `json:"user_id"`
+	Password string    `json:"password"`
+	Code     string    `json:"code"`
+}
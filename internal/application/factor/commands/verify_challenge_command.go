@@ -0,0 +1,8 @@
+package commands
+
+// VerifyChallengeCommand answers the challenge of a login with a code from the
+// app of the user or one of their recovery codes.
+type VerifyChallengeCommand struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+}
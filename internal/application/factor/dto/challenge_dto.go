@@ -0,0 +1,7 @@
+package dto
+
+type ChallengeDTO struct {
+	MFARequired    bool   `json:"mfa_required"`
+	ChallengeToken string `json:"challenge_token"`
+	ExpiresIn      int    `json:"expires_in"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// EnrollmentDTO is what authenticator apps are set up with, either by scanning
+// the URI as a QR code or by typing the secret.
+type EnrollmentDTO struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// RecoveryCodesDTO is shown once, when two-factor authentication is turned
+// on. Only the hashes of the codes are kept.
+type RecoveryCodesDTO struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"time"
+)
+
+// HandleConfirm turns two-factor authentication on with the first code of the
+// app and returns the recovery codes, which cannot be shown again.
+func (h *FactorHandler) HandleConfirm(ctx context.Context, cmd commands.ConfirmFactorCommand) (*dto.RecoveryCodesDTO, error) {
+	factor, err := h.repository.GetByUser(ctx, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = factor.Confirm(cmd.Code, time.Now()); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := factors.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.repository.Confirm(ctx, factor, hashes); err != nil {
+		h.logger.Error("Could not confirm factor of user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesDTO{RecoveryCodes: codes}, nil
+}
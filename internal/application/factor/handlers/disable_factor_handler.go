@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"golang.org/x/crypto/bcrypt"
+	"time"
+)
+
+// HandleDisable turns two-factor authentication off. A stolen access token is
+// not enough for it: the password is checked again, and a code as well once
+// the factor is on.
+func (h *FactorHandler) HandleDisable(ctx context.Context, cmd commands.DisableFactorCommand) error {
+	usr, err := h.userRepo.GetById(ctx, cmd.UserId)
+	if err != nil {
+		return err
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(usr.Password().String()), []byte(cmd.Password)); err != nil {
+		return users.ErrInvalidCredentialsUser
+	}
+
+	factor, err := h.repository.GetByUser(ctx, cmd.UserId)
+	if err != nil {
+		return err
+	}
+
+	if factor.IsConfirmed() {
+		if err = h.verify(ctx, factor, cmd.Code, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if err = h.repository.Delete(ctx, cmd.UserId); err != nil {
+		h.logger.Error("Could not delete factor of user %s: %v", cmd.UserId, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+)
+
+// HandleEnroll hands out a new secret for the app of the user. It replaces an
+// enrollment left unconfirmed, but not a factor that is on.
+func (h *FactorHandler) HandleEnroll(ctx context.Context, cmd commands.EnrollFactorCommand) (*dto.EnrollmentDTO, error) {
+	usr, err := h.userRepo.GetById(ctx, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := h.repository.GetByUser(ctx, cmd.UserId)
+	if err == nil && existing.IsConfirmed() {
+		return nil, factors.ErrConfirmedFactor
+	} else if err != nil && !errors.Is(err, factors.ErrNotFoundFactor) {
+		return nil, err
+	}
+
+	factor, err := factors.NewFactor(cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.repository.Save(ctx, factor); err != nil {
+		h.logger.Error("Could not save factor of user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return &dto.EnrollmentDTO{
+		Secret: factor.Secret(),
+		URI:    factors.URI(h.issuer, usr.Email().String(), factor.Secret()),
+	}, nil
+}
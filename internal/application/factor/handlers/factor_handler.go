@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"time"
+)
+
+// FactorHandler lets users guard their logins with a TOTP app. Once it is on,
+// a correct password only gets a challenge, which lasts challengeTTL and is
+// traded for the tokens along with a code.
+type FactorHandler struct {
+	repository   factors.FactorRepository
+	challenges   factors.ChallengeStore
+	userRepo     users.UserRepository
+	issuer       string
+	challengeTTL time.Duration
+	logger       application.Logger
+}
+
+func NewFactorHandler(repository factors.FactorRepository, challenges factors.ChallengeStore, userRepo users.UserRepository, issuer string, challengeTTL time.Duration, logger application.Logger) *FactorHandler {
+	return &FactorHandler{
+		repository:   repository,
+		challenges:   challenges,
+		userRepo:     userRepo,
+		issuer:       issuer,
+		challengeTTL: challengeTTL,
+		logger:       logger,
+	}
+}
+
+// verify accepts a code from the app of the user or one of their recovery
+// codes, each only once.
+func (h *FactorHandler) verify(ctx context.Context, factor *factors.Factor, code string, now time.Time) error {
+	if !isTOTPCode(code) {
+		used, err := h.repository.UseRecoveryCode(ctx, factor.UserId(), factors.HashRecoveryCode(code), now)
+		if err != nil {
+			return err
+		} else if !used {
+			return factors.ErrInvalidCodeFactor
+		}
+		return nil
+	}
+
+	counter, err := factor.Check(code, now)
+	if err != nil {
+		return err
+	}
+
+	accepted, err := h.repository.Accept(ctx, factor.UserId(), counter)
+	if err != nil {
+		return err
+	} else if !accepted {
+		return factors.ErrInvalidCodeFactor
+	}
+
+	factor.Accept(counter)
+	return nil
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != factors.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,440 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+	"time"
+)
+
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type MockFactorRepository struct {
+	mock.Mock
+}
+
+type MockChallengeStore struct {
+	mock.Mock
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+type factorMocks struct {
+	repository *MockFactorRepository
+	challenges *MockChallengeStore
+	userRepo   *MockUserRepository
+}
+
+func newTestFactorHandler() (*FactorHandler, factorMocks) {
+	m := factorMocks{
+		repository: new(MockFactorRepository),
+		challenges: new(MockChallengeStore),
+		userRepo:   new(MockUserRepository),
+	}
+
+	return NewFactorHandler(m.repository, m.challenges, m.userRepo, "Pinterest", 5*time.Minute, new(MockLogger)), m
+}
+
+func newTestUser(t *testing.T) *users.User {
+	now := time.Now()
+	usr, err := users.NewUserFromDB(uuid.New(), "John", "Doe", "johndoe", "john@doe.com", "5Tr0nG1.!", "Male", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, nil, nil)
+	require.NoError(t, err)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("5Tr0nG1.!"), bcrypt.MinCost)
+	require.NoError(t, err)
+	password, err := shared.NewHashedPassword(string(hashed))
+	require.NoError(t, err)
+	require.NoError(t, usr.ChangePassword(password))
+
+	return usr
+}
+
+func newTestFactor(userId uuid.UUID, confirmed bool) *factors.Factor {
+	now := time.Now()
+	var confirmedAt *time.Time
+	if confirmed {
+		confirmedAt = &now
+	}
+	return factors.NewFactorFromDB(userId, testSecret, 0, now, confirmedAt)
+}
+
+func currentCode(t *testing.T) string {
+	code, err := factors.Code(testSecret, time.Now())
+	require.NoError(t, err)
+	return code
+}
+
+func TestNewFactorHandler(t *testing.T) {
+	repository, challenges, userRepo, logger := new(MockFactorRepository), new(MockChallengeStore), new(MockUserRepository), new(MockLogger)
+	handler := NewFactorHandler(repository, challenges, userRepo, "Pinterest", time.Minute, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, challenges, handler.challenges)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Equal(t, "Pinterest", handler.issuer)
+	require.Equal(t, time.Minute, handler.challengeTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestFactorHandler_HandleEnroll(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	usr := newTestUser(t)
+
+	var saved *factors.Factor
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.repository.On("GetByUser", ctx, usr.Id()).Return(nil, factors.ErrNotFoundFactor)
+	m.repository.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*factors.Factor)
+	}).Return(nil)
+
+	enrollment, err := handler.HandleEnroll(ctx, commands.EnrollFactorCommand{UserId: usr.Id()})
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, usr.Id(), saved.UserId())
+	assert.False(t, saved.IsConfirmed())
+	assert.Equal(t, saved.Secret(), enrollment.Secret)
+	assert.Equal(t, factors.URI("Pinterest", "john@doe.com", saved.Secret()), enrollment.URI)
+}
+
+func TestFactorHandler_HandleEnroll_Confirmed(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	usr := newTestUser(t)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.repository.On("GetByUser", ctx, usr.Id()).Return(newTestFactor(usr.Id(), true), nil)
+
+	enrollment, err := handler.HandleEnroll(ctx, commands.EnrollFactorCommand{UserId: usr.Id()})
+
+	assert.ErrorIs(t, err, factors.ErrConfirmedFactor)
+	assert.Nil(t, enrollment)
+	m.repository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestFactorHandler_HandleConfirm(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+
+	var hashes []string
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, false), nil)
+	m.repository.On("Confirm", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		assert.True(t, args.Get(1).(*factors.Factor).IsConfirmed())
+		hashes = args.Get(2).([]string)
+	}).Return(nil)
+
+	codes, err := handler.HandleConfirm(ctx, commands.ConfirmFactorCommand{UserId: userId, Code: currentCode(t)})
+
+	require.NoError(t, err)
+	require.Len(t, codes.RecoveryCodes, factors.RecoveryCodes)
+	require.Len(t, hashes, factors.RecoveryCodes)
+	for i, code := range codes.RecoveryCodes {
+		assert.Equal(t, factors.HashRecoveryCode(code), hashes[i])
+	}
+}
+
+func TestFactorHandler_HandleConfirm_InvalidCode(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, false), nil)
+
+	codes, err := handler.HandleConfirm(ctx, commands.ConfirmFactorCommand{UserId: userId, Code: "abcdef"})
+
+	assert.ErrorIs(t, err, factors.ErrInvalidCodeFactor)
+	assert.Nil(t, codes)
+	m.repository.AssertNotCalled(t, "Confirm", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFactorHandler_HandleDisable(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	usr := newTestUser(t)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.repository.On("GetByUser", ctx, usr.Id()).Return(newTestFactor(usr.Id(), true), nil)
+	m.repository.On("Accept", ctx, usr.Id(), mock.Anything).Return(true, nil)
+	m.repository.On("Delete", ctx, usr.Id()).Return(nil)
+
+	err := handler.HandleDisable(ctx, commands.DisableFactorCommand{UserId: usr.Id(), Password: "5Tr0nG1.!", Code: currentCode(t)})
+
+	require.NoError(t, err)
+	m.repository.AssertExpectations(t)
+}
+
+func TestFactorHandler_HandleDisable_Reauthentication(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	usr := newTestUser(t)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.repository.On("GetByUser", ctx, usr.Id()).Return(newTestFactor(usr.Id(), true), nil)
+	m.repository.On("UseRecoveryCode", ctx, usr.Id(), factors.HashRecoveryCode("wrong"), mock.Anything).Return(false, nil)
+
+	err := handler.HandleDisable(ctx, commands.DisableFactorCommand{UserId: usr.Id(), Password: "wrong", Code: currentCode(t)})
+	assert.ErrorIs(t, err, users.ErrInvalidCredentialsUser)
+
+	err = handler.HandleDisable(ctx, commands.DisableFactorCommand{UserId: usr.Id(), Password: "5Tr0nG1.!", Code: "wrong"})
+	assert.ErrorIs(t, err, factors.ErrInvalidCodeFactor)
+
+	m.repository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestFactorHandler_HandleChallenge(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+
+	var saved *factors.Challenge
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, true), nil)
+	m.challenges.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*factors.Challenge)
+	}).Return(nil)
+
+	challenge, err := handler.HandleChallenge(ctx, commands.StartChallengeCommand{UserId: userId})
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.True(t, challenge.MFARequired)
+	assert.Equal(t, 300, challenge.ExpiresIn)
+	assert.Equal(t, tokens.Hash(challenge.ChallengeToken), saved.Hash())
+	assert.Equal(t, userId, saved.UserId())
+}
+
+func TestFactorHandler_HandleChallenge_NotEnabled(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	withoutFactor, unconfirmed := uuid.New(), uuid.New()
+
+	m.repository.On("GetByUser", ctx, withoutFactor).Return(nil, factors.ErrNotFoundFactor)
+	m.repository.On("GetByUser", ctx, unconfirmed).Return(newTestFactor(unconfirmed, false), nil)
+
+	for _, userId := range []uuid.UUID{withoutFactor, unconfirmed} {
+		challenge, err := handler.HandleChallenge(ctx, commands.StartChallengeCommand{UserId: userId})
+		require.NoError(t, err)
+		assert.Nil(t, challenge)
+	}
+	m.challenges.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestFactorHandler_HandleVerifyChallenge(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+	hash := tokens.Hash("challenge")
+
+	m.challenges.On("Get", ctx, hash).Return(factors.NewChallengeFromStore(hash, userId, time.Now().Add(time.Minute)), nil)
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, true), nil)
+	m.repository.On("Accept", ctx, userId, mock.Anything).Return(true, nil)
+	m.challenges.On("Delete", ctx, hash).Return(true, nil)
+
+	id, err := handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "challenge", Code: currentCode(t)})
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, id)
+	m.repository.AssertExpectations(t)
+	m.challenges.AssertExpectations(t)
+}
+
+func TestFactorHandler_HandleVerifyChallenge_RecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+	hash := tokens.Hash("challenge")
+
+	m.challenges.On("Get", ctx, hash).Return(factors.NewChallengeFromStore(hash, userId, time.Now().Add(time.Minute)), nil)
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, true), nil)
+	m.repository.On("UseRecoveryCode", ctx, userId, factors.HashRecoveryCode("abcde-fghij"), mock.Anything).Return(true, nil)
+	m.challenges.On("Delete", ctx, hash).Return(true, nil)
+
+	id, err := handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "challenge", Code: "ABCDE-FGHIJ"})
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, id)
+	m.repository.AssertNotCalled(t, "Accept", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFactorHandler_HandleVerifyChallenge_InvalidCode(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+	hash := tokens.Hash("challenge")
+
+	m.challenges.On("Get", ctx, hash).Return(factors.NewChallengeFromStore(hash, userId, time.Now().Add(time.Minute)), nil)
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, true), nil)
+	m.repository.On("Accept", ctx, userId, mock.Anything).Return(false, nil)
+	m.challenges.On("Fail", ctx, hash).Return(1, nil).Once()
+	m.challenges.On("Fail", ctx, hash).Return(factors.MaxChallengeAttempts, nil).Once()
+	m.challenges.On("Delete", ctx, hash).Return(true, nil).Once()
+
+	_, err := handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "challenge", Code: currentCode(t)})
+	assert.ErrorIs(t, err, factors.ErrInvalidCodeFactor)
+	m.challenges.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+
+	_, err = handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "challenge", Code: currentCode(t)})
+	assert.ErrorIs(t, err, factors.ErrInvalidCodeFactor)
+	m.challenges.AssertExpectations(t)
+}
+
+func TestFactorHandler_HandleVerifyChallenge_InvalidChallenge(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFactorHandler()
+	userId := uuid.New()
+	hash := tokens.Hash("answered")
+
+	m.challenges.On("Get", ctx, tokens.Hash("unknown")).Return(nil, factors.ErrInvalidChallenge)
+	m.challenges.On("Get", ctx, hash).Return(factors.NewChallengeFromStore(hash, userId, time.Now().Add(time.Minute)), nil)
+	m.repository.On("GetByUser", ctx, userId).Return(newTestFactor(userId, true), nil)
+	m.repository.On("Accept", ctx, userId, mock.Anything).Return(true, nil)
+	m.challenges.On("Delete", ctx, hash).Return(false, nil)
+
+	_, err := handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{Code: currentCode(t)})
+	assert.ErrorIs(t, err, factors.ErrInvalidChallenge)
+
+	_, err = handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "unknown", Code: currentCode(t)})
+	assert.ErrorIs(t, err, factors.ErrInvalidChallenge)
+
+	_, err = handler.HandleVerifyChallenge(ctx, commands.VerifyChallengeCommand{ChallengeToken: "answered", Code: currentCode(t)})
+	assert.ErrorIs(t, err, factors.ErrInvalidChallenge)
+}
+
+func (m *MockFactorRepository) GetByUser(ctx context.Context, userId uuid.UUID) (*factors.Factor, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*factors.Factor), args.Error(1)
+}
+
+func (m *MockFactorRepository) Save(ctx context.Context, factor *factors.Factor) error {
+	args := m.Called(ctx, factor)
+	return args.Error(0)
+}
+
+func (m *MockFactorRepository) Confirm(ctx context.Context, factor *factors.Factor, hashes []string) error {
+	args := m.Called(ctx, factor, hashes)
+	return args.Error(0)
+}
+
+func (m *MockFactorRepository) Accept(ctx context.Context, userId uuid.UUID, counter int64) (bool, error) {
+	args := m.Called(ctx, userId, counter)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFactorRepository) UseRecoveryCode(ctx context.Context, userId uuid.UUID, hash string, at time.Time) (bool, error) {
+	args := m.Called(ctx, userId, hash, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFactorRepository) Delete(ctx context.Context, userId uuid.UUID) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+func (m *MockChallengeStore) Save(ctx context.Context, challenge *factors.Challenge) error {
+	args := m.Called(ctx, challenge)
+	return args.Error(0)
+}
+
+func (m *MockChallengeStore) Get(ctx context.Context, hash string) (*factors.Challenge, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*factors.Challenge), args.Error(1)
+}
+
+func (m *MockChallengeStore) Fail(ctx context.Context, hash string) (int, error) {
+	args := m.Called(ctx, hash)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockChallengeStore) Delete(ctx context.Context, hash string) (bool, error) {
+	args := m.Called(ctx, hash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
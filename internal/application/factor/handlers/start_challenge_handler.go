@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+)
+
+// HandleChallenge is called once the password of a login checks out. It
+// returns nil when the user has no factor on, so the login can go on.
+func (h *FactorHandler) HandleChallenge(ctx context.Context, cmd commands.StartChallengeCommand) (*dto.ChallengeDTO, error) {
+	factor, err := h.repository.GetByUser(ctx, cmd.UserId)
+	if errors.Is(err, factors.ErrNotFoundFactor) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if !factor.IsConfirmed() {
+		return nil, nil
+	}
+
+	challenge, secret, err := factors.NewChallenge(cmd.UserId, h.challengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.challenges.Save(ctx, challenge); err != nil {
+		h.logger.Error("Could not save challenge of user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return &dto.ChallengeDTO{
+		MFARequired:    true,
+		ChallengeToken: secret,
+		ExpiresIn:      int(h.challengeTTL.Seconds()),
+	}, nil
+}
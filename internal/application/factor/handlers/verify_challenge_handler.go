@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"time"
+)
+
+// HandleVerifyChallenge returns the user to issue tokens for once the code
+// answers the challenge. Each challenge is answered once, and dropped after
+// MaxChallengeAttempts wrong codes.
+func (h *FactorHandler) HandleVerifyChallenge(ctx context.Context, cmd commands.VerifyChallengeCommand) (uuid.UUID, error) {
+	if cmd.ChallengeToken == "" {
+		return uuid.Nil, factors.ErrInvalidChallenge
+	}
+
+	hash := tokens.Hash(cmd.ChallengeToken)
+	challenge, err := h.challenges.Get(ctx, hash)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	factor, err := h.repository.GetByUser(ctx, challenge.UserId())
+	if errors.Is(err, factors.ErrNotFoundFactor) {
+		return uuid.Nil, factors.ErrInvalidChallenge
+	} else if err != nil {
+		return uuid.Nil, err
+	} else if !factor.IsConfirmed() {
+		return uuid.Nil, factors.ErrInvalidChallenge
+	}
+
+	err = h.verify(ctx, factor, cmd.Code, time.Now())
+	if errors.Is(err, factors.ErrInvalidCodeFactor) {
+		fails, failErr := h.challenges.Fail(ctx, hash)
+		if failErr != nil {
+			h.logger.Error("Could not count failed code of user %s: %v", challenge.UserId(), failErr)
+		} else if fails >= factors.MaxChallengeAttempts {
+			if _, err = h.challenges.Delete(ctx, hash); err != nil {
+				h.logger.Error("Could not drop challenge of user %s: %v", challenge.UserId(), err)
+			}
+		}
+		return uuid.Nil, factors.ErrInvalidCodeFactor
+	} else if err != nil {
+		return uuid.Nil, err
+	}
+
+	deleted, err := h.challenges.Delete(ctx, hash)
+	if err != nil {
+		return uuid.Nil, err
+	} else if !deleted {
+		return uuid.Nil, factors.ErrInvalidChallenge
+	}
+
+	return challenge.UserId(), nil
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type FollowBoardCommand struct {
+	UserId  uuid.UUID `json:"user_id"`
+	BoardId uuid.UUID `json:"board_id"`
+}
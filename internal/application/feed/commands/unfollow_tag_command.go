@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UnfollowTagCommand struct {
+	UserId uuid.UUID `json:"user_id"`
+	Tag    string    `json:"tag"`
+}
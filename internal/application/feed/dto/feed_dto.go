@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+
+// FeedPinDTO is a pin of the home feed. Score and Explanation tell why the
+// ranker placed it where it is; they are only filled when asked for.
+type FeedPinDTO struct {
+	*dto.PinDTO
+	Score       *float64 `json:"score,omitempty"`
+	Explanation *string  `json:"explanation,omitempty"`
+}
+
+type FeedPageDTO struct {
+	Pins       []*FeedPinDTO `json:"pins"`
+	NextCursor *string       `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type BoardFollowDTO struct {
+	UserId    uuid.UUID `json:"user_id"`
+	BoardId   uuid.UUID `json:"board_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TagFollowDTO struct {
+	UserId    uuid.UUID `json:"user_id"`
+	TagId     uuid.UUID `json:"tag_id"`
+	Tag       string    `json:"tag"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+// Distribute copies a new pin into the stored feeds of the users it reaches.
+// It implements feeds.Distributor: failures are logged and never returned.
+func (h *FeedHandler) Distribute(ctx context.Context, pin *pins.Pin) {
+	if !pin.Visibility() {
+		return
+	}
+
+	recipients, err := h.repository.GetRecipients(ctx, pin.Id(), h.popularAt)
+	if err != nil {
+		h.logger.Error("Could not find feed recipients of pin %s: %v", pin.Id(), err)
+		return
+	}
+
+	if err = h.store.Add(ctx, recipients, []feeds.Entry{feeds.NewEntry(pin.Id(), pin.CreatedAt())}); err != nil {
+		h.logger.Error("Could not distribute pin %s to %d feeds: %v", pin.Id(), len(recipients), err)
+	}
+}
+
+// Followed backfills the newest pins of a source the user just followed.
+// Popular authors are skipped, their pins are read with the feed.
+func (h *FeedHandler) Followed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	if source.Kind() == feeds.UserSource {
+		popular, err := h.repository.IsPopular(ctx, source.Id(), h.popularAt)
+		if err != nil {
+			h.logger.Error("Could not check followers of %s: %v", source.Id(), err)
+			return
+		} else if popular {
+			return
+		}
+	}
+
+	entries, err := h.repository.GetListBySource(ctx, source, h.maxSize)
+	if err != nil {
+		h.logger.Error("Could not load pins of %s %s: %v", source.Kind(), source.Id(), err)
+		return
+	}
+
+	if err = h.store.Add(ctx, []uuid.UUID{userId}, entries); err != nil {
+		h.logger.Error("Could not backfill the feed of %s: %v", userId, err)
+	}
+}
+
+// Unfollowed takes the pins of a source out of the user's stored feed, except
+// the ones another followed source still brings in.
+func (h *FeedHandler) Unfollowed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	pinIds, err := h.repository.GetListOrphaned(ctx, userId, source, h.maxSize)
+	if err != nil {
+		h.logger.Error("Could not load pins of %s %s: %v", source.Kind(), source.Id(), err)
+		return
+	}
+
+	if err = h.store.Remove(ctx, userId, pinIds); err != nil {
+		h.logger.Error("Could not clean the feed of %s: %v", userId, err)
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+// FeedHandler follows boards and tags and keeps the stored home feeds up to
+// date. Pins of authors with at least popularAt followers are left out of
+// stored feeds and read when the feed is requested; backfills and removals
+// look at the newest maxSize pins of a source, as much as a stored feed holds.
+type FeedHandler struct {
+	repository      feeds.FeedRepository
+	store           feeds.FeedStore
+	boardFollowRepo follows.BoardFollowRepository
+	tagFollowRepo   follows.TagFollowRepository
+	boardRepo       boards.BoardRepository
+	tagRepo         pins.TagRepository
+	blockRepo       blocks.BlockRepository
+	tracker         interests.Tracker
+	popularAt       int
+	maxSize         int
+	logger          application.Logger
+}
+
+func NewFeedHandler(repository feeds.FeedRepository, store feeds.FeedStore, boardFollowRepo follows.BoardFollowRepository, tagFollowRepo follows.TagFollowRepository, boardRepo boards.BoardRepository, tagRepo pins.TagRepository, blockRepo blocks.BlockRepository, tracker interests.Tracker, popularAt, maxSize int, logger application.Logger) *FeedHandler {
+	return &FeedHandler{
+		repository:      repository,
+		store:           store,
+		boardFollowRepo: boardFollowRepo,
+		tagFollowRepo:   tagFollowRepo,
+		boardRepo:       boardRepo,
+		tagRepo:         tagRepo,
+		blockRepo:       blockRepo,
+		tracker:         tracker,
+		popularAt:       popularAt,
+		maxSize:         maxSize,
+		logger:          logger,
+	}
+}
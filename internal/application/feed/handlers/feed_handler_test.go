@@ -0,0 +1,475 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockStore struct {
+	mock.Mock
+}
+
+type MockBoardFollowRepository struct {
+	mock.Mock
+}
+
+type MockTagFollowRepository struct {
+	mock.Mock
+}
+
+type MockBoardRepository struct {
+	mock.Mock
+}
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+type MockTracker struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+type feedHandlerMocks struct {
+	repository      *MockRepository
+	store           *MockStore
+	boardFollowRepo *MockBoardFollowRepository
+	tagFollowRepo   *MockTagFollowRepository
+	boardRepo       *MockBoardRepository
+	tagRepo         *MockTagRepository
+	blockRepo       *MockBlockRepository
+	tracker         *MockTracker
+}
+
+func TestNewFeedHandler(t *testing.T) {
+	repository := new(MockRepository)
+	store := new(MockStore)
+	boardFollowRepo := new(MockBoardFollowRepository)
+	tagFollowRepo := new(MockTagFollowRepository)
+	boardRepo := new(MockBoardRepository)
+	tagRepo := new(MockTagRepository)
+	blockRepo := new(MockBlockRepository)
+	tracker := new(MockTracker)
+	logger := new(MockLogger)
+	handler := NewFeedHandler(repository, store, boardFollowRepo, tagFollowRepo, boardRepo, tagRepo, blockRepo, tracker, 100, 50, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, store, handler.store)
+	require.Exactly(t, boardFollowRepo, handler.boardFollowRepo)
+	require.Exactly(t, tagFollowRepo, handler.tagFollowRepo)
+	require.Exactly(t, boardRepo, handler.boardRepo)
+	require.Exactly(t, tagRepo, handler.tagRepo)
+	require.Exactly(t, blockRepo, handler.blockRepo)
+	require.Exactly(t, tracker, handler.tracker)
+	require.Equal(t, 100, handler.popularAt)
+	require.Equal(t, 50, handler.maxSize)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestFeedHandler_Distribute(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	pin := pins.NewPin(uuid.New(), uuid.New(), "Kitchen", nil, nil)
+	recipients := []uuid.UUID{uuid.New(), uuid.New()}
+
+	m.repository.On("GetRecipients", ctx, pin.Id(), 100).Return(recipients, nil)
+	m.store.On("Add", ctx, recipients, []feeds.Entry{feeds.NewEntry(pin.Id(), pin.CreatedAt())}).Return(nil)
+
+	handler.Distribute(ctx, pin)
+
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_Distribute_Hidden(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	now := time.Now()
+	pin := pins.NewPinFromDB(uuid.New(), uuid.New(), uuid.New(), "Kitchen", nil, nil, 0, 0, 0, false, nil, now, now, nil)
+
+	handler.Distribute(ctx, pin)
+
+	m.repository.AssertNotCalled(t, "GetRecipients", mock.Anything, mock.Anything, mock.Anything)
+	m.store.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_Distribute_RecipientsError(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	pin := pins.NewPin(uuid.New(), uuid.New(), "Kitchen", nil, nil)
+
+	m.repository.On("GetRecipients", ctx, pin.Id(), 100).Return(nil, errors.New("db down"))
+
+	handler.Distribute(ctx, pin)
+
+	m.assertExpectations(t)
+	m.store.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_Followed(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	userId := uuid.New()
+	source, err := feeds.NewSource(feeds.UserSource, uuid.New())
+	require.NoError(t, err)
+	entries := []feeds.Entry{feeds.NewEntry(uuid.New(), time.Now())}
+
+	m.repository.On("IsPopular", ctx, source.Id(), 100).Return(false, nil)
+	m.repository.On("GetListBySource", ctx, source, 50).Return(entries, nil)
+	m.store.On("Add", ctx, []uuid.UUID{userId}, entries).Return(nil)
+
+	handler.Followed(ctx, userId, source)
+
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_Followed_Popular(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	source, err := feeds.NewSource(feeds.UserSource, uuid.New())
+	require.NoError(t, err)
+
+	m.repository.On("IsPopular", ctx, source.Id(), 100).Return(true, nil)
+
+	handler.Followed(ctx, uuid.New(), source)
+
+	m.assertExpectations(t)
+	m.repository.AssertNotCalled(t, "GetListBySource", mock.Anything, mock.Anything, mock.Anything)
+	m.store.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_Followed_Board(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	userId := uuid.New()
+	source, err := feeds.NewSource(feeds.BoardSource, uuid.New())
+	require.NoError(t, err)
+	entries := []feeds.Entry{feeds.NewEntry(uuid.New(), time.Now())}
+
+	m.repository.On("GetListBySource", ctx, source, 50).Return(entries, nil)
+	m.store.On("Add", ctx, []uuid.UUID{userId}, entries).Return(nil)
+
+	handler.Followed(ctx, userId, source)
+
+	m.assertExpectations(t)
+	m.repository.AssertNotCalled(t, "IsPopular", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_Unfollowed(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	userId := uuid.New()
+	source, err := feeds.NewSource(feeds.TagSource, uuid.New())
+	require.NoError(t, err)
+	pinIds := []uuid.UUID{uuid.New()}
+
+	m.repository.On("GetListOrphaned", ctx, userId, source, 50).Return(pinIds, nil)
+	m.store.On("Remove", ctx, userId, pinIds).Return(nil)
+
+	handler.Unfollowed(ctx, userId, source)
+
+	m.assertExpectations(t)
+}
+
+func newTestFeedHandler() (*FeedHandler, *feedHandlerMocks) {
+	m := &feedHandlerMocks{
+		repository:      new(MockRepository),
+		store:           new(MockStore),
+		boardFollowRepo: new(MockBoardFollowRepository),
+		tagFollowRepo:   new(MockTagFollowRepository),
+		boardRepo:       new(MockBoardRepository),
+		tagRepo:         new(MockTagRepository),
+		blockRepo:       new(MockBlockRepository),
+		tracker:         new(MockTracker),
+	}
+	handler := NewFeedHandler(m.repository, m.store, m.boardFollowRepo, m.tagFollowRepo, m.boardRepo, m.tagRepo, m.blockRepo, m.tracker, 100, 50, new(MockLogger))
+	return handler, m
+}
+
+func (m *feedHandlerMocks) assertExpectations(t *testing.T) {
+	m.repository.AssertExpectations(t)
+	m.store.AssertExpectations(t)
+	m.boardFollowRepo.AssertExpectations(t)
+	m.tagFollowRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.tagRepo.AssertExpectations(t)
+	m.blockRepo.AssertExpectations(t)
+	m.tracker.AssertExpectations(t)
+}
+
+func (m *MockRepository) GetRecipients(ctx context.Context, pinId uuid.UUID, popularAt int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, pinId, popularAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetList(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListFromPopular(ctx context.Context, userId uuid.UUID, popularAt int, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, popularAt, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListBySource(ctx context.Context, source feeds.Source, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, source, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListOrphaned(ctx context.Context, userId uuid.UUID, source feeds.Source, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userId, source, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetListVisible(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) IsPopular(ctx context.Context, userId uuid.UUID, popularAt int) (bool, error) {
+	args := m.Called(ctx, userId, popularAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockStore) Exists(ctx context.Context, userId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockStore) Page(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockStore) Add(ctx context.Context, userIds []uuid.UUID, entries []feeds.Entry) error {
+	args := m.Called(ctx, userIds, entries)
+	return args.Error(0)
+}
+
+func (m *MockStore) Replace(ctx context.Context, userId uuid.UUID, entries []feeds.Entry) error {
+	args := m.Called(ctx, userId, entries)
+	return args.Error(0)
+}
+
+func (m *MockStore) Remove(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) error {
+	args := m.Called(ctx, userId, pinIds)
+	return args.Error(0)
+}
+
+func (m *MockBoardFollowRepository) Exists(ctx context.Context, userId, boardId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userId, boardId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBoardFollowRepository) Create(ctx context.Context, f *follows.BoardFollow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockBoardFollowRepository) Delete(ctx context.Context, f *follows.BoardFollow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockTagFollowRepository) Exists(ctx context.Context, userId, tagId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userId, tagId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTagFollowRepository) Create(ctx context.Context, f *follows.TagFollow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockTagFollowRepository) Delete(ctx context.Context, f *follows.TagFollow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockBoardRepository) GetAll(ctx context.Context) ([]*boards.Board, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) GetList(ctx context.Context) ([]*boards.Board, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*boards.Board, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) GetListByName(ctx context.Context, name string) ([]*boards.Board, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) GetById(ctx context.Context, id uuid.UUID) (*boards.Board, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBoardRepository) Create(ctx context.Context, b *boards.Board) (*boards.Board, error) {
+	args := m.Called(ctx, b)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) Update(ctx context.Context, b *boards.Board) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockBoardRepository) Delete(ctx context.Context, b *boards.Board) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) GetByName(ctx context.Context, name string) (*pins.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) GetListByPinId(ctx context.Context, pinId uuid.UUID) ([]pins.Tag, error) {
+	args := m.Called(ctx, pinId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pins.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) GetOrCreate(ctx context.Context, name string) (*pins.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Tag), args.Error(1)
+}
+
+func (m *MockBlockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	args := m.Called(ctx, blockerId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*blocks.Block), args.Error(1)
+}
+
+func (m *MockBlockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, blockerId, blockedId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userIds)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockBlockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockTracker) Track(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	m.Called(ctx, userId, signal, pinId)
+}
+
+func (m *MockTracker) Untrack(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	m.Called(ctx, userId, signal, pinId)
+}
+
+func (m *MockTracker) FollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	m.Called(ctx, userId, tagId)
+}
+
+func (m *MockTracker) UnfollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	m.Called(ctx, userId, tagId)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/google/uuid"
+)
+
+func (h *FeedHandler) HandleFollowBoard(ctx context.Context, cmd commands.FollowBoardCommand) (*dto.BoardFollowDTO, error) {
+	follow, err := follows.NewBoardFollow(cmd.UserId, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.boardRepo.ExistById(ctx, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, boards.ErrNotFoundBoard
+	}
+
+	board, err := h.boardRepo.GetById(ctx, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	if board.UserId() == cmd.UserId {
+		return nil, follows.ErrOwnBoardFollow
+	} else if !board.Visibility() {
+		return nil, boards.ErrNotFoundBoard
+	}
+
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{cmd.UserId, board.UserId()})
+	if err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, blocks.ErrBlockedUser
+	}
+
+	exist, err = h.boardFollowRepo.Exists(ctx, cmd.UserId, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, follows.ErrExistsBoardFollow
+	}
+
+	if err = h.boardFollowRepo.Create(ctx, follow); err != nil {
+		h.logger.Error("Could not follow board %s: %v", cmd.BoardId, err)
+		return nil, err
+	}
+
+	source, _ := feeds.NewSource(feeds.BoardSource, cmd.BoardId)
+	h.Followed(ctx, cmd.UserId, source)
+
+	return mappers.MapToBoardFollowDTO(follow), nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestFeedHandler_HandleFollowBoard(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	board := boards.NewBoard(uuid.New(), "Kitchen", nil, true)
+	cmd := commands.FollowBoardCommand{
+		UserId:  uuid.New(),
+		BoardId: board.Id(),
+	}
+	source, err := feeds.NewSource(feeds.BoardSource, board.Id())
+	require.NoError(t, err)
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, board.UserId()}).Return(false, nil)
+	m.boardFollowRepo.On("Exists", ctx, cmd.UserId, board.Id()).Return(false, nil)
+	m.boardFollowRepo.On("Create", ctx, mock.AnythingOfType("*follows.BoardFollow")).Return(nil)
+	m.repository.On("GetListBySource", ctx, source, 50).Return([]feeds.Entry{}, nil)
+	m.store.On("Add", ctx, []uuid.UUID{cmd.UserId}, []feeds.Entry{}).Return(nil)
+
+	follow, err := handler.HandleFollowBoard(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.UserId, follow.UserId)
+	assert.Equal(t, cmd.BoardId, follow.BoardId)
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_HandleFollowBoard_Errors(t *testing.T) {
+	ownerId, userId := uuid.New(), uuid.New()
+	public := boards.NewBoard(ownerId, "Kitchen", nil, true)
+	now := time.Now()
+	secret := boards.NewBoardFromDB(uuid.New(), ownerId, "Secret", nil, false, 0, nil, now, now, nil)
+	own := boards.NewBoard(userId, "Mine", nil, true)
+
+	cases := []struct {
+		name  string
+		board *boards.Board
+		setup func(ctx context.Context, m *feedHandlerMocks, board *boards.Board)
+		err   error
+	}{
+		{
+			name:  "not found",
+			board: public,
+			setup: func(ctx context.Context, m *feedHandlerMocks, board *boards.Board) {
+				m.boardRepo.On("ExistById", ctx, board.Id()).Return(false, nil)
+			},
+			err: boards.ErrNotFoundBoard,
+		},
+		{
+			name:  "own board",
+			board: own,
+			setup: func(ctx context.Context, m *feedHandlerMocks, board *boards.Board) {
+				m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+				m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+			},
+			err: follows.ErrOwnBoardFollow,
+		},
+		{
+			name:  "secret board",
+			board: secret,
+			setup: func(ctx context.Context, m *feedHandlerMocks, board *boards.Board) {
+				m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+				m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+			},
+			err: boards.ErrNotFoundBoard,
+		},
+		{
+			name:  "blocked",
+			board: public,
+			setup: func(ctx context.Context, m *feedHandlerMocks, board *boards.Board) {
+				m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+				m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+				m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, ownerId}).Return(true, nil)
+			},
+			err: blocks.ErrBlockedUser,
+		},
+		{
+			name:  "already following",
+			board: public,
+			setup: func(ctx context.Context, m *feedHandlerMocks, board *boards.Board) {
+				m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+				m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+				m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, ownerId}).Return(false, nil)
+				m.boardFollowRepo.On("Exists", ctx, userId, board.Id()).Return(true, nil)
+			},
+			err: follows.ErrExistsBoardFollow,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			handler, m := newTestFeedHandler()
+			tc.setup(ctx, m, tc.board)
+
+			follow, err := handler.HandleFollowBoard(ctx, commands.FollowBoardCommand{UserId: userId, BoardId: tc.board.Id()})
+
+			assert.Nil(t, follow)
+			assert.ErrorIs(t, err, tc.err)
+			m.assertExpectations(t)
+			m.boardFollowRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFeedHandler_HandleUnfollowBoard(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	cmd := commands.UnfollowBoardCommand{
+		UserId:  uuid.New(),
+		BoardId: uuid.New(),
+	}
+	source, err := feeds.NewSource(feeds.BoardSource, cmd.BoardId)
+	require.NoError(t, err)
+	pinIds := []uuid.UUID{uuid.New()}
+
+	m.boardFollowRepo.On("Exists", ctx, cmd.UserId, cmd.BoardId).Return(true, nil)
+	m.boardFollowRepo.On("Delete", ctx, mock.AnythingOfType("*follows.BoardFollow")).Return(nil)
+	m.repository.On("GetListOrphaned", ctx, cmd.UserId, source, 50).Return(pinIds, nil)
+	m.store.On("Remove", ctx, cmd.UserId, pinIds).Return(nil)
+
+	err = handler.HandleUnfollowBoard(ctx, cmd)
+
+	require.NoError(t, err)
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_HandleUnfollowBoard_NotFollowing(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	cmd := commands.UnfollowBoardCommand{
+		UserId:  uuid.New(),
+		BoardId: uuid.New(),
+	}
+
+	m.boardFollowRepo.On("Exists", ctx, cmd.UserId, cmd.BoardId).Return(false, nil)
+
+	err := handler.HandleUnfollowBoard(ctx, cmd)
+
+	assert.ErrorIs(t, err, follows.ErrNotFoundBoardFollow)
+	m.assertExpectations(t)
+	m.store.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything, mock.Anything)
+}
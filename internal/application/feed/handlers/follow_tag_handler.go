@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+// HandleFollowTag follows a hashtag. Tags nobody has used yet are created, so
+// users can follow a topic before its first pin.
+func (h *FeedHandler) HandleFollowTag(ctx context.Context, cmd commands.FollowTagCommand) (*dto.TagFollowDTO, error) {
+	name, err := shared.NewHashtag(cmd.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := h.tagRepo.GetOrCreate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	follow, err := follows.NewTagFollow(cmd.UserId, tag.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.tagFollowRepo.Exists(ctx, cmd.UserId, tag.Id())
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, follows.ErrExistsTagFollow
+	}
+
+	if err = h.tagFollowRepo.Create(ctx, follow); err != nil {
+		h.logger.Error("Could not follow tag %s: %v", name, err)
+		return nil, err
+	}
+
+	source, _ := feeds.NewSource(feeds.TagSource, tag.Id())
+	h.Followed(ctx, cmd.UserId, source)
+	h.tracker.FollowedTag(ctx, cmd.UserId, tag.Id())
+
+	return mappers.MapToTagFollowDTO(follow, tag.Name()), nil
+}
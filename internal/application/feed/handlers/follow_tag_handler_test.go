@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFeedHandler_HandleFollowTag(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	tag := pins.NewTag("kitchen")
+	cmd := commands.FollowTagCommand{
+		UserId: uuid.New(),
+		Tag:    "#Kitchen",
+	}
+	source, err := feeds.NewSource(feeds.TagSource, tag.Id())
+	require.NoError(t, err)
+
+	m.tagRepo.On("GetOrCreate", ctx, "kitchen").Return(tag, nil)
+	m.tagFollowRepo.On("Exists", ctx, cmd.UserId, tag.Id()).Return(false, nil)
+	m.tagFollowRepo.On("Create", ctx, mock.AnythingOfType("*follows.TagFollow")).Return(nil)
+	m.repository.On("GetListBySource", ctx, source, 50).Return([]feeds.Entry{}, nil)
+	m.store.On("Add", ctx, []uuid.UUID{cmd.UserId}, []feeds.Entry{}).Return(nil)
+	m.tracker.On("FollowedTag", ctx, cmd.UserId, tag.Id()).Return()
+
+	follow, err := handler.HandleFollowTag(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.UserId, follow.UserId)
+	assert.Equal(t, tag.Id(), follow.TagId)
+	assert.Equal(t, "kitchen", follow.Tag)
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_HandleFollowTag_Invalid(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	follow, err := handler.HandleFollowTag(ctx, commands.FollowTagCommand{UserId: uuid.New(), Tag: "#"})
+
+	assert.Nil(t, follow)
+	assert.ErrorIs(t, err, shared.ErrEmptyHashtag)
+	m.tagRepo.AssertNotCalled(t, "GetOrCreate", mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_HandleFollowTag_AlreadyFollowing(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	tag := pins.NewTag("kitchen")
+	cmd := commands.FollowTagCommand{
+		UserId: uuid.New(),
+		Tag:    "kitchen",
+	}
+
+	m.tagRepo.On("GetOrCreate", ctx, "kitchen").Return(tag, nil)
+	m.tagFollowRepo.On("Exists", ctx, cmd.UserId, tag.Id()).Return(true, nil)
+
+	follow, err := handler.HandleFollowTag(ctx, cmd)
+
+	assert.Nil(t, follow)
+	assert.ErrorIs(t, err, follows.ErrExistsTagFollow)
+	m.assertExpectations(t)
+	m.tagFollowRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFeedHandler_HandleUnfollowTag(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	tag := pins.NewTag("kitchen")
+	cmd := commands.UnfollowTagCommand{
+		UserId: uuid.New(),
+		Tag:    "kitchen",
+	}
+	source, err := feeds.NewSource(feeds.TagSource, tag.Id())
+	require.NoError(t, err)
+	pinIds := []uuid.UUID{uuid.New()}
+
+	m.tagRepo.On("GetByName", ctx, "kitchen").Return(tag, nil)
+	m.tagFollowRepo.On("Exists", ctx, cmd.UserId, tag.Id()).Return(true, nil)
+	m.tagFollowRepo.On("Delete", ctx, mock.AnythingOfType("*follows.TagFollow")).Return(nil)
+	m.repository.On("GetListOrphaned", ctx, cmd.UserId, source, 50).Return(pinIds, nil)
+	m.store.On("Remove", ctx, cmd.UserId, pinIds).Return(nil)
+	m.tracker.On("UnfollowedTag", ctx, cmd.UserId, tag.Id()).Return()
+
+	err = handler.HandleUnfollowTag(ctx, cmd)
+
+	require.NoError(t, err)
+	m.assertExpectations(t)
+}
+
+func TestFeedHandler_HandleUnfollowTag_UnknownTag(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestFeedHandler()
+
+	m.tagRepo.On("GetByName", ctx, "kitchen").Return(nil, pins.ErrNotFoundTag)
+
+	err := handler.HandleUnfollowTag(ctx, commands.UnfollowTagCommand{UserId: uuid.New(), Tag: "kitchen"})
+
+	assert.ErrorIs(t, err, follows.ErrNotFoundTagFollow)
+	m.assertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+)
+
+func (h *FeedHandler) HandleUnfollowBoard(ctx context.Context, cmd commands.UnfollowBoardCommand) error {
+	follow, err := follows.NewBoardFollow(cmd.UserId, cmd.BoardId)
+	if err != nil {
+		return err
+	}
+
+	exist, err := h.boardFollowRepo.Exists(ctx, cmd.UserId, cmd.BoardId)
+	if err != nil {
+		return err
+	} else if !exist {
+		return follows.ErrNotFoundBoardFollow
+	}
+
+	if err = h.boardFollowRepo.Delete(ctx, follow); err != nil {
+		return err
+	}
+
+	source, _ := feeds.NewSource(feeds.BoardSource, cmd.BoardId)
+	h.Unfollowed(ctx, cmd.UserId, source)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+func (h *FeedHandler) HandleUnfollowTag(ctx context.Context, cmd commands.UnfollowTagCommand) error {
+	name, err := shared.NewHashtag(cmd.Tag)
+	if err != nil {
+		return err
+	}
+
+	tag, err := h.tagRepo.GetByName(ctx, name)
+	if errors.Is(err, pins.ErrNotFoundTag) {
+		return follows.ErrNotFoundTagFollow
+	} else if err != nil {
+		return err
+	}
+
+	follow, err := follows.NewTagFollow(cmd.UserId, tag.Id())
+	if err != nil {
+		return err
+	}
+
+	exist, err := h.tagFollowRepo.Exists(ctx, cmd.UserId, tag.Id())
+	if err != nil {
+		return err
+	} else if !exist {
+		return follows.ErrNotFoundTagFollow
+	}
+
+	if err = h.tagFollowRepo.Delete(ctx, follow); err != nil {
+		return err
+	}
+
+	source, _ := feeds.NewSource(feeds.TagSource, tag.Id())
+	h.Unfollowed(ctx, cmd.UserId, source)
+	h.tracker.UnfollowedTag(ctx, cmd.UserId, tag.Id())
+
+	return nil
+}
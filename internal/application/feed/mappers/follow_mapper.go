@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+)
+
+func MapToBoardFollowDTO(follow *follows.BoardFollow) *dto.BoardFollowDTO {
+	return &dto.BoardFollowDTO{
+		UserId:    follow.UserId(),
+		BoardId:   follow.BoardId(),
+		CreatedAt: follow.CreatedAt(),
+	}
+}
+
+func MapToTagFollowDTO(follow *follows.TagFollow, tag string) *dto.TagFollowDTO {
+	return &dto.TagFollowDTO{
+		UserId:    follow.UserId(),
+		TagId:     follow.TagId(),
+		Tag:       tag,
+		CreatedAt: follow.CreatedAt(),
+	}
+}
@@ -0,0 +1,10 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetFeedQuery struct {
+	UserId  uuid.UUID `json:"user_id"`
+	Cursor  string    `json:"cursor,omitempty"`
+	Limit   int       `json:"limit"`
+	Explain bool      `json:"explain,omitempty"`
+}
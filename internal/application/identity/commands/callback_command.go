@@ -0,0 +1,10 @@
+package commands
+
+// CallbackCommand is what the provider sends the user back with: the code
+// and state of the login, or the error when it was not authorized.
+type CallbackCommand struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Code     string `json:"code"`
+	Error    string `json:"error"`
+}
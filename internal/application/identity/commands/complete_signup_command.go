@@ -0,0 +1,16 @@
+package commands
+
+import "time"
+
+// CompleteSignupCommand fills in what the provider did not tell about a new
+// user. The names default to the ones the provider gave.
+type CompleteSignupCommand struct {
+	SignupToken string    `json:"signup_token"`
+	Username    string    `json:"username"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name"`
+	Gender      string    `json:"gender"`
+	Birth       time.Time `json:"birth"`
+	Country     string    `json:"country"`
+	Language    string    `json:"language"`
+}
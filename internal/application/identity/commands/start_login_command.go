@@ -0,0 +1,5 @@
+package commands
+
+type StartLoginCommand struct {
+	Provider string `json:"provider"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// AuthorizationDTO is the page of the provider to send the user to.
+type AuthorizationDTO struct {
+	AuthorizationURL string `json:"authorization_url"`
+	ExpiresIn        int    `json:"expires_in"`
+}
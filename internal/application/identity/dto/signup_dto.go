@@ -0,0 +1,13 @@
+package dto
+
+// SignupDTO is returned when the provider account has no user yet. The
+// token is traded, along with the fields the provider did not give, for the
+// new user at /auth/oidc/complete.
+type SignupDTO struct {
+	CompletionRequired bool   `json:"completion_required"`
+	SignupToken        string `json:"signup_token"`
+	Email              string `json:"email"`
+	FirstName          string `json:"first_name"`
+	LastName           string `json:"last_name"`
+	ExpiresIn          int    `json:"expires_in"`
+}
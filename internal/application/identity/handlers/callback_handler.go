@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// HandleCallback finishes a login once the provider sends the user back. It
+// returns the user to issue tokens for or, when the account has no user yet,
+// the signup to complete.
+func (h *IdentityHandler) HandleCallback(ctx context.Context, cmd commands.CallbackCommand) (uuid.UUID, *dto.SignupDTO, error) {
+	provider, err := h.provider(cmd.Provider)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	authorization, err := h.authorizations.Take(ctx, cmd.State)
+	if err != nil {
+		return uuid.Nil, nil, err
+	} else if authorization.Provider() != cmd.Provider {
+		return uuid.Nil, nil, identities.ErrInvalidAuthorization
+	} else if cmd.Error != "" || cmd.Code == "" {
+		return uuid.Nil, nil, identities.ErrProviderDenied
+	}
+
+	claims, err := provider.Exchange(ctx, cmd.Code, authorization.Verifier())
+	if err != nil {
+		h.logger.Warn("Could not redeem code of %s: %v", cmd.Provider, err)
+		return uuid.Nil, nil, err
+	} else if claims.Nonce != authorization.Nonce() {
+		return uuid.Nil, nil, identities.ErrNonce
+	}
+
+	var verifiedEmail string
+	if claims.EmailVerified && claims.Email != "" {
+		email, err := shared.NewEmail(claims.Email)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		verifiedEmail = email.String()
+	}
+
+	identity, err := h.repository.GetBySubject(ctx, cmd.Provider, claims.Subject)
+	if err == nil {
+		usr, err := h.userRepo.GetById(ctx, identity.UserId())
+		if err != nil {
+			return uuid.Nil, nil, err
+		} else if err = h.login(ctx, usr); err != nil {
+			return uuid.Nil, nil, err
+		}
+		return usr.Id(), nil, nil
+	} else if !errors.Is(err, identities.ErrNotFoundIdentity) {
+		return uuid.Nil, nil, err
+	}
+
+	if verifiedEmail == "" {
+		return uuid.Nil, nil, identities.ErrUnverifiedEmail
+	}
+
+	exists, err := h.userRepo.ExistsByEmail(ctx, verifiedEmail)
+	if err != nil {
+		return uuid.Nil, nil, err
+	} else if !exists {
+		signup, err := h.startSignup(ctx, cmd.Provider, claims, verifiedEmail)
+		return uuid.Nil, signup, err
+	}
+
+	// Anyone can sign up with an email they do not own and wait for its
+	// owner to log in through a provider, so accounts whose user never
+	// verified the email are not linked; they log in with their password and
+	// verify it first.
+	usr, err := h.userRepo.GetByEmail(ctx, verifiedEmail)
+	if err != nil {
+		return uuid.Nil, nil, err
+	} else if !usr.IsVerified() {
+		return uuid.Nil, nil, identities.ErrUnverifiedUserIdentity
+	}
+
+	identity, err = identities.NewIdentity(cmd.Provider, claims.Subject, usr.Id(), verifiedEmail)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	if err = h.repository.Create(ctx, identity); err != nil && !errors.Is(err, identities.ErrExistsIdentity) {
+		h.logger.Error("Could not link %s account of user %s: %v", cmd.Provider, usr.Id(), err)
+		return uuid.Nil, nil, err
+	}
+
+	if err = h.login(ctx, usr); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return usr.Id(), nil, nil
+}
+
+func (h *IdentityHandler) startSignup(ctx context.Context, provider string, claims *identities.Claims, email string) (*dto.SignupDTO, error) {
+	claims.Email = email
+	signup, secret, err := identities.NewSignup(provider, claims, h.signupTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.signups.Save(ctx, signup); err != nil {
+		h.logger.Error("Could not save signup of %s account: %v", provider, err)
+		return nil, err
+	}
+
+	return &dto.SignupDTO{
+		CompletionRequired: true,
+		SignupToken:        secret,
+		Email:              signup.Email(),
+		FirstName:          signup.GivenName(),
+		LastName:           signup.FamilyName(),
+		ExpiresIn:          int(h.signupTTL.Seconds()),
+	}, nil
+}
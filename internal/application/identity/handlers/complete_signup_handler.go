@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HandleCompleteSignup creates the user of a provider account with the
+// fields the provider did not give, and returns it to issue tokens for. The
+// email counts as verified, as the provider verified it. The user gets a
+// random password, which they can replace through a password reset.
+func (h *IdentityHandler) HandleCompleteSignup(ctx context.Context, cmd commands.CompleteSignupCommand) (uuid.UUID, error) {
+	if cmd.SignupToken == "" {
+		return uuid.Nil, identities.ErrInvalidSignup
+	}
+
+	hash := tokens.Hash(cmd.SignupToken)
+	signup, err := h.signups.Get(ctx, hash)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	firstName, lastName := cmd.FirstName, cmd.LastName
+	if firstName == "" {
+		firstName = signup.GivenName()
+	}
+	if lastName == "" {
+		lastName = signup.FamilyName()
+	}
+
+	username, err := shared.NewUsername(cmd.Username)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	email, err := shared.NewEmail(signup.Email())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	gender, err := shared.ParseGender(cmd.Gender)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	birth, err := shared.NewBirthDate(cmd.Birth)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	country, err := shared.ParseCountry(cmd.Country)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	language, err := shared.ParseLanguage(cmd.Language)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	password, err := randomPassword()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if exists, err := h.userRepo.ExistsByUserName(ctx, username.String()); err != nil {
+		return uuid.Nil, err
+	} else if exists {
+		return uuid.Nil, users.ErrExistsUser
+	}
+
+	if exists, err := h.userRepo.ExistsByEmail(ctx, email.String()); err != nil {
+		return uuid.Nil, err
+	} else if exists {
+		return uuid.Nil, users.ErrExistsUser
+	}
+
+	newUser, err := h.factory.Create(firstName, lastName, username, email, password, gender, birth, country, language, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	deleted, err := h.signups.Delete(ctx, hash)
+	if err != nil {
+		return uuid.Nil, err
+	} else if !deleted {
+		return uuid.Nil, identities.ErrInvalidSignup
+	}
+
+	usr, err := h.userRepo.Create(ctx, newUser)
+	if err != nil {
+		h.logger.Error("Could not create user of %s account: %v", signup.Provider(), err)
+		return uuid.Nil, err
+	}
+
+	identity, err := identities.NewIdentity(signup.Provider(), signup.Subject(), usr.Id(), email.String())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = h.repository.Create(ctx, identity); err != nil {
+		h.logger.Error("Could not link %s account of user %s: %v", signup.Provider(), usr.Id(), err)
+		return uuid.Nil, err
+	}
+
+	if err = usr.Verify(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = h.login(ctx, usr); err != nil {
+		return uuid.Nil, err
+	}
+
+	return usr.Id(), nil
+}
+
+// randomPassword is a password nobody knows, for users who log in through a
+// provider.
+func randomPassword() (shared.Password, error) {
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return shared.Password{}, err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return shared.Password{}, err
+	}
+
+	return shared.NewHashedPassword(string(hashed))
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"time"
+)
+
+// IdentityHandler logs users in with their account at an OpenID Connect
+// provider. Accounts are linked to the user with the same email the first
+// time, as long as both the provider and the user verified it; accounts with
+// no user get a signup to complete. Logins sent to a provider last stateTTL and signups
+// signupTTL.
+type IdentityHandler struct {
+	providers      map[string]identities.Provider
+	repository     identities.IdentityRepository
+	authorizations identities.AuthorizationStore
+	signups        identities.SignupStore
+	userRepo       users.UserRepository
+	factory        users.UserFactory
+	stateTTL       time.Duration
+	signupTTL      time.Duration
+	logger         application.Logger
+}
+
+func NewIdentityHandler(providers []identities.Provider, repository identities.IdentityRepository, authorizations identities.AuthorizationStore, signups identities.SignupStore, userRepo users.UserRepository, factory users.UserFactory, stateTTL, signupTTL time.Duration, logger application.Logger) *IdentityHandler {
+	byName := make(map[string]identities.Provider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return &IdentityHandler{
+		providers:      byName,
+		repository:     repository,
+		authorizations: authorizations,
+		signups:        signups,
+		userRepo:       userRepo,
+		factory:        factory,
+		stateTTL:       stateTTL,
+		signupTTL:      signupTTL,
+		logger:         logger,
+	}
+}
+
+func (h *IdentityHandler) provider(name string) (identities.Provider, error) {
+	provider, ok := h.providers[name]
+	if !ok {
+		return nil, identities.ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// login records the login of the user. It never verifies them: the email a
+// provider verified says nothing about who set the password of an account
+// that already had one.
+func (h *IdentityHandler) login(ctx context.Context, usr *users.User) error {
+	if usr.DeletedAt() != nil {
+		return users.ErrNotFoundUser
+	}
+
+	usr.ChangeLastLoginAt()
+	if err := h.userRepo.Update(ctx, usr); err != nil {
+		h.logger.Error("Could not record login of user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,513 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockProvider struct {
+	mock.Mock
+}
+
+type MockIdentityRepository struct {
+	mock.Mock
+}
+
+type MockAuthorizationStore struct {
+	mock.Mock
+}
+
+type MockSignupStore struct {
+	mock.Mock
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+type identityMocks struct {
+	provider       *MockProvider
+	repository     *MockIdentityRepository
+	authorizations *MockAuthorizationStore
+	signups        *MockSignupStore
+	userRepo       *MockUserRepository
+}
+
+func newTestIdentityHandler() (*IdentityHandler, identityMocks) {
+	m := identityMocks{
+		provider:       new(MockProvider),
+		repository:     new(MockIdentityRepository),
+		authorizations: new(MockAuthorizationStore),
+		signups:        new(MockSignupStore),
+		userRepo:       new(MockUserRepository),
+	}
+	m.provider.On("Name").Return("google")
+
+	handler := NewIdentityHandler([]identities.Provider{m.provider}, m.repository, m.authorizations, m.signups, m.userRepo, users.NewUserFactory(), 10*time.Minute, 30*time.Minute, new(MockLogger))
+	return handler, m
+}
+
+func newTestUser(t *testing.T, verified bool) *users.User {
+	now := time.Now()
+	var verifiedAt *time.Time
+	if verified {
+		verifiedAt = &now
+	}
+
+	usr, err := users.NewUserFromDB(uuid.New(), "John", "Doe", "johndoe", "john@doe.com", "5Tr0nG1.!", "Male", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, nil, verifiedAt)
+	require.NoError(t, err)
+	return usr
+}
+
+// newTestCallback sets the mocks up for a callback of google with the claims,
+// and returns the command the provider would send the user back with.
+func newTestCallback(m identityMocks, claims *identities.Claims) commands.CallbackCommand {
+	authorization := identities.NewAuthorizationFromStore("state", "google", "verifier", "nonce", time.Now().Add(time.Minute))
+	claims.Nonce = "nonce"
+
+	m.authorizations.On("Take", mock.Anything, "state").Return(authorization, nil)
+	m.provider.On("Exchange", mock.Anything, "code", "verifier").Return(claims, nil)
+
+	return commands.CallbackCommand{Provider: "google", State: "state", Code: "code"}
+}
+
+func TestNewIdentityHandler(t *testing.T) {
+	provider, repository, authorizations, signups, userRepo, logger := new(MockProvider), new(MockIdentityRepository), new(MockAuthorizationStore), new(MockSignupStore), new(MockUserRepository), new(MockLogger)
+	factory := users.NewUserFactory()
+	provider.On("Name").Return("google")
+
+	handler := NewIdentityHandler([]identities.Provider{provider}, repository, authorizations, signups, userRepo, factory, time.Minute, time.Hour, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, provider, handler.providers["google"])
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, authorizations, handler.authorizations)
+	require.Exactly(t, signups, handler.signups)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Exactly(t, factory, handler.factory)
+	require.Equal(t, time.Minute, handler.stateTTL)
+	require.Equal(t, time.Hour, handler.signupTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestIdentityHandler_HandleStartLogin(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+
+	var saved *identities.Authorization
+	m.authorizations.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*identities.Authorization)
+	}).Return(nil)
+	m.provider.On("AuthCodeURL", ctx, mock.Anything, mock.Anything, mock.Anything).Return("https://accounts.google.test/authorize?x", nil)
+
+	authorization, err := handler.HandleStartLogin(ctx, commands.StartLoginCommand{Provider: "google"})
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, "https://accounts.google.test/authorize?x", authorization.AuthorizationURL)
+	assert.Equal(t, 600, authorization.ExpiresIn)
+	assert.Equal(t, "google", saved.Provider())
+	m.provider.AssertCalled(t, "AuthCodeURL", ctx, saved.State(), identities.CodeChallenge(saved.Verifier()), saved.Nonce())
+}
+
+func TestIdentityHandler_HandleStartLogin_UnknownProvider(t *testing.T) {
+	handler, m := newTestIdentityHandler()
+
+	authorization, err := handler.HandleStartLogin(context.Background(), commands.StartLoginCommand{Provider: "myspace"})
+
+	assert.ErrorIs(t, err, identities.ErrUnknownProvider)
+	assert.Nil(t, authorization)
+	m.authorizations.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestIdentityHandler_HandleCallback_Linked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	usr := newTestUser(t, true)
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: true})
+
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(identities.NewIdentityFromDB("google", "1234", usr.Id(), "john@doe.com", time.Now()), nil)
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.userRepo.On("Update", ctx, usr).Return(nil)
+
+	userId, signup, err := handler.HandleCallback(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, usr.Id(), userId)
+	assert.Nil(t, signup)
+	m.userRepo.AssertCalled(t, "Update", ctx, usr)
+}
+
+func TestIdentityHandler_HandleCallback_LinkedUnverified(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	usr := newTestUser(t, false)
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: true})
+
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(identities.NewIdentityFromDB("google", "1234", usr.Id(), "john@doe.com", time.Now()), nil)
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.userRepo.On("Update", ctx, usr).Return(nil)
+
+	userId, _, err := handler.HandleCallback(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, usr.Id(), userId)
+	assert.False(t, usr.IsVerified())
+}
+
+func TestIdentityHandler_HandleCallback_LinksByEmail(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	usr := newTestUser(t, true)
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: true})
+
+	var linked *identities.Identity
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(nil, identities.ErrNotFoundIdentity)
+	m.userRepo.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+	m.userRepo.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+	m.repository.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		linked = args.Get(1).(*identities.Identity)
+	}).Return(nil)
+	m.userRepo.On("Update", ctx, usr).Return(nil)
+
+	userId, signup, err := handler.HandleCallback(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, usr.Id(), userId)
+	assert.Nil(t, signup)
+	require.NotNil(t, linked)
+	assert.Equal(t, "google", linked.Provider())
+	assert.Equal(t, "1234", linked.Subject())
+	assert.Equal(t, usr.Id(), linked.UserId())
+}
+
+func TestIdentityHandler_HandleCallback_UnverifiedUser(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	usr := newTestUser(t, false)
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: true})
+
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(nil, identities.ErrNotFoundIdentity)
+	m.userRepo.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+	m.userRepo.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+
+	userId, signup, err := handler.HandleCallback(ctx, cmd)
+
+	assert.ErrorIs(t, err, identities.ErrUnverifiedUserIdentity)
+	assert.Equal(t, uuid.Nil, userId)
+	assert.Nil(t, signup)
+	assert.False(t, usr.IsVerified())
+	m.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	m.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestIdentityHandler_HandleCallback_UnverifiedEmail(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: false})
+
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(nil, identities.ErrNotFoundIdentity)
+
+	userId, signup, err := handler.HandleCallback(ctx, cmd)
+
+	assert.ErrorIs(t, err, identities.ErrUnverifiedEmail)
+	assert.Equal(t, uuid.Nil, userId)
+	assert.Nil(t, signup)
+	m.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	m.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestIdentityHandler_HandleCallback_Signup(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	cmd := newTestCallback(m, &identities.Claims{Subject: "1234", Email: "jane@doe.com", EmailVerified: true, GivenName: "Jane", FamilyName: "Doe"})
+
+	var saved *identities.Signup
+	m.repository.On("GetBySubject", ctx, "google", "1234").Return(nil, identities.ErrNotFoundIdentity)
+	m.userRepo.On("ExistsByEmail", ctx, "jane@doe.com").Return(false, nil)
+	m.signups.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*identities.Signup)
+	}).Return(nil)
+
+	userId, signup, err := handler.HandleCallback(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, userId)
+	require.NotNil(t, signup)
+	require.NotNil(t, saved)
+	assert.True(t, signup.CompletionRequired)
+	assert.Equal(t, tokens.Hash(signup.SignupToken), saved.Hash())
+	assert.Equal(t, "jane@doe.com", signup.Email)
+	assert.Equal(t, "Jane", signup.FirstName)
+	assert.Equal(t, "Doe", signup.LastName)
+	assert.Equal(t, 1800, signup.ExpiresIn)
+	assert.Equal(t, "1234", saved.Subject())
+}
+
+func TestIdentityHandler_HandleCallback_Rejected(t *testing.T) {
+	cases := []struct {
+		name  string
+		setup func(m identityMocks) commands.CallbackCommand
+		err   error
+	}{
+		{"unknown provider", func(m identityMocks) commands.CallbackCommand {
+			return commands.CallbackCommand{Provider: "myspace", State: "state", Code: "code"}
+		}, identities.ErrUnknownProvider},
+		{"unknown state", func(m identityMocks) commands.CallbackCommand {
+			m.authorizations.On("Take", mock.Anything, "state").Return(nil, identities.ErrInvalidAuthorization)
+			return commands.CallbackCommand{Provider: "google", State: "state", Code: "code"}
+		}, identities.ErrInvalidAuthorization},
+		{"state of another provider", func(m identityMocks) commands.CallbackCommand {
+			m.authorizations.On("Take", mock.Anything, "state").Return(identities.NewAuthorizationFromStore("state", "apple", "verifier", "nonce", time.Now().Add(time.Minute)), nil)
+			return commands.CallbackCommand{Provider: "google", State: "state", Code: "code"}
+		}, identities.ErrInvalidAuthorization},
+		{"denied", func(m identityMocks) commands.CallbackCommand {
+			m.authorizations.On("Take", mock.Anything, "state").Return(identities.NewAuthorizationFromStore("state", "google", "verifier", "nonce", time.Now().Add(time.Minute)), nil)
+			return commands.CallbackCommand{Provider: "google", State: "state", Error: "access_denied"}
+		}, identities.ErrProviderDenied},
+		{"other nonce", func(m identityMocks) commands.CallbackCommand {
+			m.authorizations.On("Take", mock.Anything, "state").Return(identities.NewAuthorizationFromStore("state", "google", "verifier", "nonce", time.Now().Add(time.Minute)), nil)
+			m.provider.On("Exchange", mock.Anything, "code", "verifier").Return(&identities.Claims{Subject: "1234", Nonce: "replayed"}, nil)
+			return commands.CallbackCommand{Provider: "google", State: "state", Code: "code"}
+		}, identities.ErrNonce},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, m := newTestIdentityHandler()
+
+			userId, signup, err := handler.HandleCallback(context.Background(), tc.setup(m))
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, uuid.Nil, userId)
+			assert.Nil(t, signup)
+			m.repository.AssertNotCalled(t, "GetBySubject", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestIdentityHandler_HandleCompleteSignup(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	signup := identities.NewSignupFromStore(tokens.Hash("token"), "google", "1234", "jane@doe.com", "Jane", "Doe", time.Now().Add(time.Minute))
+
+	var created *users.User
+	var linked *identities.Identity
+	m.signups.On("Get", ctx, tokens.Hash("token")).Return(signup, nil)
+	m.signups.On("Delete", ctx, tokens.Hash("token")).Return(true, nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janedoe").Return(false, nil)
+	m.userRepo.On("ExistsByEmail", ctx, "jane@doe.com").Return(false, nil)
+	m.userRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*users.User)
+	}).Return(nil)
+	m.repository.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		linked = args.Get(1).(*identities.Identity)
+	}).Return(nil)
+	m.userRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+	userId, err := handler.HandleCompleteSignup(ctx, commands.CompleteSignupCommand{
+		SignupToken: "token",
+		Username:    "janedoe",
+		Gender:      "Female",
+		Birth:       time.Now().AddDate(-25, 0, 0),
+		Country:     "Bolivia",
+		Language:    "Spanish",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	require.NotNil(t, linked)
+	assert.Equal(t, created.Id(), userId)
+	assert.Equal(t, "Jane", created.FirstName())
+	assert.Equal(t, "Doe", created.LastName())
+	assert.Equal(t, "jane@doe.com", created.Email().String())
+	assert.True(t, created.IsVerified())
+	assert.NotEmpty(t, created.Password().String())
+	assert.Equal(t, "1234", linked.Subject())
+	assert.Equal(t, created.Id(), linked.UserId())
+}
+
+func TestIdentityHandler_HandleCompleteSignup_UsernameTaken(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+	signup := identities.NewSignupFromStore(tokens.Hash("token"), "google", "1234", "jane@doe.com", "Jane", "Doe", time.Now().Add(time.Minute))
+
+	m.signups.On("Get", ctx, tokens.Hash("token")).Return(signup, nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janedoe").Return(true, nil)
+
+	userId, err := handler.HandleCompleteSignup(ctx, commands.CompleteSignupCommand{
+		SignupToken: "token",
+		Username:    "janedoe",
+		Gender:      "Female",
+		Birth:       time.Now().AddDate(-25, 0, 0),
+		Country:     "Bolivia",
+		Language:    "Spanish",
+	})
+
+	assert.ErrorIs(t, err, users.ErrExistsUser)
+	assert.Equal(t, uuid.Nil, userId)
+	m.signups.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	m.userRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestIdentityHandler_HandleCompleteSignup_InvalidToken(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestIdentityHandler()
+
+	m.signups.On("Get", ctx, tokens.Hash("token")).Return(nil, identities.ErrInvalidSignup)
+
+	_, err := handler.HandleCompleteSignup(ctx, commands.CompleteSignupCommand{})
+	assert.ErrorIs(t, err, identities.ErrInvalidSignup)
+
+	_, err = handler.HandleCompleteSignup(ctx, commands.CompleteSignupCommand{SignupToken: "token"})
+	assert.ErrorIs(t, err, identities.ErrInvalidSignup)
+}
+
+func (m *MockProvider) Name() string {
+	return m.Called().String(0)
+}
+
+func (m *MockProvider) AuthCodeURL(ctx context.Context, state, codeChallenge, nonce string) (string, error) {
+	args := m.Called(ctx, state, codeChallenge, nonce)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockProvider) Exchange(ctx context.Context, code, verifier string) (*identities.Claims, error) {
+	args := m.Called(ctx, code, verifier)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*identities.Claims), args.Error(1)
+}
+
+func (m *MockIdentityRepository) GetBySubject(ctx context.Context, provider, subject string) (*identities.Identity, error) {
+	args := m.Called(ctx, provider, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*identities.Identity), args.Error(1)
+}
+
+func (m *MockIdentityRepository) Create(ctx context.Context, identity *identities.Identity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *MockAuthorizationStore) Save(ctx context.Context, authorization *identities.Authorization) error {
+	args := m.Called(ctx, authorization)
+	return args.Error(0)
+}
+
+func (m *MockAuthorizationStore) Take(ctx context.Context, state string) (*identities.Authorization, error) {
+	args := m.Called(ctx, state)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*identities.Authorization), args.Error(1)
+}
+
+func (m *MockSignupStore) Save(ctx context.Context, signup *identities.Signup) error {
+	args := m.Called(ctx, signup)
+	return args.Error(0)
+}
+
+func (m *MockSignupStore) Get(ctx context.Context, hash string) (*identities.Signup, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*identities.Signup), args.Error(1)
+}
+
+func (m *MockSignupStore) Delete(ctx context.Context, hash string) (bool, error) {
+	args := m.Called(ctx, hash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+// Create hands the user back as the database would.
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	args := m.Called(ctx, u)
+	return u, args.Error(0)
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	args := m.Called(ctx, u)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+)
+
+// HandleStartLogin returns the page of the provider to send the user to.
+func (h *IdentityHandler) HandleStartLogin(ctx context.Context, cmd commands.StartLoginCommand) (*dto.AuthorizationDTO, error) {
+	provider, err := h.provider(cmd.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := identities.NewAuthorization(cmd.Provider, h.stateTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, authorization.State(), authorization.CodeChallenge(), authorization.Nonce())
+	if err != nil {
+		h.logger.Error("Could not reach identity provider %s: %v", cmd.Provider, err)
+		return nil, err
+	}
+
+	if err = h.authorizations.Save(ctx, authorization); err != nil {
+		h.logger.Error("Could not save login state for %s: %v", cmd.Provider, err)
+		return nil, err
+	}
+
+	return &dto.AuthorizationDTO{
+		AuthorizationURL: authURL,
+		ExpiresIn:        int(h.stateTTL.Seconds()),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/google/uuid"
+)
+
+// InterestHandler updates interest profiles one signal at a time as users act.
+// It implements interests.Tracker: failures are logged and never returned.
+type InterestHandler struct {
+	repository interests.InterestRepository
+	logger     application.Logger
+}
+
+func NewInterestHandler(repository interests.InterestRepository, logger application.Logger) *InterestHandler {
+	return &InterestHandler{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Track adds a save or a like of the pin to the interests of the user in its
+// tags.
+func (h *InterestHandler) Track(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	if err := h.repository.AddPin(ctx, userId, signal, pinId); err != nil {
+		h.logger.Error("Could not track %s of pin %s by %s: %v", signal, pinId, userId, err)
+	}
+}
+
+// Untrack takes back a save or a like of the pin.
+func (h *InterestHandler) Untrack(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	if err := h.repository.RemovePin(ctx, userId, signal, pinId); err != nil {
+		h.logger.Error("Could not untrack %s of pin %s by %s: %v", signal, pinId, userId, err)
+	}
+}
+
+func (h *InterestHandler) FollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	if err := h.repository.AddTag(ctx, userId, tagId); err != nil {
+		h.logger.Error("Could not track follow of tag %s by %s: %v", tagId, userId, err)
+	}
+}
+
+func (h *InterestHandler) UnfollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	if err := h.repository.RemoveTag(ctx, userId, tagId); err != nil {
+		h.logger.Error("Could not untrack follow of tag %s by %s: %v", tagId, userId, err)
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockInterestRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func TestNewInterestHandler(t *testing.T) {
+	repository := new(MockInterestRepository)
+	logger := new(MockLogger)
+
+	handler := NewInterestHandler(repository, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestInterestHandler_Track(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockInterestRepository)
+	handler := NewInterestHandler(repository, new(MockLogger))
+
+	userId, pinId, tagId := uuid.New(), uuid.New(), uuid.New()
+
+	repository.On("AddPin", ctx, userId, interests.SaveSignal, pinId).Return(nil)
+	repository.On("RemovePin", ctx, userId, interests.LikeSignal, pinId).Return(nil)
+	repository.On("AddTag", ctx, userId, tagId).Return(nil)
+	repository.On("RemoveTag", ctx, userId, tagId).Return(nil)
+
+	handler.Track(ctx, userId, interests.SaveSignal, pinId)
+	handler.Untrack(ctx, userId, interests.LikeSignal, pinId)
+	handler.FollowedTag(ctx, userId, tagId)
+	handler.UnfollowedTag(ctx, userId, tagId)
+
+	repository.AssertExpectations(t)
+}
+
+func TestInterestHandler_Track_Failures(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockInterestRepository)
+	handler := NewInterestHandler(repository, new(MockLogger))
+
+	userId, pinId, tagId := uuid.New(), uuid.New(), uuid.New()
+	dbErr := errors.New("db down")
+
+	repository.On("AddPin", ctx, userId, interests.LikeSignal, pinId).Return(dbErr)
+	repository.On("RemovePin", ctx, userId, interests.LikeSignal, pinId).Return(dbErr)
+	repository.On("AddTag", ctx, userId, tagId).Return(dbErr)
+	repository.On("RemoveTag", ctx, userId, tagId).Return(dbErr)
+
+	require.NotPanics(t, func() {
+		handler.Track(ctx, userId, interests.LikeSignal, pinId)
+		handler.Untrack(ctx, userId, interests.LikeSignal, pinId)
+		handler.FollowedTag(ctx, userId, tagId)
+		handler.UnfollowedTag(ctx, userId, tagId)
+	})
+	repository.AssertExpectations(t)
+}
+
+func (m *MockInterestRepository) GetProfile(ctx context.Context, userId uuid.UUID) (*interests.Profile, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*interests.Profile), args.Error(1)
+}
+
+func (m *MockInterestRepository) GetCandidates(ctx context.Context, pinIds []uuid.UUID) ([]interests.Candidate, error) {
+	args := m.Called(ctx, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]interests.Candidate), args.Error(1)
+}
+
+func (m *MockInterestRepository) AddPin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	return m.Called(ctx, userId, signal, pinId).Error(0)
+}
+
+func (m *MockInterestRepository) RemovePin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	return m.Called(ctx, userId, signal, pinId).Error(0)
+}
+
+func (m *MockInterestRepository) AddTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	return m.Called(ctx, userId, tagId).Error(0)
+}
+
+func (m *MockInterestRepository) RemoveTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	return m.Called(ctx, userId, tagId).Error(0)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
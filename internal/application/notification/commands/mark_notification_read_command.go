@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type MarkNotificationReadCommand struct {
+	Id     uuid.UUID `json:"id"`
+	UserId uuid.UUID `json:"user_id"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "time"
+
+type PruneNotificationsCommand struct {
+	OlderThan        time.Time `json:"older_than"`
+	KeepPerRecipient int       `json:"keep_per_recipient"`
+}
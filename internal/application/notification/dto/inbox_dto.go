@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// InboxDTO is a page of the inbox. NextBefore and NextBeforeId are the values
+// to send as before and before_id to get the next page, and are nil on the
+// last one.
+type InboxDTO struct {
+	Notifications []*NotificationResponse `json:"notifications"`
+	UnreadCount   int                     `json:"unread_count"`
+	NextBefore    *time.Time              `json:"next_before,omitempty"`
+	NextBeforeId  *uuid.UUID              `json:"next_before_id,omitempty"`
+}
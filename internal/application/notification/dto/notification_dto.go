@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/google/uuid"
+
+type NotificationDTO struct {
+	Id          uuid.UUID  `json:"id"`
+	RecipientId uuid.UUID  `json:"recipient_id"`
+	ActorId     uuid.UUID  `json:"actor_id"`
+	Kind        string     `json:"kind"`
+	PinId       *uuid.UUID `json:"pin_id,omitempty"`
+	CommentId   *uuid.UUID `json:"comment_id,omitempty"`
+	Read        bool       `json:"read"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "encoding/json"
+
+const (
+	NotificationEvent = "notification"
+	UnreadCountEvent  = "unread_count"
+	HeartbeatEvent    = "heartbeat"
+)
+
+// NotificationEventDTO is what is pushed to a connected client. Only
+// notification events carry an id, which is the notification id and the value
+// clients send back as Last-Event-ID when they reconnect.
+type NotificationEventDTO struct {
+	Id   string          `json:"id,omitempty"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type UnreadCountDTO struct {
+	UnreadCount int `json:"unread_count"`
+}
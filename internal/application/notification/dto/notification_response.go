@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+type NotificationResponse struct {
+	*NotificationDTO
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+	"time"
+)
+
+func (h *NotificationHandler) HandleMarkAllRead(ctx context.Context, cmd commands.MarkAllNotificationsReadCommand) (int64, error) {
+	updated, err := h.repository.MarkAllRead(ctx, cmd.UserId, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if updated > 0 {
+		h.publishUnreadCount(ctx, cmd.UserId)
+	}
+
+	return updated, nil
+}
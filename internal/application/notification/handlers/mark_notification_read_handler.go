@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+)
+
+func (h *NotificationHandler) HandleMarkRead(ctx context.Context, cmd commands.MarkNotificationReadCommand) (*dto.NotificationResponse, error) {
+	if cmd.Id == uuid.Nil {
+		return nil, notifications.ErrIdNilNotification
+	}
+
+	notification, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other users' notifications are reported as missing so ids cannot be probed.
+	if notification.RecipientId() != cmd.UserId {
+		return nil, notifications.ErrNotFoundNotification
+	}
+
+	if !notification.IsRead() {
+		notification.MarkRead()
+
+		if err = h.repository.MarkRead(ctx, notification); err != nil {
+			return nil, err
+		}
+
+		h.publishUnreadCount(ctx, notification.RecipientId())
+	}
+
+	notificationDto := mappers.MapToNotificationDTO(notification)
+	notificationResponse := mappers.MapToNotificationResponse(notificationDto, notification.ReadAt(), notification.CreatedAt())
+	return notificationResponse, nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler struct {
+	repository notifications.NotificationRepository
+	factory    notifications.NotificationFactory
+	publisher  notifications.Publisher
+	logger     application.Logger
+}
+
+func NewNotificationHandler(repository notifications.NotificationRepository, factory notifications.NotificationFactory, publisher notifications.Publisher, logger application.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		repository: repository,
+		factory:    factory,
+		publisher:  publisher,
+		logger:     logger,
+	}
+}
+
+// publishUnreadCount pushes the recipient's current unread count. Like every
+// push it is best effort and only logs on failure.
+func (h *NotificationHandler) publishUnreadCount(ctx context.Context, recipientId uuid.UUID) {
+	count, err := h.repository.CountUnread(ctx, recipientId)
+	if err != nil {
+		h.logger.Error("Could not count unread notifications for %s: %v", recipientId, err)
+		return
+	}
+
+	if err = h.publisher.PublishUnreadCount(ctx, recipientId, count); err != nil {
+		h.logger.Warn("Could not push unread count to %s: %v", recipientId, err)
+	}
+}
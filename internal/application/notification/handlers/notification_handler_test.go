@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+type MockLogger struct {
+	warnings int
+	errors   int
+}
+
+var ErrDbFailureNotification = errors.New("db failure")
+
+func TestNewNotificationHandler(t *testing.T) {
+	repository := new(MockNotificationRepository)
+	factory := notifications.NewNotificationFactory()
+	publisher := new(MockPublisher)
+	logger := new(MockLogger)
+	handler := NewNotificationHandler(repository, factory, publisher, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, factory, handler.factory)
+	require.Exactly(t, publisher, handler.publisher)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestNotificationHandler_Notify(t *testing.T) {
+	ctx := context.Background()
+	repository, publisher := new(MockNotificationRepository), new(MockPublisher)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), publisher, new(MockLogger))
+
+	recipientId, actorId, pinId := uuid.New(), uuid.New(), uuid.New()
+
+	repository.On("Create", ctx, mock.MatchedBy(func(n *notifications.Notification) bool {
+		return n.RecipientId() == recipientId && n.ActorId() == actorId && n.Kind() == notifications.SaveKind && *n.PinId() == pinId
+	})).Return(func(ctx context.Context, n *notifications.Notification) *notifications.Notification { return n }, nil)
+	publisher.On("PublishNotification", ctx, mock.AnythingOfType("*notifications.Notification")).Return(nil)
+	repository.On("CountUnread", ctx, recipientId).Return(4, nil)
+	publisher.On("PublishUnreadCount", ctx, recipientId, 4).Return(nil)
+
+	handler.Notify(ctx, recipientId, actorId, notifications.SaveKind, &pinId, nil)
+
+	repository.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestNotificationHandler_Notify_PublishError(t *testing.T) {
+	ctx := context.Background()
+	repository, publisher := new(MockNotificationRepository), new(MockPublisher)
+	logger := new(MockLogger)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), publisher, logger)
+
+	recipientId := uuid.New()
+
+	repository.On("Create", ctx, mock.AnythingOfType("*notifications.Notification")).Return(func(ctx context.Context, n *notifications.Notification) *notifications.Notification { return n }, nil)
+	publisher.On("PublishNotification", ctx, mock.AnythingOfType("*notifications.Notification")).Return(ErrDbFailureNotification)
+	repository.On("CountUnread", ctx, recipientId).Return(1, nil)
+	publisher.On("PublishUnreadCount", ctx, recipientId, 1).Return(ErrDbFailureNotification)
+
+	assert.NotPanics(t, func() {
+		handler.Notify(ctx, recipientId, uuid.New(), notifications.FollowKind, nil, nil)
+	})
+	assert.Equal(t, 2, logger.warnings)
+	assert.Zero(t, logger.errors)
+	repository.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestNotificationHandler_Notify_Self(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	logger := new(MockLogger)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), logger)
+
+	userId := uuid.New()
+
+	handler.Notify(ctx, userId, userId, notifications.FollowKind, nil, nil)
+
+	repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	assert.Zero(t, logger.errors)
+}
+
+func TestNotificationHandler_Notify_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	logger := new(MockLogger)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), logger)
+
+	repository.On("Create", ctx, mock.AnythingOfType("*notifications.Notification")).Return(nil, ErrDbFailureNotification)
+
+	assert.NotPanics(t, func() {
+		handler.Notify(ctx, uuid.New(), uuid.New(), notifications.FollowKind, nil, nil)
+	})
+	assert.Equal(t, 1, logger.errors)
+	repository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleMarkRead(t *testing.T) {
+	ctx := context.Background()
+	repository, publisher := new(MockNotificationRepository), new(MockPublisher)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), publisher, new(MockLogger))
+
+	n := notifications.NewNotification(uuid.New(), uuid.New(), notifications.FollowKind, nil, nil)
+	cmd := commands.MarkNotificationReadCommand{
+		Id:     n.Id(),
+		UserId: n.RecipientId(),
+	}
+
+	repository.On("GetById", ctx, n.Id()).Return(n, nil)
+	repository.On("MarkRead", ctx, n).Return(nil)
+	repository.On("CountUnread", ctx, n.RecipientId()).Return(0, nil)
+	publisher.On("PublishUnreadCount", ctx, n.RecipientId(), 0).Return(nil)
+
+	resp, err := handler.HandleMarkRead(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Read)
+	assert.NotNil(t, resp.ReadAt)
+	assert.Equal(t, "follow", resp.Kind)
+	repository.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleMarkRead_AlreadyRead(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), new(MockLogger))
+
+	n := notifications.NewNotification(uuid.New(), uuid.New(), notifications.FollowKind, nil, nil)
+	n.MarkRead()
+	cmd := commands.MarkNotificationReadCommand{
+		Id:     n.Id(),
+		UserId: n.RecipientId(),
+	}
+
+	repository.On("GetById", ctx, n.Id()).Return(n, nil)
+
+	resp, err := handler.HandleMarkRead(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Read)
+	repository.AssertNotCalled(t, "MarkRead", mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleMarkRead_OtherRecipient(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), new(MockLogger))
+
+	n := notifications.NewNotification(uuid.New(), uuid.New(), notifications.FollowKind, nil, nil)
+	cmd := commands.MarkNotificationReadCommand{
+		Id:     n.Id(),
+		UserId: uuid.New(),
+	}
+
+	repository.On("GetById", ctx, n.Id()).Return(n, nil)
+
+	resp, err := handler.HandleMarkRead(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, notifications.ErrNotFoundNotification)
+	assert.False(t, n.IsRead())
+	repository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleMarkRead_NilId(t *testing.T) {
+	handler := NewNotificationHandler(new(MockNotificationRepository), notifications.NewNotificationFactory(), new(MockPublisher), new(MockLogger))
+
+	resp, err := handler.HandleMarkRead(context.Background(), commands.MarkNotificationReadCommand{UserId: uuid.New()})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, notifications.ErrIdNilNotification)
+}
+
+func TestNotificationHandler_HandleMarkAllRead(t *testing.T) {
+	ctx := context.Background()
+	repository, publisher := new(MockNotificationRepository), new(MockPublisher)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), publisher, new(MockLogger))
+
+	userId := uuid.New()
+	repository.On("MarkAllRead", ctx, userId, mock.AnythingOfType("time.Time")).Return(int64(3), nil)
+	repository.On("CountUnread", ctx, userId).Return(0, nil)
+	publisher.On("PublishUnreadCount", ctx, userId, 0).Return(nil)
+
+	updated, err := handler.HandleMarkAllRead(ctx, commands.MarkAllNotificationsReadCommand{UserId: userId})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), updated)
+	repository.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandlePrune(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), new(MockLogger))
+
+	cmd := commands.PruneNotificationsCommand{
+		OlderThan:        time.Now().AddDate(0, 0, -90),
+		KeepPerRecipient: 500,
+	}
+	repository.On("Prune", ctx, cmd.OlderThan, 500).Return(int64(8), nil)
+
+	deleted, err := handler.HandlePrune(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), deleted)
+	repository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandlePrune_Error(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockNotificationRepository)
+	logger := new(MockLogger)
+	handler := NewNotificationHandler(repository, notifications.NewNotificationFactory(), new(MockPublisher), logger)
+
+	cmd := commands.PruneNotificationsCommand{
+		OlderThan:        time.Now(),
+		KeepPerRecipient: 500,
+	}
+	repository.On("Prune", ctx, cmd.OlderThan, 500).Return(int64(0), ErrDbFailureNotification)
+
+	deleted, err := handler.HandlePrune(ctx, cmd)
+
+	assert.Zero(t, deleted)
+	assert.ErrorIs(t, err, ErrDbFailureNotification)
+	assert.Equal(t, 1, logger.errors)
+	repository.AssertExpectations(t)
+}
+
+func (m *MockNotificationRepository) GetListByRecipient(ctx context.Context, recipientId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	args := m.Called(ctx, recipientId, before, beforeId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) GetListSince(ctx context.Context, recipientId, sinceId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	args := m.Called(ctx, recipientId, sinceId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) GetById(ctx context.Context, id uuid.UUID) (*notifications.Notification, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, recipientId uuid.UUID) (int, error) {
+	args := m.Called(ctx, recipientId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Create(ctx context.Context, n *notifications.Notification) (*notifications.Notification, error) {
+	args := m.Called(ctx, n)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(context.Context, *notifications.Notification) *notifications.Notification); ok {
+		return fn(ctx, n), args.Error(1)
+	}
+	return args.Get(0).(*notifications.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, n *notifications.Notification) error {
+	args := m.Called(ctx, n)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, recipientId uuid.UUID, readAt time.Time) (int64, error) {
+	args := m.Called(ctx, recipientId, readAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Prune(ctx context.Context, olderThan time.Time, keepPerRecipient int) (int64, error) {
+	args := m.Called(ctx, olderThan, keepPerRecipient)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPublisher) PublishNotification(ctx context.Context, n *notifications.Notification) error {
+	args := m.Called(ctx, n)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishUnreadCount(ctx context.Context, recipientId uuid.UUID, count int) error {
+	args := m.Called(ctx, recipientId, count)
+	return args.Error(0)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {
+	m.warnings++
+}
+
+func (m *MockLogger) Error(msg string, args ...any) {
+	m.errors++
+}
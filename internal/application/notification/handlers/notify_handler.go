@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+)
+
+// Notify stores a notification for the recipient. It implements
+// notifications.Notifier: failures are logged and never returned, so a broken
+// inbox cannot make a follow, save or comment fail.
+func (h *NotificationHandler) Notify(ctx context.Context, recipientId, actorId uuid.UUID, kind notifications.Kind, pinId, commentId *uuid.UUID) {
+	notificationFactory, err := h.factory.Create(recipientId, actorId, kind, pinId, commentId)
+	if errors.Is(err, notifications.ErrSelfNotification) {
+		return
+	} else if err != nil {
+		h.logger.Error("Invalid %s notification for %s: %v", kind, recipientId, err)
+		return
+	}
+
+	notification, err := h.repository.Create(ctx, notificationFactory)
+	if err != nil {
+		h.logger.Error("Could not store %s notification for %s: %v", kind, recipientId, err)
+		return
+	}
+
+	if err = h.publisher.PublishNotification(ctx, notification); err != nil {
+		h.logger.Warn("Could not push %s notification to %s: %v", kind, recipientId, err)
+	}
+
+	h.publishUnreadCount(ctx, recipientId)
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+)
+
+func (h *NotificationHandler) HandlePrune(ctx context.Context, cmd commands.PruneNotificationsCommand) (int64, error) {
+	deleted, err := h.repository.Prune(ctx, cmd.OlderThan, cmd.KeepPerRecipient)
+	if err != nil {
+		h.logger.Error("Could not prune notifications: %v", err)
+		return 0, err
+	}
+
+	if deleted > 0 {
+		h.logger.Info("Pruned %d notifications", deleted)
+	}
+
+	return deleted, nil
+}
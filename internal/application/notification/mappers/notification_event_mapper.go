@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"encoding/json"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+)
+
+func MapToNotificationEventDTO(notification *dto.NotificationResponse) (*dto.NotificationEventDTO, error) {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NotificationEventDTO{
+		Id:   notification.Id.String(),
+		Type: dto.NotificationEvent,
+		Data: data,
+	}, nil
+}
+
+func MapToUnreadCountEventDTO(count int) (*dto.NotificationEventDTO, error) {
+	data, err := json.Marshal(dto.UnreadCountDTO{UnreadCount: count})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NotificationEventDTO{
+		Type: dto.UnreadCountEvent,
+		Data: data,
+	}, nil
+}
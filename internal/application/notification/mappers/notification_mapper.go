@@ -0,0 +1,27 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"time"
+)
+
+func MapToNotificationDTO(notification *notifications.Notification) *dto.NotificationDTO {
+	return &dto.NotificationDTO{
+		Id:          notification.Id(),
+		RecipientId: notification.RecipientId(),
+		ActorId:     notification.ActorId(),
+		Kind:        string(notification.Kind()),
+		PinId:       notification.PinId(),
+		CommentId:   notification.CommentId(),
+		Read:        notification.IsRead(),
+	}
+}
+
+func MapToNotificationResponse(notification *dto.NotificationDTO, readAt *time.Time, createdAt time.Time) *dto.NotificationResponse {
+	return &dto.NotificationResponse{
+		NotificationDTO: notification,
+		ReadAt:          readAt,
+		CreatedAt:       createdAt,
+	}
+}
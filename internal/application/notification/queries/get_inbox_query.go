@@ -0,0 +1,13 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type GetInboxQuery struct {
+	UserId   uuid.UUID  `json:"user_id"`
+	Before   *time.Time `json:"before,omitempty"`
+	BeforeId uuid.UUID  `json:"before_id,omitempty"`
+	Limit    int        `json:"limit"`
+}
@@ -0,0 +1,8 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetNotificationsSinceQuery struct {
+	UserId      uuid.UUID `json:"user_id"`
+	LastEventId uuid.UUID `json:"last_event_id"`
+}
@@ -0,0 +1,7 @@
+package commands
+
+import "github.com/google/uuid"
+
+type BeginRegistrationCommand struct {
+	UserId uuid.UUID `json:"user_id"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type DeletePasskeyCommand struct {
+	UserId uuid.UUID `json:"user_id"`
+	Id     string    `json:"id"`
+}
@@ -0,0 +1,16 @@
+package commands
+
+// FinishLoginCommand carries the credential navigator.credentials.get
+// returned, serialized with toJSON(), so its buffers are base64url encoded.
+type FinishLoginCommand struct {
+	Id       string            `json:"id"`
+	Type     string            `json:"type"`
+	Response AssertionResponse `json:"response"`
+}
+
+type AssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle"`
+}
@@ -0,0 +1,18 @@
+package commands
+
+import "github.com/google/uuid"
+
+// FinishRegistrationCommand carries the credential navigator.credentials.create
+// returned, serialized with toJSON(), so its buffers are base64url encoded.
+type FinishRegistrationCommand struct {
+	UserId   uuid.UUID            `json:"user_id"`
+	Name     string               `json:"name"`
+	Id       string               `json:"id"`
+	Type     string               `json:"type"`
+	Response RegistrationResponse `json:"response"`
+}
+
+type RegistrationResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+// CreationOptionsDTO is handed to navigator.credentials.create, once its
+// challenge, user id and excluded ids are decoded from base64url. Passkeys
+// must be discoverable and verify the user, and no attestation is asked for.
+type CreationOptionsDTO struct {
+	Challenge              string                    `json:"challenge"`
+	RP                     RelyingPartyDTO           `json:"rp"`
+	User                   UserEntityDTO             `json:"user"`
+	PubKeyCredParams       []CredentialParameterDTO  `json:"pubKeyCredParams"`
+	ExcludeCredentials     []CredentialDescriptorDTO `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelectionDTO `json:"authenticatorSelection"`
+	Attestation            string                    `json:"attestation"`
+	Timeout                int                       `json:"timeout"`
+}
+
+type RelyingPartyDTO struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserEntityDTO struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type CredentialParameterDTO struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+type CredentialDescriptorDTO struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type AuthenticatorSelectionDTO struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type PasskeyDTO struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+// RequestOptionsDTO is handed to navigator.credentials.get, once its
+// challenge is decoded from base64url. No credentials are listed, so the
+// browser offers every passkey the user keeps for the relying party.
+type RequestOptionsDTO struct {
+	Challenge        string `json:"challenge"`
+	RPId             string `json:"rpId"`
+	UserVerification string `json:"userVerification"`
+	Timeout          int    `json:"timeout"`
+}
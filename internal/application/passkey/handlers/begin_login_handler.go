@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/google/uuid"
+)
+
+// HandleBeginLogin starts a login with a passkey. The user is not known until
+// the authenticator answers with one of theirs.
+func (h *PasskeyHandler) HandleBeginLogin(ctx context.Context) (*dto.RequestOptionsDTO, error) {
+	ceremony, err := passkeys.NewCeremony(passkeys.LoginCeremony, uuid.Nil, h.ceremonyTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.ceremonies.Save(ctx, ceremony); err != nil {
+		h.logger.Error("Could not save passkey login: %v", err)
+		return nil, err
+	}
+
+	return &dto.RequestOptionsDTO{
+		Challenge:        ceremony.Challenge(),
+		RPId:             h.rp.Id(),
+		UserVerification: "required",
+		Timeout:          int(h.ceremonyTTL.Milliseconds()),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+)
+
+// HandleBeginRegistration starts adding a passkey to the account of the user.
+// The passkeys they have are excluded, so an authenticator does not register
+// twice.
+func (h *PasskeyHandler) HandleBeginRegistration(ctx context.Context, cmd commands.BeginRegistrationCommand) (*dto.CreationOptionsDTO, error) {
+	usr, err := h.userRepo.GetById(ctx, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := h.repository.GetByUser(ctx, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := passkeys.NewCeremony(passkeys.RegistrationCeremony, cmd.UserId, h.ceremonyTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.ceremonies.Save(ctx, ceremony); err != nil {
+		h.logger.Error("Could not save passkey registration of user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	params := make([]dto.CredentialParameterDTO, 0, len(passkeys.Algorithms))
+	for _, alg := range passkeys.Algorithms {
+		params = append(params, dto.CredentialParameterDTO{Type: "public-key", Alg: int(alg)})
+	}
+
+	exclude := make([]dto.CredentialDescriptorDTO, 0, len(existing))
+	for _, credential := range existing {
+		exclude = append(exclude, dto.CredentialDescriptorDTO{Type: "public-key", Id: credential.EncodedId()})
+	}
+
+	return &dto.CreationOptionsDTO{
+		Challenge: ceremony.Challenge(),
+		RP: dto.RelyingPartyDTO{
+			Id:   h.rp.Id(),
+			Name: h.rp.Name(),
+		},
+		User: dto.UserEntityDTO{
+			Id:          base64.RawURLEncoding.EncodeToString(passkeys.UserHandle(cmd.UserId)),
+			Name:        usr.Email().String(),
+			DisplayName: usr.Username().String(),
+		},
+		PubKeyCredParams:   params,
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: dto.AuthenticatorSelectionDTO{
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   "required",
+		},
+		Attestation: "none",
+		Timeout:     int(h.ceremonyTTL.Milliseconds()),
+	}, nil
+}
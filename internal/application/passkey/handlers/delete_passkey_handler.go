@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+)
+
+func (h *PasskeyHandler) HandleDelete(ctx context.Context, cmd commands.DeletePasskeyCommand) error {
+	id, err := passkeys.ParseCredentialId(cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	if err = h.repository.Delete(ctx, cmd.UserId, id); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"time"
+)
+
+// HandleFinishLogin returns the user to issue tokens for once a passkey of
+// theirs signed the challenge of a login. The counter it signed must have
+// moved past the stored one, unless the authenticator does not count.
+func (h *PasskeyHandler) HandleFinishLogin(ctx context.Context, cmd commands.FinishLoginCommand) (uuid.UUID, error) {
+	raw, err := decode(cmd.Response.ClientDataJSON)
+	if err != nil {
+		return uuid.Nil, passkeys.ErrInvalidClientData
+	}
+
+	cd, err := passkeys.ParseClientData(raw)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err = h.ceremonies.Take(ctx, passkeys.LoginCeremony, cd.Challenge); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := passkeys.ParseCredentialId(cmd.Id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	credential, err := h.repository.GetById(ctx, id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userHandle, err := decode(cmd.Response.UserHandle)
+	if err != nil || (len(userHandle) != 0 && !bytes.Equal(userHandle, passkeys.UserHandle(credential.UserId()))) {
+		return uuid.Nil, passkeys.ErrUserHandleCredential
+	}
+
+	authenticatorData, err := decode(cmd.Response.AuthenticatorData)
+	if err != nil {
+		return uuid.Nil, passkeys.ErrMalformedCBOR
+	}
+
+	signature, err := decode(cmd.Response.Signature)
+	if err != nil {
+		return uuid.Nil, passkeys.ErrSignature
+	}
+
+	ad, err := h.rp.VerifyAssertion(credential, cd, authenticatorData, signature)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	previous := credential.SignCount()
+	if err = credential.Use(ad.SignCount, time.Now()); err != nil {
+		h.logger.Warn("Passkey %s of user %s signed counter %d after %d", credential.EncodedId(), credential.UserId(), ad.SignCount, previous)
+		return uuid.Nil, err
+	}
+
+	used, err := h.repository.Use(ctx, credential, previous)
+	if err != nil {
+		return uuid.Nil, err
+	} else if !used {
+		return uuid.Nil, passkeys.ErrClonedCredential
+	}
+
+	usr, err := h.userRepo.GetById(ctx, credential.UserId())
+	if err != nil {
+		return uuid.Nil, err
+	} else if usr.DeletedAt() != nil {
+		return uuid.Nil, users.ErrNotFoundUser
+	}
+
+	usr.ChangeLastLoginAt()
+	if err = h.userRepo.Update(ctx, usr); err != nil {
+		return uuid.Nil, err
+	}
+
+	return usr.Id(), nil
+}
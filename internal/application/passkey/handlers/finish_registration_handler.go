@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+)
+
+// HandleFinishRegistration keeps the passkey the authenticator created, once
+// it answered a registration the same user started.
+func (h *PasskeyHandler) HandleFinishRegistration(ctx context.Context, cmd commands.FinishRegistrationCommand) (*dto.PasskeyDTO, error) {
+	raw, err := decode(cmd.Response.ClientDataJSON)
+	if err != nil {
+		return nil, passkeys.ErrInvalidClientData
+	}
+
+	cd, err := passkeys.ParseClientData(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := h.ceremonies.Take(ctx, passkeys.RegistrationCeremony, cd.Challenge)
+	if err != nil {
+		return nil, err
+	} else if ceremony.UserId() != cmd.UserId {
+		return nil, passkeys.ErrInvalidCeremony
+	}
+
+	attestationObject, err := decode(cmd.Response.AttestationObject)
+	if err != nil {
+		return nil, passkeys.ErrMalformedCBOR
+	}
+
+	ad, err := h.rp.VerifyRegistration(cd, attestationObject)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := passkeys.NewCredential(cmd.UserId, ad, cmd.Name)
+	if err != nil {
+		return nil, err
+	} else if cmd.Id != "" && cmd.Id != credential.EncodedId() {
+		return nil, passkeys.ErrInvalidIdCredential
+	}
+
+	if err = h.repository.Create(ctx, credential); err != nil {
+		h.logger.Error("Could not save passkey of user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return mappers.MapToPasskeyDTO(credential), nil
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"strings"
+	"time"
+)
+
+// PasskeyHandler has users log in with passkeys, WebAuthn credentials held by
+// their devices, in place of a password. Each ceremony answers a challenge
+// that lasts ceremonyTTL.
+type PasskeyHandler struct {
+	repository  passkeys.CredentialRepository
+	ceremonies  passkeys.CeremonyStore
+	userRepo    users.UserRepository
+	rp          *passkeys.RelyingParty
+	ceremonyTTL time.Duration
+	logger      application.Logger
+}
+
+func NewPasskeyHandler(repository passkeys.CredentialRepository, ceremonies passkeys.CeremonyStore, userRepo users.UserRepository, rp *passkeys.RelyingParty, ceremonyTTL time.Duration, logger application.Logger) *PasskeyHandler {
+	return &PasskeyHandler{
+		repository:  repository,
+		ceremonies:  ceremonies,
+		userRepo:    userRepo,
+		rp:          rp,
+		ceremonyTTL: ceremonyTTL,
+		logger:      logger,
+	}
+}
+
+// decode reads a buffer the way toJSON() encodes it, base64url, tolerating
+// the padding some clients add.
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
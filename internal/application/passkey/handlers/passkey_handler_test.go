@@ -0,0 +1,469 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey/passkeytest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testRPId   = "pinterest.test"
+	testOrigin = "https://pinterest.test"
+)
+
+// MockCeremonyStore and MockCredentialRepository keep what they are given in
+// memory, so whole ceremonies can run against a software authenticator.
+type MockCeremonyStore struct {
+	mu         sync.Mutex
+	ceremonies map[string]*passkeys.Ceremony
+}
+
+type MockCredentialRepository struct {
+	mu          sync.Mutex
+	credentials map[string]*passkeys.Credential
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+type passkeyMocks struct {
+	repository *MockCredentialRepository
+	ceremonies *MockCeremonyStore
+	userRepo   *MockUserRepository
+}
+
+func newTestPasskeyHandler() (*PasskeyHandler, passkeyMocks) {
+	m := passkeyMocks{
+		repository: &MockCredentialRepository{credentials: map[string]*passkeys.Credential{}},
+		ceremonies: &MockCeremonyStore{ceremonies: map[string]*passkeys.Ceremony{}},
+		userRepo:   new(MockUserRepository),
+	}
+	rp := passkeys.NewRelyingParty(testRPId, "Pinterest", []string{testOrigin})
+
+	return NewPasskeyHandler(m.repository, m.ceremonies, m.userRepo, rp, 5*time.Minute, new(MockLogger)), m
+}
+
+func newTestUser(t *testing.T) *users.User {
+	now := time.Now()
+	usr, err := users.NewUserFromDB(uuid.New(), "John", "Doe", "johndoe", "john@doe.com", "5Tr0nG1.!", "Male", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, nil, nil)
+	require.NoError(t, err)
+	return usr
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// register runs a whole registration of the authenticator for the user.
+func register(t *testing.T, handler *PasskeyHandler, authenticator *passkeytest.SoftwareAuthenticator, usr *users.User) {
+	ctx := context.Background()
+
+	options, err := handler.HandleBeginRegistration(ctx, commands.BeginRegistrationCommand{UserId: usr.Id()})
+	require.NoError(t, err)
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(options.User.Id)
+	require.NoError(t, err)
+	clientData, attestationObject, err := authenticator.Create(options.Challenge, userHandle)
+	require.NoError(t, err)
+
+	_, err = handler.HandleFinishRegistration(ctx, commands.FinishRegistrationCommand{
+		UserId: usr.Id(),
+		Id:     encode(authenticator.CredentialId()),
+		Type:   "public-key",
+		Response: commands.RegistrationResponse{
+			ClientDataJSON:    encode(clientData),
+			AttestationObject: encode(attestationObject),
+		},
+	})
+	require.NoError(t, err)
+}
+
+// login answers the challenge with the authenticator.
+func login(t *testing.T, authenticator *passkeytest.SoftwareAuthenticator, challenge string) commands.FinishLoginCommand {
+	clientData, authData, signature, userHandle, err := authenticator.Get(challenge)
+	require.NoError(t, err)
+
+	return commands.FinishLoginCommand{
+		Id:   encode(authenticator.CredentialId()),
+		Type: "public-key",
+		Response: commands.AssertionResponse{
+			ClientDataJSON:    encode(clientData),
+			AuthenticatorData: encode(authData),
+			Signature:         encode(signature),
+			UserHandle:        encode(userHandle),
+		},
+	}
+}
+
+func TestNewPasskeyHandler(t *testing.T) {
+	repository, ceremonies, userRepo, logger := new(MockCredentialRepository), new(MockCeremonyStore), new(MockUserRepository), new(MockLogger)
+	rp := passkeys.NewRelyingParty(testRPId, "Pinterest", []string{testOrigin})
+	handler := NewPasskeyHandler(repository, ceremonies, userRepo, rp, time.Minute, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, ceremonies, handler.ceremonies)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Exactly(t, rp, handler.rp)
+	require.Equal(t, time.Minute, handler.ceremonyTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestPasskeyHandler_HandleBeginRegistration(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	existing := passkeys.NewCredentialFromDB([]byte{1, 2, 3}, usr.Id(), []byte{9}, 0, "Phone", time.Now(), nil)
+	m.repository.credentials[string(existing.Id())] = existing
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+
+	options, err := handler.HandleBeginRegistration(ctx, commands.BeginRegistrationCommand{UserId: usr.Id()})
+
+	require.NoError(t, err)
+	assert.Equal(t, testRPId, options.RP.Id)
+	assert.Equal(t, encode(passkeys.UserHandle(usr.Id())), options.User.Id)
+	assert.Equal(t, "john@doe.com", options.User.Name)
+	assert.Equal(t, "johndoe", options.User.DisplayName)
+	require.Len(t, options.ExcludeCredentials, 1)
+	assert.Equal(t, existing.EncodedId(), options.ExcludeCredentials[0].Id)
+	assert.Len(t, options.PubKeyCredParams, len(passkeys.Algorithms))
+	assert.Equal(t, "required", options.AuthenticatorSelection.UserVerification)
+	assert.Equal(t, "none", options.Attestation)
+	assert.Contains(t, m.ceremonies.ceremonies, passkeys.RegistrationCeremony+":"+options.Challenge)
+}
+
+func TestPasskeyHandler_RegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	register(t, handler, authenticator, usr)
+
+	credential := m.repository.credentials[string(authenticator.CredentialId())]
+	require.NotNil(t, credential)
+	assert.Equal(t, usr.Id(), credential.UserId())
+	assert.Equal(t, passkeys.DefaultName, credential.Name())
+
+	options, err := handler.HandleBeginLogin(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testRPId, options.RPId)
+
+	userId, err := handler.HandleFinishLogin(ctx, login(t, authenticator, options.Challenge))
+
+	require.NoError(t, err)
+	assert.Equal(t, usr.Id(), userId)
+	credential = m.repository.credentials[string(authenticator.CredentialId())]
+	assert.Equal(t, uint32(1), credential.SignCount())
+	assert.NotNil(t, credential.LastUsedAt())
+}
+
+func TestPasskeyHandler_HandleFinishRegistration_OtherUser(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	options, err := handler.HandleBeginRegistration(ctx, commands.BeginRegistrationCommand{UserId: usr.Id()})
+	require.NoError(t, err)
+
+	clientData, attestationObject, err := authenticator.Create(options.Challenge, passkeys.UserHandle(usr.Id()))
+	require.NoError(t, err)
+
+	passkey, err := handler.HandleFinishRegistration(ctx, commands.FinishRegistrationCommand{
+		UserId: uuid.New(),
+		Response: commands.RegistrationResponse{
+			ClientDataJSON:    encode(clientData),
+			AttestationObject: encode(attestationObject),
+		},
+	})
+
+	assert.ErrorIs(t, err, passkeys.ErrInvalidCeremony)
+	assert.Nil(t, passkey)
+	assert.Empty(t, m.repository.credentials)
+}
+
+func TestPasskeyHandler_HandleFinishLogin_Replayed(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	register(t, handler, authenticator, usr)
+
+	options, err := handler.HandleBeginLogin(ctx)
+	require.NoError(t, err)
+	cmd := login(t, authenticator, options.Challenge)
+
+	_, err = handler.HandleFinishLogin(ctx, cmd)
+	require.NoError(t, err)
+
+	userId, err := handler.HandleFinishLogin(ctx, cmd)
+
+	assert.ErrorIs(t, err, passkeys.ErrInvalidCeremony)
+	assert.Equal(t, uuid.Nil, userId)
+}
+
+func TestPasskeyHandler_HandleFinishLogin_Cloned(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	register(t, handler, authenticator, usr)
+
+	for range 3 {
+		options, err := handler.HandleBeginLogin(ctx)
+		require.NoError(t, err)
+		_, err = handler.HandleFinishLogin(ctx, login(t, authenticator, options.Challenge))
+		require.NoError(t, err)
+	}
+
+	authenticator.SetSignCount(1)
+	options, err := handler.HandleBeginLogin(ctx)
+	require.NoError(t, err)
+
+	userId, err := handler.HandleFinishLogin(ctx, login(t, authenticator, options.Challenge))
+
+	assert.ErrorIs(t, err, passkeys.ErrClonedCredential)
+	assert.Equal(t, uuid.Nil, userId)
+	assert.Equal(t, uint32(3), m.repository.credentials[string(authenticator.CredentialId())].SignCount())
+}
+
+func TestPasskeyHandler_HandleFinishLogin_NotCounting(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	authenticator.Counting = false
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	register(t, handler, authenticator, usr)
+
+	for range 2 {
+		options, err := handler.HandleBeginLogin(ctx)
+		require.NoError(t, err)
+
+		userId, err := handler.HandleFinishLogin(ctx, login(t, authenticator, options.Challenge))
+		require.NoError(t, err)
+		assert.Equal(t, usr.Id(), userId)
+	}
+}
+
+func TestPasskeyHandler_HandleFinishLogin_UnknownPasskey(t *testing.T) {
+	ctx := context.Background()
+	handler, _ := newTestPasskeyHandler()
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	options, err := handler.HandleBeginLogin(ctx)
+	require.NoError(t, err)
+
+	userId, err := handler.HandleFinishLogin(ctx, login(t, authenticator, options.Challenge))
+
+	assert.ErrorIs(t, err, passkeys.ErrNotFoundCredential)
+	assert.Equal(t, uuid.Nil, userId)
+}
+
+func TestPasskeyHandler_HandleFinishLogin_OtherUserHandle(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	usr := newTestUser(t)
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+
+	m.userRepo.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	register(t, handler, authenticator, usr)
+
+	options, err := handler.HandleBeginLogin(ctx)
+	require.NoError(t, err)
+	cmd := login(t, authenticator, options.Challenge)
+	other := uuid.New()
+	cmd.Response.UserHandle = encode(other[:])
+
+	userId, err := handler.HandleFinishLogin(ctx, cmd)
+
+	assert.ErrorIs(t, err, passkeys.ErrUserHandleCredential)
+	assert.Equal(t, uuid.Nil, userId)
+}
+
+func TestPasskeyHandler_HandleDelete(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasskeyHandler()
+	userId := uuid.New()
+	credential := passkeys.NewCredentialFromDB([]byte{1, 2, 3}, userId, []byte{9}, 0, "Phone", time.Now(), nil)
+	m.repository.credentials[string(credential.Id())] = credential
+
+	err := handler.HandleDelete(ctx, commands.DeletePasskeyCommand{UserId: uuid.New(), Id: credential.EncodedId()})
+	assert.ErrorIs(t, err, passkeys.ErrNotFoundCredential)
+
+	err = handler.HandleDelete(ctx, commands.DeletePasskeyCommand{UserId: userId, Id: "not base64!"})
+	assert.ErrorIs(t, err, passkeys.ErrInvalidIdCredential)
+
+	err = handler.HandleDelete(ctx, commands.DeletePasskeyCommand{UserId: userId, Id: credential.EncodedId()})
+	require.NoError(t, err)
+	assert.Empty(t, m.repository.credentials)
+}
+
+func (m *MockCeremonyStore) Save(ctx context.Context, ceremony *passkeys.Ceremony) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ceremonies[ceremony.Kind()+":"+ceremony.Challenge()] = ceremony
+	return nil
+}
+
+func (m *MockCeremonyStore) Take(ctx context.Context, kind, challenge string) (*passkeys.Ceremony, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ceremony, ok := m.ceremonies[kind+":"+challenge]
+	if !ok || time.Now().After(ceremony.ExpiresAt()) {
+		return nil, passkeys.ErrInvalidCeremony
+	}
+	delete(m.ceremonies, kind+":"+challenge)
+	return ceremony, nil
+}
+
+func (m *MockCredentialRepository) GetById(ctx context.Context, id []byte) (*passkeys.Credential, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	credential, ok := m.credentials[string(id)]
+	if !ok {
+		return nil, passkeys.ErrNotFoundCredential
+	}
+	return passkeys.NewCredentialFromDB(credential.Id(), credential.UserId(), credential.PublicKey(), credential.SignCount(), credential.Name(), credential.CreatedAt(), credential.LastUsedAt()), nil
+}
+
+func (m *MockCredentialRepository) GetByUser(ctx context.Context, userId uuid.UUID) ([]*passkeys.Credential, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var credentials []*passkeys.Credential
+	for _, credential := range m.credentials {
+		if credential.UserId() == userId {
+			credentials = append(credentials, credential)
+		}
+	}
+	return credentials, nil
+}
+
+func (m *MockCredentialRepository) Create(ctx context.Context, credential *passkeys.Credential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.credentials[string(credential.Id())]; ok {
+		return passkeys.ErrExistsCredential
+	}
+	m.credentials[string(credential.Id())] = credential
+	return nil
+}
+
+func (m *MockCredentialRepository) Use(ctx context.Context, credential *passkeys.Credential, previous uint32) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.credentials[string(credential.Id())]
+	if !ok || stored.SignCount() != previous {
+		return false, nil
+	}
+	m.credentials[string(credential.Id())] = credential
+	return true, nil
+}
+
+func (m *MockCredentialRepository) Delete(ctx context.Context, userId uuid.UUID, id []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	credential, ok := m.credentials[string(id)]
+	if !ok || credential.UserId() != userId {
+		return passkeys.ErrNotFoundCredential
+	}
+	delete(m.credentials, string(id))
+	return nil
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
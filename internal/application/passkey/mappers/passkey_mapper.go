@@ -0,0 +1,15 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+)
+
+func MapToPasskeyDTO(credential *passkeys.Credential) *dto.PasskeyDTO {
+	return &dto.PasskeyDTO{
+		Id:         credential.EncodedId(),
+		Name:       credential.Name(),
+		CreatedAt:  credential.CreatedAt(),
+		LastUsedAt: credential.LastUsedAt(),
+	}
+}
@@ -0,0 +1,5 @@
+package commands
+
+type RequestPasswordResetCommand struct {
+	Email string `json:"email"`
+}
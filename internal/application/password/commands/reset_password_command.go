@@ -0,0 +1,6 @@
+package commands
+
+type ResetPasswordCommand struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
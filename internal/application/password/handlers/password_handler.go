@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"time"
+)
+
+// PasswordHandler lets users who forgot their password set a new one through
+// a link emailed to them, which works once and for resetTTL. Setting it logs
+// every session out, turning away its access tokens for accessTTL.
+type PasswordHandler struct {
+	repository users.UserRepository
+	resetRepo  email.PasswordResetRepository
+	tokenRepo  tokens.RefreshTokenRepository
+	sessions   sessions.SessionRepository
+	revoker    sessions.SessionRevoker
+	sender     email.Sender
+	resetTTL   time.Duration
+	accessTTL  time.Duration
+	logger     application.Logger
+}
+
+func NewPasswordHandler(repository users.UserRepository, resetRepo email.PasswordResetRepository, tokenRepo tokens.RefreshTokenRepository, sessionRepo sessions.SessionRepository, revoker sessions.SessionRevoker, sender email.Sender, resetTTL, accessTTL time.Duration, logger application.Logger) *PasswordHandler {
+	return &PasswordHandler{
+		repository: repository,
+		resetRepo:  resetRepo,
+		tokenRepo:  tokenRepo,
+		sessions:   sessionRepo,
+		revoker:    revoker,
+		sender:     sender,
+		resetTTL:   resetTTL,
+		accessTTL:  accessTTL,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,460 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/password/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+	"time"
+)
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockPasswordResetRepository struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockSessionRevoker struct {
+	mock.Mock
+}
+
+type MockSender struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+type passwordMocks struct {
+	repository *MockUserRepository
+	resetRepo  *MockPasswordResetRepository
+	tokenRepo  *MockRefreshTokenRepository
+	sessions   *MockSessionRepository
+	revoker    *MockSessionRevoker
+	sender     *MockSender
+}
+
+func newTestPasswordHandler() (*PasswordHandler, passwordMocks) {
+	m := passwordMocks{
+		repository: new(MockUserRepository),
+		resetRepo:  new(MockPasswordResetRepository),
+		tokenRepo:  new(MockRefreshTokenRepository),
+		sessions:   new(MockSessionRepository),
+		revoker:    new(MockSessionRevoker),
+		sender:     new(MockSender),
+	}
+
+	return NewPasswordHandler(m.repository, m.resetRepo, m.tokenRepo, m.sessions, m.revoker, m.sender, time.Hour, 15*time.Minute, new(MockLogger)), m
+}
+
+func newTestUser(t *testing.T, deletedAt *time.Time) *users.User {
+	now := time.Now()
+	usr, err := users.NewUserFromDB(uuid.New(), "John", "Doe", "johndoe", "john@doe.com", "5Tr0nG1.!", "Male", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, deletedAt, nil)
+	require.NoError(t, err)
+	return usr
+}
+
+func TestNewPasswordHandler(t *testing.T) {
+	repository, resetRepo, tokenRepo, sessionRepo, revoker, sender, logger := new(MockUserRepository), new(MockPasswordResetRepository), new(MockRefreshTokenRepository), new(MockSessionRepository), new(MockSessionRevoker), new(MockSender), new(MockLogger)
+	handler := NewPasswordHandler(repository, resetRepo, tokenRepo, sessionRepo, revoker, sender, time.Hour, time.Minute, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, resetRepo, handler.resetRepo)
+	require.Exactly(t, tokenRepo, handler.tokenRepo)
+	require.Exactly(t, sessionRepo, handler.sessions)
+	require.Exactly(t, revoker, handler.revoker)
+	require.Exactly(t, sender, handler.sender)
+	require.Equal(t, time.Hour, handler.resetTTL)
+	require.Equal(t, time.Minute, handler.accessTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestPasswordHandler_HandleRequest(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	usr := newTestUser(t, nil)
+
+	var (
+		stored *email.PasswordReset
+		sent   string
+	)
+	m.repository.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+	m.repository.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+	m.resetRepo.On("CountSince", ctx, usr.Id(), mock.Anything).Return(0, nil)
+	m.resetRepo.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*email.PasswordReset)
+	}).Return(nil)
+	m.sender.On("SendPasswordResetEmail", "john@doe.com", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.String(1)
+	}).Return(nil)
+
+	err := handler.HandleRequest(ctx, commands.RequestPasswordResetCommand{Email: "john@doe.com"})
+
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, usr.Id(), stored.UserId)
+	assert.Equal(t, tokens.Hash(sent), stored.TokenHash)
+	assert.NotEqual(t, sent, stored.TokenHash)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+	m.sender.AssertExpectations(t)
+}
+
+func TestPasswordHandler_HandleRequest_Unknown(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	deleted := newTestUser(t, new(time.Time))
+
+	m.repository.On("ExistsByEmail", ctx, "nobody@doe.com").Return(false, nil)
+	m.repository.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+	m.repository.On("GetByEmail", ctx, "john@doe.com").Return(deleted, nil)
+
+	require.NoError(t, handler.HandleRequest(ctx, commands.RequestPasswordResetCommand{Email: "nobody@doe.com"}))
+	require.NoError(t, handler.HandleRequest(ctx, commands.RequestPasswordResetCommand{Email: "john@doe.com"}))
+	m.resetRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	m.sender.AssertNotCalled(t, "SendPasswordResetEmail", mock.Anything, mock.Anything)
+}
+
+func TestPasswordHandler_HandleRequest_RateLimited(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name  string
+		setup func(m passwordMocks, usr *users.User)
+	}{
+		{name: "Cooldown", setup: func(m passwordMocks, usr *users.User) {
+			m.resetRepo.On("CountSince", ctx, usr.Id(), mock.Anything).Return(1, nil)
+		}},
+		{name: "Daily limit", setup: func(m passwordMocks, usr *users.User) {
+			m.resetRepo.On("CountSince", ctx, usr.Id(), mock.MatchedBy(func(since time.Time) bool {
+				return time.Since(since) < time.Hour
+			})).Return(0, nil)
+			m.resetRepo.On("CountSince", ctx, usr.Id(), mock.Anything).Return(email.MaxSendsPerDay, nil)
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, m := newTestPasswordHandler()
+			usr := newTestUser(t, nil)
+			m.repository.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+			m.repository.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+			tc.setup(m, usr)
+
+			err := handler.HandleRequest(ctx, commands.RequestPasswordResetCommand{Email: "john@doe.com"})
+
+			require.NoError(t, err)
+			m.resetRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			m.sender.AssertNotCalled(t, "SendPasswordResetEmail", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPasswordHandler_HandleReset(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	usr := newTestUser(t, nil)
+	reset, secret, err := email.NewPasswordReset(usr.Id(), time.Hour)
+	require.NoError(t, err)
+	phone, err := sessions.NewSession(usr.Id(), "Mozilla/5.0 (iPhone) Safari/604.1", "203.0.113.7")
+	require.NoError(t, err)
+	laptop, err := sessions.NewSession(usr.Id(), "Mozilla/5.0 (Windows NT 10.0) Firefox/120.0", "203.0.113.8")
+	require.NoError(t, err)
+
+	m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(reset, nil)
+	m.repository.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.resetRepo.On("MarkUsed", ctx, reset.Id, mock.Anything).Return(true, nil)
+	m.repository.On("Update", ctx, usr).Return(nil)
+	m.sessions.On("GetActiveByUser", ctx, usr.Id(), mock.Anything).Return([]*sessions.Session{phone, laptop}, nil)
+	m.tokenRepo.On("RevokeUser", ctx, usr.Id(), mock.Anything).Return(nil)
+	m.revoker.On("RevokeSessions", []uuid.UUID{phone.Id(), laptop.Id()}, 15*time.Minute).Return(nil)
+
+	err = handler.HandleReset(ctx, commands.ResetPasswordCommand{Token: secret, Password: "N3wP4ss.!"})
+
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(usr.Password().String()), []byte("N3wP4ss.!")))
+	m.resetRepo.AssertExpectations(t)
+	m.repository.AssertExpectations(t)
+	m.sessions.AssertExpectations(t)
+	m.tokenRepo.AssertExpectations(t)
+	m.revoker.AssertExpectations(t)
+}
+
+func TestPasswordHandler_HandleReset_Errors(t *testing.T) {
+	ctx := context.Background()
+	usedAt := time.Now().Add(-time.Minute)
+
+	cases := []struct {
+		name     string
+		password string
+		reset    func(secret string) *email.PasswordReset
+		expected error
+	}{
+		{name: "Weak password", password: "weak", expected: shared.ErrShortPassword},
+		{name: "Unknown token", password: "N3wP4ss.!", expected: email.ErrInvalidPasswordReset},
+		{name: "Used token", password: "N3wP4ss.!", expected: email.ErrUsedPasswordReset, reset: func(secret string) *email.PasswordReset {
+			return &email.PasswordReset{Id: uuid.New(), UserId: uuid.New(), TokenHash: tokens.Hash(secret), ExpiresAt: time.Now().Add(time.Hour), UsedAt: &usedAt}
+		}},
+		{name: "Expired token", password: "N3wP4ss.!", expected: email.ErrExpiredPasswordReset, reset: func(secret string) *email.PasswordReset {
+			return &email.PasswordReset{Id: uuid.New(), UserId: uuid.New(), TokenHash: tokens.Hash(secret), ExpiresAt: time.Now().Add(-time.Minute)}
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, m := newTestPasswordHandler()
+			secret, _ := tokens.NewSecret()
+			if tc.reset != nil {
+				m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(tc.reset(secret), nil)
+			} else {
+				m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(nil, email.ErrNotFoundPasswordReset)
+			}
+
+			err := handler.HandleReset(ctx, commands.ResetPasswordCommand{Token: secret, Password: tc.password})
+
+			assert.ErrorIs(t, err, tc.expected)
+			m.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			m.tokenRepo.AssertNotCalled(t, "RevokeUser", mock.Anything, mock.Anything, mock.Anything)
+			m.revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPasswordHandler_HandleReset_Race(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	usr := newTestUser(t, nil)
+	reset, secret, err := email.NewPasswordReset(usr.Id(), time.Hour)
+	require.NoError(t, err)
+
+	m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(reset, nil)
+	m.repository.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.resetRepo.On("MarkUsed", ctx, reset.Id, mock.Anything).Return(false, nil)
+
+	err = handler.HandleReset(ctx, commands.ResetPasswordCommand{Token: secret, Password: "N3wP4ss.!"})
+
+	assert.ErrorIs(t, err, email.ErrUsedPasswordReset)
+	m.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestPasswordHandler_HandleReset_RevokeError(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	usr := newTestUser(t, nil)
+	reset, secret, err := email.NewPasswordReset(usr.Id(), time.Hour)
+	require.NoError(t, err)
+	dbErr := errors.New("database error")
+
+	m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(reset, nil)
+	m.repository.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.resetRepo.On("MarkUsed", ctx, reset.Id, mock.Anything).Return(true, nil)
+	m.repository.On("Update", ctx, usr).Return(nil)
+	m.sessions.On("GetActiveByUser", ctx, usr.Id(), mock.Anything).Return([]*sessions.Session{}, nil)
+	m.tokenRepo.On("RevokeUser", ctx, usr.Id(), mock.Anything).Return(dbErr)
+
+	err = handler.HandleReset(ctx, commands.ResetPasswordCommand{Token: secret, Password: "N3wP4ss.!"})
+
+	assert.ErrorIs(t, err, dbErr)
+	m.revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+func TestPasswordHandler_HandleReset_RevokeSessionsError(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPasswordHandler()
+	usr := newTestUser(t, nil)
+	reset, secret, err := email.NewPasswordReset(usr.Id(), time.Hour)
+	require.NoError(t, err)
+	session, err := sessions.NewSession(usr.Id(), "Mozilla/5.0 (iPhone) Safari/604.1", "203.0.113.7")
+	require.NoError(t, err)
+	redisErr := errors.New("redis error")
+
+	m.resetRepo.On("FindByHash", ctx, tokens.Hash(secret)).Return(reset, nil)
+	m.repository.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	m.resetRepo.On("MarkUsed", ctx, reset.Id, mock.Anything).Return(true, nil)
+	m.repository.On("Update", ctx, usr).Return(nil)
+	m.sessions.On("GetActiveByUser", ctx, usr.Id(), mock.Anything).Return([]*sessions.Session{session}, nil)
+	m.tokenRepo.On("RevokeUser", ctx, usr.Id(), mock.Anything).Return(nil)
+	m.revoker.On("RevokeSessions", []uuid.UUID{session.Id()}, 15*time.Minute).Return(redisErr)
+
+	err = handler.HandleReset(ctx, commands.ResetPasswordCommand{Token: secret, Password: "N3wP4ss.!"})
+
+	assert.ErrorIs(t, err, redisErr)
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	args := m.Called(ctx, u)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockPasswordResetRepository) Save(ctx context.Context, pr *email.PasswordReset) error {
+	args := m.Called(ctx, pr)
+	return args.Error(0)
+}
+
+func (m *MockPasswordResetRepository) FindByHash(ctx context.Context, hash string) (*email.PasswordReset, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*email.PasswordReset), args.Error(1)
+}
+
+func (m *MockPasswordResetRepository) CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error) {
+	args := m.Called(ctx, userId, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPasswordResetRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.RefreshToken, error) {
+	return nil, nil
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *tokens.RefreshToken) error {
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, userId, at)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	args := m.Called(ctx, userId, now)
+	return args.Get(0).([]*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *sessions.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	args := m.Called(ctx, id, ip, at)
+	return args.Error(0)
+}
+
+func (m *MockSessionRevoker) RevokeSessions(ids []uuid.UUID, ttl time.Duration) error {
+	args := m.Called(ids, ttl)
+	return args.Error(0)
+}
+
+func (m *MockSender) SendVerificationEmail(toEmail, token string) error {
+	return nil
+}
+
+func (m *MockSender) SendPasswordResetEmail(toEmail, token string) error {
+	args := m.Called(toEmail, token)
+	return args.Error(0)
+}
+
+func (m *MockSender) SendUnlockEmail(toEmail, token string) error {
+	return nil
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
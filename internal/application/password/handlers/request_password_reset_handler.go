@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/password/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"time"
+)
+
+// HandleRequest emails a reset link to the account of the address, if there
+// is one. Unknown and rate limited accounts are skipped without an error, so
+// it succeeds alike and cannot tell who has an account.
+func (h *PasswordHandler) HandleRequest(ctx context.Context, cmd commands.RequestPasswordResetCommand) error {
+	em, err := shared.NewEmail(cmd.Email)
+	if err != nil {
+		return err
+	}
+
+	exists, err := h.repository.ExistsByEmail(ctx, em.String())
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+
+	usr, err := h.repository.GetByEmail(ctx, em.String())
+	if err != nil {
+		return err
+	} else if usr.DeletedAt() != nil {
+		return nil
+	}
+
+	now := time.Now()
+	recent, err := h.resetRepo.CountSince(ctx, usr.Id(), now.Add(-email.ResendCooldown))
+	if err != nil {
+		return err
+	}
+
+	daily, err := h.resetRepo.CountSince(ctx, usr.Id(), now.Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	if recent > 0 || daily >= email.MaxSendsPerDay {
+		h.logger.Warn("Skipping password reset email to user %s, %d sent today", usr.Id(), daily)
+		return nil
+	}
+
+	reset, secret, err := email.NewPasswordReset(usr.Id(), h.resetTTL)
+	if err != nil {
+		return err
+	}
+
+	if err = h.resetRepo.Save(ctx, reset); err != nil {
+		h.logger.Error("Could not store password reset for user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	if err = h.sender.SendPasswordResetEmail(usr.Email().String(), secret); err != nil {
+		h.logger.Error("Could not send password reset email to user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	return nil
+}
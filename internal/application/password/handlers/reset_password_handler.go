@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/password/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"time"
+)
+
+// HandleReset sets the new password of the user the token was sent to and
+// logs out every session of theirs: their refresh tokens are revoked, which
+// ends the sessions, and the access tokens still alive are turned away, so
+// whoever knew the old password has to log in again with the new one.
+func (h *PasswordHandler) HandleReset(ctx context.Context, cmd commands.ResetPasswordCommand) error {
+	if cmd.Token == "" {
+		return email.ErrInvalidPasswordReset
+	}
+
+	if _, err := shared.NewPassword(cmd.Password); err != nil {
+		return err
+	}
+
+	reset, err := h.resetRepo.FindByHash(ctx, tokens.Hash(cmd.Token))
+	if errors.Is(err, email.ErrNotFoundPasswordReset) {
+		return email.ErrInvalidPasswordReset
+	} else if err != nil {
+		return err
+	}
+
+	if reset.IsUsed() {
+		return email.ErrUsedPasswordReset
+	} else if reset.IsExpired() {
+		return email.ErrExpiredPasswordReset
+	}
+
+	usr, err := h.repository.GetById(ctx, reset.UserId)
+	if err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	password, err := shared.NewHashedPassword(string(hashed))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	marked, err := h.resetRepo.MarkUsed(ctx, reset.Id, now)
+	if err != nil {
+		return err
+	} else if !marked {
+		return email.ErrUsedPasswordReset
+	}
+
+	if err = usr.ChangePassword(password); err != nil {
+		return err
+	}
+	usr.Update()
+
+	if err = h.repository.Update(ctx, usr); err != nil {
+		h.logger.Error("Could not update password of user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	active, err := h.sessions.GetActiveByUser(ctx, usr.Id(), now)
+	if err != nil {
+		return err
+	}
+
+	if err = h.tokenRepo.RevokeUser(ctx, usr.Id(), now); err != nil {
+		h.logger.Error("Could not revoke refresh tokens of user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(active))
+	for i, session := range active {
+		ids[i] = session.Id()
+	}
+
+	if err = h.revoker.RevokeSessions(ids, h.accessTTL); err != nil {
+		h.logger.Error("Could not revoke access tokens of sessions %v: %v", ids, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type CreateCommentCommand struct {
+	PinId   uuid.UUID `json:"pin_id"`
+	UserId  uuid.UUID `json:"user_id"`
+	Content string    `json:"content"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UnlikePinCommand struct {
+	PinId  uuid.UUID `json:"pin_id"`
+	UserId uuid.UUID `json:"user_id"`
+}
@@ -4,6 +4,7 @@ import "github.com/google/uuid"
 
 type UpdatePinCommand struct {
 	Id          uuid.UUID `json:"id"`
+	UserId      uuid.UUID `json:"user_id"`
 	Title       *string   `json:"title,omitempty"`
 	Description *string   `json:"description,omitempty"`
 	Visibility  *bool     `json:"visibility,omitempty"`
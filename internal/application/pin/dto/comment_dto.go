@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/google/uuid"
+
+type CommentDTO struct {
+	Id       uuid.UUID        `json:"id"`
+	PinId    uuid.UUID        `json:"pin_id"`
+	UserId   uuid.UUID        `json:"user_id"`
+	Content  string           `json:"content"`
+	Entities []*TextEntityDTO `json:"entities,omitempty"`
+}
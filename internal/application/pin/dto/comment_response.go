@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type CommentResponse struct {
+	*CommentDTO
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
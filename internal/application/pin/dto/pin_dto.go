@@ -8,10 +8,12 @@ type PinDTO struct {
 	BoardId      uuid.UUID `json:"board_id"`
 	Title        string    `json:"title"`
 	Description  *string   `json:"description,omitempty"`
-	Image        string    `json:"image"`
+	Image        *string   `json:"image,omitempty"`
 	SaveCount    int       `json:"save_count"`
 	LikeCount    int       `json:"like_count"`
 	CommentCount int       `json:"comment_count"`
 	Visibility   bool      `json:"visibility"`
 	Tags         []*TagDTO `json:"tags"`
+
+	DescriptionEntities []*TextEntityDTO `json:"description_entities,omitempty"`
 }
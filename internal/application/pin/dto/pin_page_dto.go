@@ -0,0 +1,8 @@
+package dto
+
+// PinPageDTO is a page of pins read with a cursor. NextCursor is set while
+// there may be more pins to read.
+type PinPageDTO struct {
+	Pins       []*PinDTO `json:"pins"`
+	NextCursor *string   `json:"next_cursor,omitempty"`
+}
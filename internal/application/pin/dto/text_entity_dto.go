@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/google/uuid"
+
+// TextEntityDTO points at a @mention or #hashtag inside a text. Offset and
+// length are counted in UTF-16 code units, as JavaScript strings index, and
+// include the sigil.
+type TextEntityDTO struct {
+	Type   string     `json:"type"`
+	Offset int        `json:"offset"`
+	Length int        `json:"length"`
+	Value  string     `json:"value"`
+	UserId *uuid.UUID `json:"user_id,omitempty"`
+	TagId  *uuid.UUID `json:"tag_id,omitempty"`
+}
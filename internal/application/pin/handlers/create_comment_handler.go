@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+func (h *PinHandler) HandleCreateComment(ctx context.Context, cmd commands.CreateCommentCommand) (*dto.CommentResponse, error) {
+	if cmd.PinId == uuid.Nil {
+		return nil, pins.ErrIdNilPin
+	}
+
+	exist, err := h.repository.ExistById(ctx, cmd.PinId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	pin, err := h.repository.GetById(ctx, cmd.PinId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.ensureNotBlocked(ctx, cmd.UserId, pin.UserId()); err != nil {
+		return nil, err
+	}
+
+	commentFactory, err := h.commentFactory.Create(cmd.PinId, cmd.UserId, cmd.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := h.commentRepo.Create(ctx, commentFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hashtags in comments stay entities of the comment; the tags of the pin
+	// are left to its owner.
+	pin.PlusCommentCount()
+
+	if err = h.repository.Update(ctx, pin); err != nil {
+		return nil, err
+	}
+
+	mentionsList, err := h.resolveMentions(ctx, mentions.CommentSource, comment.Id(), comment.UserId(), comment.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.mentionRepo.Replace(ctx, mentions.CommentSource, comment.Id(), mentionsList); err != nil {
+		return nil, err
+	}
+
+	pinId, commentId := pin.Id(), comment.Id()
+	h.notifier.Notify(ctx, pin.UserId(), comment.UserId(), notifications.CommentKind, &pinId, &commentId)
+	h.notifyMentions(ctx, mentionsList, nil, pinId, &commentId)
+
+	entities := mappers.MapToTextEntityDTOs(comment.Content(), mentionsList, pin.Tags())
+	commentDto := mappers.MapToCommentDTO(comment, entities)
+	commentResponse := mappers.MapToCommentResponse(commentDto, comment.CreatedAt(), comment.UpdatedAt(), comment.DeletedAt())
+	return commentResponse, nil
+}
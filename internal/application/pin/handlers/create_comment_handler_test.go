@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newTestPin(userId uuid.UUID, tags []pins.Tag) *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(uuid.New(), userId, uuid.New(), "Kitchen", nil, nil, 0, 0, 0, true, tags, now, now, nil)
+}
+
+func TestPinHandler_HandleCreateComment(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	kitchen := pins.NewTag("kitchen")
+	pin := newTestPin(uuid.New(), []pins.Tag{*kitchen})
+	mentioned := newTestUser(t, "janesmith")
+
+	cmd := commands.CreateCommentCommand{
+		PinId:   pin.Id(),
+		UserId:  uuid.New(),
+		Content: "@janesmith look, #oak #Kitchen",
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+	m.commentRepo.On("Create", ctx, mock.AnythingOfType("*comments.Comment")).Return(func(ctx context.Context, c *comments.Comment) *comments.Comment { return c }, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janesmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "janesmith").Return(mentioned, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, mentioned.Id()}).Return(false, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.CommentSource, mock.Anything, mock.MatchedBy(func(list []*mentions.Mention) bool {
+		return len(list) == 1 && list[0].MentionedUserId() == mentioned.Id() && list[0].Offset() == 0
+	})).Return(nil)
+	m.notifier.On("Notify", ctx, pin.UserId(), cmd.UserId, notifications.CommentKind, mock.Anything, mock.AnythingOfType("*uuid.UUID")).Return()
+	m.notifier.On("Notify", ctx, mentioned.Id(), cmd.UserId, notifications.MentionKind, mock.Anything, mock.AnythingOfType("*uuid.UUID")).Return()
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, cmd.Content, resp.Content)
+	assert.Equal(t, cmd.UserId, resp.UserId)
+	assert.Equal(t, 1, pin.CommentCount())
+	assert.Len(t, pin.Tags(), 1)
+
+	require.Len(t, resp.Entities, 3)
+	assert.Equal(t, mentioned.Id(), *resp.Entities[0].UserId)
+	assert.Nil(t, resp.Entities[1].TagId)
+	assert.Equal(t, kitchen.Id(), *resp.Entities[2].TagId)
+
+	m.assertExpectations(t)
+	m.tagRepo.AssertNotCalled(t, "GetOrCreate", mock.Anything, mock.Anything)
+}
+
+func TestPinHandler_HandleCreateComment_UTF16Offsets(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	cmd := commands.CreateCommentCommand{
+		PinId:   pin.Id(),
+		UserId:  uuid.New(),
+		Content: "😀 héllo #oak",
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+	m.commentRepo.On("Create", ctx, mock.AnythingOfType("*comments.Comment")).Return(func(ctx context.Context, c *comments.Comment) *comments.Comment { return c }, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.mentionRepo.On("Replace", ctx, mentions.CommentSource, mock.Anything, []*mentions.Mention(nil)).Return(nil)
+	m.notifier.On("Notify", ctx, pin.UserId(), cmd.UserId, notifications.CommentKind, mock.Anything, mock.Anything).Return()
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Entities, 1)
+	assert.Equal(t, 9, resp.Entities[0].Offset)
+	assert.Equal(t, 4, resp.Entities[0].Length)
+	assert.Empty(t, pin.Tags())
+
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreateComment_Blocked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	cmd := commands.CreateCommentCommand{
+		PinId:   pin.Id(),
+		UserId:  uuid.New(),
+		Content: "Nice",
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(true, nil)
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, blocks.ErrBlockedUser)
+	m.commentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	m.notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreateComment_BlockedMentionStaysText(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	mentioned := newTestUser(t, "janesmith")
+	cmd := commands.CreateCommentCommand{
+		PinId:   pin.Id(),
+		UserId:  uuid.New(),
+		Content: "@janesmith look",
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+	m.commentRepo.On("Create", ctx, mock.AnythingOfType("*comments.Comment")).Return(func(ctx context.Context, c *comments.Comment) *comments.Comment { return c }, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janesmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "janesmith").Return(mentioned, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, mentioned.Id()}).Return(true, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.CommentSource, mock.Anything, []*mentions.Mention(nil)).Return(nil)
+	m.notifier.On("Notify", ctx, pin.UserId(), cmd.UserId, notifications.CommentKind, mock.Anything, mock.AnythingOfType("*uuid.UUID")).Return()
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Entities)
+	m.notifier.AssertNumberOfCalls(t, "Notify", 1)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreateComment_DeletedMentionStaysText(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	now := time.Now()
+	mentioned, err := users.NewUserFromDB(uuid.New(), "Jane", "Smith", "janesmith", "jane@smith.com", "5Tr0nG1.!", "Female", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, &now, nil)
+	require.NoError(t, err)
+	cmd := commands.CreateCommentCommand{
+		PinId:   pin.Id(),
+		UserId:  uuid.New(),
+		Content: "@janesmith look",
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+	m.commentRepo.On("Create", ctx, mock.AnythingOfType("*comments.Comment")).Return(func(ctx context.Context, c *comments.Comment) *comments.Comment { return c }, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janesmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "janesmith").Return(mentioned, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.CommentSource, mock.Anything, []*mentions.Mention(nil)).Return(nil)
+	m.notifier.On("Notify", ctx, pin.UserId(), cmd.UserId, notifications.CommentKind, mock.Anything, mock.AnythingOfType("*uuid.UUID")).Return()
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Entities)
+	m.blockRepo.AssertNotCalled(t, "ExistsAmong", ctx, []uuid.UUID{cmd.UserId, mentioned.Id()})
+	m.notifier.AssertNumberOfCalls(t, "Notify", 1)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreateComment_PinNotFound(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.CreateCommentCommand{
+		PinId:   uuid.New(),
+		UserId:  uuid.New(),
+		Content: "hello",
+	}
+
+	m.repository.On("ExistById", ctx, cmd.PinId).Return(false, nil)
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrNotFoundPin)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreateComment_EmptyContent(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	cmd := commands.CreateCommentCommand{
+		PinId:  pin.Id(),
+		UserId: uuid.New(),
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+
+	resp, err := handler.HandleCreateComment(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, comments.ErrEmptyContentComment)
+	m.assertExpectations(t)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+func (h *PinHandler) HandleCreate(ctx context.Context, cmd commands.CreatePinCommand) (*dto.PinResponse, error) {
+	if err := h.ensureBoardOwner(ctx, cmd.BoardId, cmd.UserId); err != nil {
+		return nil, err
+	}
+
+	tags, err := h.resolveTags(ctx, cmd.Tags, cmd.Description)
+	if err != nil {
+		h.logger.Error("Invalid tags for pin %q: %v", cmd.Title, err)
+		return nil, err
+	}
+
+	pinFactory, err := h.factory.Create(cmd.UserId, cmd.BoardId, cmd.Title, cmd.Description, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := h.repository.Create(ctx, pinFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentionsList []*mentions.Mention
+	if pin.Description() != nil {
+		mentionsList, err = h.resolveMentions(ctx, mentions.PinSource, pin.Id(), pin.UserId(), *pin.Description())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = h.mentionRepo.Replace(ctx, mentions.PinSource, pin.Id(), mentionsList); err != nil {
+			return nil, err
+		}
+
+		h.notifyMentions(ctx, mentionsList, nil, pin.Id(), nil)
+	}
+
+	h.distributor.Distribute(ctx, pin)
+
+	pinDto := mappers.MapToPinDTO(pin)
+	if pin.Description() != nil {
+		pinDto.DescriptionEntities = mappers.MapToTextEntityDTOs(*pin.Description(), mentionsList, pin.Tags())
+	}
+
+	pinResponse := mappers.MapToPinResponse(pinDto, pin.CreatedAt(), pin.UpdatedAt(), pin.DeletedAt())
+	return pinResponse, nil
+}
+
+func (h *PinHandler) ensureBoardOwner(ctx context.Context, boardId, userId uuid.UUID) error {
+	if boardId == uuid.Nil {
+		return pins.ErrNilBoardIdPin
+	}
+
+	exist, err := h.boardRepo.ExistById(ctx, boardId)
+	if err != nil {
+		return err
+	} else if !exist {
+		return boards.ErrNotFoundBoard
+	}
+
+	board, err := h.boardRepo.GetById(ctx, boardId)
+	if err != nil {
+		return err
+	}
+
+	if board.UserId() != userId {
+		return pins.ErrForbiddenBoardPin
+	}
+
+	return nil
+}
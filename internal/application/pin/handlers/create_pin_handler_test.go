@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPinHandler_HandleCreate(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	board := boards.NewBoard(userId, "Kitchen", nil, true)
+	mentioned := newTestUser(t, "janesmith")
+	description := "New cabinets by @janesmith, thanks @nobody_here #DIY"
+
+	cmd := commands.CreatePinCommand{
+		UserId:      userId,
+		BoardId:     board.Id(),
+		Title:       "Kitchen",
+		Description: &description,
+		Tags:        []string{"Kitchen", "#diy"},
+	}
+
+	kitchen := pins.NewTag("kitchen")
+	diy := pins.NewTag("diy")
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.tagRepo.On("GetOrCreate", ctx, "kitchen").Return(kitchen, nil)
+	m.tagRepo.On("GetOrCreate", ctx, "diy").Return(diy, nil)
+	m.repository.On("Create", ctx, mock.AnythingOfType("*pins.Pin")).Return(func(ctx context.Context, p *pins.Pin) *pins.Pin { return p }, nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janesmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "janesmith").Return(mentioned, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, mentioned.Id()}).Return(false, nil)
+	m.userRepo.On("ExistsByUserName", ctx, "nobody_here").Return(false, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.PinSource, mock.Anything, mock.MatchedBy(func(list []*mentions.Mention) bool {
+		return len(list) == 1 && list[0].MentionedUserId() == mentioned.Id() && list[0].Offset() == 16 && list[0].AuthorId() == userId
+	})).Return(nil)
+	m.notifier.On("Notify", ctx, mentioned.Id(), userId, notifications.MentionKind, mock.AnythingOfType("*uuid.UUID"), (*uuid.UUID)(nil)).Return()
+	m.distributor.On("Distribute", ctx, mock.AnythingOfType("*pins.Pin")).Return()
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, resp.Tags, 2)
+	assert.Equal(t, "kitchen", resp.Tags[0].Name)
+	assert.Equal(t, "diy", resp.Tags[1].Name)
+
+	require.Len(t, resp.DescriptionEntities, 2)
+	assert.Equal(t, string(shared.MentionEntity), resp.DescriptionEntities[0].Type)
+	assert.Equal(t, 16, resp.DescriptionEntities[0].Offset)
+	assert.Equal(t, 10, resp.DescriptionEntities[0].Length)
+	require.NotNil(t, resp.DescriptionEntities[0].UserId)
+	assert.Equal(t, mentioned.Id(), *resp.DescriptionEntities[0].UserId)
+	assert.Equal(t, string(shared.HashtagEntity), resp.DescriptionEntities[1].Type)
+	assert.Equal(t, "diy", resp.DescriptionEntities[1].Value)
+	require.NotNil(t, resp.DescriptionEntities[1].TagId)
+	assert.Equal(t, diy.Id(), *resp.DescriptionEntities[1].TagId)
+
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreate_BoardOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	board := boards.NewBoard(uuid.New(), "Kitchen", nil, true)
+	cmd := commands.CreatePinCommand{
+		UserId:  uuid.New(),
+		BoardId: board.Id(),
+		Title:   "Kitchen",
+	}
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrForbiddenBoardPin)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreate_BoardNotFound(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.CreatePinCommand{
+		UserId:  uuid.New(),
+		BoardId: uuid.New(),
+		Title:   "Kitchen",
+	}
+
+	m.boardRepo.On("ExistById", ctx, cmd.BoardId).Return(false, nil)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, boards.ErrNotFoundBoard)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreate_InvalidTag(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	board := boards.NewBoard(userId, "Kitchen", nil, true)
+	cmd := commands.CreatePinCommand{
+		UserId:  userId,
+		BoardId: board.Id(),
+		Title:   "Kitchen",
+		Tags:    []string{"two words"},
+	}
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, shared.ErrInvalidHashtag)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreate_TooManyTags(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	board := boards.NewBoard(userId, "Kitchen", nil, true)
+	description := "#k #l"
+	cmd := commands.CreatePinCommand{
+		UserId:      userId,
+		BoardId:     board.Id(),
+		Title:       "Kitchen",
+		Description: &description,
+		Tags:        []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"},
+	}
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrManyTagsPin)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleCreate_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	board := boards.NewBoard(userId, "Kitchen", nil, true)
+	cmd := commands.CreatePinCommand{
+		UserId:  userId,
+		BoardId: board.Id(),
+		Title:   "Kitchen",
+	}
+
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.repository.On("Create", ctx, mock.AnythingOfType("*pins.Pin")).Return(nil, ErrDbFailurePin)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrDbFailurePin)
+	m.assertExpectations(t)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPinHandler_HandleLike(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	cmd := commands.LikePinCommand{
+		PinId:  pin.Id(),
+		UserId: uuid.New(),
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.UserId, pin.UserId()}).Return(false, nil)
+	m.likeRepo.On("Exists", ctx, pin.Id(), cmd.UserId).Return(false, nil)
+	m.likeRepo.On("Create", ctx, mock.AnythingOfType("*pins.Like")).Return(nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.tracker.On("Track", ctx, cmd.UserId, interests.LikeSignal, pin.Id()).Return()
+
+	resp, err := handler.HandleLike(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.LikeCount)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleLike_Errors(t *testing.T) {
+	cases := []struct {
+		name  string
+		setup func(ctx context.Context, m *pinHandlerMocks, pin *pins.Pin, userId uuid.UUID)
+		err   error
+	}{
+		{
+			name: "pin not found",
+			setup: func(ctx context.Context, m *pinHandlerMocks, pin *pins.Pin, userId uuid.UUID) {
+				m.repository.On("ExistById", ctx, pin.Id()).Return(false, nil)
+			},
+			err: pins.ErrNotFoundPin,
+		},
+		{
+			name: "blocked",
+			setup: func(ctx context.Context, m *pinHandlerMocks, pin *pins.Pin, userId uuid.UUID) {
+				m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+				m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+				m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, pin.UserId()}).Return(true, nil)
+			},
+			err: blocks.ErrBlockedUser,
+		},
+		{
+			name: "already liked",
+			setup: func(ctx context.Context, m *pinHandlerMocks, pin *pins.Pin, userId uuid.UUID) {
+				m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+				m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+				m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, pin.UserId()}).Return(false, nil)
+				m.likeRepo.On("Exists", ctx, pin.Id(), userId).Return(true, nil)
+			},
+			err: pins.ErrExistsLike,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			handler, m := newTestPinHandler()
+			pin, userId := newTestPin(uuid.New(), nil), uuid.New()
+			tc.setup(ctx, m, pin, userId)
+
+			resp, err := handler.HandleLike(ctx, commands.LikePinCommand{PinId: pin.Id(), UserId: userId})
+
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.err)
+			m.likeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			m.assertExpectations(t)
+		})
+	}
+}
+
+func TestPinHandler_HandleUnlike(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	pin.PlusLikeCount()
+	cmd := commands.UnlikePinCommand{
+		PinId:  pin.Id(),
+		UserId: uuid.New(),
+	}
+
+	m.likeRepo.On("Exists", ctx, pin.Id(), cmd.UserId).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.likeRepo.On("Delete", ctx, mock.AnythingOfType("*pins.Like")).Return(nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.tracker.On("Untrack", ctx, cmd.UserId, interests.LikeSignal, pin.Id()).Return()
+
+	resp, err := handler.HandleUnlike(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.LikeCount)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleUnlike_NotLiked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.UnlikePinCommand{
+		PinId:  uuid.New(),
+		UserId: uuid.New(),
+	}
+
+	m.likeRepo.On("Exists", ctx, cmd.PinId, cmd.UserId).Return(false, nil)
+
+	resp, err := handler.HandleUnlike(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrNotFoundLike)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleView(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.ViewPinCommand{
+		PinId:  uuid.New(),
+		UserId: uuid.New(),
+	}
+
+	m.viewRepo.On("Create", ctx, mock.MatchedBy(func(v *pins.View) bool {
+		return v.PinId() == cmd.PinId && v.UserId() == cmd.UserId
+	})).Return(ErrDbFailurePin)
+
+	handler.HandleView(ctx, cmd)
+
+	m.assertExpectations(t)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type PinHandler struct {
+	repository     pins.PinRepository
+	tagRepo        pins.TagRepository
+	commentRepo    comments.CommentRepository
+	mentionRepo    mentions.MentionRepository
+	userRepo       users.UserRepository
+	boardRepo      boards.BoardRepository
+	saveRepo       pins.SaveRepository
+	likeRepo       pins.LikeRepository
+	viewRepo       pins.ViewRepository
+	blockRepo      blocks.BlockRepository
+	notifier       notifications.Notifier
+	distributor    feeds.Distributor
+	tracker        interests.Tracker
+	factory        pins.PinFactory
+	commentFactory comments.CommentFactory
+	logger         application.Logger
+}
+
+func NewPinHandler(repository pins.PinRepository, tagRepo pins.TagRepository, commentRepo comments.CommentRepository, mentionRepo mentions.MentionRepository, userRepo users.UserRepository, boardRepo boards.BoardRepository, saveRepo pins.SaveRepository, likeRepo pins.LikeRepository, viewRepo pins.ViewRepository, blockRepo blocks.BlockRepository, notifier notifications.Notifier, distributor feeds.Distributor, tracker interests.Tracker, factory pins.PinFactory, commentFactory comments.CommentFactory, logger application.Logger) *PinHandler {
+	return &PinHandler{
+		repository:     repository,
+		tagRepo:        tagRepo,
+		commentRepo:    commentRepo,
+		mentionRepo:    mentionRepo,
+		userRepo:       userRepo,
+		boardRepo:      boardRepo,
+		saveRepo:       saveRepo,
+		likeRepo:       likeRepo,
+		viewRepo:       viewRepo,
+		blockRepo:      blockRepo,
+		notifier:       notifier,
+		distributor:    distributor,
+		tracker:        tracker,
+		factory:        factory,
+		commentFactory: commentFactory,
+		logger:         logger,
+	}
+}
+
+// ensureNotBlocked fails when the two users are in a block, whichever of them
+// created it.
+func (h *PinHandler) ensureNotBlocked(ctx context.Context, userId, otherId uuid.UUID) error {
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{userId, otherId})
+	if err != nil {
+		return err
+	} else if blocked {
+		return blocks.ErrBlockedUser
+	}
+
+	return nil
+}
@@ -0,0 +1,494 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+type MockMentionRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockBoardRepository struct {
+	mock.Mock
+}
+
+type MockSaveRepository struct {
+	mock.Mock
+}
+
+type MockLikeRepository struct {
+	mock.Mock
+}
+
+type MockViewRepository struct {
+	mock.Mock
+}
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+type MockDistributor struct {
+	mock.Mock
+}
+
+type MockTracker struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+var ErrDbFailurePin = errors.New("db failure")
+
+func TestNewPinHandler(t *testing.T) {
+	repository := new(MockPinRepository)
+	tagRepo := new(MockTagRepository)
+	commentRepo := new(MockCommentRepository)
+	mentionRepo := new(MockMentionRepository)
+	userRepo := new(MockUserRepository)
+	boardRepo := new(MockBoardRepository)
+	saveRepo := new(MockSaveRepository)
+	likeRepo := new(MockLikeRepository)
+	viewRepo := new(MockViewRepository)
+	blockRepo := new(MockBlockRepository)
+	notifier := new(MockNotifier)
+	distributor := new(MockDistributor)
+	tracker := new(MockTracker)
+	factory := pins.NewPinFactory()
+	commentFactory := comments.NewCommentFactory()
+	logger := new(MockLogger)
+
+	handler := NewPinHandler(repository, tagRepo, commentRepo, mentionRepo, userRepo, boardRepo, saveRepo, likeRepo, viewRepo, blockRepo, notifier, distributor, tracker, factory, commentFactory, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, tagRepo, handler.tagRepo)
+	require.Exactly(t, commentRepo, handler.commentRepo)
+	require.Exactly(t, mentionRepo, handler.mentionRepo)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Exactly(t, boardRepo, handler.boardRepo)
+	require.Exactly(t, saveRepo, handler.saveRepo)
+	require.Exactly(t, likeRepo, handler.likeRepo)
+	require.Exactly(t, viewRepo, handler.viewRepo)
+	require.Exactly(t, blockRepo, handler.blockRepo)
+	require.Exactly(t, notifier, handler.notifier)
+	require.Exactly(t, distributor, handler.distributor)
+	require.Exactly(t, tracker, handler.tracker)
+	require.Exactly(t, factory, handler.factory)
+	require.Exactly(t, commentFactory, handler.commentFactory)
+	require.Exactly(t, logger, handler.logger)
+}
+
+type pinHandlerMocks struct {
+	repository  *MockPinRepository
+	tagRepo     *MockTagRepository
+	commentRepo *MockCommentRepository
+	mentionRepo *MockMentionRepository
+	userRepo    *MockUserRepository
+	boardRepo   *MockBoardRepository
+	saveRepo    *MockSaveRepository
+	likeRepo    *MockLikeRepository
+	viewRepo    *MockViewRepository
+	blockRepo   *MockBlockRepository
+	notifier    *MockNotifier
+	distributor *MockDistributor
+	tracker     *MockTracker
+}
+
+func newTestPinHandler() (*PinHandler, *pinHandlerMocks) {
+	m := &pinHandlerMocks{
+		repository:  new(MockPinRepository),
+		tagRepo:     new(MockTagRepository),
+		commentRepo: new(MockCommentRepository),
+		mentionRepo: new(MockMentionRepository),
+		userRepo:    new(MockUserRepository),
+		boardRepo:   new(MockBoardRepository),
+		saveRepo:    new(MockSaveRepository),
+		likeRepo:    new(MockLikeRepository),
+		viewRepo:    new(MockViewRepository),
+		blockRepo:   new(MockBlockRepository),
+		notifier:    new(MockNotifier),
+		distributor: new(MockDistributor),
+		tracker:     new(MockTracker),
+	}
+
+	handler := NewPinHandler(m.repository, m.tagRepo, m.commentRepo, m.mentionRepo, m.userRepo, m.boardRepo, m.saveRepo, m.likeRepo, m.viewRepo, m.blockRepo, m.notifier, m.distributor, m.tracker, pins.NewPinFactory(), comments.NewCommentFactory(), new(MockLogger))
+	return handler, m
+}
+
+func (m *pinHandlerMocks) assertExpectations(t *testing.T) {
+	m.repository.AssertExpectations(t)
+	m.tagRepo.AssertExpectations(t)
+	m.commentRepo.AssertExpectations(t)
+	m.mentionRepo.AssertExpectations(t)
+	m.userRepo.AssertExpectations(t)
+	m.boardRepo.AssertExpectations(t)
+	m.saveRepo.AssertExpectations(t)
+	m.likeRepo.AssertExpectations(t)
+	m.viewRepo.AssertExpectations(t)
+	m.blockRepo.AssertExpectations(t)
+	m.notifier.AssertExpectations(t)
+	m.distributor.AssertExpectations(t)
+	m.tracker.AssertExpectations(t)
+}
+
+func newTestUser(t *testing.T, username string) *users.User {
+	now := time.Now()
+	usr, err := users.NewUserFromDB(uuid.New(), "John", "Doe", username, username+"@doe.com", "5Tr0nG1.!", "Male", now.AddDate(-20, 0, 0), "Bolivia", "Spanish", nil, nil, nil, nil, true, now, now, now, nil, nil)
+	require.NoError(t, err)
+	return usr
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, p *pins.Pin) (*pins.Pin, error) {
+	args := m.Called(ctx, p)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(context.Context, *pins.Pin) *pins.Pin); ok {
+		return fn(ctx, p), args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, p *pins.Pin) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, p *pins.Pin) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) GetByName(ctx context.Context, name string) (*pins.Tag, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) GetListByPinId(ctx context.Context, pinId uuid.UUID) ([]pins.Tag, error) {
+	return nil, nil
+}
+
+func (m *MockTagRepository) GetOrCreate(ctx context.Context, name string) (*pins.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Tag), args.Error(1)
+}
+
+func (m *MockCommentRepository) GetListByPinId(ctx context.Context, pinId, viewerId uuid.UUID) ([]*comments.Comment, error) {
+	return nil, nil
+}
+
+func (m *MockCommentRepository) GetById(ctx context.Context, id uuid.UUID) (*comments.Comment, error) {
+	return nil, nil
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, c *comments.Comment) (*comments.Comment, error) {
+	args := m.Called(ctx, c)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(context.Context, *comments.Comment) *comments.Comment); ok {
+		return fn(ctx, c), args.Error(1)
+	}
+	return args.Get(0).(*comments.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, c *comments.Comment) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) Delete(ctx context.Context, c *comments.Comment) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockMentionRepository) GetListBySource(ctx context.Context, sourceType mentions.SourceType, sourceId uuid.UUID) ([]*mentions.Mention, error) {
+	args := m.Called(ctx, sourceType, sourceId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mentions.Mention), args.Error(1)
+}
+
+func (m *MockMentionRepository) Replace(ctx context.Context, sourceType mentions.SourceType, sourceId uuid.UUID, mentionsList []*mentions.Mention) error {
+	args := m.Called(ctx, sourceType, sourceId, mentionsList)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetAll(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetList(ctx context.Context) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*users.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*users.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByCountry(ctx context.Context, country string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListByLanguage(ctx context.Context, language string) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) ExistsByUserName(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *users.User) (*users.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
+}
+
+func (m *MockBoardRepository) GetAll(ctx context.Context) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetList(ctx context.Context) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetListByName(ctx context.Context, name string) ([]*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) GetById(ctx context.Context, id uuid.UUID) (*boards.Board, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*boards.Board), args.Error(1)
+}
+
+func (m *MockBoardRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBoardRepository) Create(ctx context.Context, b *boards.Board) (*boards.Board, error) {
+	return nil, nil
+}
+
+func (m *MockBoardRepository) Update(ctx context.Context, b *boards.Board) error {
+	return nil
+}
+
+func (m *MockBoardRepository) Delete(ctx context.Context, b *boards.Board) error {
+	return nil
+}
+
+func (m *MockSaveRepository) Exists(ctx context.Context, pinId, boardId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, pinId, boardId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSaveRepository) Create(ctx context.Context, s *pins.Save) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockSaveRepository) Delete(ctx context.Context, s *pins.Save) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockLikeRepository) Exists(ctx context.Context, pinId, userId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, pinId, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLikeRepository) Create(ctx context.Context, l *pins.Like) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+func (m *MockLikeRepository) Delete(ctx context.Context, l *pins.Like) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+
+func (m *MockViewRepository) Create(ctx context.Context, v *pins.View) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockViewRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBlockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	return nil, nil
+}
+
+func (m *MockBlockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockBlockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userIds)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockBlockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, recipientId, actorId uuid.UUID, kind notifications.Kind, pinId, commentId *uuid.UUID) {
+	m.Called(ctx, recipientId, actorId, kind, pinId, commentId)
+}
+
+func (m *MockDistributor) Distribute(ctx context.Context, pin *pins.Pin) {
+	m.Called(ctx, pin)
+}
+
+func (m *MockDistributor) Followed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	m.Called(ctx, userId, source)
+}
+
+func (m *MockDistributor) Unfollowed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	m.Called(ctx, userId, source)
+}
+
+func (m *MockTracker) Track(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	m.Called(ctx, userId, signal, pinId)
+}
+
+func (m *MockTracker) Untrack(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) {
+	m.Called(ctx, userId, signal, pinId)
+}
+
+func (m *MockTracker) FollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	m.Called(ctx, userId, tagId)
+}
+
+func (m *MockTracker) UnfollowedTag(ctx context.Context, userId, tagId uuid.UUID) {
+	m.Called(ctx, userId, tagId)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+func (h *PinHandler) HandleSave(ctx context.Context, cmd commands.SavePinCommand) (*dto.PinResponse, error) {
+	if cmd.PinId == uuid.Nil {
+		return nil, pins.ErrIdNilPin
+	}
+
+	exist, err := h.repository.ExistById(ctx, cmd.PinId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	if err = h.ensureBoardOwner(ctx, cmd.BoardId, cmd.UserId); err != nil {
+		return nil, err
+	}
+
+	save, err := pins.NewSave(cmd.PinId, cmd.UserId, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err = h.saveRepo.Exists(ctx, cmd.PinId, cmd.BoardId)
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, pins.ErrExistsSave
+	}
+
+	pin, err := h.repository.GetById(ctx, cmd.PinId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.ensureNotBlocked(ctx, cmd.UserId, pin.UserId()); err != nil {
+		return nil, err
+	}
+
+	if err = h.saveRepo.Create(ctx, save); err != nil {
+		return nil, err
+	}
+
+	pin.PlusSaveCount()
+
+	if err = h.repository.Update(ctx, pin); err != nil {
+		return nil, err
+	}
+
+	pinId := pin.Id()
+	h.notifier.Notify(ctx, pin.UserId(), cmd.UserId, notifications.SaveKind, &pinId, nil)
+	h.tracker.Track(ctx, cmd.UserId, interests.SaveSignal, pinId)
+
+	pinDto := mappers.MapToPinDTO(pin)
+	pinResponse := mappers.MapToPinResponse(pinDto, pin.CreatedAt(), pin.UpdatedAt(), pin.DeletedAt())
+	return pinResponse, nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPinHandler_HandleSave(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	pin := newTestPin(uuid.New(), nil)
+	board := boards.NewBoard(userId, "Ideas", nil, true)
+
+	cmd := commands.SavePinCommand{
+		PinId:   pin.Id(),
+		UserId:  userId,
+		BoardId: board.Id(),
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.saveRepo.On("Exists", ctx, pin.Id(), board.Id()).Return(false, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, pin.UserId()}).Return(false, nil)
+	m.saveRepo.On("Create", ctx, mock.AnythingOfType("*pins.Save")).Return(nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.notifier.On("Notify", ctx, pin.UserId(), userId, notifications.SaveKind, mock.AnythingOfType("*uuid.UUID"), (*uuid.UUID)(nil)).Return()
+	m.tracker.On("Track", ctx, userId, interests.SaveSignal, pin.Id()).Return()
+
+	resp, err := handler.HandleSave(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 1, resp.SaveCount)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleSave_Blocked(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	pin := newTestPin(uuid.New(), nil)
+	board := boards.NewBoard(userId, "Ideas", nil, true)
+
+	cmd := commands.SavePinCommand{
+		PinId:   pin.Id(),
+		UserId:  userId,
+		BoardId: board.Id(),
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.saveRepo.On("Exists", ctx, pin.Id(), board.Id()).Return(false, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.blockRepo.On("ExistsAmong", ctx, []uuid.UUID{userId, pin.UserId()}).Return(true, nil)
+
+	resp, err := handler.HandleSave(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, blocks.ErrBlockedUser)
+	m.saveRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleSave_AlreadySaved(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	board := boards.NewBoard(userId, "Ideas", nil, true)
+	cmd := commands.SavePinCommand{
+		PinId:   uuid.New(),
+		UserId:  userId,
+		BoardId: board.Id(),
+	}
+
+	m.repository.On("ExistById", ctx, cmd.PinId).Return(true, nil)
+	m.boardRepo.On("ExistById", ctx, board.Id()).Return(true, nil)
+	m.boardRepo.On("GetById", ctx, board.Id()).Return(board, nil)
+	m.saveRepo.On("Exists", ctx, cmd.PinId, board.Id()).Return(true, nil)
+
+	resp, err := handler.HandleSave(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrExistsSave)
+	m.notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleSave_PinNotFound(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.SavePinCommand{
+		PinId:   uuid.New(),
+		UserId:  uuid.New(),
+		BoardId: uuid.New(),
+	}
+
+	m.repository.On("ExistById", ctx, cmd.PinId).Return(false, nil)
+
+	resp, err := handler.HandleSave(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrNotFoundPin)
+	m.assertExpectations(t)
+}
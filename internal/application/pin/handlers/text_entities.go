@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// resolveMentions turns every @username in text that belongs to an active
+// user into a mention record. Unknown usernames, deleted users and users in a
+// block with the author are left as plain text.
+func (h *PinHandler) resolveMentions(ctx context.Context, sourceType mentions.SourceType, sourceId, authorId uuid.UUID, text string) ([]*mentions.Mention, error) {
+	var mentionsList []*mentions.Mention
+
+	userIds := make(map[string]uuid.UUID)
+	for _, entity := range shared.ParseTextEntities(text) {
+		if entity.Type() != shared.MentionEntity {
+			continue
+		}
+
+		userId, resolved := userIds[entity.Value()]
+		if !resolved {
+			exist, err := h.userRepo.ExistsByUserName(ctx, entity.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			if exist {
+				usr, err := h.userRepo.GetByUsername(ctx, entity.Value())
+				if err != nil {
+					return nil, err
+				}
+
+				if usr.DeletedAt() == nil {
+					blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{authorId, usr.Id()})
+					if err != nil {
+						return nil, err
+					} else if !blocked {
+						userId = usr.Id()
+					}
+				}
+			}
+			userIds[entity.Value()] = userId
+		}
+
+		if userId == uuid.Nil {
+			continue
+		}
+
+		mention, err := mentions.NewMention(sourceType, sourceId, authorId, userId, entity.Offset(), entity.Length())
+		if err != nil {
+			return nil, err
+		}
+		mentionsList = append(mentionsList, mention)
+	}
+
+	return mentionsList, nil
+}
+
+// notifyMentions tells every user mentioned in mentionsList about it, once per
+// user. Users already mentioned in previous were told before and are skipped.
+func (h *PinHandler) notifyMentions(ctx context.Context, mentionsList, previous []*mentions.Mention, pinId uuid.UUID, commentId *uuid.UUID) {
+	notified := make(map[uuid.UUID]bool)
+	for _, mention := range previous {
+		notified[mention.MentionedUserId()] = true
+	}
+
+	for _, mention := range mentionsList {
+		if notified[mention.MentionedUserId()] {
+			continue
+		}
+		notified[mention.MentionedUserId()] = true
+
+		h.notifier.Notify(ctx, mention.MentionedUserId(), mention.AuthorId(), notifications.MentionKind, &pinId, commentId)
+	}
+}
+
+// resolveTags normalizes the explicit tag names, adds the #hashtags found in
+// text and returns the matching tags, creating the ones that do not exist yet.
+func (h *PinHandler) resolveTags(ctx context.Context, names []string, text *string) ([]pins.Tag, error) {
+	var normalized []string
+
+	seen := make(map[string]bool)
+	for _, name := range names {
+		tag, err := shared.NewHashtag(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if text != nil {
+		for _, tag := range shared.UniqueValues(shared.ParseTextEntities(*text), shared.HashtagEntity) {
+			if !seen[tag] {
+				seen[tag] = true
+				normalized = append(normalized, tag)
+			}
+		}
+	}
+
+	if len(normalized) > 10 {
+		return nil, pins.ErrManyTagsPin
+	}
+
+	tags := make([]pins.Tag, 0, len(normalized))
+	for _, name := range normalized {
+		tag, err := h.tagRepo.GetOrCreate(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *tag)
+	}
+
+	return tags, nil
+}
+
+func tagNames(tags []pins.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name())
+	}
+	return names
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+func (h *PinHandler) HandleUnlike(ctx context.Context, cmd commands.UnlikePinCommand) (*dto.PinResponse, error) {
+	like, err := pins.NewLike(cmd.PinId, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.likeRepo.Exists(ctx, cmd.PinId, cmd.UserId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, pins.ErrNotFoundLike
+	}
+
+	pin, err := h.repository.GetById(ctx, cmd.PinId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.likeRepo.Delete(ctx, like); err != nil {
+		return nil, err
+	}
+
+	pin.LessLikeCount()
+
+	if err = h.repository.Update(ctx, pin); err != nil {
+		return nil, err
+	}
+
+	h.tracker.Untrack(ctx, cmd.UserId, interests.LikeSignal, pin.Id())
+
+	pinDto := mappers.MapToPinDTO(pin)
+	pinResponse := mappers.MapToPinResponse(pinDto, pin.CreatedAt(), pin.UpdatedAt(), pin.DeletedAt())
+	return pinResponse, nil
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+func (h *PinHandler) HandleUpdate(ctx context.Context, cmd commands.UpdatePinCommand) (*dto.PinResponse, error) {
+	if cmd.Id == uuid.Nil {
+		return nil, pins.ErrIdNilPin
+	}
+
+	exist, err := h.repository.ExistById(ctx, cmd.Id)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	pin, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if pin.UserId() != cmd.UserId {
+		return nil, pins.ErrForbiddenPin
+	}
+
+	pin.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
+
+	if cmd.Title != nil {
+		if err = pin.ChangeTitle(*cmd.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Description != nil {
+		if *cmd.Description != "" {
+			err = pin.ChangeDescription(cmd.Description)
+		} else {
+			err = pin.ChangeDescription(nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Visibility != nil {
+		pin.ChangeVisibility(*cmd.Visibility)
+	}
+
+	if cmd.Tags != nil || cmd.Description != nil {
+		names := tagNames(pin.Tags())
+		if cmd.Tags != nil {
+			names = *cmd.Tags
+		}
+
+		tags, err := h.resolveTags(ctx, names, pin.Description())
+		if err != nil {
+			h.logger.Error("Invalid tags for pin %s: %v", pin.Id(), err)
+			return nil, err
+		}
+
+		if err = pin.ChangeTags(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	pin.Update()
+
+	if err = h.repository.Update(ctx, pin); err != nil {
+		return nil, err
+	}
+
+	var mentionsList []*mentions.Mention
+	if pin.Description() != nil {
+		mentionsList, err = h.resolveMentions(ctx, mentions.PinSource, pin.Id(), pin.UserId(), *pin.Description())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Description != nil {
+		previous, err := h.mentionRepo.GetListBySource(ctx, mentions.PinSource, pin.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = h.mentionRepo.Replace(ctx, mentions.PinSource, pin.Id(), mentionsList); err != nil {
+			return nil, err
+		}
+
+		h.notifyMentions(ctx, mentionsList, previous, pin.Id(), nil)
+	}
+
+	pinDto := mappers.MapToPinDTO(pin)
+	if pin.Description() != nil {
+		pinDto.DescriptionEntities = mappers.MapToTextEntityDTOs(*pin.Description(), mentionsList, pin.Tags())
+	}
+
+	pinResponse := mappers.MapToPinResponse(pinDto, pin.CreatedAt(), pin.UpdatedAt(), pin.DeletedAt())
+	return pinResponse, nil
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPinHandler_HandleUpdate(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	kitchen := pins.NewTag("kitchen")
+	pin := newTestPin(userId, []pins.Tag{*kitchen})
+	oak := pins.NewTag("oak")
+	description := "Now in #oak"
+	visibility := false
+
+	cmd := commands.UpdatePinCommand{
+		Id:          pin.Id(),
+		UserId:      userId,
+		Description: &description,
+		Visibility:  &visibility,
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.tagRepo.On("GetOrCreate", ctx, "kitchen").Return(kitchen, nil)
+	m.tagRepo.On("GetOrCreate", ctx, "oak").Return(oak, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.mentionRepo.On("GetListBySource", ctx, mentions.PinSource, pin.Id()).Return(nil, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.PinSource, pin.Id(), []*mentions.Mention(nil)).Return(nil)
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.False(t, resp.Visibility)
+	assert.Equal(t, &description, resp.Description)
+	require.Len(t, resp.Tags, 2)
+	require.Len(t, resp.DescriptionEntities, 1)
+	assert.Equal(t, oak.Id(), *resp.DescriptionEntities[0].TagId)
+
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleUpdate_Forbidden(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	pin := newTestPin(uuid.New(), nil)
+	title := "Mine now"
+	cmd := commands.UpdatePinCommand{
+		Id:     pin.Id(),
+		UserId: uuid.New(),
+		Title:  &title,
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrForbiddenPin)
+	m.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleUpdate_NotFound(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	cmd := commands.UpdatePinCommand{
+		Id:     uuid.New(),
+		UserId: uuid.New(),
+	}
+
+	m.repository.On("ExistById", ctx, cmd.Id).Return(false, nil)
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrNotFoundPin)
+	m.assertExpectations(t)
+}
+
+func TestPinHandler_HandleUpdate_NilId(t *testing.T) {
+	handler, _ := newTestPinHandler()
+
+	resp, err := handler.HandleUpdate(context.Background(), commands.UpdatePinCommand{})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pins.ErrIdNilPin)
+}
+
+func TestPinHandler_HandleUpdate_NotifiesNewMentionsOnly(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestPinHandler()
+
+	userId := uuid.New()
+	pin := newTestPin(userId, nil)
+	jane := newTestUser(t, "janesmith")
+	john := newTestUser(t, "johnsmith")
+	description := "@janesmith and @johnsmith"
+
+	previous, err := mentions.NewMention(mentions.PinSource, pin.Id(), userId, jane.Id(), 0, 10)
+	require.NoError(t, err)
+
+	cmd := commands.UpdatePinCommand{
+		Id:          pin.Id(),
+		UserId:      userId,
+		Description: &description,
+	}
+
+	m.repository.On("ExistById", ctx, pin.Id()).Return(true, nil)
+	m.repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	m.repository.On("Update", ctx, pin).Return(nil)
+	m.userRepo.On("ExistsByUserName", ctx, "janesmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "janesmith").Return(jane, nil)
+	m.userRepo.On("ExistsByUserName", ctx, "johnsmith").Return(true, nil)
+	m.userRepo.On("GetByUsername", ctx, "johnsmith").Return(john, nil)
+	m.blockRepo.On("ExistsAmong", ctx, mock.Anything).Return(false, nil)
+	m.mentionRepo.On("GetListBySource", ctx, mentions.PinSource, pin.Id()).Return([]*mentions.Mention{previous}, nil)
+	m.mentionRepo.On("Replace", ctx, mentions.PinSource, pin.Id(), mock.Anything).Return(nil)
+	m.notifier.On("Notify", ctx, john.Id(), userId, notifications.MentionKind, mock.Anything, (*uuid.UUID)(nil)).Return()
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	m.notifier.AssertNumberOfCalls(t, "Notify", 1)
+	m.assertExpectations(t)
+}
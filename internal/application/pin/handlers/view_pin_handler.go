@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+// HandleView records that a user opened a pin, for trends. Like notifications
+// it is best effort: a failure is logged and the pin is still shown.
+func (h *PinHandler) HandleView(ctx context.Context, cmd commands.ViewPinCommand) {
+	if err := h.viewRepo.Create(ctx, pins.NewView(cmd.PinId, cmd.UserId)); err != nil {
+		h.logger.Warn("Could not record view of pin %s: %v", cmd.PinId, err)
+	}
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"time"
+)
+
+func MapToCommentDTO(comment *comments.Comment, entities []*dto.TextEntityDTO) *dto.CommentDTO {
+	return &dto.CommentDTO{
+		Id:       comment.Id(),
+		PinId:    comment.PinId(),
+		UserId:   comment.UserId(),
+		Content:  comment.Content(),
+		Entities: entities,
+	}
+}
+
+func MapToCommentResponse(comment *dto.CommentDTO, createdAt, updatedAt time.Time, deletedAt *time.Time) *dto.CommentResponse {
+	return &dto.CommentResponse{
+		CommentDTO: comment,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+		DeletedAt:  deletedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"unicode/utf16"
+)
+
+// MapToTextEntityDTOs parses text and links every entity to what it resolved
+// to: mentions to the stored mention at the same offset and hashtags to the tag
+// with the same name. Mentions that did not resolve to a user are dropped so
+// clients never render a link to a missing profile. Offsets and lengths are
+// turned from runes into UTF-16 code units, the way web clients index strings.
+func MapToTextEntityDTOs(text string, mentionsList []*mentions.Mention, tags []pins.Tag) []*dto.TextEntityDTO {
+	var entitiesDTO []*dto.TextEntityDTO
+
+	mentionsByOffset := make(map[int]*mentions.Mention, len(mentionsList))
+	for _, m := range mentionsList {
+		mentionsByOffset[m.Offset()] = m
+	}
+
+	tagsByName := make(map[string]pins.Tag, len(tags))
+	for _, t := range tags {
+		tagsByName[t.Name()] = t
+	}
+
+	units := utf16Prefix(text)
+	for _, entity := range shared.ParseTextEntities(text) {
+		end := entity.Offset() + entity.Length()
+		entityDTO := &dto.TextEntityDTO{
+			Type:   string(entity.Type()),
+			Offset: units[entity.Offset()],
+			Length: units[end] - units[entity.Offset()],
+			Value:  entity.Value(),
+		}
+
+		switch entity.Type() {
+		case shared.MentionEntity:
+			m, ok := mentionsByOffset[entity.Offset()]
+			if !ok {
+				continue
+			}
+			userId := m.MentionedUserId()
+			entityDTO.UserId = &userId
+		case shared.HashtagEntity:
+			if t, ok := tagsByName[entity.Value()]; ok {
+				tagId := t.Id()
+				entityDTO.TagId = &tagId
+			}
+		}
+
+		entitiesDTO = append(entitiesDTO, entityDTO)
+	}
+
+	return entitiesDTO
+}
+
+// utf16Prefix returns, for every rune offset of text up to its length, how
+// many UTF-16 code units come before it.
+func utf16Prefix(text string) []int {
+	units := []int{0}
+	for _, r := range text {
+		units = append(units, units[len(units)-1]+utf16.RuneLen(r))
+	}
+	return units
+}
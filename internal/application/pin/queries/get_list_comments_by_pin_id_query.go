@@ -0,0 +1,8 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetListCommentsByPinIdQuery struct {
+	PinId    uuid.UUID `json:"pin_id"`
+	ViewerId uuid.UUID `json:"viewer_id"`
+}
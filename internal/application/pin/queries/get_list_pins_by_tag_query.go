@@ -1,5 +1,8 @@
 package queries
 
+import "github.com/google/uuid"
+
 type GetListPinsByTagQuery struct {
-	Tag string `json:"tag"`
+	Tag      string    `json:"tag"`
+	ViewerId uuid.UUID `json:"viewer_id"`
 }
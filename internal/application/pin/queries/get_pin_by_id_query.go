@@ -3,5 +3,6 @@ package queries
 import "github.com/google/uuid"
 
 type GetPinByIdQuery struct {
-	Id uuid.UUID `json:"id"`
+	Id       uuid.UUID `json:"id"`
+	ViewerId uuid.UUID `json:"viewer_id"`
 }
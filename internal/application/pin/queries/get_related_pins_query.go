@@ -0,0 +1,9 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetRelatedPinsQuery struct {
+	Id       uuid.UUID `json:"id"`
+	ViewerId uuid.UUID `json:"viewer_id"`
+	Limit    int       `json:"limit"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "time"
+
+type RefreshRelatedPinsCommand struct {
+	Since time.Time `json:"since"`
+	Limit int       `json:"limit"`
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+)
+
+// RecommendationHandler precomputes the related pins of each pin, keeping the
+// best size of them in the cache.
+type RecommendationHandler struct {
+	repository recommendations.RelatedRepository
+	cache      recommendations.RelatedCache
+	size       int
+	logger     application.Logger
+}
+
+func NewRecommendationHandler(repository recommendations.RelatedRepository, cache recommendations.RelatedCache, size int, logger application.Logger) *RecommendationHandler {
+	return &RecommendationHandler{
+		repository: repository,
+		cache:      cache,
+		size:       size,
+		logger:     logger,
+	}
+}
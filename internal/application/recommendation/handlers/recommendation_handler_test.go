@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/recommendation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRelatedRepository struct {
+	mock.Mock
+}
+
+type MockRelatedCache struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func TestNewRecommendationHandler(t *testing.T) {
+	repository, cache, logger := new(MockRelatedRepository), new(MockRelatedCache), new(MockLogger)
+	handler := NewRecommendationHandler(repository, cache, 20, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, cache, handler.cache)
+	require.Equal(t, 20, handler.size)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestRecommendationHandler_HandleRefresh(t *testing.T) {
+	ctx := context.Background()
+	repository, cache := new(MockRelatedRepository), new(MockRelatedCache)
+	handler := NewRecommendationHandler(repository, cache, 1, new(MockLogger))
+
+	now := time.Now()
+	cmd := commands.RefreshRelatedPinsCommand{Since: now.Add(-time.Hour), Limit: 100}
+	ok, failing := uuid.New(), uuid.New()
+	best, other := uuid.New(), uuid.New()
+
+	repository.On("GetListActive", ctx, cmd.Since, 100).Return([]uuid.UUID{failing, ok}, nil)
+	repository.On("GetCandidates", ctx, failing, 1).Return(nil, errors.New("db down"))
+	repository.On("GetCandidates", ctx, ok, 1).Return([]recommendations.Candidate{
+		recommendations.NewCandidate(other, 0, 1, 0, now),
+		recommendations.NewCandidate(best, 1, 0, 0, now),
+	}, nil)
+	cache.On("Set", ctx, ok, []uuid.UUID{best}).Return(nil)
+
+	refreshed, err := handler.HandleRefresh(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	repository.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestRecommendationHandler_HandleRefresh_Error(t *testing.T) {
+	ctx := context.Background()
+	repository, cache := new(MockRelatedRepository), new(MockRelatedCache)
+	handler := NewRecommendationHandler(repository, cache, 10, new(MockLogger))
+
+	cmd := commands.RefreshRelatedPinsCommand{Since: time.Now(), Limit: 100}
+	repository.On("GetListActive", ctx, cmd.Since, 100).Return(nil, errors.New("db down"))
+
+	refreshed, err := handler.HandleRefresh(ctx, cmd)
+
+	require.Error(t, err)
+	assert.Zero(t, refreshed)
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *MockRelatedRepository) GetCandidates(ctx context.Context, pinId uuid.UUID, limit int) ([]recommendations.Candidate, error) {
+	args := m.Called(ctx, pinId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]recommendations.Candidate), args.Error(1)
+}
+
+func (m *MockRelatedRepository) GetListActive(ctx context.Context, since time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRelatedRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, viewerId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRelatedCache) Get(ctx context.Context, pinId uuid.UUID) ([]uuid.UUID, bool, error) {
+	args := m.Called(ctx, pinId)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]uuid.UUID), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRelatedCache) Set(ctx context.Context, pinId uuid.UUID, related []uuid.UUID) error {
+	args := m.Called(ctx, pinId, related)
+	return args.Error(0)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
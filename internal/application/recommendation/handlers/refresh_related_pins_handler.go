@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/recommendation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+)
+
+// HandleRefresh ranks again the related pins of the pins touched since
+// cmd.Since. A pin that fails is logged and skipped so one bad pin does not
+// hold back the rest; the count of refreshed pins is returned.
+func (h *RecommendationHandler) HandleRefresh(ctx context.Context, cmd commands.RefreshRelatedPinsCommand) (int, error) {
+	pinIds, err := h.repository.GetListActive(ctx, cmd.Since, cmd.Limit)
+	if err != nil {
+		h.logger.Error("Could not list pins to refresh: %v", err)
+		return 0, err
+	}
+
+	refreshed := 0
+	for _, pinId := range pinIds {
+		candidates, err := h.repository.GetCandidates(ctx, pinId, h.size)
+		if err != nil {
+			h.logger.Error("Could not load related candidates of pin %s: %v", pinId, err)
+			continue
+		}
+
+		if err = h.cache.Set(ctx, pinId, recommendations.Rank(candidates, h.size)); err != nil {
+			h.logger.Error("Could not cache related pins of pin %s: %v", pinId, err)
+			continue
+		}
+		refreshed++
+	}
+
+	if refreshed > 0 {
+		h.logger.Info("Refreshed related pins of %d pins", refreshed)
+	}
+
+	return refreshed, nil
+}
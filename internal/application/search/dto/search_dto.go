@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/google/uuid"
+
+type SearchResultDTO struct {
+	Kind    string    `json:"kind"`
+	Id      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Snippet string    `json:"snippet"`
+	Score   float64   `json:"score"`
+}
+
+type FacetBucketDTO struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int    `json:"count"`
+}
+
+type SearchFacetsDTO struct {
+	Tags      []*FacetBucketDTO `json:"tags"`
+	Boards    []*FacetBucketDTO `json:"boards"`
+	Countries []*FacetBucketDTO `json:"countries"`
+	Languages []*FacetBucketDTO `json:"languages"`
+	CreatedAt []*FacetBucketDTO `json:"created_at"`
+	HasImage  []*FacetBucketDTO `json:"has_image"`
+}
+
+type SearchPageDTO struct {
+	Results    []*SearchResultDTO `json:"results"`
+	Facets     *SearchFacetsDTO   `json:"facets,omitempty"`
+	NextCursor *string            `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,66 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+func MapToSearchResultDTO(result searches.Result) *dto.SearchResultDTO {
+	return &dto.SearchResultDTO{
+		Kind:    string(result.Kind()),
+		Id:      result.Id(),
+		Title:   result.Title(),
+		Snippet: result.Snippet(),
+		Score:   result.Rank(),
+	}
+}
+
+func MapToFacetBucketDTO(bucket searches.Bucket) *dto.FacetBucketDTO {
+	label := bucket.Label()
+	switch bucket.Facet() {
+	case searches.CountryFacet:
+		label = shared.Country(bucket.Value()).String()
+	case searches.LanguageFacet:
+		label = shared.Language(bucket.Value()).String()
+	}
+
+	return &dto.FacetBucketDTO{
+		Value: bucket.Value(),
+		Label: label,
+		Count: bucket.Count(),
+	}
+}
+
+// MapToSearchFacetsDTO groups the buckets by facet, keeping their order.
+// Every facet is present, empty when nothing matched.
+func MapToSearchFacetsDTO(buckets []searches.Bucket) *dto.SearchFacetsDTO {
+	facets := &dto.SearchFacetsDTO{
+		Tags:      []*dto.FacetBucketDTO{},
+		Boards:    []*dto.FacetBucketDTO{},
+		Countries: []*dto.FacetBucketDTO{},
+		Languages: []*dto.FacetBucketDTO{},
+		CreatedAt: []*dto.FacetBucketDTO{},
+		HasImage:  []*dto.FacetBucketDTO{},
+	}
+
+	for _, bucket := range buckets {
+		b := MapToFacetBucketDTO(bucket)
+		switch bucket.Facet() {
+		case searches.TagFacet:
+			facets.Tags = append(facets.Tags, b)
+		case searches.BoardFacet:
+			facets.Boards = append(facets.Boards, b)
+		case searches.CountryFacet:
+			facets.Countries = append(facets.Countries, b)
+		case searches.LanguageFacet:
+			facets.Languages = append(facets.Languages, b)
+		case searches.CreatedAtFacet:
+			facets.CreatedAt = append(facets.CreatedAt, b)
+		case searches.HasImageFacet:
+			facets.HasImage = append(facets.HasImage, b)
+		}
+	}
+
+	return facets
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SearchQuery struct {
+	ViewerId    uuid.UUID  `json:"viewer_id"`
+	Text        string     `json:"q"`
+	Types       []string   `json:"types,omitempty"`
+	Language    string     `json:"language,omitempty"`
+	Tag         string     `json:"tag,omitempty"`
+	BoardId     *uuid.UUID `json:"board_id,omitempty"`
+	Country     string     `json:"country,omitempty"`
+	CreatedFrom *time.Time `json:"created_from,omitempty"`
+	CreatedTo   *time.Time `json:"created_to,omitempty"`
+	HasImage    *bool      `json:"has_image,omitempty"`
+	Cursor      string     `json:"cursor,omitempty"`
+	Limit       int        `json:"limit"`
+}
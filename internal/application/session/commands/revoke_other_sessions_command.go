@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+// RevokeOtherSessionsCommand logs the user out of every session but the one
+// the request was made from.
+type RevokeOtherSessionsCommand struct {
+	UserId    uuid.UUID `json:"user_id"`
+	CurrentId uuid.UUID `json:"current_id"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type RevokeSessionCommand struct {
+	UserId uuid.UUID `json:"user_id"`
+	Id     uuid.UUID `json:"id"`
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/commands"
+	"github.com/google/uuid"
+	"time"
+)
+
+// HandleRevokeOthers logs the user out everywhere but the current session,
+// and returns how many sessions it ended.
+func (h *SessionHandler) HandleRevokeOthers(ctx context.Context, cmd commands.RevokeOtherSessionsCommand) (int, error) {
+	active, err := h.repository.GetActiveByUser(ctx, cmd.UserId, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []uuid.UUID
+	for _, session := range active {
+		if session.Id() != cmd.CurrentId {
+			ids = append(ids, session.Id())
+		}
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err = h.revoke(ctx, ids); err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
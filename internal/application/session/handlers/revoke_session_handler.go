@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/google/uuid"
+)
+
+// HandleRevoke logs the user out of one of their sessions, which may be the
+// current one. Sessions of other users are reported as not found.
+func (h *SessionHandler) HandleRevoke(ctx context.Context, cmd commands.RevokeSessionCommand) error {
+	session, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		return err
+	} else if session.UserId() != cmd.UserId {
+		return sessions.ErrNotFoundSession
+	}
+
+	return h.revoke(ctx, []uuid.UUID{session.Id()})
+}
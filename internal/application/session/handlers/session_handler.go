@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"time"
+)
+
+// SessionHandler logs users out of their sessions. Revoking a session revokes
+// its refresh tokens, so it cannot refresh, and hands it to revoker, which
+// turns its access tokens away for the accessTTL they may still live.
+type SessionHandler struct {
+	repository sessions.SessionRepository
+	tokenRepo  tokens.RefreshTokenRepository
+	revoker    sessions.SessionRevoker
+	accessTTL  time.Duration
+	logger     application.Logger
+}
+
+func NewSessionHandler(repository sessions.SessionRepository, tokenRepo tokens.RefreshTokenRepository, revoker sessions.SessionRevoker, accessTTL time.Duration, logger application.Logger) *SessionHandler {
+	return &SessionHandler{
+		repository: repository,
+		tokenRepo:  tokenRepo,
+		revoker:    revoker,
+		accessTTL:  accessTTL,
+		logger:     logger,
+	}
+}
+
+func (h *SessionHandler) revoke(ctx context.Context, ids []uuid.UUID) error {
+	now := time.Now()
+	for _, id := range ids {
+		if err := h.tokenRepo.RevokeFamily(ctx, id, now); err != nil {
+			h.logger.Error("Could not revoke refresh tokens of session %s: %v", id, err)
+			return err
+		}
+	}
+
+	if err := h.revoker.RevokeSessions(ids, h.accessTTL); err != nil {
+		h.logger.Error("Could not revoke access tokens of sessions %v: %v", ids, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+type MockSessionRevoker struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func newTestSessionHandler() (*SessionHandler, *MockSessionRepository, *MockRefreshTokenRepository, *MockSessionRevoker) {
+	repository, tokenRepo, revoker := new(MockSessionRepository), new(MockRefreshTokenRepository), new(MockSessionRevoker)
+	return NewSessionHandler(repository, tokenRepo, revoker, 15*time.Minute, new(MockLogger)), repository, tokenRepo, revoker
+}
+
+func newTestSession(userId uuid.UUID) *sessions.Session {
+	now := time.Now()
+	return sessions.NewSessionFromDB(uuid.New(), userId, "Firefox on Linux", "Mozilla/5.0", "203.0.113.7", now, now)
+}
+
+func TestNewSessionHandler(t *testing.T) {
+	repository, tokenRepo, revoker, logger := new(MockSessionRepository), new(MockRefreshTokenRepository), new(MockSessionRevoker), new(MockLogger)
+	handler := NewSessionHandler(repository, tokenRepo, revoker, time.Minute, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, tokenRepo, handler.tokenRepo)
+	require.Exactly(t, revoker, handler.revoker)
+	require.Equal(t, time.Minute, handler.accessTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestSessionHandler_HandleRevoke(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, tokenRepo, revoker := newTestSessionHandler()
+	userId := uuid.New()
+	session := newTestSession(userId)
+
+	repository.On("GetById", ctx, session.Id()).Return(session, nil)
+	tokenRepo.On("RevokeFamily", ctx, session.Id(), mock.Anything).Return(nil)
+	revoker.On("RevokeSessions", []uuid.UUID{session.Id()}, 15*time.Minute).Return(nil)
+
+	err := handler.HandleRevoke(ctx, commands.RevokeSessionCommand{UserId: userId, Id: session.Id()})
+
+	require.NoError(t, err)
+	tokenRepo.AssertExpectations(t)
+	revoker.AssertExpectations(t)
+}
+
+func TestSessionHandler_HandleRevoke_Errors(t *testing.T) {
+	ctx := context.Background()
+	dbErr := errors.New("database error")
+
+	t.Run("Unknown", func(t *testing.T) {
+		handler, repository, tokenRepo, _ := newTestSessionHandler()
+		id := uuid.New()
+		repository.On("GetById", ctx, id).Return(nil, sessions.ErrNotFoundSession)
+
+		err := handler.HandleRevoke(ctx, commands.RevokeSessionCommand{UserId: uuid.New(), Id: id})
+
+		assert.ErrorIs(t, err, sessions.ErrNotFoundSession)
+		tokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		handler, repository, tokenRepo, revoker := newTestSessionHandler()
+		session := newTestSession(uuid.New())
+		repository.On("GetById", ctx, session.Id()).Return(session, nil)
+
+		err := handler.HandleRevoke(ctx, commands.RevokeSessionCommand{UserId: uuid.New(), Id: session.Id()})
+
+		assert.ErrorIs(t, err, sessions.ErrNotFoundSession)
+		tokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+		revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("RefreshTokens", func(t *testing.T) {
+		handler, repository, tokenRepo, revoker := newTestSessionHandler()
+		session := newTestSession(uuid.New())
+		repository.On("GetById", ctx, session.Id()).Return(session, nil)
+		tokenRepo.On("RevokeFamily", ctx, session.Id(), mock.Anything).Return(dbErr)
+
+		err := handler.HandleRevoke(ctx, commands.RevokeSessionCommand{UserId: session.UserId(), Id: session.Id()})
+
+		assert.ErrorIs(t, err, dbErr)
+		revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("AccessTokens", func(t *testing.T) {
+		handler, repository, tokenRepo, revoker := newTestSessionHandler()
+		session := newTestSession(uuid.New())
+		repository.On("GetById", ctx, session.Id()).Return(session, nil)
+		tokenRepo.On("RevokeFamily", ctx, session.Id(), mock.Anything).Return(nil)
+		revoker.On("RevokeSessions", mock.Anything, mock.Anything).Return(dbErr)
+
+		err := handler.HandleRevoke(ctx, commands.RevokeSessionCommand{UserId: session.UserId(), Id: session.Id()})
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestSessionHandler_HandleRevokeOthers(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, tokenRepo, revoker := newTestSessionHandler()
+	userId := uuid.New()
+	current, phone, tablet := newTestSession(userId), newTestSession(userId), newTestSession(userId)
+
+	repository.On("GetActiveByUser", ctx, userId, mock.Anything).Return([]*sessions.Session{phone, current, tablet}, nil)
+	tokenRepo.On("RevokeFamily", ctx, phone.Id(), mock.Anything).Return(nil)
+	tokenRepo.On("RevokeFamily", ctx, tablet.Id(), mock.Anything).Return(nil)
+	revoker.On("RevokeSessions", []uuid.UUID{phone.Id(), tablet.Id()}, 15*time.Minute).Return(nil)
+
+	revoked, err := handler.HandleRevokeOthers(ctx, commands.RevokeOtherSessionsCommand{UserId: userId, CurrentId: current.Id()})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, revoked)
+	tokenRepo.AssertNotCalled(t, "RevokeFamily", ctx, current.Id(), mock.Anything)
+	tokenRepo.AssertExpectations(t)
+	revoker.AssertExpectations(t)
+}
+
+func TestSessionHandler_HandleRevokeOthers_OnlyCurrent(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, tokenRepo, revoker := newTestSessionHandler()
+	userId := uuid.New()
+	current := newTestSession(userId)
+
+	repository.On("GetActiveByUser", ctx, userId, mock.Anything).Return([]*sessions.Session{current}, nil)
+
+	revoked, err := handler.HandleRevokeOthers(ctx, commands.RevokeOtherSessionsCommand{UserId: userId, CurrentId: current.Id()})
+
+	require.NoError(t, err)
+	assert.Zero(t, revoked)
+	tokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+	revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+func TestSessionHandler_HandleRevokeOthers_Error(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, _, _ := newTestSessionHandler()
+	userId := uuid.New()
+	dbErr := errors.New("database error")
+
+	repository.On("GetActiveByUser", ctx, userId, mock.Anything).Return(nil, dbErr)
+
+	revoked, err := handler.HandleRevokeOthers(ctx, commands.RevokeOtherSessionsCommand{UserId: userId})
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Zero(t, revoked)
+}
+
+func (m *MockSessionRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	args := m.Called(ctx, userId, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *sessions.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	args := m.Called(ctx, id, ip, at)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.RefreshToken, error) {
+	return nil, nil
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *tokens.RefreshToken) error {
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, familyId, at)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	return nil
+}
+
+func (m *MockSessionRevoker) RevokeSessions(ids []uuid.UUID, ttl time.Duration) error {
+	args := m.Called(ids, ttl)
+	return args.Error(0)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/google/uuid"
+)
+
+// MapToSessionDTO marks the session as current when it is the one the
+// request was made from.
+func MapToSessionDTO(session *sessions.Session, currentId uuid.UUID) *dto.SessionDTO {
+	return &dto.SessionDTO{
+		Id:         session.Id(),
+		Device:     session.Device(),
+		UserAgent:  session.UserAgent(),
+		IP:         session.IP(),
+		CreatedAt:  session.CreatedAt(),
+		LastSeenAt: session.LastSeenAt(),
+		Current:    session.Id() == currentId,
+	}
+}
@@ -0,0 +1,8 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetSessionsQuery struct {
+	UserId    uuid.UUID `json:"user_id"`
+	CurrentId uuid.UUID `json:"current_id"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/google/uuid"
+
+type SuggestionDTO struct {
+	Id         uuid.UUID `json:"id"`
+	Text       string    `json:"text"`
+	Label      string    `json:"label,omitempty"`
+	Popularity int       `json:"popularity"`
+}
+
+type AutocompleteDTO struct {
+	Users  []*SuggestionDTO `json:"users"`
+	Tags   []*SuggestionDTO `json:"tags"`
+	Boards []*SuggestionDTO `json:"boards"`
+}
@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+)
+
+func MapToSuggestionDTO(suggestion suggestions.Suggestion) *dto.SuggestionDTO {
+	return &dto.SuggestionDTO{
+		Id:         suggestion.Id(),
+		Text:       suggestion.Text(),
+		Label:      suggestion.Label(),
+		Popularity: suggestion.Popularity(),
+	}
+}
+
+func MapToSuggestionDTOs(list []suggestions.Suggestion) []*dto.SuggestionDTO {
+	dtos := make([]*dto.SuggestionDTO, 0, len(list))
+	for _, suggestion := range list {
+		dtos = append(dtos, MapToSuggestionDTO(suggestion))
+	}
+	return dtos
+}
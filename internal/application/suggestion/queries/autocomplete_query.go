@@ -0,0 +1,10 @@
+package queries
+
+import "github.com/google/uuid"
+
+type AutocompleteQuery struct {
+	ViewerId uuid.UUID `json:"viewer_id"`
+	Text     string    `json:"q"`
+	Types    []string  `json:"types,omitempty"`
+	Limit    int       `json:"limit"`
+}
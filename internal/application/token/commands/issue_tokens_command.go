@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+// IssueTokensCommand starts a session of the user on the device the request
+// came from.
+type IssueTokensCommand struct {
+	UserId    uuid.UUID `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+}
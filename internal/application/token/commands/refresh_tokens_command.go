@@ -0,0 +1,6 @@
+package commands
+
+type RefreshTokensCommand struct {
+	RefreshToken string `json:"refresh_token"`
+	IP           string `json:"-"`
+}
@@ -0,0 +1,5 @@
+package commands
+
+type RevokeTokensCommand struct {
+	RefreshToken string `json:"refresh_token"`
+}
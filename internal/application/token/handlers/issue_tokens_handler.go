@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+)
+
+// HandleIssue starts a new session and token family, as on login.
+func (h *TokenHandler) HandleIssue(ctx context.Context, cmd commands.IssueTokensCommand) (*dto.TokenPairDTO, error) {
+	session, err := sessions.NewSession(cmd.UserId, cmd.UserAgent, cmd.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.sessions.Create(ctx, session); err != nil {
+		h.logger.Error("Could not store session for user %s: %v", cmd.UserId, err)
+		return nil, err
+	}
+
+	return h.issue(ctx, cmd.UserId, session.Id())
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"time"
+)
+
+// HandleRefresh trades a refresh token for a new pair of the same family,
+// recording the session was seen. A token redeemed twice, even by two racing
+// requests, revokes the family, so whoever stole it and the legitimate client
+// both have to log in again.
+func (h *TokenHandler) HandleRefresh(ctx context.Context, cmd commands.RefreshTokensCommand) (*dto.TokenPairDTO, error) {
+	if cmd.RefreshToken == "" {
+		return nil, tokens.ErrInvalidRefreshToken
+	}
+
+	refresh, err := h.repository.GetByHash(ctx, tokens.Hash(cmd.RefreshToken))
+	if errors.Is(err, tokens.ErrNotFoundRefreshToken) {
+		return nil, tokens.ErrInvalidRefreshToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err = refresh.Check(now); errors.Is(err, tokens.ErrReusedRefreshToken) {
+		return nil, h.revokeReused(ctx, refresh, now)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = h.sessions.Touch(ctx, refresh.FamilyId(), cmd.IP, now); err != nil {
+		h.logger.Error("Could not record activity of session %s: %v", refresh.FamilyId(), err)
+		return nil, err
+	}
+
+	marked, err := h.repository.MarkUsed(ctx, refresh.Id(), now)
+	if err != nil {
+		return nil, err
+	} else if !marked {
+		return nil, h.revokeReused(ctx, refresh, now)
+	}
+
+	return h.issue(ctx, refresh.UserId(), refresh.FamilyId())
+}
+
+func (h *TokenHandler) revokeReused(ctx context.Context, refresh *tokens.RefreshToken, now time.Time) error {
+	h.logger.Warn("Refresh token %s of user %s was reused, revoking family %s", refresh.Id(), refresh.UserId(), refresh.FamilyId())
+	if err := h.repository.RevokeFamily(ctx, refresh.FamilyId(), now); err != nil {
+		h.logger.Error("Could not revoke refresh token family %s: %v", refresh.FamilyId(), err)
+		return err
+	}
+
+	return tokens.ErrReusedRefreshToken
+}
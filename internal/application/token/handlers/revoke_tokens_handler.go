@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"time"
+)
+
+// HandleRevoke revokes the family of a refresh token, logging its session out.
+// Unknown tokens are ignored, so revoking is idempotent.
+func (h *TokenHandler) HandleRevoke(ctx context.Context, cmd commands.RevokeTokensCommand) error {
+	refresh, err := h.repository.GetByHash(ctx, tokens.Hash(cmd.RefreshToken))
+	if errors.Is(err, tokens.ErrNotFoundRefreshToken) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err = h.repository.RevokeFamily(ctx, refresh.FamilyId(), time.Now()); err != nil {
+		h.logger.Error("Could not revoke refresh token family %s: %v", refresh.FamilyId(), err)
+		return err
+	}
+
+	return nil
+}
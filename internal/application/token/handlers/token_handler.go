@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"time"
+)
+
+// TokenHandler hands out access tokens signed by signer along with refresh
+// tokens that last refreshTTL. Each token family is a session, kept in
+// sessions with the device it was started on. Access tokens carry the role
+// the user has in roles when they are signed, so a new role is picked up on
+// the next refresh.
+type TokenHandler struct {
+	repository tokens.RefreshTokenRepository
+	sessions   sessions.SessionRepository
+	roles      users.RoleRepository
+	signer     tokens.AccessSigner
+	refreshTTL time.Duration
+	logger     application.Logger
+}
+
+func NewTokenHandler(repository tokens.RefreshTokenRepository, sessions sessions.SessionRepository, roles users.RoleRepository, signer tokens.AccessSigner, refreshTTL time.Duration, logger application.Logger) *TokenHandler {
+	return &TokenHandler{
+		repository: repository,
+		sessions:   sessions,
+		roles:      roles,
+		signer:     signer,
+		refreshTTL: refreshTTL,
+		logger:     logger,
+	}
+}
+
+// issue signs an access token and stores a refresh token of the session,
+// whose id is the id of its token family.
+func (h *TokenHandler) issue(ctx context.Context, userId, sessionId uuid.UUID) (*dto.TokenPairDTO, error) {
+	refresh, secret, err := tokens.NewRefreshToken(userId, sessionId, h.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := h.roles.GetRole(ctx, userId)
+	if err != nil {
+		h.logger.Error("Could not get role of user %s: %v", userId, err)
+		return nil, err
+	}
+
+	access, err := h.signer.Generate(userId.String(), sessionId.String(), role.String())
+	if err != nil {
+		h.logger.Error("Could not sign access token for user %s: %v", userId, err)
+		return nil, err
+	}
+
+	if err = h.repository.Create(ctx, refresh); err != nil {
+		h.logger.Error("Could not store refresh token for user %s: %v", userId, err)
+		return nil, err
+	}
+
+	return &dto.TokenPairDTO{
+		AccessToken:      access,
+		TokenType:        "Bearer",
+		ExpiresIn:        int(h.signer.TTL().Seconds()),
+		RefreshToken:     secret,
+		RefreshExpiresIn: int(h.refreshTTL.Seconds()),
+	}, nil
+}
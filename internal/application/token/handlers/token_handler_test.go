@@ -0,0 +1,368 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+type MockSigner struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func newTestTokenHandler() (*TokenHandler, *MockRefreshTokenRepository, *MockSessionRepository, *MockSigner) {
+	repository, sessionRepo, roles, signer := new(MockRefreshTokenRepository), new(MockSessionRepository), new(MockRoleRepository), new(MockSigner)
+	roles.On("GetRole", mock.Anything, mock.Anything).Return(users.RoleUser, nil).Maybe()
+	signer.On("TTL").Return(15 * time.Minute).Maybe()
+	return NewTokenHandler(repository, sessionRepo, roles, signer, 30*24*time.Hour, new(MockLogger)), repository, sessionRepo, signer
+}
+
+func newTestRefreshToken(userId uuid.UUID, usedAt, revokedAt *time.Time) (*tokens.RefreshToken, string) {
+	secret, _ := tokens.NewSecret()
+	now := time.Now()
+	return tokens.NewRefreshTokenFromDB(uuid.New(), userId, uuid.New(), tokens.Hash(secret), now.Add(time.Hour), now, usedAt, revokedAt), secret
+}
+
+func TestNewTokenHandler(t *testing.T) {
+	repository, sessionRepo, roles, signer, logger := new(MockRefreshTokenRepository), new(MockSessionRepository), new(MockRoleRepository), new(MockSigner), new(MockLogger)
+	handler := NewTokenHandler(repository, sessionRepo, roles, signer, time.Hour, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, sessionRepo, handler.sessions)
+	require.Exactly(t, roles, handler.roles)
+	require.Exactly(t, signer, handler.signer)
+	require.Equal(t, time.Hour, handler.refreshTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestTokenHandler_HandleIssue(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, sessionRepo, signer := newTestTokenHandler()
+	userId := uuid.New()
+
+	var session *sessions.Session
+	var stored *tokens.RefreshToken
+	sessionRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		session = args.Get(1).(*sessions.Session)
+	}).Return(nil)
+	signer.On("Generate", userId.String(), mock.Anything, "user").Return("access", nil)
+	repository.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*tokens.RefreshToken)
+	}).Return(nil)
+
+	pair, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{UserId: userId, UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0", IP: "203.0.113.7"})
+
+	require.NoError(t, err)
+	require.NotNil(t, session)
+	assert.Equal(t, userId, session.UserId())
+	assert.Equal(t, "Firefox on Linux", session.Device())
+	assert.Equal(t, "203.0.113.7", session.IP())
+	signer.AssertCalled(t, "Generate", userId.String(), session.Id().String(), "user")
+	assert.Equal(t, "access", pair.AccessToken)
+	assert.Equal(t, "Bearer", pair.TokenType)
+	assert.Equal(t, 900, pair.ExpiresIn)
+	assert.Equal(t, 30*24*3600, pair.RefreshExpiresIn)
+	require.NotNil(t, stored)
+	assert.Equal(t, userId, stored.UserId())
+	assert.Equal(t, tokens.Hash(pair.RefreshToken), stored.Hash())
+	assert.Equal(t, session.Id(), stored.FamilyId())
+	repository.AssertExpectations(t)
+}
+
+func TestTokenHandler_HandleIssue_Error(t *testing.T) {
+	ctx := context.Background()
+	userId := uuid.New()
+	dbErr := errors.New("database error")
+
+	t.Run("Session", func(t *testing.T) {
+		handler, repository, sessionRepo, signer := newTestTokenHandler()
+		sessionRepo.On("Create", ctx, mock.Anything).Return(dbErr)
+
+		pair, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{UserId: userId})
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, pair)
+		signer.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything, mock.Anything)
+		repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("RefreshToken", func(t *testing.T) {
+		handler, repository, sessionRepo, signer := newTestTokenHandler()
+		sessionRepo.On("Create", ctx, mock.Anything).Return(nil)
+		signer.On("Generate", userId.String(), mock.Anything, "user").Return("access", nil)
+		repository.On("Create", ctx, mock.Anything).Return(dbErr)
+
+		pair, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{UserId: userId})
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, pair)
+	})
+
+	t.Run("Role", func(t *testing.T) {
+		repository, sessionRepo, roles, signer := new(MockRefreshTokenRepository), new(MockSessionRepository), new(MockRoleRepository), new(MockSigner)
+		handler := NewTokenHandler(repository, sessionRepo, roles, signer, time.Hour, new(MockLogger))
+		sessionRepo.On("Create", ctx, mock.Anything).Return(nil)
+		roles.On("GetRole", ctx, userId).Return(users.RoleModerator, nil)
+		signer.On("Generate", userId.String(), mock.Anything, "moderator").Return("access", nil)
+		signer.On("TTL").Return(15 * time.Minute)
+		repository.On("Create", ctx, mock.Anything).Return(nil)
+
+		pair, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{UserId: userId})
+
+		require.NoError(t, err)
+		assert.Equal(t, "access", pair.AccessToken)
+		signer.AssertExpectations(t)
+	})
+
+	t.Run("RoleError", func(t *testing.T) {
+		repository, sessionRepo, roles, signer := new(MockRefreshTokenRepository), new(MockSessionRepository), new(MockRoleRepository), new(MockSigner)
+		handler := NewTokenHandler(repository, sessionRepo, roles, signer, time.Hour, new(MockLogger))
+		sessionRepo.On("Create", ctx, mock.Anything).Return(nil)
+		roles.On("GetRole", ctx, userId).Return(users.Role(""), users.ErrNotFoundUser)
+
+		pair, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{UserId: userId})
+
+		assert.ErrorIs(t, err, users.ErrNotFoundUser)
+		assert.Nil(t, pair)
+		signer.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything, mock.Anything)
+		repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NilUser", func(t *testing.T) {
+		handler, _, sessionRepo, _ := newTestTokenHandler()
+
+		_, err := handler.HandleIssue(ctx, commands.IssueTokensCommand{})
+
+		assert.ErrorIs(t, err, sessions.ErrNilUserIdSession)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTokenHandler_HandleRefresh(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, sessionRepo, signer := newTestTokenHandler()
+	userId := uuid.New()
+	refresh, secret := newTestRefreshToken(userId, nil, nil)
+
+	var rotated *tokens.RefreshToken
+	repository.On("GetByHash", ctx, refresh.Hash()).Return(refresh, nil)
+	sessionRepo.On("Touch", ctx, refresh.FamilyId(), "203.0.113.7", mock.Anything).Return(nil)
+	repository.On("MarkUsed", ctx, refresh.Id(), mock.Anything).Return(true, nil)
+	signer.On("Generate", userId.String(), refresh.FamilyId().String(), "user").Return("access", nil)
+	repository.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		rotated = args.Get(1).(*tokens.RefreshToken)
+	}).Return(nil)
+
+	pair, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: secret, IP: "203.0.113.7"})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, pair.RefreshToken)
+	require.NotNil(t, rotated)
+	assert.Equal(t, refresh.FamilyId(), rotated.FamilyId())
+	repository.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestTokenHandler_HandleRefresh_Reused(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, sessionRepo, signer := newTestTokenHandler()
+	used := time.Now().Add(-time.Minute)
+	refresh, secret := newTestRefreshToken(uuid.New(), &used, nil)
+
+	repository.On("GetByHash", ctx, refresh.Hash()).Return(refresh, nil)
+	repository.On("RevokeFamily", ctx, refresh.FamilyId(), mock.Anything).Return(nil)
+
+	pair, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: secret})
+
+	assert.ErrorIs(t, err, tokens.ErrReusedRefreshToken)
+	assert.Nil(t, pair)
+	signer.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything, mock.Anything)
+	sessionRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+}
+
+func TestTokenHandler_HandleRefresh_Race(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, sessionRepo, signer := newTestTokenHandler()
+	refresh, secret := newTestRefreshToken(uuid.New(), nil, nil)
+
+	repository.On("GetByHash", ctx, refresh.Hash()).Return(refresh, nil)
+	sessionRepo.On("Touch", ctx, refresh.FamilyId(), "", mock.Anything).Return(nil)
+	repository.On("MarkUsed", ctx, refresh.Id(), mock.Anything).Return(false, nil)
+	repository.On("RevokeFamily", ctx, refresh.FamilyId(), mock.Anything).Return(nil)
+
+	pair, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: secret})
+
+	assert.ErrorIs(t, err, tokens.ErrReusedRefreshToken)
+	assert.Nil(t, pair)
+	signer.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+}
+
+func TestTokenHandler_HandleRefresh_Errors(t *testing.T) {
+	ctx := context.Background()
+	revokedAt := time.Now().Add(-time.Minute)
+	revoked, revokedSecret := newTestRefreshToken(uuid.New(), nil, &revokedAt)
+
+	t.Run("Empty", func(t *testing.T) {
+		handler, repository, _, _ := newTestTokenHandler()
+
+		_, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{})
+
+		assert.ErrorIs(t, err, tokens.ErrInvalidRefreshToken)
+		repository.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		handler, repository, _, _ := newTestTokenHandler()
+		repository.On("GetByHash", ctx, tokens.Hash("unknown")).Return(nil, tokens.ErrNotFoundRefreshToken)
+
+		_, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: "unknown"})
+
+		assert.ErrorIs(t, err, tokens.ErrInvalidRefreshToken)
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		handler, repository, _, _ := newTestTokenHandler()
+		repository.On("GetByHash", ctx, revoked.Hash()).Return(revoked, nil)
+
+		_, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: revokedSecret})
+
+		assert.ErrorIs(t, err, tokens.ErrRevokedRefreshToken)
+		repository.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Session", func(t *testing.T) {
+		handler, repository, sessionRepo, _ := newTestTokenHandler()
+		refresh, secret := newTestRefreshToken(uuid.New(), nil, nil)
+		dbErr := errors.New("database error")
+		repository.On("GetByHash", ctx, refresh.Hash()).Return(refresh, nil)
+		sessionRepo.On("Touch", ctx, refresh.FamilyId(), "", mock.Anything).Return(dbErr)
+
+		_, err := handler.HandleRefresh(ctx, commands.RefreshTokensCommand{RefreshToken: secret})
+
+		assert.ErrorIs(t, err, dbErr)
+		repository.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTokenHandler_HandleRevoke(t *testing.T) {
+	ctx := context.Background()
+	handler, repository, _, _ := newTestTokenHandler()
+	refresh, secret := newTestRefreshToken(uuid.New(), nil, nil)
+
+	repository.On("GetByHash", ctx, refresh.Hash()).Return(refresh, nil)
+	repository.On("GetByHash", ctx, tokens.Hash("unknown")).Return(nil, tokens.ErrNotFoundRefreshToken)
+	repository.On("RevokeFamily", ctx, refresh.FamilyId(), mock.Anything).Return(nil)
+
+	require.NoError(t, handler.HandleRevoke(ctx, commands.RevokeTokensCommand{RefreshToken: secret}))
+	require.NoError(t, handler.HandleRevoke(ctx, commands.RevokeTokensCommand{RefreshToken: "unknown"}))
+	repository.AssertExpectations(t)
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.RefreshToken, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tokens.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *tokens.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, familyId, at)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, userId, at)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	args := m.Called(ctx, userId, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *sessions.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	args := m.Called(ctx, id, ip, at)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) GetRole(ctx context.Context, id uuid.UUID) (users.Role, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(users.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) ChangeRole(ctx context.Context, id uuid.UUID, role users.Role) error {
+	args := m.Called(ctx, id, role)
+	return args.Error(0)
+}
+
+func (m *MockSigner) Generate(userID, sessionID, role string) (string, error) {
+	args := m.Called(userID, sessionID, role)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSigner) TTL() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,6 @@
+package dto
+
+type TrendingTagDTO struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+)
+
+// HandleRefresh scores trending pins and tags again as of cmd.Now and swaps
+// them into the store. Pins and tags are refreshed independently so a failure
+// in one keeps the other fresh. Views older than the window no longer count
+// for anything and are pruned.
+func (h *TrendHandler) HandleRefresh(ctx context.Context, cmd commands.RefreshTrendsCommand) error {
+	since := cmd.Now.Add(-h.window)
+	rate := trends.DecayRate(h.halfLife)
+
+	pinErr := h.refresh(ctx, trends.PinKind, func() ([]trends.Score, error) {
+		return h.repository.GetPinScores(ctx, since, cmd.Now, rate, h.size)
+	})
+	tagErr := h.refresh(ctx, trends.TagKind, func() ([]trends.Score, error) {
+		return h.repository.GetTagScores(ctx, since, cmd.Now, rate, h.size)
+	})
+
+	if pruned, err := h.viewRepo.DeleteBefore(ctx, since); err != nil {
+		h.logger.Warn("Could not prune pin views: %v", err)
+	} else if pruned > 0 {
+		h.logger.Info("Pruned %d pin views", pruned)
+	}
+
+	return errors.Join(pinErr, tagErr)
+}
+
+func (h *TrendHandler) refresh(ctx context.Context, kind trends.Kind, score func() ([]trends.Score, error)) error {
+	scores, err := score()
+	if err != nil {
+		h.logger.Error("Could not score trending %s: %v", kind, err)
+		return err
+	}
+
+	if err = h.store.Replace(ctx, kind, scores); err != nil {
+		h.logger.Error("Could not store trending %s: %v", kind, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"time"
+)
+
+// TrendHandler scores the engagement of the last window, keeping the best size
+// pins and tags of every segment in the store.
+type TrendHandler struct {
+	repository trends.TrendRepository
+	store      trends.TrendStore
+	viewRepo   pins.ViewRepository
+	halfLife   time.Duration
+	window     time.Duration
+	size       int
+	logger     application.Logger
+}
+
+func NewTrendHandler(repository trends.TrendRepository, store trends.TrendStore, viewRepo pins.ViewRepository, halfLife, window time.Duration, size int, logger application.Logger) *TrendHandler {
+	return &TrendHandler{
+		repository: repository,
+		store:      store,
+		viewRepo:   viewRepo,
+		halfLife:   halfLife,
+		window:     window,
+		size:       size,
+		logger:     logger,
+	}
+}
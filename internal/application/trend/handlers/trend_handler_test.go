@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockTrendRepository struct {
+	mock.Mock
+}
+
+type MockTrendStore struct {
+	mock.Mock
+}
+
+type MockViewRepository struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
+func TestNewTrendHandler(t *testing.T) {
+	repository, store, viewRepo, logger := new(MockTrendRepository), new(MockTrendStore), new(MockViewRepository), new(MockLogger)
+	handler := NewTrendHandler(repository, store, viewRepo, time.Hour, 24*time.Hour, 20, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, store, handler.store)
+	require.Exactly(t, viewRepo, handler.viewRepo)
+	require.Equal(t, time.Hour, handler.halfLife)
+	require.Equal(t, 24*time.Hour, handler.window)
+	require.Equal(t, 20, handler.size)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestTrendHandler_HandleRefresh(t *testing.T) {
+	ctx := context.Background()
+	repository, store, viewRepo := new(MockTrendRepository), new(MockTrendStore), new(MockViewRepository)
+	handler := NewTrendHandler(repository, store, viewRepo, 12*time.Hour, 24*time.Hour, 10, new(MockLogger))
+
+	now := time.Now()
+	since, rate := now.Add(-24*time.Hour), trends.DecayRate(12*time.Hour)
+	pinScores := []trends.Score{trends.NewScore(trends.Global, uuid.NewString(), 2)}
+	tagScores := []trends.Score{trends.NewScore(trends.Global, "kitchen", 1)}
+
+	repository.On("GetPinScores", ctx, since, now, rate, 10).Return(pinScores, nil)
+	repository.On("GetTagScores", ctx, since, now, rate, 10).Return(tagScores, nil)
+	store.On("Replace", ctx, trends.PinKind, pinScores).Return(nil)
+	store.On("Replace", ctx, trends.TagKind, tagScores).Return(nil)
+	viewRepo.On("DeleteBefore", ctx, since).Return(int64(3), nil)
+
+	err := handler.HandleRefresh(ctx, commands.RefreshTrendsCommand{Now: now})
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+	store.AssertExpectations(t)
+	viewRepo.AssertExpectations(t)
+}
+
+func TestTrendHandler_HandleRefresh_Error(t *testing.T) {
+	ctx := context.Background()
+	repository, store, viewRepo := new(MockTrendRepository), new(MockTrendStore), new(MockViewRepository)
+	handler := NewTrendHandler(repository, store, viewRepo, 12*time.Hour, 24*time.Hour, 10, new(MockLogger))
+
+	now := time.Now()
+	since, rate := now.Add(-24*time.Hour), trends.DecayRate(12*time.Hour)
+	tagScores := []trends.Score{trends.NewScore(trends.Global, "kitchen", 1)}
+	failure := errors.New("db down")
+
+	repository.On("GetPinScores", ctx, since, now, rate, 10).Return(nil, failure)
+	repository.On("GetTagScores", ctx, since, now, rate, 10).Return(tagScores, nil)
+	store.On("Replace", ctx, trends.TagKind, tagScores).Return(nil)
+	viewRepo.On("DeleteBefore", ctx, since).Return(int64(0), failure)
+
+	err := handler.HandleRefresh(ctx, commands.RefreshTrendsCommand{Now: now})
+
+	assert.ErrorIs(t, err, failure)
+	store.AssertNotCalled(t, "Replace", mock.Anything, trends.PinKind, mock.Anything)
+	store.AssertExpectations(t)
+}
+
+func (m *MockTrendRepository) GetPinScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	args := m.Called(ctx, since, now, rate, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trends.Score), args.Error(1)
+}
+
+func (m *MockTrendRepository) GetTagScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	args := m.Called(ctx, since, now, rate, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trends.Score), args.Error(1)
+}
+
+func (m *MockTrendRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, viewerId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockTrendStore) Replace(ctx context.Context, kind trends.Kind, scores []trends.Score) error {
+	args := m.Called(ctx, kind, scores)
+	return args.Error(0)
+}
+
+func (m *MockTrendStore) Top(ctx context.Context, kind trends.Kind, segment trends.Segment, limit int) ([]trends.Score, error) {
+	args := m.Called(ctx, kind, segment, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trends.Score), args.Error(1)
+}
+
+func (m *MockViewRepository) Create(ctx context.Context, view *pins.View) error {
+	args := m.Called(ctx, view)
+	return args.Error(0)
+}
+
+func (m *MockViewRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
+
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
@@ -0,0 +1,10 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetTrendingPinsQuery struct {
+	ViewerId uuid.UUID `json:"viewer_id"`
+	Country  string    `json:"country,omitempty"`
+	Language string    `json:"language,omitempty"`
+	Limit    int       `json:"limit"`
+}
@@ -0,0 +1,7 @@
+package queries
+
+type GetTrendingTagsQuery struct {
+	Country  string `json:"country,omitempty"`
+	Language string `json:"language,omitempty"`
+	Limit    int    `json:"limit"`
+}
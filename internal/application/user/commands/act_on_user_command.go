@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ActOnUserCommand struct {
+	ActorId   uuid.UUID `json:"-"`
+	ActorRole string    `json:"-"`
+	Id        uuid.UUID `json:"-"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type BlockUserCommand struct {
+	BlockerId uuid.UUID `json:"blocker_id"`
+	BlockedId uuid.UUID `json:"blocked_id"`
+}
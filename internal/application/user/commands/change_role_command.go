@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ChangeRoleCommand struct {
+	ActorId uuid.UUID `json:"-"`
+	Id      uuid.UUID `json:"-"`
+	Role    string    `json:"role"`
+}
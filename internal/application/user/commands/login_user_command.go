@@ -3,4 +3,5 @@ package commands
 type LoginUserCommand struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	IP       string `json:"-"`
 }
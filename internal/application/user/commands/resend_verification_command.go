@@ -0,0 +1,5 @@
+package commands
+
+type ResendVerificationCommand struct {
+	Email string `json:"email"`
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UnfollowUserCommand struct {
+	FollowerId uuid.UUID `json:"follower_id"`
+	FolloweeId uuid.UUID `json:"followee_id"`
+}
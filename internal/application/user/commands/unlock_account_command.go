@@ -0,0 +1,5 @@
+package commands
+
+type UnlockAccountCommand struct {
+	Token string `json:"token"`
+}
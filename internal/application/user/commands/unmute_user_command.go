@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UnmuteUserCommand struct {
+	MuterId uuid.UUID `json:"muter_id"`
+	MutedId uuid.UUID `json:"muted_id"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type BlockDTO struct {
+	BlockerId uuid.UUID `json:"blocker_id"`
+	BlockedId uuid.UUID `json:"blocked_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type FollowDTO struct {
+	FollowerId uuid.UUID `json:"follower_id"`
+	FolloweeId uuid.UUID `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type MuteDTO struct {
+	MuterId   uuid.UUID `json:"muter_id"`
+	MutedId   uuid.UUID `json:"muted_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
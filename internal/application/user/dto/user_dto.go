@@ -21,4 +21,5 @@ type UserDTO struct {
 	ProfilePicURL *string   `json:"profilePicURL,omitempty"`
 	Website       *string   `json:"website,omitempty"`
 	Visibility    bool      `json:"visibility"`
+	Verified      bool      `json:"verified"`
 }
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+// HandleActOn checks the actor may act on the account of the user, as when
+// deleting or restoring it. Users may act on their own; moderators on those
+// of plain users too, and admins on anyone's.
+func (h *RoleHandler) HandleActOn(ctx context.Context, cmd commands.ActOnUserCommand) error {
+	if cmd.Id == cmd.ActorId {
+		return nil
+	}
+
+	actor := users.Role(cmd.ActorRole)
+	if !actor.Includes(users.RoleModerator) {
+		return users.ErrOutranked
+	}
+
+	role, err := h.repository.GetRole(ctx, cmd.Id)
+	if err != nil {
+		return err
+	} else if !actor.Manages(role) {
+		return users.ErrOutranked
+	}
+
+	return nil
+}
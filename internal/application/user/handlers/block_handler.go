@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+type BlockHandler struct {
+	repository blocks.BlockRepository
+	muteRepo   mutes.MuteRepository
+	userRepo   users.UserRepository
+	logger     application.Logger
+}
+
+func NewBlockHandler(repository blocks.BlockRepository, muteRepo mutes.MuteRepository, userRepo users.UserRepository, logger application.Logger) *BlockHandler {
+	return &BlockHandler{
+		repository: repository,
+		muteRepo:   muteRepo,
+		userRepo:   userRepo,
+		logger:     logger,
+	}
+}
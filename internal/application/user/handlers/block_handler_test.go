@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+type MockMuteRepository struct {
+	mock.Mock
+}
+
+func TestNewBlockHandler(t *testing.T) {
+	repository := new(MockBlockRepository)
+	muteRepo := new(MockMuteRepository)
+	userRepo := new(MockRepository)
+	logger := new(MockLogger)
+	handler := NewBlockHandler(repository, muteRepo, userRepo, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, muteRepo, handler.muteRepo)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestBlockHandler_HandleBlock(t *testing.T) {
+	ctx := context.Background()
+	repository, userRepo := new(MockBlockRepository), new(MockRepository)
+	handler := NewBlockHandler(repository, new(MockMuteRepository), userRepo, new(MockLogger))
+
+	cmd := commands.BlockUserCommand{
+		BlockerId: uuid.New(),
+		BlockedId: uuid.New(),
+	}
+
+	userRepo.On("ExistsById", ctx, cmd.BlockedId).Return(true, nil)
+	repository.On("Exists", ctx, cmd.BlockerId, cmd.BlockedId).Return(false, nil)
+	repository.On("Create", ctx, mock.AnythingOfType("*blocks.Block")).Return(nil)
+
+	block, err := handler.HandleBlock(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.BlockerId, block.BlockerId)
+	assert.Equal(t, cmd.BlockedId, block.BlockedId)
+	repository.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestBlockHandler_HandleBlock_Errors(t *testing.T) {
+	ctx := context.Background()
+	blockerId, blockedId := uuid.New(), uuid.New()
+
+	cases := []struct {
+		name  string
+		cmd   commands.BlockUserCommand
+		setup func(repository *MockBlockRepository, userRepo *MockRepository)
+		err   error
+	}{
+		{
+			name: "self",
+			cmd:  commands.BlockUserCommand{BlockerId: blockerId, BlockedId: blockerId},
+			err:  blocks.ErrSelfBlock,
+		},
+		{
+			name: "unknown user",
+			cmd:  commands.BlockUserCommand{BlockerId: blockerId, BlockedId: blockedId},
+			setup: func(repository *MockBlockRepository, userRepo *MockRepository) {
+				userRepo.On("ExistsById", ctx, blockedId).Return(false, nil)
+			},
+			err: users.ErrNotFoundUser,
+		},
+		{
+			name: "already blocked",
+			cmd:  commands.BlockUserCommand{BlockerId: blockerId, BlockedId: blockedId},
+			setup: func(repository *MockBlockRepository, userRepo *MockRepository) {
+				userRepo.On("ExistsById", ctx, blockedId).Return(true, nil)
+				repository.On("Exists", ctx, blockerId, blockedId).Return(true, nil)
+			},
+			err: blocks.ErrExistsBlock,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository, userRepo := new(MockBlockRepository), new(MockRepository)
+			handler := NewBlockHandler(repository, new(MockMuteRepository), userRepo, new(MockLogger))
+			if tc.setup != nil {
+				tc.setup(repository, userRepo)
+			}
+
+			block, err := handler.HandleBlock(ctx, tc.cmd)
+
+			assert.Nil(t, block)
+			assert.ErrorIs(t, err, tc.err)
+			repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			repository.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBlockHandler_HandleUnblock(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockBlockRepository)
+	handler := NewBlockHandler(repository, new(MockMuteRepository), new(MockRepository), new(MockLogger))
+
+	cmd := commands.UnblockUserCommand{
+		BlockerId: uuid.New(),
+		BlockedId: uuid.New(),
+	}
+
+	repository.On("Exists", ctx, cmd.BlockerId, cmd.BlockedId).Return(true, nil)
+	repository.On("Delete", ctx, mock.AnythingOfType("*blocks.Block")).Return(nil)
+
+	err := handler.HandleUnblock(ctx, cmd)
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestBlockHandler_HandleUnblock_NotBlocked(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockBlockRepository)
+	handler := NewBlockHandler(repository, new(MockMuteRepository), new(MockRepository), new(MockLogger))
+
+	cmd := commands.UnblockUserCommand{
+		BlockerId: uuid.New(),
+		BlockedId: uuid.New(),
+	}
+
+	repository.On("Exists", ctx, cmd.BlockerId, cmd.BlockedId).Return(false, nil)
+
+	err := handler.HandleUnblock(ctx, cmd)
+
+	assert.ErrorIs(t, err, blocks.ErrNotFoundBlock)
+	repository.AssertExpectations(t)
+}
+
+func TestBlockHandler_HandleMute(t *testing.T) {
+	ctx := context.Background()
+	muteRepo, userRepo := new(MockMuteRepository), new(MockRepository)
+	handler := NewBlockHandler(new(MockBlockRepository), muteRepo, userRepo, new(MockLogger))
+
+	cmd := commands.MuteUserCommand{
+		MuterId: uuid.New(),
+		MutedId: uuid.New(),
+	}
+
+	userRepo.On("ExistsById", ctx, cmd.MutedId).Return(true, nil)
+	muteRepo.On("Exists", ctx, cmd.MuterId, cmd.MutedId).Return(false, nil)
+	muteRepo.On("Create", ctx, mock.AnythingOfType("*mutes.Mute")).Return(nil)
+
+	mute, err := handler.HandleMute(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.MuterId, mute.MuterId)
+	assert.Equal(t, cmd.MutedId, mute.MutedId)
+	muteRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestBlockHandler_HandleMute_AlreadyMuted(t *testing.T) {
+	ctx := context.Background()
+	muteRepo, userRepo := new(MockMuteRepository), new(MockRepository)
+	handler := NewBlockHandler(new(MockBlockRepository), muteRepo, userRepo, new(MockLogger))
+
+	cmd := commands.MuteUserCommand{
+		MuterId: uuid.New(),
+		MutedId: uuid.New(),
+	}
+
+	userRepo.On("ExistsById", ctx, cmd.MutedId).Return(true, nil)
+	muteRepo.On("Exists", ctx, cmd.MuterId, cmd.MutedId).Return(true, nil)
+
+	mute, err := handler.HandleMute(ctx, cmd)
+
+	assert.Nil(t, mute)
+	assert.ErrorIs(t, err, mutes.ErrExistsMute)
+	muteRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestBlockHandler_HandleUnmute(t *testing.T) {
+	ctx := context.Background()
+	muteRepo := new(MockMuteRepository)
+	handler := NewBlockHandler(new(MockBlockRepository), muteRepo, new(MockRepository), new(MockLogger))
+
+	cmd := commands.UnmuteUserCommand{
+		MuterId: uuid.New(),
+		MutedId: uuid.New(),
+	}
+
+	muteRepo.On("Exists", ctx, cmd.MuterId, cmd.MutedId).Return(false, nil)
+
+	err := handler.HandleUnmute(ctx, cmd)
+
+	assert.ErrorIs(t, err, mutes.ErrNotFoundMute)
+	muteRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	muteRepo.AssertExpectations(t)
+}
+
+func (m *MockBlockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	args := m.Called(ctx, blockerId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*blocks.Block), args.Error(1)
+}
+
+func (m *MockBlockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, blockerId, blockedId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userIds)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockBlockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	args := m.Called(ctx, b)
+	return args.Error(0)
+}
+
+func (m *MockMuteRepository) GetListByMuterId(ctx context.Context, muterId uuid.UUID) ([]*mutes.Mute, error) {
+	args := m.Called(ctx, muterId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mutes.Mute), args.Error(1)
+}
+
+func (m *MockMuteRepository) Exists(ctx context.Context, muterId, mutedId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, muterId, mutedId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMuteRepository) Create(ctx context.Context, mu *mutes.Mute) error {
+	args := m.Called(ctx, mu)
+	return args.Error(0)
+}
+
+func (m *MockMuteRepository) Delete(ctx context.Context, mu *mutes.Mute) error {
+	args := m.Called(ctx, mu)
+	return args.Error(0)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+func (h *BlockHandler) HandleBlock(ctx context.Context, cmd commands.BlockUserCommand) (*dto.BlockDTO, error) {
+	block, err := blocks.NewBlock(cmd.BlockerId, cmd.BlockedId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.userRepo.ExistsById(ctx, cmd.BlockedId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, users.ErrNotFoundUser
+	}
+
+	exist, err = h.repository.Exists(ctx, cmd.BlockerId, cmd.BlockedId)
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, blocks.ErrExistsBlock
+	}
+
+	if err = h.repository.Create(ctx, block); err != nil {
+		h.logger.Error("Could not block %s: %v", cmd.BlockedId, err)
+		return nil, err
+	}
+
+	return mappers.MapToBlockDTO(block), nil
+}
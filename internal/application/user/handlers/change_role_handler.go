@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+// HandleChangeRole gives the user the role. Users cannot change their own,
+// so the last admin cannot demote themselves by mistake.
+func (h *RoleHandler) HandleChangeRole(ctx context.Context, cmd commands.ChangeRoleCommand) error {
+	role, err := users.ParseRole(cmd.Role)
+	if err != nil {
+		return err
+	} else if cmd.Id == cmd.ActorId {
+		return users.ErrOwnRole
+	}
+
+	current, err := h.repository.GetRole(ctx, cmd.Id)
+	if err != nil {
+		return err
+	} else if current == role {
+		return nil
+	}
+
+	if err = h.repository.ChangeRole(ctx, cmd.Id, role); err != nil {
+		h.logger.Error("Could not change role of user %s: %v", cmd.Id, err)
+		return err
+	}
+
+	if role.Includes(current) {
+		return nil
+	}
+
+	return h.HandleLogout(ctx, cmd.Id)
+}
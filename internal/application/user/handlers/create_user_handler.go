@@ -2,18 +2,13 @@ package handlers
 
 import (
 	"context"
-	"encoding/base64"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
-	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
-	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
-	"log"
-	"time"
 )
 
 func (h *UserHandler) HandleCreate(ctx context.Context, cmd commands.CreateUserCommand) (*dto.UserResponse, error) {
@@ -78,27 +73,10 @@ func (h *UserHandler) HandleCreate(ctx context.Context, cmd commands.CreateUserC
 		return nil, err
 	}
 
-	token := base64.URLEncoding.EncodeToString(make([]byte, 32))
-
-	verification := email.EmailVerification{
-		Id:        uuid.New(),
-		UserId:    usr.Id(),
-		Token:     token,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(24 * time.Hour),
-	}
-
-	if err = h.emailRepo.Save(ctx, &verification); err != nil {
+	if err = h.sendVerification(ctx, usr); err != nil {
 		return nil, err
 	}
 
-	go func() {
-		err = h.emailService.SendVerificationEmail(usr.Email().String(), verification.Token)
-		if err != nil {
-			log.Printf("failed to send verification email to %s: %v", usr.Email().String(), err)
-		}
-	}()
-
 	userDto := mappers.MapToUserDTO(usr)
 	userResponse := mappers.MapToUserResponse(userDto, usr.LastLoginAt(), usr.CreatedAt(), usr.UpdatedAt(), usr.DeletedAt())
 	return userResponse, nil
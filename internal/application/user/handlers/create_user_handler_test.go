@@ -19,9 +19,10 @@ func TestUserHandler_HandleCreate(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
+	mockEmailRepository := new(MockEmailRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, mockEmailRepository, new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	username, email, password, gender, birth, country, language, phone := valueObjects(cmd.Username, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth, cmd.Country, cmd.Language, cmd.Phone, t)
@@ -36,6 +37,7 @@ func TestUserHandler_HandleCreate(t *testing.T) {
 		}), gender, birth, country, language, &phone).Return(usr, nil)
 
 	mockRepository.On("Create", ctx, usr).Return(usr, nil)
+	mockEmailRepository.On("Save", ctx, mock.AnythingOfType("*email.EmailVerification")).Return(nil)
 
 	resp, err := handler.HandleCreate(ctx, cmd)
 
@@ -66,6 +68,7 @@ func TestUserHandler_HandleCreate(t *testing.T) {
 	assert.Nil(t, resp.DeletedAt)
 
 	mockRepository.AssertExpectations(t)
+	mockEmailRepository.AssertExpectations(t)
 	mockFactory.AssertExpectations(t)
 }
 
@@ -75,7 +78,7 @@ func TestUserHandler_HandleCreate_UsernameError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Username = ""
@@ -109,7 +112,7 @@ func TestUserHandler_HandleCreate_EmailError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Email = ""
@@ -143,7 +146,7 @@ func TestUserHandler_HandleCreate_PasswordError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Password = ""
@@ -177,7 +180,7 @@ func TestUserHandler_HandleCreate_GenderError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Gender = "X"
@@ -193,7 +196,7 @@ func TestUserHandler_HandleCreate_BirthError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Birth = time.Now().AddDate(1, 0, 0)
@@ -215,7 +218,7 @@ func TestUserHandler_HandleCreate_CountryError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Country = "X"
@@ -231,7 +234,7 @@ func TestUserHandler_HandleCreate_LanguageError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	cmd.Language = "X"
@@ -247,7 +250,7 @@ func TestUserHandler_HandleCreate_PhoneError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	newPhone := "a"
@@ -288,7 +291,7 @@ func TestUserHandler_HandleCreate_ExistenceByUserNameCheck(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepository := new(MockRepository)
 			mockFactory := new(MockFactory)
-			handler := NewUserHandler(mockRepository, mockFactory)
+			handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 			cmd := validCreateUserCommand()
 
 			mockRepository.On("ExistsByUserName", ctx, cmd.Username).Return(tc.exist, tc.repoError)
@@ -320,7 +323,7 @@ func TestUserHandler_HandleCreate_ExistenceByEmailCheck(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepository := new(MockRepository)
 			mockFactory := new(MockFactory)
-			handler := NewUserHandler(mockRepository, mockFactory)
+			handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 			cmd := validCreateUserCommand()
 
 			mockRepository.On("ExistsByUserName", ctx, cmd.Username).Return(false, nil)
@@ -343,7 +346,7 @@ func TestUserHandler_HandleCreate_FactoryError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	mockRepository.On("ExistsByUserName", ctx, cmd.Username).Return(false, nil)
@@ -365,7 +368,7 @@ func TestUserHandler_HandleCreate_CreateError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validCreateUserCommand()
 
 	mockRepository.On("ExistsByUserName", ctx, cmd.Username).Return(false, nil)
@@ -19,7 +19,7 @@ func TestUserHandler_HandleDelete(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -30,7 +30,7 @@ func TestUserHandler_HandleDelete(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, id).Return(true, nil)
 	mockRepository.On("GetById", ctx, id).Return(usr, nil)
-	mockRepository.On("Delete", ctx, usr).Return(usr, nil)
+	mockRepository.On("Delete", ctx, usr).Return(nil)
 
 	resp, err := handler.HandleDelete(ctx, id)
 
@@ -49,7 +49,7 @@ func TestUserHandler_HandleDelete_IdError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.Nil
 
 	resp, err := handler.HandleDelete(ctx, id)
@@ -64,7 +64,7 @@ func TestUserHandler_HandleDelete_ExistError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	mockRepository.On("ExistsById", ctx, id).Return(false, errors.New("new error"))
@@ -83,7 +83,7 @@ func TestUserHandler_HandleDelete_NotFoundError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	mockRepository.On("ExistsById", ctx, id).Return(false, nil)
@@ -102,7 +102,7 @@ func TestUserHandler_HandleDelete_GetByIdError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -128,7 +128,7 @@ func TestUserHandler_HandleDelete_ChangeDeleteError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -156,7 +156,7 @@ func TestUserHandler_HandleDelete_DeleteError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -167,7 +167,7 @@ func TestUserHandler_HandleDelete_DeleteError(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, id).Return(true, nil)
 	mockRepository.On("GetById", ctx, id).Return(usr, nil)
-	mockRepository.On("Delete", ctx, usr).Return(nil, errors.New("mock repository error"))
+	mockRepository.On("Delete", ctx, usr).Return(errors.New("mock repository error"))
 
 	resp, err := handler.HandleDelete(ctx, id)
 
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+type FollowHandler struct {
+	repository  follows.FollowRepository
+	userRepo    users.UserRepository
+	blockRepo   blocks.BlockRepository
+	notifier    notifications.Notifier
+	distributor feeds.Distributor
+	logger      application.Logger
+}
+
+func NewFollowHandler(repository follows.FollowRepository, userRepo users.UserRepository, blockRepo blocks.BlockRepository, notifier notifications.Notifier, distributor feeds.Distributor, logger application.Logger) *FollowHandler {
+	return &FollowHandler{
+		repository:  repository,
+		userRepo:    userRepo,
+		blockRepo:   blockRepo,
+		notifier:    notifier,
+		distributor: distributor,
+		logger:      logger,
+	}
+}
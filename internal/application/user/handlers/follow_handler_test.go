@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockFollowRepository struct {
+	mock.Mock
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+type MockDistributor struct {
+	mock.Mock
+}
+
+func TestNewFollowHandler(t *testing.T) {
+	repository := new(MockFollowRepository)
+	userRepo := new(MockRepository)
+	blockRepo := new(MockBlockRepository)
+	notifier := new(MockNotifier)
+	distributor := new(MockDistributor)
+	logger := new(MockLogger)
+	handler := NewFollowHandler(repository, userRepo, blockRepo, notifier, distributor, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, userRepo, handler.userRepo)
+	require.Exactly(t, blockRepo, handler.blockRepo)
+	require.Exactly(t, notifier, handler.notifier)
+	require.Exactly(t, distributor, handler.distributor)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestFollowHandler_HandleFollow(t *testing.T) {
+	ctx := context.Background()
+	repository, userRepo, blockRepo, notifier, distributor := new(MockFollowRepository), new(MockRepository), new(MockBlockRepository), new(MockNotifier), new(MockDistributor)
+	handler := NewFollowHandler(repository, userRepo, blockRepo, notifier, distributor, new(MockLogger))
+
+	cmd := commands.FollowUserCommand{
+		FollowerId: uuid.New(),
+		FolloweeId: uuid.New(),
+	}
+
+	userRepo.On("ExistsById", ctx, cmd.FolloweeId).Return(true, nil)
+	blockRepo.On("ExistsAmong", ctx, []uuid.UUID{cmd.FollowerId, cmd.FolloweeId}).Return(false, nil)
+	repository.On("Exists", ctx, cmd.FollowerId, cmd.FolloweeId).Return(false, nil)
+	repository.On("Create", ctx, mock.AnythingOfType("*follows.Follow")).Return(nil)
+	notifier.On("Notify", ctx, cmd.FolloweeId, cmd.FollowerId, notifications.FollowKind, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return()
+	source, _ := feeds.NewSource(feeds.UserSource, cmd.FolloweeId)
+	distributor.On("Followed", ctx, cmd.FollowerId, source).Return()
+
+	follow, err := handler.HandleFollow(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.FollowerId, follow.FollowerId)
+	assert.Equal(t, cmd.FolloweeId, follow.FolloweeId)
+	repository.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+	blockRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+	distributor.AssertExpectations(t)
+}
+
+func TestFollowHandler_HandleFollow_Errors(t *testing.T) {
+	ctx := context.Background()
+	followerId, followeeId := uuid.New(), uuid.New()
+
+	cases := []struct {
+		name  string
+		cmd   commands.FollowUserCommand
+		setup func(repository *MockFollowRepository, userRepo *MockRepository, blockRepo *MockBlockRepository)
+		err   error
+	}{
+		{
+			name: "self",
+			cmd:  commands.FollowUserCommand{FollowerId: followerId, FolloweeId: followerId},
+			err:  follows.ErrSelfFollow,
+		},
+		{
+			name: "unknown user",
+			cmd:  commands.FollowUserCommand{FollowerId: followerId, FolloweeId: followeeId},
+			setup: func(repository *MockFollowRepository, userRepo *MockRepository, blockRepo *MockBlockRepository) {
+				userRepo.On("ExistsById", ctx, followeeId).Return(false, nil)
+			},
+			err: users.ErrNotFoundUser,
+		},
+		{
+			name: "blocked",
+			cmd:  commands.FollowUserCommand{FollowerId: followerId, FolloweeId: followeeId},
+			setup: func(repository *MockFollowRepository, userRepo *MockRepository, blockRepo *MockBlockRepository) {
+				userRepo.On("ExistsById", ctx, followeeId).Return(true, nil)
+				blockRepo.On("ExistsAmong", ctx, []uuid.UUID{followerId, followeeId}).Return(true, nil)
+			},
+			err: blocks.ErrBlockedUser,
+		},
+		{
+			name: "already following",
+			cmd:  commands.FollowUserCommand{FollowerId: followerId, FolloweeId: followeeId},
+			setup: func(repository *MockFollowRepository, userRepo *MockRepository, blockRepo *MockBlockRepository) {
+				userRepo.On("ExistsById", ctx, followeeId).Return(true, nil)
+				blockRepo.On("ExistsAmong", ctx, []uuid.UUID{followerId, followeeId}).Return(false, nil)
+				repository.On("Exists", ctx, followerId, followeeId).Return(true, nil)
+			},
+			err: follows.ErrExistsFollow,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository, userRepo, blockRepo, notifier, distributor := new(MockFollowRepository), new(MockRepository), new(MockBlockRepository), new(MockNotifier), new(MockDistributor)
+			handler := NewFollowHandler(repository, userRepo, blockRepo, notifier, distributor, new(MockLogger))
+			if tc.setup != nil {
+				tc.setup(repository, userRepo, blockRepo)
+			}
+
+			follow, err := handler.HandleFollow(ctx, tc.cmd)
+
+			assert.Nil(t, follow)
+			assert.ErrorIs(t, err, tc.err)
+			notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			repository.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+			blockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFollowHandler_HandleUnfollow(t *testing.T) {
+	ctx := context.Background()
+	repository, distributor := new(MockFollowRepository), new(MockDistributor)
+	handler := NewFollowHandler(repository, new(MockRepository), new(MockBlockRepository), new(MockNotifier), distributor, new(MockLogger))
+
+	cmd := commands.UnfollowUserCommand{
+		FollowerId: uuid.New(),
+		FolloweeId: uuid.New(),
+	}
+
+	repository.On("Exists", ctx, cmd.FollowerId, cmd.FolloweeId).Return(true, nil)
+	repository.On("Delete", ctx, mock.AnythingOfType("*follows.Follow")).Return(nil)
+	source, _ := feeds.NewSource(feeds.UserSource, cmd.FolloweeId)
+	distributor.On("Unfollowed", ctx, cmd.FollowerId, source).Return()
+
+	err := handler.HandleUnfollow(ctx, cmd)
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+	distributor.AssertExpectations(t)
+}
+
+func TestFollowHandler_HandleUnfollow_NotFollowing(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockFollowRepository)
+	handler := NewFollowHandler(repository, new(MockRepository), new(MockBlockRepository), new(MockNotifier), new(MockDistributor), new(MockLogger))
+
+	cmd := commands.UnfollowUserCommand{
+		FollowerId: uuid.New(),
+		FolloweeId: uuid.New(),
+	}
+
+	repository.On("Exists", ctx, cmd.FollowerId, cmd.FolloweeId).Return(false, nil)
+
+	err := handler.HandleUnfollow(ctx, cmd)
+
+	assert.ErrorIs(t, err, follows.ErrNotFoundFollow)
+	repository.AssertExpectations(t)
+}
+
+func (m *MockFollowRepository) GetFollowers(ctx context.Context, userId uuid.UUID) ([]*follows.Follow, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*follows.Follow), args.Error(1)
+}
+
+func (m *MockFollowRepository) GetFollowing(ctx context.Context, userId uuid.UUID) ([]*follows.Follow, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*follows.Follow), args.Error(1)
+}
+
+func (m *MockFollowRepository) Exists(ctx context.Context, followerId, followeeId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, followerId, followeeId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFollowRepository) Create(ctx context.Context, f *follows.Follow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockFollowRepository) Delete(ctx context.Context, f *follows.Follow) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, recipientId, actorId uuid.UUID, kind notifications.Kind, pinId, commentId *uuid.UUID) {
+	m.Called(ctx, recipientId, actorId, kind, pinId, commentId)
+}
+
+func (m *MockDistributor) Distribute(ctx context.Context, pin *pins.Pin) {
+	m.Called(ctx, pin)
+}
+
+func (m *MockDistributor) Followed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	m.Called(ctx, userId, source)
+}
+
+func (m *MockDistributor) Unfollowed(ctx context.Context, userId uuid.UUID, source feeds.Source) {
+	m.Called(ctx, userId, source)
+}
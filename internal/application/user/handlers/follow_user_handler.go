@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+func (h *FollowHandler) HandleFollow(ctx context.Context, cmd commands.FollowUserCommand) (*dto.FollowDTO, error) {
+	follow, err := follows.NewFollow(cmd.FollowerId, cmd.FolloweeId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.userRepo.ExistsById(ctx, cmd.FolloweeId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, users.ErrNotFoundUser
+	}
+
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{cmd.FollowerId, cmd.FolloweeId})
+	if err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, blocks.ErrBlockedUser
+	}
+
+	exist, err = h.repository.Exists(ctx, cmd.FollowerId, cmd.FolloweeId)
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, follows.ErrExistsFollow
+	}
+
+	if err = h.repository.Create(ctx, follow); err != nil {
+		h.logger.Error("Could not follow %s: %v", cmd.FolloweeId, err)
+		return nil, err
+	}
+
+	h.notifier.Notify(ctx, cmd.FolloweeId, cmd.FollowerId, notifications.FollowKind, nil, nil)
+
+	source, _ := feeds.NewSource(feeds.UserSource, cmd.FolloweeId)
+	h.distributor.Followed(ctx, cmd.FollowerId, source)
+
+	return mappers.MapToFollowDTO(follow), nil
+}
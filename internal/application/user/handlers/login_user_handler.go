@@ -5,36 +5,60 @@ import (
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	"golang.org/x/crypto/bcrypt"
+	"sync"
+	"time"
 )
 
+// dummyHash is what passwords are compared against when no account has the
+// email, so a login takes as long whether the account exists or not.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("no account has this email"), bcrypt.DefaultCost)
+	return hash
+})
+
+// HandleLogin answers ErrInvalidCredentialsUser whether the email or the
+// password is wrong. Failures are counted against the email and the address
+// the login came from, which past a few have to wait between attempts, and
+// an email failing too often is locked and its owner emailed an unlock link.
 func (h *UserHandler) HandleLogin(ctx context.Context, cmd commands.LoginUserCommand) (*dto.UserResponse, error) {
 	email, err := shared.NewEmail(cmd.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	exists, err := h.repository.ExistsByEmail(ctx, cmd.Email)
-	if err != nil {
-		return nil, err
-	} else if !exists {
-		return nil, users.ErrNotFoundUser
+	account, address := attempts.AccountKey(email.String()), ""
+	if cmd.IP != "" {
+		address = attempts.IPKey(cmd.IP)
 	}
 
-	usr, err := h.repository.GetByEmail(ctx, email.String())
-	if err != nil {
+	if err = h.hold(ctx, account, address); err != nil {
 		return nil, err
 	}
 
-	password, err := shared.NewPassword(cmd.Password)
+	exists, err := h.repository.ExistsByEmail(ctx, cmd.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(usr.Password().String()), []byte(password.String())); err != nil {
-		return nil, users.ErrInvalidCredentialsUser
+	var usr *users.User
+	hash := dummyHash()
+	if exists {
+		if usr, err = h.repository.GetByEmail(ctx, email.String()); err != nil {
+			return nil, err
+		}
+		hash = []byte(usr.Password().String())
+	}
+
+	if err = bcrypt.CompareHashAndPassword(hash, []byte(cmd.Password)); err != nil || usr == nil {
+		return nil, h.fail(ctx, usr, account, address)
+	}
+
+	if err = h.attemptStore.Reset(ctx, account); err != nil {
+		h.logger.Error("Could not reset failed logins of %s: %v", usr.Id(), err)
 	}
 
 	usr.ChangeLastLoginAt()
@@ -48,3 +72,92 @@ func (h *UserHandler) HandleLogin(ctx context.Context, cmd commands.LoginUserCom
 
 	return userResponse, nil
 }
+
+// hold keeps out the logins of a locked account and makes the account and
+// the address wait between attempts once they failed too often.
+func (h *UserHandler) hold(ctx context.Context, account, address string) error {
+	failures, locked, err := h.attemptStore.Get(ctx, account)
+	if err != nil {
+		return err
+	} else if locked > 0 {
+		return &attempts.RetryError{Err: attempts.ErrLockedAccount, After: locked}
+	}
+
+	if err = h.wait(ctx, account, h.policy.AccountDelay(failures)); err != nil || address == "" {
+		return err
+	}
+
+	failures, _, err = h.attemptStore.Get(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	return h.wait(ctx, address, h.policy.IPDelay(failures))
+}
+
+func (h *UserHandler) wait(ctx context.Context, key string, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	wait, err := h.attemptStore.Wait(ctx, key, delay)
+	if err != nil {
+		return err
+	} else if wait > 0 {
+		return &attempts.RetryError{Err: attempts.ErrTooManyAttempts, After: wait}
+	}
+
+	return nil
+}
+
+// fail counts a failed login against the account and the address. The
+// account is locked once it failed too often, and when a user has the email
+// they are sent a link to unlock it. Only the failure that sets the lock
+// sends one, as logins racing it may reach the count too.
+func (h *UserHandler) fail(ctx context.Context, usr *users.User, account, address string) error {
+	if address != "" {
+		if _, err := h.attemptStore.Fail(ctx, address, h.policy.Window); err != nil {
+			return err
+		}
+	}
+
+	failures, err := h.attemptStore.Fail(ctx, account, h.policy.Window)
+	if err != nil {
+		return err
+	} else if !h.policy.Locks(failures) {
+		return users.ErrInvalidCredentialsUser
+	}
+
+	locked, err := h.attemptStore.Lock(ctx, account, h.policy.LockoutDuration)
+	if err != nil {
+		return err
+	} else if locked && usr != nil {
+		h.sendUnlock(ctx, usr, account)
+	}
+
+	return &attempts.RetryError{Err: attempts.ErrLockedAccount, After: h.policy.LockoutDuration}
+}
+
+// sendUnlock only logs what goes wrong, since the account is locked either way
+// and unlocks on its own once the lock expires. An account locked again while
+// the link emailed before still works is not sent another.
+func (h *UserHandler) sendUnlock(ctx context.Context, usr *users.User, account string) {
+	if wait, err := h.attemptStore.Wait(ctx, attempts.UnlockEmailKey(account), h.policy.UnlockTTL); err != nil {
+		h.logger.Error("Could not hold back unlock emails to %s: %v", usr.Id(), err)
+		return
+	} else if wait > 0 {
+		return
+	}
+
+	unlock, secret, err := attempts.NewUnlock(usr.Email().String(), h.policy.UnlockTTL)
+	if err == nil {
+		err = h.unlockStore.Save(ctx, unlock)
+	}
+	if err == nil {
+		err = h.emailService.SendUnlockEmail(usr.Email().String(), secret)
+	}
+
+	if err != nil {
+		h.logger.Error("Could not send unlock email to %s: %v", usr.Id(), err)
+	}
+}
@@ -5,37 +5,38 @@ import (
 	"errors"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 	"testing"
 	"time"
 )
 
+const (
+	loginAccountKey = "account:valid@email.com"
+	loginIPKey      = "ip:10.0.0.1"
+)
+
 func TestUserHandler_HandleLogin(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
+	usr := newLoginUser(cmd, t)
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
-	require.NoError(t, err)
-
-	password, err := shared.NewHashedPassword(string(hashedPassword))
-	require.NoError(t, err)
-
-	username, email, _, gender, birth, country, language, phone := valueObjects("username", cmd.Email, string(hashedPassword), "Male", time.Now().AddDate(-20, -10, -5), "Bolivia", "Spanish", nil, t)
-
-	usr := users.NewUser("john", "doe", username, email, password, gender, birth, country, language, phone)
-
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
 	mockRepository.On("GetByEmail", ctx, cmd.Email).Return(usr, nil)
-	mockRepository.On("Update", ctx, usr).Return(usr, nil)
+	mockAttempts.On("Reset", ctx, loginAccountKey).Return(nil)
+	mockRepository.On("Update", ctx, usr).Return(nil)
 
 	time.Sleep(5 * time.Millisecond)
 
@@ -48,15 +49,16 @@ func TestUserHandler_HandleLogin(t *testing.T) {
 	assert.True(t, resp.LastLoginAt.After(resp.CreatedAt))
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
 func TestUserHandler_HandleLogin_EmailError(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
 
 	cmd.Email = "invalid"
@@ -67,17 +69,20 @@ func TestUserHandler_HandleLogin_EmailError(t *testing.T) {
 	require.Error(t, err)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
 func TestUserHandler_HandleLogin_ExistsError(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
 
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(false, errors.New("new error"))
 
 	resp, err := handler.HandleLogin(ctx, cmd)
@@ -86,36 +91,44 @@ func TestUserHandler_HandleLogin_ExistsError(t *testing.T) {
 	require.Error(t, err)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
-func TestUserHandler_HandleLogin_NotFoundError(t *testing.T) {
+func TestUserHandler_HandleLogin_UnknownEmail(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
 
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(false, nil)
+	mockAttempts.On("Fail", ctx, loginIPKey, 15*time.Minute).Return(1, nil)
+	mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(1, nil)
 
 	resp, err := handler.HandleLogin(ctx, cmd)
 
 	require.Nil(t, resp)
-	require.ErrorIs(t, err, users.ErrNotFoundUser)
+	require.ErrorIs(t, err, users.ErrInvalidCredentialsUser)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
 func TestUserHandler_HandleLogin_GetByEmailError(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
 
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
 	mockRepository.On("GetByEmail", ctx, cmd.Email).Return(nil, users.ErrNotFoundUser)
 
@@ -125,30 +138,73 @@ func TestUserHandler_HandleLogin_GetByEmailError(t *testing.T) {
 	require.ErrorIs(t, err, users.ErrNotFoundUser)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
 func TestUserHandler_HandleLogin_PasswordError(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
+	usr := newLoginUser(cmd, t)
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
-	require.NoError(t, err)
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
+	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
+	mockRepository.On("GetByEmail", ctx, cmd.Email).Return(usr, nil)
+	mockAttempts.On("Fail", ctx, loginIPKey, 15*time.Minute).Return(1, nil)
+	mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(1, nil)
 
-	password, err := shared.NewHashedPassword(string(hashedPassword))
-	require.NoError(t, err)
+	cmd.Password = "softpassword"
 
-	username, email, _, gender, birth, country, language, phone := valueObjects("username", cmd.Email, string(hashedPassword), "Male", time.Now().AddDate(-20, -10, -5), "Bolivia", "Spanish", nil, t)
-	usr := users.NewUser("john", "doe", username, email, password, gender, birth, country, language, phone)
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, users.ErrInvalidCredentialsUser)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_UpdateError(t *testing.T) {
+	ctx := context.Background()
 
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+	usr := newLoginUser(cmd, t)
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(0, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
 	mockRepository.On("GetByEmail", ctx, cmd.Email).Return(usr, nil)
+	mockAttempts.On("Reset", ctx, loginAccountKey).Return(nil)
+	mockRepository.On("Update", ctx, usr).Return(errors.New("new error"))
 
-	cmd.Password = "softpassword"
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.Error(t, err)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_AttemptStoreError(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), errors.New("redis down"))
 
 	resp, err := handler.HandleLogin(ctx, cmd)
 
@@ -156,36 +212,218 @@ func TestUserHandler_HandleLogin_PasswordError(t *testing.T) {
 	require.Error(t, err)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
-func TestUserHandler_HandleLogin_UpdateError(t *testing.T) {
+func TestUserHandler_HandleLogin_Locked(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepository := new(MockRepository)
-	mockFactory := new(MockFactory)
+	mockAttempts := new(MockAttemptStore)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validLoginCommand()
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
-	require.NoError(t, err)
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(10, 5*time.Minute, nil)
 
-	password, err := shared.NewHashedPassword(string(hashedPassword))
-	require.NoError(t, err)
+	resp, err := handler.HandleLogin(ctx, cmd)
 
-	username, email, _, gender, birth, country, language, phone := valueObjects("username", cmd.Email, string(hashedPassword), "Male", time.Now().AddDate(-20, -10, -5), "Bolivia", "Spanish", nil, t)
-	usr := users.NewUser("john", "doe", username, email, password, gender, birth, country, language, phone)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, attempts.ErrLockedAccount)
+
+	var retry *attempts.RetryError
+	require.ErrorAs(t, err, &retry)
+	assert.Equal(t, 5*time.Minute, retry.After)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_AccountWait(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(5, time.Duration(0), nil)
+	mockAttempts.On("Wait", ctx, loginAccountKey, 2*time.Second).Return(1500*time.Millisecond, nil)
+
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, attempts.ErrTooManyAttempts)
 
+	var retry *attempts.RetryError
+	require.ErrorAs(t, err, &retry)
+	assert.Equal(t, 1500*time.Millisecond, retry.After)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_IPWait(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(4, time.Duration(0), nil)
+	mockAttempts.On("Wait", ctx, loginAccountKey, time.Second).Return(time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(22, time.Duration(0), nil)
+	mockAttempts.On("Wait", ctx, loginIPKey, 2*time.Second).Return(time.Second, nil)
+
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, attempts.ErrTooManyAttempts)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_WithoutIP(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, new(MockUnlockStore), testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+	cmd.IP = ""
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(0, time.Duration(0), nil)
+	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(false, nil)
+	mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(1, nil)
+
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, users.ErrInvalidCredentialsUser)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_Lockout(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+	mockUnlocks := new(MockUnlockStore)
+	sender := new(MockSender)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), sender, new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+	usr := newLoginUser(cmd, t)
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(9, time.Duration(0), nil)
+	mockAttempts.On("Wait", ctx, loginAccountKey, 30*time.Second).Return(time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(9, time.Duration(0), nil)
 	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
 	mockRepository.On("GetByEmail", ctx, cmd.Email).Return(usr, nil)
-	mockRepository.On("Update", ctx, usr).Return(nil, errors.New("new error"))
+	mockAttempts.On("Fail", ctx, loginIPKey, 15*time.Minute).Return(10, nil)
+	mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(10, nil)
+	mockAttempts.On("Lock", ctx, loginAccountKey, 15*time.Minute).Return(true, nil)
+	mockAttempts.On("Wait", ctx, attempts.UnlockEmailKey(loginAccountKey), time.Hour).Return(time.Duration(0), nil)
+	mockUnlocks.On("Save", ctx, mock.MatchedBy(func(u *attempts.Unlock) bool {
+		return u.Email() == cmd.Email
+	})).Return(nil)
+
+	cmd.Password = "wrongP4ssword!"
 
 	resp, err := handler.HandleLogin(ctx, cmd)
 
 	require.Nil(t, resp)
-	require.Error(t, err)
+	require.ErrorIs(t, err, attempts.ErrLockedAccount)
+	assert.Equal(t, []string{cmd.Email}, sender.unlocks)
 
 	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+	mockUnlocks.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_LockoutUnknownEmail(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	mockAttempts := new(MockAttemptStore)
+	mockUnlocks := new(MockUnlockStore)
+	sender := new(MockSender)
+
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), sender, new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+	cmd := validLoginCommand()
+
+	mockAttempts.On("Get", ctx, loginAccountKey).Return(9, time.Duration(0), nil)
+	mockAttempts.On("Wait", ctx, loginAccountKey, 30*time.Second).Return(time.Duration(0), nil)
+	mockAttempts.On("Get", ctx, loginIPKey).Return(9, time.Duration(0), nil)
+	mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(false, nil)
+	mockAttempts.On("Fail", ctx, loginIPKey, 15*time.Minute).Return(10, nil)
+	mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(10, nil)
+	mockAttempts.On("Lock", ctx, loginAccountKey, 15*time.Minute).Return(true, nil)
+
+	resp, err := handler.HandleLogin(ctx, cmd)
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, attempts.ErrLockedAccount)
+	assert.Empty(t, sender.unlocks)
+
+	mockRepository.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+	mockUnlocks.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleLogin_LockoutNoUnlockEmail(t *testing.T) {
+	cases := []struct {
+		name   string
+		locked bool
+		wait   time.Duration
+	}{
+		{name: "Locked already", locked: false},
+		{name: "Link emailed before", locked: true, wait: 40 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockRepository := new(MockRepository)
+			mockAttempts := new(MockAttemptStore)
+			mockUnlocks := new(MockUnlockStore)
+			sender := new(MockSender)
+
+			handler := NewUserHandler(mockRepository, new(MockEmailRepository), sender, new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+			cmd := validLoginCommand()
+			usr := newLoginUser(cmd, t)
+
+			mockAttempts.On("Get", ctx, loginAccountKey).Return(9, time.Duration(0), nil)
+			mockAttempts.On("Wait", ctx, loginAccountKey, 30*time.Second).Return(time.Duration(0), nil)
+			mockAttempts.On("Get", ctx, loginIPKey).Return(9, time.Duration(0), nil)
+			mockRepository.On("ExistsByEmail", ctx, cmd.Email).Return(true, nil)
+			mockRepository.On("GetByEmail", ctx, cmd.Email).Return(usr, nil)
+			mockAttempts.On("Fail", ctx, loginIPKey, 15*time.Minute).Return(10, nil)
+			mockAttempts.On("Fail", ctx, loginAccountKey, 15*time.Minute).Return(10, nil)
+			mockAttempts.On("Lock", ctx, loginAccountKey, 15*time.Minute).Return(tc.locked, nil)
+			if tc.locked {
+				mockAttempts.On("Wait", ctx, attempts.UnlockEmailKey(loginAccountKey), time.Hour).Return(tc.wait, nil)
+			}
+
+			cmd.Password = "wrongP4ssword!"
+
+			resp, err := handler.HandleLogin(ctx, cmd)
+
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, attempts.ErrLockedAccount)
+			assert.Empty(t, sender.unlocks)
+			mockAttempts.AssertExpectations(t)
+			mockUnlocks.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
 }
 
 func validLoginCommand() commands.LoginUserCommand {
@@ -195,7 +433,20 @@ func validLoginCommand() commands.LoginUserCommand {
 	cmd := commands.LoginUserCommand{
 		Email:    email,
 		Password: password,
+		IP:       "10.0.0.1",
 	}
 
 	return cmd
 }
+
+func newLoginUser(cmd commands.LoginUserCommand, t *testing.T) *users.User {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
+	require.NoError(t, err)
+
+	password, err := shared.NewHashedPassword(string(hashedPassword))
+	require.NoError(t, err)
+
+	username, email, _, gender, birth, country, language, phone := valueObjects("username", cmd.Email, string(hashedPassword), "Male", time.Now().AddDate(-20, -10, -5), "Bolivia", "Spanish", nil, t)
+
+	return users.NewUser("john", "doe", username, email, password, gender, birth, country, language, phone)
+}
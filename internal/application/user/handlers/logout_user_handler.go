@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// HandleLogout logs the user out of every session, as when they lose a role
+// or their account is deleted.
+func (h *RoleHandler) HandleLogout(ctx context.Context, userId uuid.UUID) error {
+	now := time.Now()
+	active, err := h.sessions.GetActiveByUser(ctx, userId, now)
+	if err != nil {
+		return err
+	}
+
+	if err = h.tokenRepo.RevokeUser(ctx, userId, now); err != nil {
+		h.logger.Error("Could not revoke refresh tokens of user %s: %v", userId, err)
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(active))
+	for i, session := range active {
+		ids[i] = session.Id()
+	}
+
+	if err = h.revoker.RevokeSessions(ids, h.accessTTL); err != nil {
+		h.logger.Error("Could not revoke access tokens of sessions %v: %v", ids, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+)
+
+func (h *BlockHandler) HandleMute(ctx context.Context, cmd commands.MuteUserCommand) (*dto.MuteDTO, error) {
+	mute, err := mutes.NewMute(cmd.MuterId, cmd.MutedId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := h.userRepo.ExistsById(ctx, cmd.MutedId)
+	if err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, users.ErrNotFoundUser
+	}
+
+	exist, err = h.muteRepo.Exists(ctx, cmd.MuterId, cmd.MutedId)
+	if err != nil {
+		return nil, err
+	} else if exist {
+		return nil, mutes.ErrExistsMute
+	}
+
+	if err = h.muteRepo.Create(ctx, mute); err != nil {
+		h.logger.Error("Could not mute %s: %v", cmd.MutedId, err)
+		return nil, err
+	}
+
+	return mappers.MapToMuteDTO(mute), nil
+}
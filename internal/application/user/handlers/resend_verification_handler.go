@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"time"
+)
+
+// HandleResendVerification emails a new verification link to the unverified
+// account of the address. Unknown, verified and rate limited accounts are
+// skipped without an error, so the answer cannot tell them apart.
+func (h *UserHandler) HandleResendVerification(ctx context.Context, cmd commands.ResendVerificationCommand) error {
+	em, err := shared.NewEmail(cmd.Email)
+	if err != nil {
+		return err
+	}
+
+	exists, err := h.repository.ExistsByEmail(ctx, em.String())
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+
+	usr, err := h.repository.GetByEmail(ctx, em.String())
+	if err != nil {
+		return err
+	} else if usr.IsVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	recent, err := h.emailRepo.CountSince(ctx, usr.Id(), now.Add(-email.ResendCooldown))
+	if err != nil {
+		return err
+	}
+
+	daily, err := h.emailRepo.CountSince(ctx, usr.Id(), now.Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	if recent > 0 || daily >= email.MaxSendsPerDay {
+		h.logger.Warn("Skipping verification email to user %s, %d sent today", usr.Id(), daily)
+		return nil
+	}
+
+	return h.sendVerification(ctx, usr)
+}
+
+func (h *UserHandler) sendVerification(ctx context.Context, usr *users.User) error {
+	ev, secret, err := email.NewEmailVerification(usr.Id())
+	if err != nil {
+		return err
+	}
+
+	if err = h.emailRepo.Save(ctx, ev); err != nil {
+		h.logger.Error("Could not store email verification for user %s: %v", usr.Id(), err)
+		return err
+	}
+
+	if err = h.emailService.SendVerificationEmail(usr.Email().String(), secret); err != nil {
+		h.logger.Error("Could not send verification email to user %s: %v", usr.Id(), err)
+	}
+
+	return nil
+}
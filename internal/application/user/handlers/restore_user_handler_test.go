@@ -19,7 +19,7 @@ func TestUserHandler_HandleRestore(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -32,7 +32,7 @@ func TestUserHandler_HandleRestore(t *testing.T) {
 	require.NotNil(t, usr.DeletedAt())
 
 	mockRepository.On("GetById", ctx, id).Return(usr, nil)
-	mockRepository.On("Delete", ctx, usr).Return(usr, nil)
+	mockRepository.On("Delete", ctx, usr).Return(nil)
 
 	resp, err := handler.HandleRestore(ctx, id)
 
@@ -50,7 +50,7 @@ func TestUserHandler_HandleRestore_IdError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.Nil
 
 	resp, err := handler.HandleRestore(ctx, id)
@@ -65,7 +65,7 @@ func TestUserHandler_HandleRestore_GetByIdError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	mockRepository.On("GetById", ctx, id).Return(nil, users.ErrNotFoundUser)
@@ -84,7 +84,7 @@ func TestUserHandler_HandleRestore_ChangeDeleteError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -109,7 +109,7 @@ func TestUserHandler_HandleRestore_RestoreError(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	id := uuid.New()
 
 	phoneStr := "+591-70926048"
@@ -122,7 +122,7 @@ func TestUserHandler_HandleRestore_RestoreError(t *testing.T) {
 	require.NotNil(t, usr.DeletedAt())
 
 	mockRepository.On("GetById", ctx, id).Return(usr, nil)
-	mockRepository.On("Delete", ctx, usr).Return(nil, errors.New("mock repository error"))
+	mockRepository.On("Delete", ctx, usr).Return(errors.New("mock repository error"))
 
 	resp, err := handler.HandleRestore(ctx, id)
 
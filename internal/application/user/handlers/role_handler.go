@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"time"
+)
+
+// RoleHandler changes the roles of users and checks who may act on whose
+// account. Access tokens carry the role they were signed with, so a user who
+// loses a role is logged out of every session: their refresh tokens are
+// revoked and revoker turns their access tokens away for the accessTTL they
+// may still live.
+type RoleHandler struct {
+	repository users.RoleRepository
+	sessions   sessions.SessionRepository
+	tokenRepo  tokens.RefreshTokenRepository
+	revoker    sessions.SessionRevoker
+	accessTTL  time.Duration
+	logger     application.Logger
+}
+
+func NewRoleHandler(repository users.RoleRepository, sessions sessions.SessionRepository, tokenRepo tokens.RefreshTokenRepository, revoker sessions.SessionRevoker, accessTTL time.Duration, logger application.Logger) *RoleHandler {
+	return &RoleHandler{
+		repository: repository,
+		sessions:   sessions,
+		tokenRepo:  tokenRepo,
+		revoker:    revoker,
+		accessTTL:  accessTTL,
+		logger:     logger,
+	}
+}
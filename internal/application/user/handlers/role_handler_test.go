@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+type MockSessionRevoker struct {
+	mock.Mock
+}
+
+type roleMocks struct {
+	repository *MockRoleRepository
+	sessions   *MockSessionRepository
+	tokenRepo  *MockRefreshTokenRepository
+	revoker    *MockSessionRevoker
+}
+
+func newTestRoleHandler() (*RoleHandler, roleMocks) {
+	m := roleMocks{
+		repository: new(MockRoleRepository),
+		sessions:   new(MockSessionRepository),
+		tokenRepo:  new(MockRefreshTokenRepository),
+		revoker:    new(MockSessionRevoker),
+	}
+	return NewRoleHandler(m.repository, m.sessions, m.tokenRepo, m.revoker, 15*time.Minute, new(MockLogger)), m
+}
+
+func TestNewRoleHandler(t *testing.T) {
+	repository, sessionRepo, tokenRepo, revoker, logger := new(MockRoleRepository), new(MockSessionRepository), new(MockRefreshTokenRepository), new(MockSessionRevoker), new(MockLogger)
+	handler := NewRoleHandler(repository, sessionRepo, tokenRepo, revoker, time.Minute, logger)
+
+	require.NotEmpty(t, handler)
+	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, sessionRepo, handler.sessions)
+	require.Exactly(t, tokenRepo, handler.tokenRepo)
+	require.Exactly(t, revoker, handler.revoker)
+	require.Equal(t, time.Minute, handler.accessTTL)
+	require.Exactly(t, logger, handler.logger)
+}
+
+func TestRoleHandler_HandleChangeRole_Promote(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestRoleHandler()
+	cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "moderator"}
+
+	m.repository.On("GetRole", ctx, cmd.Id).Return(users.RoleUser, nil)
+	m.repository.On("ChangeRole", ctx, cmd.Id, users.RoleModerator).Return(nil)
+
+	err := handler.HandleChangeRole(ctx, cmd)
+
+	require.NoError(t, err)
+	m.repository.AssertExpectations(t)
+	m.tokenRepo.AssertNotCalled(t, "RevokeUser", mock.Anything, mock.Anything, mock.Anything)
+	m.revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+func TestRoleHandler_HandleChangeRole_Demote(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestRoleHandler()
+	cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "user"}
+
+	first, _ := sessions.NewSession(cmd.Id, "", "")
+	second, _ := sessions.NewSession(cmd.Id, "", "")
+
+	m.repository.On("GetRole", ctx, cmd.Id).Return(users.RoleAdmin, nil)
+	m.repository.On("ChangeRole", ctx, cmd.Id, users.RoleUser).Return(nil)
+	m.sessions.On("GetActiveByUser", ctx, cmd.Id, mock.Anything).Return([]*sessions.Session{first, second}, nil)
+	m.tokenRepo.On("RevokeUser", ctx, cmd.Id, mock.Anything).Return(nil)
+	m.revoker.On("RevokeSessions", []uuid.UUID{first.Id(), second.Id()}, 15*time.Minute).Return(nil)
+
+	err := handler.HandleChangeRole(ctx, cmd)
+
+	require.NoError(t, err)
+	m.repository.AssertExpectations(t)
+	m.sessions.AssertExpectations(t)
+	m.tokenRepo.AssertExpectations(t)
+	m.revoker.AssertExpectations(t)
+}
+
+func TestRoleHandler_HandleChangeRole_Unchanged(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestRoleHandler()
+	cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "admin"}
+
+	m.repository.On("GetRole", ctx, cmd.Id).Return(users.RoleAdmin, nil)
+
+	err := handler.HandleChangeRole(ctx, cmd)
+
+	require.NoError(t, err)
+	m.repository.AssertNotCalled(t, "ChangeRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRoleHandler_HandleChangeRole_Errors(t *testing.T) {
+	ctx := context.Background()
+	dbErr := errors.New("database error")
+
+	t.Run("InvalidRole", func(t *testing.T) {
+		handler, m := newTestRoleHandler()
+
+		err := handler.HandleChangeRole(ctx, commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "root"})
+
+		assert.ErrorIs(t, err, users.ErrNotARole)
+		m.repository.AssertNotCalled(t, "GetRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("OwnRole", func(t *testing.T) {
+		handler, m := newTestRoleHandler()
+		id := uuid.New()
+
+		err := handler.HandleChangeRole(ctx, commands.ChangeRoleCommand{ActorId: id, Id: id, Role: "user"})
+
+		assert.ErrorIs(t, err, users.ErrOwnRole)
+		m.repository.AssertNotCalled(t, "GetRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		handler, m := newTestRoleHandler()
+		cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "admin"}
+		m.repository.On("GetRole", ctx, cmd.Id).Return(users.Role(""), users.ErrNotFoundUser)
+
+		err := handler.HandleChangeRole(ctx, cmd)
+
+		assert.ErrorIs(t, err, users.ErrNotFoundUser)
+	})
+
+	t.Run("ChangeRole", func(t *testing.T) {
+		handler, m := newTestRoleHandler()
+		cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "admin"}
+		m.repository.On("GetRole", ctx, cmd.Id).Return(users.RoleUser, nil)
+		m.repository.On("ChangeRole", ctx, cmd.Id, users.RoleAdmin).Return(dbErr)
+
+		err := handler.HandleChangeRole(ctx, cmd)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("RevokeUser", func(t *testing.T) {
+		handler, m := newTestRoleHandler()
+		cmd := commands.ChangeRoleCommand{ActorId: uuid.New(), Id: uuid.New(), Role: "user"}
+		m.repository.On("GetRole", ctx, cmd.Id).Return(users.RoleModerator, nil)
+		m.repository.On("ChangeRole", ctx, cmd.Id, users.RoleUser).Return(nil)
+		m.sessions.On("GetActiveByUser", ctx, cmd.Id, mock.Anything).Return([]*sessions.Session{}, nil)
+		m.tokenRepo.On("RevokeUser", ctx, cmd.Id, mock.Anything).Return(dbErr)
+
+		err := handler.HandleChangeRole(ctx, cmd)
+
+		assert.ErrorIs(t, err, dbErr)
+		m.revoker.AssertNotCalled(t, "RevokeSessions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRoleHandler_HandleActOn(t *testing.T) {
+	ctx := context.Background()
+	dbErr := errors.New("database error")
+
+	cases := []struct {
+		name   string
+		actor  users.Role
+		target users.Role
+		getErr error
+		err    error
+	}{
+		{name: "Moderator on user", actor: users.RoleModerator, target: users.RoleUser},
+		{name: "Admin on admin", actor: users.RoleAdmin, target: users.RoleAdmin},
+		{name: "Moderator on moderator", actor: users.RoleModerator, target: users.RoleModerator, err: users.ErrOutranked},
+		{name: "Moderator on admin", actor: users.RoleModerator, target: users.RoleAdmin, err: users.ErrOutranked},
+		{name: "Not found", actor: users.RoleAdmin, getErr: users.ErrNotFoundUser, err: users.ErrNotFoundUser},
+		{name: "Database", actor: users.RoleModerator, getErr: dbErr, err: dbErr},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, m := newTestRoleHandler()
+			cmd := commands.ActOnUserCommand{ActorId: uuid.New(), ActorRole: tc.actor.String(), Id: uuid.New()}
+			m.repository.On("GetRole", ctx, cmd.Id).Return(tc.target, tc.getErr)
+
+			err := handler.HandleActOn(ctx, cmd)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+			m.repository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRoleHandler_HandleActOn_WithoutLookup(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestRoleHandler()
+	id := uuid.New()
+
+	require.NoError(t, handler.HandleActOn(ctx, commands.ActOnUserCommand{ActorId: id, ActorRole: users.RoleUser.String(), Id: id}))
+
+	err := handler.HandleActOn(ctx, commands.ActOnUserCommand{ActorId: id, ActorRole: users.RoleUser.String(), Id: uuid.New()})
+	assert.ErrorIs(t, err, users.ErrOutranked)
+
+	m.repository.AssertNotCalled(t, "GetRole", mock.Anything, mock.Anything)
+}
+
+func TestRoleHandler_HandleLogout(t *testing.T) {
+	ctx := context.Background()
+	handler, m := newTestRoleHandler()
+	id := uuid.New()
+	session, _ := sessions.NewSession(id, "", "")
+
+	m.sessions.On("GetActiveByUser", ctx, id, mock.Anything).Return([]*sessions.Session{session}, nil)
+	m.tokenRepo.On("RevokeUser", ctx, id, mock.Anything).Return(nil)
+	m.revoker.On("RevokeSessions", []uuid.UUID{session.Id()}, 15*time.Minute).Return(nil)
+
+	err := handler.HandleLogout(ctx, id)
+
+	require.NoError(t, err)
+	m.sessions.AssertExpectations(t)
+	m.tokenRepo.AssertExpectations(t)
+	m.revoker.AssertExpectations(t)
+}
+
+func (m *MockRoleRepository) GetRole(ctx context.Context, id uuid.UUID) (users.Role, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(users.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) ChangeRole(ctx context.Context, id uuid.UUID, role users.Role) error {
+	args := m.Called(ctx, id, role)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	args := m.Called(ctx, userId, now)
+	return args.Get(0).([]*sessions.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *sessions.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	args := m.Called(ctx, id, ip, at)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.RefreshToken, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tokens.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *tokens.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, familyId, at)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, userId, at)
+	return args.Error(0)
+}
+
+func (m *MockSessionRevoker) RevokeSessions(ids []uuid.UUID, ttl time.Duration) error {
+	args := m.Called(ids, ttl)
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+)
+
+func (h *BlockHandler) HandleUnblock(ctx context.Context, cmd commands.UnblockUserCommand) error {
+	block, err := blocks.NewBlock(cmd.BlockerId, cmd.BlockedId)
+	if err != nil {
+		return err
+	}
+
+	exist, err := h.repository.Exists(ctx, cmd.BlockerId, cmd.BlockedId)
+	if err != nil {
+		return err
+	} else if !exist {
+		return blocks.ErrNotFoundBlock
+	}
+
+	return h.repository.Delete(ctx, block)
+}
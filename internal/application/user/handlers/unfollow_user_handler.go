@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+)
+
+func (h *FollowHandler) HandleUnfollow(ctx context.Context, cmd commands.UnfollowUserCommand) error {
+	follow, err := follows.NewFollow(cmd.FollowerId, cmd.FolloweeId)
+	if err != nil {
+		return err
+	}
+
+	exist, err := h.repository.Exists(ctx, cmd.FollowerId, cmd.FolloweeId)
+	if err != nil {
+		return err
+	} else if !exist {
+		return follows.ErrNotFoundFollow
+	}
+
+	if err = h.repository.Delete(ctx, follow); err != nil {
+		return err
+	}
+
+	source, _ := feeds.NewSource(feeds.UserSource, cmd.FolloweeId)
+	h.distributor.Unfollowed(ctx, cmd.FollowerId, source)
+
+	return nil
+}
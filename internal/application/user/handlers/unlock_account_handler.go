@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+)
+
+// HandleUnlock lifts the lock of the account the link was emailed for and
+// forgets its failed logins. A link works once.
+func (h *UserHandler) HandleUnlock(ctx context.Context, cmd commands.UnlockAccountCommand) error {
+	if cmd.Token == "" {
+		return attempts.ErrInvalidUnlock
+	}
+
+	unlock, err := h.unlockStore.Take(ctx, tokens.Hash(cmd.Token))
+	if err != nil {
+		return err
+	}
+
+	return h.attemptStore.Reset(ctx, attempts.AccountKey(unlock.Email()))
+}
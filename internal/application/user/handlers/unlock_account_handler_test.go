@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUserHandler_HandleUnlock(t *testing.T) {
+	ctx := context.Background()
+
+	mockAttempts := new(MockAttemptStore)
+	mockUnlocks := new(MockUnlockStore)
+
+	handler := NewUserHandler(new(MockRepository), new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+	unlock := attempts.NewUnlockFromStore(tokens.Hash("secret"), "Valid@Email.com", time.Now().Add(time.Hour))
+
+	mockUnlocks.On("Take", ctx, tokens.Hash("secret")).Return(unlock, nil)
+	mockAttempts.On("Reset", ctx, "account:valid@email.com").Return(nil)
+
+	err := handler.HandleUnlock(ctx, commands.UnlockAccountCommand{Token: "secret"})
+
+	require.NoError(t, err)
+
+	mockAttempts.AssertExpectations(t)
+	mockUnlocks.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleUnlock_EmptyToken(t *testing.T) {
+	ctx := context.Background()
+
+	mockUnlocks := new(MockUnlockStore)
+
+	handler := NewUserHandler(new(MockRepository), new(MockEmailRepository), new(MockSender), new(MockFactory), new(MockAttemptStore), mockUnlocks, testPolicy(), new(MockLogger))
+
+	err := handler.HandleUnlock(ctx, commands.UnlockAccountCommand{})
+
+	require.ErrorIs(t, err, attempts.ErrInvalidUnlock)
+
+	mockUnlocks.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleUnlock_InvalidToken(t *testing.T) {
+	ctx := context.Background()
+
+	mockAttempts := new(MockAttemptStore)
+	mockUnlocks := new(MockUnlockStore)
+
+	handler := NewUserHandler(new(MockRepository), new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+
+	mockUnlocks.On("Take", ctx, tokens.Hash("secret")).Return(nil, attempts.ErrInvalidUnlock)
+
+	err := handler.HandleUnlock(ctx, commands.UnlockAccountCommand{Token: "secret"})
+
+	require.ErrorIs(t, err, attempts.ErrInvalidUnlock)
+
+	mockAttempts.AssertExpectations(t)
+	mockUnlocks.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleUnlock_ResetError(t *testing.T) {
+	ctx := context.Background()
+
+	mockAttempts := new(MockAttemptStore)
+	mockUnlocks := new(MockUnlockStore)
+
+	handler := NewUserHandler(new(MockRepository), new(MockEmailRepository), new(MockSender), new(MockFactory), mockAttempts, mockUnlocks, testPolicy(), new(MockLogger))
+	unlock := attempts.NewUnlockFromStore(tokens.Hash("secret"), "valid@email.com", time.Now().Add(time.Hour))
+
+	mockUnlocks.On("Take", ctx, tokens.Hash("secret")).Return(unlock, nil)
+	mockAttempts.On("Reset", ctx, "account:valid@email.com").Return(errors.New("redis down"))
+
+	err := handler.HandleUnlock(ctx, commands.UnlockAccountCommand{Token: "secret"})
+
+	require.Error(t, err)
+
+	mockAttempts.AssertExpectations(t)
+	mockUnlocks.AssertExpectations(t)
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+)
+
+func (h *BlockHandler) HandleUnmute(ctx context.Context, cmd commands.UnmuteUserCommand) error {
+	mute, err := mutes.NewMute(cmd.MuterId, cmd.MutedId)
+	if err != nil {
+		return err
+	}
+
+	exist, err := h.muteRepo.Exists(ctx, cmd.MuterId, cmd.MutedId)
+	if err != nil {
+		return err
+	} else if !exist {
+		return mutes.ErrNotFoundMute
+	}
+
+	return h.muteRepo.Delete(ctx, mute)
+}
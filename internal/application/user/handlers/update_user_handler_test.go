@@ -23,7 +23,7 @@ func TestUserHandler_HandleUpdate(t *testing.T) {
 	mockRepository := new(MockRepository)
 	mockFactory := new(MockFactory)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -35,7 +35,7 @@ func TestUserHandler_HandleUpdate(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, usr).Return(usr, nil)
+	mockRepository.On("Update", ctx, usr).Return(nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -75,7 +75,7 @@ func TestUserHandler_HandleUpdate_IdError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 
 	cmd.Id = uuid.Nil
@@ -91,7 +91,7 @@ func TestUserHandler_HandleUpdate_ExistError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(false, errors.New("new error"))
@@ -110,7 +110,7 @@ func TestUserHandler_HandleUpdate_NotFoundError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(false, nil)
@@ -129,7 +129,7 @@ func TestUserHandler_HandleUpdate_GetByIdError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
@@ -149,7 +149,7 @@ func TestUserHandler_HandleUpdate_FirstNameError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -189,7 +189,7 @@ func TestUserHandler_HandleUpdate_LastNameError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -229,7 +229,7 @@ func TestUserHandler_HandleUpdate_UserNameError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -271,7 +271,7 @@ func TestUserHandler_HandleUpdate_EmailError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -313,7 +313,7 @@ func TestUserHandler_HandleUpdate_PasswordError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -355,7 +355,7 @@ func TestUserHandler_HandleUpdate_GenderError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -383,7 +383,7 @@ func TestUserHandler_HandleUpdate_BirthError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -423,7 +423,7 @@ func TestUserHandler_HandleUpdate_CountryError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -451,7 +451,7 @@ func TestUserHandler_HandleUpdate_LanguageError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -479,7 +479,7 @@ func TestUserHandler_HandleUpdate_PhoneError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cases := []struct {
 		name        string
@@ -520,7 +520,7 @@ func TestUserHandler_HandleUpdate_EmptyPhone(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, _ := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, nil, t)
 
@@ -532,7 +532,7 @@ func TestUserHandler_HandleUpdate_EmptyPhone(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(usr, nil)
+	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -549,7 +549,7 @@ func TestUserHandler_HandleUpdate_InformationError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -577,7 +577,7 @@ func TestUserHandler_HandleUpdate_EmptyInformation(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, nil, t)
 
@@ -589,7 +589,7 @@ func TestUserHandler_HandleUpdate_EmptyInformation(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(usr, nil)
+	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -606,7 +606,7 @@ func TestUserHandler_HandleUpdate_ProfilePicError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -634,7 +634,7 @@ func TestUserHandler_HandleUpdate_EmptyProfilePic(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, nil, t)
 
@@ -646,7 +646,7 @@ func TestUserHandler_HandleUpdate_EmptyProfilePic(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(usr, nil)
+	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -663,7 +663,7 @@ func TestUserHandler_HandleUpdate_WebSiteError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -691,7 +691,7 @@ func TestUserHandler_HandleUpdate_EmptyWebSite(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, nil, t)
 
@@ -703,7 +703,7 @@ func TestUserHandler_HandleUpdate_EmptyWebSite(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(usr, nil)
+	mockRepository.On("Update", ctx, mock.AnythingOfType("*users.User")).Return(nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -720,7 +720,7 @@ func TestUserHandler_HandleUpdate_UpdateError(t *testing.T) {
 	mockFactory := new(MockFactory)
 	mockRepository := new(MockRepository)
 
-	handler := NewUserHandler(mockRepository, mockFactory)
+	handler := NewUserHandler(mockRepository, new(MockEmailRepository), new(MockSender), mockFactory, new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
 
 	cmd := validUpdateUserCommand()
 	username, email, password, gender, birth, country, language, phone := valueObjects(*cmd.UserName, *cmd.Email, *cmd.Password, *cmd.Gender, *cmd.Birth, *cmd.Country, *cmd.Language, cmd.Phone, t)
@@ -730,7 +730,7 @@ func TestUserHandler_HandleUpdate_UpdateError(t *testing.T) {
 
 	mockRepository.On("ExistsById", ctx, cmd.Id).Return(true, nil)
 	mockRepository.On("GetById", ctx, cmd.Id).Return(usr, nil)
-	mockRepository.On("Update", ctx, usr).Return(nil, errors.New("mock repository error"))
+	mockRepository.On("Update", ctx, usr).Return(errors.New("mock repository error"))
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
 
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 )
@@ -11,15 +12,21 @@ type UserHandler struct {
 	emailRepo    email.EmailVerificationRepository
 	emailService email.Sender
 	factory      users.UserFactory
+	attemptStore attempts.AttemptStore
+	unlockStore  attempts.UnlockStore
+	policy       attempts.Policy
 	logger       application.Logger
 }
 
-func NewUserHandler(repository users.UserRepository, emailRepo email.EmailVerificationRepository, emailService email.Sender, factory users.UserFactory, logger application.Logger) *UserHandler {
+func NewUserHandler(repository users.UserRepository, emailRepo email.EmailVerificationRepository, emailService email.Sender, factory users.UserFactory, attemptStore attempts.AttemptStore, unlockStore attempts.UnlockStore, policy attempts.Policy, logger application.Logger) *UserHandler {
 	return &UserHandler{
 		repository:   repository,
 		emailRepo:    emailRepo,
 		emailService: emailService,
 		factory:      factory,
+		attemptStore: attemptStore,
+		unlockStore:  unlockStore,
+		policy:       policy,
 		logger:       logger,
 	}
 }
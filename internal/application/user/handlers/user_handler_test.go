@@ -3,6 +3,8 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	"github.com/google/uuid"
@@ -21,16 +23,46 @@ type MockRepository struct {
 	mock.Mock
 }
 
+type MockEmailRepository struct {
+	mock.Mock
+}
+
+type MockSender struct {
+	unlocks []string
+}
+
+type MockAttemptStore struct {
+	mock.Mock
+}
+
+type MockUnlockStore struct {
+	mock.Mock
+}
+
+type MockLogger struct{}
+
 var ErrDbFailureUser error = errors.New("db failure")
 
 func TestNewUserHandler(t *testing.T) {
 	factory := new(MockFactory)
 	repository := new(MockRepository)
-	handler := NewUserHandler(repository, factory)
+	emailRepository := new(MockEmailRepository)
+	sender := new(MockSender)
+	attemptStore := new(MockAttemptStore)
+	unlockStore := new(MockUnlockStore)
+	policy := testPolicy()
+	logger := new(MockLogger)
+	handler := NewUserHandler(repository, emailRepository, sender, factory, attemptStore, unlockStore, policy, logger)
 
 	require.NotEmpty(t, handler)
 	require.Exactly(t, factory, handler.factory)
 	require.Exactly(t, repository, handler.repository)
+	require.Exactly(t, emailRepository, handler.emailRepo)
+	require.Exactly(t, sender, handler.emailService)
+	require.Exactly(t, attemptStore, handler.attemptStore)
+	require.Exactly(t, unlockStore, handler.unlockStore)
+	require.Exactly(t, policy, handler.policy)
+	require.Exactly(t, logger, handler.logger)
 }
 
 func (m *MockFactory) Create(firstName, lastName string, usersName shared.Username, email shared.Email, password shared.Password, gender shared.Gender, birth shared.BirthDate, country shared.Country, language shared.Language, phone *shared.Phone) (*users.User, error) {
@@ -83,6 +115,10 @@ func (m *MockRepository) GetListByLanguage(ctx context.Context, language string)
 	return nil, nil
 }
 
+func (m *MockRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	return nil, nil
+}
+
 func (m *MockRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(ctx, id)
 	return args.Bool(0), args.Error(1)
@@ -109,26 +145,109 @@ func (m *MockRepository) Create(ctx context.Context, u *users.User) (*users.User
 	return result, args.Error(1)
 }
 
-func (m *MockRepository) Update(ctx context.Context, u *users.User) (*users.User, error) {
+func (m *MockRepository) Update(ctx context.Context, u *users.User) error {
 	args := m.Called(ctx, u)
+	return args.Error(0)
+}
 
-	var result *users.User
-	if v := args.Get(0); v != nil {
-		result = v.(*users.User)
+func (m *MockRepository) Delete(ctx context.Context, u *users.User) error {
+	args := m.Called(ctx, u)
+	return args.Error(0)
+}
+
+func (m *MockEmailRepository) Save(ctx context.Context, ev *email.EmailVerification) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+
+func (m *MockEmailRepository) FindByHash(ctx context.Context, hash string) (*email.EmailVerification, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
+	return args.Get(0).(*email.EmailVerification), args.Error(1)
+}
 
-	return result, args.Error(1)
+func (m *MockEmailRepository) MarkVerified(ctx context.Context, ev *email.EmailVerification) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
 }
 
-func (m *MockRepository) Delete(ctx context.Context, u *users.User) (*users.User, error) {
-	args := m.Called(ctx, u)
+func (m *MockEmailRepository) CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error) {
+	args := m.Called(ctx, userId, since)
+	return args.Int(0), args.Error(1)
+}
 
-	var usr *users.User
-	if v := args.Get(0); v != nil {
-		usr, _ = v.(*users.User)
+func (m *MockSender) SendVerificationEmail(toEmail, token string) error {
+	return nil
+}
+
+func (m *MockSender) SendPasswordResetEmail(toEmail, token string) error {
+	return nil
+}
+
+func (m *MockSender) SendUnlockEmail(toEmail, token string) error {
+	m.unlocks = append(m.unlocks, toEmail)
+	return nil
+}
+
+func (m *MockAttemptStore) Get(ctx context.Context, key string) (int, time.Duration, error) {
+	args := m.Called(ctx, key)
+	return args.Int(0), args.Get(1).(time.Duration), args.Error(2)
+}
+
+func (m *MockAttemptStore) Fail(ctx context.Context, key string, window time.Duration) (int, error) {
+	args := m.Called(ctx, key, window)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAttemptStore) Wait(ctx context.Context, key string, delay time.Duration) (time.Duration, error) {
+	args := m.Called(ctx, key, delay)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *MockAttemptStore) Lock(ctx context.Context, key string, d time.Duration) (bool, error) {
+	args := m.Called(ctx, key, d)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAttemptStore) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockUnlockStore) Save(ctx context.Context, unlock *attempts.Unlock) error {
+	args := m.Called(ctx, unlock)
+	return args.Error(0)
+}
+
+func (m *MockUnlockStore) Take(ctx context.Context, hash string) (*attempts.Unlock, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
+	return args.Get(0).(*attempts.Unlock), args.Error(1)
+}
+
+func (m *MockLogger) Debug(msg string, args ...any) {}
+
+func (m *MockLogger) Info(msg string, args ...any) {}
 
-	return usr, args.Error(1)
+func (m *MockLogger) Warn(msg string, args ...any) {}
+
+func (m *MockLogger) Error(msg string, args ...any) {}
+
+func testPolicy() attempts.Policy {
+	return attempts.Policy{
+		FreeAttempts:    3,
+		IPFreeAttempts:  20,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutAttempts: 10,
+		LockoutDuration: 15 * time.Minute,
+		UnlockTTL:       time.Hour,
+		Window:          15 * time.Minute,
+	}
 }
 
 func valueObjects(username, email, password, gender string, birth time.Time, country, language string, phone *string, t *testing.T) (shared.Username, shared.Email, shared.Password, shared.Gender, shared.BirthDate, shared.Country, shared.Language, *shared.Phone) {
@@ -4,22 +4,46 @@ import (
 	"context"
 	"errors"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 )
 
+// HandleVerifyEmail marks the user the token was sent to as verified. Any
+// still pending link of theirs stops mattering once one works.
 func (h *UserHandler) HandleVerifyEmail(ctx context.Context, cmd commands.VerifyEmailCommand) error {
-	ev, err := h.emailRepo.FindByToken(ctx, cmd.Token)
-	if err != nil {
-		return err
+	if cmd.Token == "" {
+		return email.ErrInvalidEmailVerification
 	}
 
-	if ev.IsExpired() {
-		return errors.New("token expired")
+	ev, err := h.emailRepo.FindByHash(ctx, tokens.Hash(cmd.Token))
+	if errors.Is(err, email.ErrNotFoundEmailVerification) {
+		return email.ErrInvalidEmailVerification
+	} else if err != nil {
+		return err
 	}
 
 	if ev.IsVerified() {
-		return errors.New("already verified")
+		return email.ErrUsedEmailVerification
+	} else if ev.IsExpired() {
+		return email.ErrExpiredEmailVerification
+	}
+
+	usr, err := h.repository.GetById(ctx, ev.UserId)
+	if err != nil {
+		return err
+	}
+
+	if err = usr.Verify(); err != nil && !errors.Is(err, users.ErrAlreadyVerifiedUser) {
+		return err
+	} else if err == nil {
+		usr.Update()
+		if err = h.repository.Update(ctx, usr); err != nil {
+			h.logger.Error("Could not verify user %s: %v", usr.Id(), err)
+			return err
+		}
 	}
 
 	ev.MarkVerified()
-	return h.emailRepo.Save(ctx, ev)
+	return h.emailRepo.MarkVerified(ctx, ev)
 }
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newTestVerificationUser(t *testing.T) *users.User {
+	phoneStr := "+591-70926048"
+	username, em, password, gender, birth, country, language, phone := valueObjects("john", "john@doe.com", "5tr0nG!.", "Male", time.Now().AddDate(-20, 0, 0), "Bolivia", "Spanish", &phoneStr, t)
+	return users.NewUser("john", "doe", username, em, password, gender, birth, country, language, phone)
+}
+
+func TestUserHandler_HandleVerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	mockRepository, mockEmailRepository := new(MockRepository), new(MockEmailRepository)
+	handler := NewUserHandler(mockRepository, mockEmailRepository, new(MockSender), new(MockFactory), new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
+
+	usr := newTestVerificationUser(t)
+	ev, secret, err := email.NewEmailVerification(usr.Id())
+	require.NoError(t, err)
+
+	mockEmailRepository.On("FindByHash", ctx, tokens.Hash(secret)).Return(ev, nil)
+	mockRepository.On("GetById", ctx, usr.Id()).Return(usr, nil)
+	mockRepository.On("Update", ctx, usr).Return(nil)
+	mockEmailRepository.On("MarkVerified", ctx, ev).Return(nil)
+
+	err = handler.HandleVerifyEmail(ctx, commands.VerifyEmailCommand{Token: secret})
+
+	require.NoError(t, err)
+	assert.True(t, usr.IsVerified())
+	assert.True(t, ev.IsVerified())
+	mockRepository.AssertExpectations(t)
+	mockEmailRepository.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleVerifyEmail_Errors(t *testing.T) {
+	ctx := context.Background()
+	verifiedAt := time.Now().Add(-time.Minute)
+
+	cases := []struct {
+		name     string
+		ev       *email.EmailVerification
+		expected error
+	}{
+		{name: "Unknown token", expected: email.ErrInvalidEmailVerification},
+		{name: "Used token", ev: &email.EmailVerification{Id: uuid.New(), UserId: uuid.New(), ExpiresAt: time.Now().Add(time.Hour), VerifiedAt: &verifiedAt}, expected: email.ErrUsedEmailVerification},
+		{name: "Expired token", ev: &email.EmailVerification{Id: uuid.New(), UserId: uuid.New(), ExpiresAt: time.Now().Add(-time.Minute)}, expected: email.ErrExpiredEmailVerification},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepository, mockEmailRepository := new(MockRepository), new(MockEmailRepository)
+			handler := NewUserHandler(mockRepository, mockEmailRepository, new(MockSender), new(MockFactory), new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
+
+			if tc.ev != nil {
+				mockEmailRepository.On("FindByHash", ctx, tokens.Hash("token")).Return(tc.ev, nil)
+			} else {
+				mockEmailRepository.On("FindByHash", ctx, tokens.Hash("token")).Return(nil, email.ErrNotFoundEmailVerification)
+			}
+
+			err := handler.HandleVerifyEmail(ctx, commands.VerifyEmailCommand{Token: "token"})
+
+			assert.ErrorIs(t, err, tc.expected)
+			mockRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			mockEmailRepository.AssertNotCalled(t, "MarkVerified", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserHandler_HandleResendVerification(t *testing.T) {
+	ctx := context.Background()
+	mockRepository, mockEmailRepository := new(MockRepository), new(MockEmailRepository)
+	handler := NewUserHandler(mockRepository, mockEmailRepository, new(MockSender), new(MockFactory), new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
+	usr := newTestVerificationUser(t)
+
+	var stored *email.EmailVerification
+	mockRepository.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+	mockRepository.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+	mockEmailRepository.On("CountSince", ctx, usr.Id(), mock.Anything).Return(0, nil)
+	mockEmailRepository.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*email.EmailVerification)
+	}).Return(nil)
+
+	err := handler.HandleResendVerification(ctx, commands.ResendVerificationCommand{Email: "john@doe.com"})
+
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, usr.Id(), stored.UserId)
+	assert.Len(t, stored.TokenHash, 64)
+	mockEmailRepository.AssertExpectations(t)
+}
+
+func TestUserHandler_HandleResendVerification_Skipped(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name  string
+		setup func(r *MockRepository, e *MockEmailRepository, usr *users.User)
+	}{
+		{name: "Unknown email", setup: func(r *MockRepository, e *MockEmailRepository, usr *users.User) {
+			r.On("ExistsByEmail", ctx, "john@doe.com").Return(false, nil)
+		}},
+		{name: "Already verified", setup: func(r *MockRepository, e *MockEmailRepository, usr *users.User) {
+			require.NoError(t, usr.Verify())
+			r.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+			r.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+		}},
+		{name: "Cooldown", setup: func(r *MockRepository, e *MockEmailRepository, usr *users.User) {
+			r.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+			r.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+			e.On("CountSince", ctx, usr.Id(), mock.Anything).Return(1, nil)
+		}},
+		{name: "Daily limit", setup: func(r *MockRepository, e *MockEmailRepository, usr *users.User) {
+			r.On("ExistsByEmail", ctx, "john@doe.com").Return(true, nil)
+			r.On("GetByEmail", ctx, "john@doe.com").Return(usr, nil)
+			e.On("CountSince", ctx, usr.Id(), mock.MatchedBy(func(since time.Time) bool {
+				return time.Since(since) < time.Hour
+			})).Return(0, nil)
+			e.On("CountSince", ctx, usr.Id(), mock.Anything).Return(email.MaxSendsPerDay, nil)
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepository, mockEmailRepository := new(MockRepository), new(MockEmailRepository)
+			handler := NewUserHandler(mockRepository, mockEmailRepository, new(MockSender), new(MockFactory), new(MockAttemptStore), new(MockUnlockStore), testPolicy(), new(MockLogger))
+			tc.setup(mockRepository, mockEmailRepository, newTestVerificationUser(t))
+
+			err := handler.HandleResendVerification(ctx, commands.ResendVerificationCommand{Email: "john@doe.com"})
+
+			require.NoError(t, err)
+			mockEmailRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
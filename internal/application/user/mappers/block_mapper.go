@@ -0,0 +1,14 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+)
+
+func MapToBlockDTO(block *blocks.Block) *dto.BlockDTO {
+	return &dto.BlockDTO{
+		BlockerId: block.BlockerId(),
+		BlockedId: block.BlockedId(),
+		CreatedAt: block.CreatedAt(),
+	}
+}
@@ -0,0 +1,14 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+)
+
+func MapToFollowDTO(follow *follows.Follow) *dto.FollowDTO {
+	return &dto.FollowDTO{
+		FollowerId: follow.FollowerId(),
+		FolloweeId: follow.FolloweeId(),
+		CreatedAt:  follow.CreatedAt(),
+	}
+}
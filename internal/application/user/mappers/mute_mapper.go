@@ -0,0 +1,14 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+)
+
+func MapToMuteDTO(mute *mutes.Mute) *dto.MuteDTO {
+	return &dto.MuteDTO{
+		MuterId:   mute.MuterId(),
+		MutedId:   mute.MutedId(),
+		CreatedAt: mute.CreatedAt(),
+	}
+}
@@ -46,6 +46,7 @@ func MapToUserDTO(user *users.User) *dto.UserDTO {
 		ProfilePicURL: profilePicURL,
 		Website:       website,
 		Visibility:    user.Visibility(),
+		Verified:      user.IsVerified(),
 	}
 }
 
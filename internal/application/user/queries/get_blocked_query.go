@@ -0,0 +1,7 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetBlockedQuery struct {
+	UserId uuid.UUID `json:"user_id"`
+}
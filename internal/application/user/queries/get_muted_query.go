@@ -0,0 +1,7 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetMutedQuery struct {
+	UserId uuid.UUID `json:"user_id"`
+}
@@ -1,5 +1,8 @@
 package queries
 
+import "github.com/google/uuid"
+
 type GetUsersLikeUsernameQuery struct {
-	Username string `json:"username"`
+	Username string    `json:"username"`
+	ViewerId uuid.UUID `json:"viewer_id"`
 }
@@ -0,0 +1,25 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+)
+
+var ErrNotOwnerPin = errors.New("only the owner of a pin can see its analytics")
+
+type Dimension string
+
+const (
+	CountryDimension  Dimension = "country"
+	LanguageDimension Dimension = "language"
+)
+
+// AnalyticsRepository reads the hourly stats of the pins and boards of an
+// owner. Passing a pin id narrows them down to that pin.
+type AnalyticsRepository interface {
+	GetDaily(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, rng Range) ([]Day, error)
+	GetTopPins(ctx context.Context, ownerId uuid.UUID, rng Range, limit int) ([]PinStats, error)
+	GetTopBoards(ctx context.Context, ownerId uuid.UUID, rng Range, limit int) ([]BoardStats, error)
+	GetAudience(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, dimension Dimension, rng Range, limit int) ([]Segment, error)
+}
@@ -0,0 +1,77 @@
+package analytics
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	stats := NewStats(200, 10, 4, 5, 1)
+
+	assert.Equal(t, 200, stats.Impressions())
+	assert.Equal(t, 20, stats.Engagements())
+	assert.InDelta(t, 0.1, stats.EngagementRate(), 1e-9)
+	assert.Zero(t, Stats{}.EngagementRate())
+
+	sum := stats.Add(NewStats(100, 0, 1, 0, 0))
+	assert.Equal(t, 300, sum.Impressions())
+	assert.Equal(t, 5, sum.OutboundClicks())
+	assert.Equal(t, 5, sum.Saves())
+}
+
+func TestNewRange(t *testing.T) {
+	from := time.Date(2025, 11, 1, 15, 30, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 3, 1, 0, 0, 0, time.UTC)
+
+	r, err := NewRange(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), r.From())
+	assert.Equal(t, time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC), r.End())
+	assert.Equal(t, 3, r.Days())
+	assert.Equal(t, "2025-11-01:2025-11-03", r.String())
+
+	_, err = NewRange(to, from)
+	assert.ErrorIs(t, err, ErrInvalidRange)
+
+	_, err = NewRange(from.AddDate(-2, 0, 0), to)
+	assert.ErrorIs(t, err, ErrLongRange)
+}
+
+func TestDefaultRange(t *testing.T) {
+	r := DefaultRange(time.Date(2025, 11, 30, 22, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), r.From())
+	assert.Equal(t, time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC), r.To())
+	assert.Equal(t, DefaultDays, r.Days())
+}
+
+func TestNewReport(t *testing.T) {
+	r, err := NewRange(time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	days := []Day{
+		NewDay(time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), NewStats(10, 1, 0, 1, 0)),
+		NewDay(time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), NewStats(30, 0, 2, 0, 1)),
+	}
+
+	report := NewReport(r, days, nil, nil, nil, nil)
+
+	require.Len(t, report.Daily(), 3)
+	assert.Equal(t, time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC), report.Daily()[1].Date())
+	assert.Zero(t, report.Daily()[1].Stats().Impressions())
+	assert.Equal(t, 30, report.Daily()[2].Stats().Impressions())
+	assert.Equal(t, 40, report.Totals().Impressions())
+	assert.Equal(t, 5, report.Totals().Engagements())
+	assert.Equal(t, r, report.Range())
+}
+
+func TestReportKey(t *testing.T) {
+	ownerId, pinId := uuid.New(), uuid.New()
+	r := DefaultRange(time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, ownerId.String()+":all:2025-11-01:2025-11-30", ReportKey(ownerId, nil, r))
+	assert.Equal(t, ownerId.String()+":"+pinId.String()+":2025-11-01:2025-11-30", ReportKey(ownerId, &pinId, r))
+}
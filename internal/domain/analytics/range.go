@@ -0,0 +1,69 @@
+package analytics
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidRange = errors.New("range cannot end before it starts")
+	ErrLongRange    = errors.New("range cannot span more than 366 days")
+)
+
+const (
+	// DefaultDays is the range reported when none is given, ending today.
+	DefaultDays = 30
+	MaxDays     = 366
+)
+
+// Range is a span of whole UTC days, both ends included.
+type Range struct {
+	from time.Time
+	to   time.Time
+}
+
+func NewRange(from, to time.Time) (Range, error) {
+	from, to = day(from), day(to)
+	if to.Before(from) {
+		return Range{}, ErrInvalidRange
+	}
+
+	r := Range{from: from, to: to}
+	if r.Days() > MaxDays {
+		return Range{}, ErrLongRange
+	}
+
+	return r, nil
+}
+
+// DefaultRange is the DefaultDays ending on the day of now.
+func DefaultRange(now time.Time) Range {
+	to := day(now)
+	return Range{from: to.AddDate(0, 0, 1-DefaultDays), to: to}
+}
+
+func (r Range) From() time.Time {
+	return r.from
+}
+
+func (r Range) To() time.Time {
+	return r.to
+}
+
+// End is the first instant after the range.
+func (r Range) End() time.Time {
+	return r.to.AddDate(0, 0, 1)
+}
+
+func (r Range) Days() int {
+	return int(r.End().Sub(r.from) / (24 * time.Hour))
+}
+
+func (r Range) String() string {
+	return r.from.Format(time.DateOnly) + ":" + r.to.Format(time.DateOnly)
+}
+
+func day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,159 @@
+package analytics
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// TopLimit is how many pins, boards, countries and languages a report
+	// ranks.
+	TopLimit = 10
+)
+
+type Day struct {
+	date  time.Time
+	stats Stats
+}
+
+func NewDay(date time.Time, stats Stats) Day {
+	return Day{date: day(date), stats: stats}
+}
+
+func (d Day) Date() time.Time {
+	return d.date
+}
+
+func (d Day) Stats() Stats {
+	return d.stats
+}
+
+type PinStats struct {
+	pinId uuid.UUID
+	title string
+	stats Stats
+}
+
+func NewPinStats(pinId uuid.UUID, title string, stats Stats) PinStats {
+	return PinStats{pinId: pinId, title: title, stats: stats}
+}
+
+func (p PinStats) PinId() uuid.UUID {
+	return p.pinId
+}
+
+func (p PinStats) Title() string {
+	return p.title
+}
+
+func (p PinStats) Stats() Stats {
+	return p.stats
+}
+
+type BoardStats struct {
+	boardId uuid.UUID
+	name    string
+	stats   Stats
+}
+
+func NewBoardStats(boardId uuid.UUID, name string, stats Stats) BoardStats {
+	return BoardStats{boardId: boardId, name: name, stats: stats}
+}
+
+func (b BoardStats) BoardId() uuid.UUID {
+	return b.boardId
+}
+
+func (b BoardStats) Name() string {
+	return b.name
+}
+
+func (b BoardStats) Stats() Stats {
+	return b.stats
+}
+
+// Segment counts the distinct users of a country or language that came
+// across the content.
+type Segment struct {
+	code  string
+	users int
+}
+
+func NewSegment(code string, users int) Segment {
+	return Segment{code: code, users: users}
+}
+
+func (s Segment) Code() string {
+	return s.code
+}
+
+func (s Segment) Users() int {
+	return s.users
+}
+
+// Report sums up the engagement on a user's content, or on one of their pins,
+// over a range.
+type Report struct {
+	rng       Range
+	totals    Stats
+	daily     []Day
+	topPins   []PinStats
+	topBoards []BoardStats
+	countries []Segment
+	languages []Segment
+}
+
+// NewReport builds a report from the days of the range that had any stats,
+// filling in the others with zeros.
+func NewReport(rng Range, days []Day, topPins []PinStats, topBoards []BoardStats, countries, languages []Segment) *Report {
+	byDate := make(map[time.Time]Stats, len(days))
+	for _, d := range days {
+		byDate[d.date] = byDate[d.date].Add(d.stats)
+	}
+
+	var totals Stats
+	daily := make([]Day, 0, rng.Days())
+	for date := rng.from; date.Before(rng.End()); date = date.AddDate(0, 0, 1) {
+		stats := byDate[date]
+		totals = totals.Add(stats)
+		daily = append(daily, Day{date: date, stats: stats})
+	}
+
+	return &Report{
+		rng:       rng,
+		totals:    totals,
+		daily:     daily,
+		topPins:   topPins,
+		topBoards: topBoards,
+		countries: countries,
+		languages: languages,
+	}
+}
+
+func (r *Report) Range() Range {
+	return r.rng
+}
+
+func (r *Report) Totals() Stats {
+	return r.totals
+}
+
+func (r *Report) Daily() []Day {
+	return r.daily
+}
+
+func (r *Report) TopPins() []PinStats {
+	return r.topPins
+}
+
+func (r *Report) TopBoards() []BoardStats {
+	return r.topBoards
+}
+
+func (r *Report) Countries() []Segment {
+	return r.countries
+}
+
+func (r *Report) Languages() []Segment {
+	return r.languages
+}
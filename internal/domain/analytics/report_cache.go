@@ -0,0 +1,23 @@
+package analytics
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// ReportCache keeps reports for a while, since their stats only change when
+// events are rolled up. Get reports false when there is no entry or it expired.
+type ReportCache interface {
+	Get(ctx context.Context, key string) (*Report, bool, error)
+	Set(ctx context.Context, key string, report *Report) error
+}
+
+// ReportKey identifies the report of an owner, or of one of their pins, over a
+// range.
+func ReportKey(ownerId uuid.UUID, pinId *uuid.UUID, rng Range) string {
+	scope := "all"
+	if pinId != nil {
+		scope = pinId.String()
+	}
+	return ownerId.String() + ":" + scope + ":" + rng.String()
+}
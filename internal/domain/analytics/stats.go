@@ -0,0 +1,64 @@
+package analytics
+
+// Stats counts the engagement on some content. Closeups, outbound clicks,
+// saves and shares engage with it; impressions only show it.
+type Stats struct {
+	impressions    int
+	closeups       int
+	outboundClicks int
+	saves          int
+	shares         int
+}
+
+func NewStats(impressions, closeups, outboundClicks, saves, shares int) Stats {
+	return Stats{
+		impressions:    impressions,
+		closeups:       closeups,
+		outboundClicks: outboundClicks,
+		saves:          saves,
+		shares:         shares,
+	}
+}
+
+func (s Stats) Impressions() int {
+	return s.impressions
+}
+
+func (s Stats) Closeups() int {
+	return s.closeups
+}
+
+func (s Stats) OutboundClicks() int {
+	return s.outboundClicks
+}
+
+func (s Stats) Saves() int {
+	return s.saves
+}
+
+func (s Stats) Shares() int {
+	return s.shares
+}
+
+func (s Stats) Engagements() int {
+	return s.closeups + s.outboundClicks + s.saves + s.shares
+}
+
+// EngagementRate is the share of impressions that led to an engagement, zero
+// without impressions.
+func (s Stats) EngagementRate() float64 {
+	if s.impressions == 0 {
+		return 0
+	}
+	return float64(s.Engagements()) / float64(s.impressions)
+}
+
+func (s Stats) Add(other Stats) Stats {
+	return Stats{
+		impressions:    s.impressions + other.impressions,
+		closeups:       s.closeups + other.closeups,
+		outboundClicks: s.outboundClicks + other.outboundClicks,
+		saves:          s.saves + other.saves,
+		shares:         s.shares + other.shares,
+	}
+}
@@ -0,0 +1,90 @@
+package attempts
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrTooManyAttempts = errors.New("too many failed logins, try again later")
+	ErrLockedAccount   = errors.New("too many failed logins, the account is locked until it is unlocked from the emailed link or the lock expires")
+)
+
+// Policy holds failed logins back. An account, or an address, may fail
+// FreeAttempts (IPFreeAttempts) times before each attempt has to wait
+// BaseDelay after the previous one, doubling with every failure up to
+// MaxDelay. An account failing LockoutAttempts times is locked for
+// LockoutDuration, which starts its count over, and its owner emailed a link
+// to unlock it, valid for UnlockTTL; no other link is emailed while it is.
+// Failures are forgotten after Window without another one.
+type Policy struct {
+	FreeAttempts    int
+	IPFreeAttempts  int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutAttempts int
+	LockoutDuration time.Duration
+	UnlockTTL       time.Duration
+	Window          time.Duration
+}
+
+// RetryError tells how long a login that was held back has to wait.
+type RetryError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// AccountDelay is how long an account that failed the given times has to wait
+// between attempts.
+func (p Policy) AccountDelay(failures int) time.Duration {
+	return p.delay(failures - p.FreeAttempts)
+}
+
+// IPDelay is how long an address that failed the given times has to wait
+// between attempts.
+func (p Policy) IPDelay(failures int) time.Duration {
+	return p.delay(failures - p.IPFreeAttempts)
+}
+
+// Locks reports whether an account that failed the given times gets locked.
+func (p Policy) Locks(failures int) bool {
+	return p.LockoutAttempts > 0 && failures >= p.LockoutAttempts
+}
+
+func (p Policy) delay(over int) time.Duration {
+	if over <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < over && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, max(p.MaxDelay, p.BaseDelay))
+}
+
+// AccountKey is what the failures of an email are counted under, whether an
+// account has it or not, so counting tells nothing about who signed up.
+func AccountKey(email string) string {
+	return "account:" + strings.ToLower(email)
+}
+
+// IPKey is what the failures coming from an address are counted under.
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// UnlockEmailKey is what the unlock emails of an account are held back under.
+func UnlockEmailKey(account string) string {
+	return "unlock-email:" + account
+}
@@ -0,0 +1,25 @@
+package attempts
+
+import (
+	"context"
+	"time"
+)
+
+// AttemptStore counts failed logins under a key until they are forgotten.
+type AttemptStore interface {
+	// Get returns how many times the key failed and how long it stays locked,
+	// zero when it is not.
+	Get(ctx context.Context, key string) (int, time.Duration, error)
+	// Fail counts a failure of the key, forgotten after window without
+	// another one, and returns how many it has.
+	Fail(ctx context.Context, key string, window time.Duration) (int, error)
+	// Wait lets a single attempt of the key through every delay. It returns
+	// zero when this one may go on, or else how long until the next one may.
+	Wait(ctx context.Context, key string, delay time.Duration) (time.Duration, error)
+	// Lock keeps every attempt of the key out for d and forgets its failures,
+	// so it is not locked again by the first failure after. It returns false
+	// when the key was locked already.
+	Lock(ctx context.Context, key string, d time.Duration) (bool, error)
+	// Reset forgets the failures, the wait and the lock of the key.
+	Reset(ctx context.Context, key string) error
+}
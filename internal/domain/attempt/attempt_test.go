@@ -0,0 +1,112 @@
+package attempts
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testPolicy() Policy {
+	return Policy{
+		FreeAttempts:    3,
+		IPFreeAttempts:  20,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutAttempts: 10,
+		LockoutDuration: 15 * time.Minute,
+		UnlockTTL:       time.Hour,
+		Window:          15 * time.Minute,
+	}
+}
+
+func TestPolicy_AccountDelay(t *testing.T) {
+	policy := testPolicy()
+
+	cases := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{0, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{7, 8 * time.Second},
+		{8, 16 * time.Second},
+		{9, 30 * time.Second},
+		{50, 30 * time.Second},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.delay, policy.AccountDelay(tc.failures), tc.failures)
+	}
+}
+
+func TestPolicy_IPDelay(t *testing.T) {
+	policy := testPolicy()
+
+	assert.Zero(t, policy.IPDelay(20))
+	assert.Equal(t, time.Second, policy.IPDelay(21))
+	assert.Equal(t, 4*time.Second, policy.IPDelay(23))
+}
+
+func TestPolicy_NoDelay(t *testing.T) {
+	policy := testPolicy()
+	policy.BaseDelay = 0
+
+	assert.Zero(t, policy.AccountDelay(100))
+}
+
+func TestPolicy_Locks(t *testing.T) {
+	policy := testPolicy()
+
+	assert.False(t, policy.Locks(9))
+	assert.True(t, policy.Locks(10))
+	assert.True(t, policy.Locks(11))
+
+	policy.LockoutAttempts = 0
+	assert.False(t, policy.Locks(100))
+}
+
+func TestKeys(t *testing.T) {
+	assert.Equal(t, "account:jane@doe.com", AccountKey("Jane@Doe.com"))
+	assert.Equal(t, "ip:10.0.0.1", IPKey("10.0.0.1"))
+}
+
+func TestRetryError(t *testing.T) {
+	var err error = &RetryError{Err: ErrTooManyAttempts, After: time.Second}
+
+	var retry *RetryError
+	require.True(t, errors.As(err, &retry))
+	assert.Equal(t, time.Second, retry.After)
+	assert.ErrorIs(t, err, ErrTooManyAttempts)
+	assert.Equal(t, ErrTooManyAttempts.Error(), err.Error())
+}
+
+func TestNewUnlock(t *testing.T) {
+	unlock, secret, err := NewUnlock("jane@doe.com", time.Hour)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, secret)
+	assert.NotEqual(t, secret, unlock.Hash())
+	assert.Equal(t, "jane@doe.com", unlock.Email())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), unlock.ExpiresAt(), time.Second)
+}
+
+func TestNewUnlock_Errors(t *testing.T) {
+	_, _, err := NewUnlock("", time.Hour)
+	assert.ErrorIs(t, err, ErrEmptyEmailUnlock)
+
+	_, _, err = NewUnlock("jane@doe.com", 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLUnlock)
+}
+
+func TestNewUnlockFromStore(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	unlock := NewUnlockFromStore("hash", "jane@doe.com", expiresAt)
+
+	assert.Equal(t, "hash", unlock.Hash())
+	assert.Equal(t, "jane@doe.com", unlock.Email())
+	assert.Equal(t, expiresAt, unlock.ExpiresAt())
+}
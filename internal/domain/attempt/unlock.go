@@ -0,0 +1,61 @@
+package attempts
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"time"
+)
+
+var (
+	ErrInvalidUnlock        = errors.New("invalid or expired unlock link")
+	ErrEmptyEmailUnlock     = errors.New("unlock email is empty")
+	ErrNonPositiveTTLUnlock = errors.New("unlock ttl must be positive")
+)
+
+// Unlock lifts the lock of an account. Its secret goes in the link emailed to
+// the owner when the account gets locked; only its hash is kept.
+type Unlock struct {
+	hash      string
+	email     string
+	expiresAt time.Time
+}
+
+// NewUnlock returns the unlock along with the secret to email.
+func NewUnlock(email string, ttl time.Duration) (*Unlock, string, error) {
+	if email == "" {
+		return nil, "", ErrEmptyEmailUnlock
+	} else if ttl <= 0 {
+		return nil, "", ErrNonPositiveTTLUnlock
+	}
+
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Unlock{
+		hash:      tokens.Hash(secret),
+		email:     email,
+		expiresAt: time.Now().Add(ttl),
+	}, secret, nil
+}
+
+func NewUnlockFromStore(hash, email string, expiresAt time.Time) *Unlock {
+	return &Unlock{
+		hash:      hash,
+		email:     email,
+		expiresAt: expiresAt,
+	}
+}
+
+func (u *Unlock) Hash() string {
+	return u.hash
+}
+
+func (u *Unlock) Email() string {
+	return u.email
+}
+
+func (u *Unlock) ExpiresAt() time.Time {
+	return u.expiresAt
+}
@@ -0,0 +1,11 @@
+package attempts
+
+import "context"
+
+// UnlockStore keeps the unlock links sent until they expire.
+type UnlockStore interface {
+	Save(ctx context.Context, unlock *Unlock) error
+	// Take returns the unlock and drops it, so a link works once. It returns
+	// ErrInvalidUnlock for unknown and expired ones.
+	Take(ctx context.Context, hash string) (*Unlock, error)
+}
@@ -0,0 +1,64 @@
+package blocks
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilBlockerIdBlock = errors.New("blocker id cannot be nil")
+	ErrNilBlockedIdBlock = errors.New("blocked id cannot be nil")
+	ErrSelfBlock         = errors.New("users cannot block themselves")
+	ErrExistsBlock       = errors.New("user is already blocked")
+	ErrNotFoundBlock     = errors.New("user is not blocked")
+	ErrBlockedUser       = errors.New("user is blocked")
+)
+
+// Block hides two users from each other in both directions, whichever of them
+// created it.
+type Block struct {
+	blockerId uuid.UUID
+	blockedId uuid.UUID
+	createdAt time.Time
+}
+
+func NewBlock(blockerId, blockedId uuid.UUID) (*Block, error) {
+	if blockerId == uuid.Nil {
+		return nil, ErrNilBlockerIdBlock
+	}
+
+	if blockedId == uuid.Nil {
+		return nil, ErrNilBlockedIdBlock
+	}
+
+	if blockerId == blockedId {
+		return nil, ErrSelfBlock
+	}
+
+	return &Block{
+		blockerId: blockerId,
+		blockedId: blockedId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (b *Block) BlockerId() uuid.UUID {
+	return b.blockerId
+}
+
+func (b *Block) BlockedId() uuid.UUID {
+	return b.blockedId
+}
+
+func (b *Block) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+func NewBlockFromDB(blockerId, blockedId uuid.UUID, createdAt time.Time) *Block {
+	return &Block{
+		blockerId: blockerId,
+		blockedId: blockedId,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,20 @@
+package blocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type BlockRepository interface {
+	// GetListByBlockerId returns the users the blocker has blocked, newest
+	// first.
+	GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*Block, error)
+	Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error)
+	// ExistsAmong reports whether any of the users blocks any other of them,
+	// in either direction.
+	ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error)
+
+	// Create stores the block and drops the follows between both users.
+	Create(ctx context.Context, b *Block) error
+	Delete(ctx context.Context, b *Block) error
+}
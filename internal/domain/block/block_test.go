@@ -0,0 +1,33 @@
+package blocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewBlock(t *testing.T) {
+	blockerId, blockedId := uuid.New(), uuid.New()
+
+	block, err := NewBlock(blockerId, blockedId)
+
+	require.NoError(t, err)
+	assert.Equal(t, blockerId, block.BlockerId())
+	assert.Equal(t, blockedId, block.BlockedId())
+	assert.WithinDuration(t, time.Now(), block.CreatedAt(), time.Second)
+}
+
+func TestNewBlock_Errors(t *testing.T) {
+	id := uuid.New()
+
+	_, err := NewBlock(uuid.Nil, id)
+	assert.ErrorIs(t, err, ErrNilBlockerIdBlock)
+
+	_, err = NewBlock(id, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilBlockedIdBlock)
+
+	_, err = NewBlock(id, id)
+	assert.ErrorIs(t, err, ErrSelfBlock)
+}
@@ -0,0 +1,134 @@
+package categories
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilIdCategory           = errors.New("category id cannot be nil")
+	ErrNotFoundCategory        = errors.New("category not found")
+	ErrNotFoundParentCategory  = errors.New("parent category not found")
+	ErrEmptyNameCategory       = errors.New("category name cannot be empty")
+	ErrLongNameCategory        = errors.New("category name cannot be longer than 50 characters")
+	ErrLongDescriptionCategory = errors.New("category description cannot be longer than 500 characters")
+	ErrExistsSlugCategory      = errors.New("a category with this slug already exists")
+	ErrCycleCategory           = errors.New("a category cannot be placed under itself or one of its subcategories")
+	ErrDeepCategory            = errors.New("categories cannot be nested more than 5 levels deep")
+	ErrHasChildrenCategory     = errors.New("category still has subcategories")
+	ErrExistsTagCategory       = errors.New("tag is already mapped to this category")
+	ErrNotFoundTagCategory     = errors.New("tag is not mapped to this category")
+)
+
+// MaxDepth is how many levels the taxonomy may have, roots included.
+const MaxDepth = 5
+
+// Category is a node of the curated interest taxonomy, like Home > Kitchen >
+// Cabinets. Roots have no parent. Tags are mapped into categories, and a
+// category holds the pins tagged with any tag mapped to it or to one of its
+// subcategories.
+type Category struct {
+	*abstractions.AggregateRoot
+	parentId    *uuid.UUID
+	slug        string
+	name        string
+	description *string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewCategory(parentId *uuid.UUID, slug, name string, description *string) *Category {
+	return &Category{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		parentId:      parentId,
+		slug:          slug,
+		name:          name,
+		description:   description,
+		createdAt:     time.Now(),
+		updatedAt:     time.Now(),
+	}
+}
+
+func (c *Category) Id() uuid.UUID {
+	return c.AggregateRoot.Entity.Id
+}
+
+func (c *Category) ParentId() *uuid.UUID {
+	return c.parentId
+}
+
+func (c *Category) Slug() string {
+	return c.slug
+}
+
+func (c *Category) Name() string {
+	return c.name
+}
+
+func (c *Category) Description() *string {
+	return c.description
+}
+
+func (c *Category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Category) ChangeName(name string) error {
+	if name == "" {
+		return ErrEmptyNameCategory
+	} else if len([]rune(name)) > 50 {
+		return ErrLongNameCategory
+	}
+	c.name = name
+	return nil
+}
+
+func (c *Category) ChangeDescription(description *string) error {
+	if description != nil && len([]rune(*description)) > 500 {
+		return ErrLongDescriptionCategory
+	}
+	c.description = description
+	return nil
+}
+
+func (c *Category) ChangeSlug(slug string) error {
+	slug, err := NewSlug(slug)
+	if err != nil {
+		return err
+	}
+	c.slug = slug
+	return nil
+}
+
+// Move places the category under parentId, or makes it a root when nil. Only
+// the direct cycle is caught here; the caller checks the parent is not one of
+// its subcategories.
+func (c *Category) Move(parentId *uuid.UUID) error {
+	if parentId != nil && *parentId == c.Id() {
+		return ErrCycleCategory
+	}
+	c.parentId = parentId
+	return nil
+}
+
+func (c *Category) Update() {
+	c.updatedAt = time.Now()
+}
+
+func NewCategoryFromDB(id uuid.UUID, parentId *uuid.UUID, slug, name string, description *string, createdAt, updatedAt time.Time) *Category {
+	return &Category{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		parentId:      parentId,
+		slug:          slug,
+		name:          name,
+		description:   description,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
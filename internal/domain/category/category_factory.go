@@ -0,0 +1,45 @@
+package categories
+
+import "github.com/google/uuid"
+
+type CategoryFactory interface {
+	Create(parentId *uuid.UUID, slug *string, name string, description *string) (*Category, error)
+}
+
+type categoryFactory struct{}
+
+// Create builds a category, deriving the slug from the name when none is
+// given.
+func (categoryFactory) Create(parentId *uuid.UUID, slug *string, name string, description *string) (*Category, error) {
+	if parentId != nil && *parentId == uuid.Nil {
+		return nil, ErrNilIdCategory
+	}
+
+	if name == "" {
+		return nil, ErrEmptyNameCategory
+	}
+
+	if len([]rune(name)) > 50 {
+		return nil, ErrLongNameCategory
+	}
+
+	if description != nil && len([]rune(*description)) > 500 {
+		return nil, ErrLongDescriptionCategory
+	}
+
+	raw := Slugify(name)
+	if slug != nil {
+		raw = *slug
+	}
+
+	valid, err := NewSlug(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCategory(parentId, valid, name, description), nil
+}
+
+func NewCategoryFactory() CategoryFactory {
+	return &categoryFactory{}
+}
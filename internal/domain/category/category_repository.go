@@ -0,0 +1,38 @@
+package categories
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+type CategoryRepository interface {
+	// GetAll returns the whole taxonomy, parents before their children and
+	// siblings by name.
+	GetAll(ctx context.Context) ([]*Category, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Category, error)
+	GetBySlug(ctx context.Context, slug string) (*Category, error)
+	// GetPath returns the ancestors of a category from its root down, ending
+	// with the category itself.
+	GetPath(ctx context.Context, id uuid.UUID) ([]*Category, error)
+	GetChildren(ctx context.Context, id uuid.UUID) ([]*Category, error)
+	// GetHeight counts the levels of the subtree under a category, 1 for one
+	// without subcategories.
+	GetHeight(ctx context.Context, id uuid.UUID) (int, error)
+
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	HasChildren(ctx context.Context, id uuid.UUID) (bool, error)
+
+	GetTags(ctx context.Context, id uuid.UUID) ([]pins.Tag, error)
+	ExistsTag(ctx context.Context, id, tagId uuid.UUID) (bool, error)
+	AddTag(ctx context.Context, id, tagId uuid.UUID) error
+	RemoveTag(ctx context.Context, id, tagId uuid.UUID) error
+
+	// GetRankedPins ranks the pins the viewer may see in a category and its
+	// subcategories, best first, reading limit pins after the cursor.
+	GetRankedPins(ctx context.Context, id, viewerId uuid.UUID, after *Cursor, limit int) ([]RankedPin, error)
+
+	Create(ctx context.Context, c *Category) error
+	Update(ctx context.Context, c *Category) error
+	Delete(ctx context.Context, c *Category) error
+}
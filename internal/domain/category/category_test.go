@@ -0,0 +1,130 @@
+package categories
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestCategoryFactory_Create(t *testing.T) {
+	factory := NewCategoryFactory()
+	parentId := uuid.New()
+	description := "Cabinets, counters and pantries"
+
+	category, err := factory.Create(&parentId, nil, "Kitchen & Dining", &description)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, category.Id())
+	assert.Equal(t, &parentId, category.ParentId())
+	assert.Equal(t, "kitchen-dining", category.Slug())
+	assert.Equal(t, "Kitchen & Dining", category.Name())
+	assert.Equal(t, &description, category.Description())
+
+	slug := "Cocina"
+	category, err = factory.Create(nil, &slug, "Cocina española", nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, category.ParentId())
+	assert.Equal(t, "cocina", category.Slug())
+}
+
+func TestCategoryFactory_Create_Invalid(t *testing.T) {
+	factory := NewCategoryFactory()
+	nilId, bad, long := uuid.Nil, "not a slug", strings.Repeat("a", 501)
+
+	cases := []struct {
+		name        string
+		parentId    *uuid.UUID
+		slug        *string
+		title       string
+		description *string
+		err         error
+	}{
+		{name: "nil parent", parentId: &nilId, title: "Home", err: ErrNilIdCategory},
+		{name: "empty name", title: "", err: ErrEmptyNameCategory},
+		{name: "long name", title: strings.Repeat("a", 51), err: ErrLongNameCategory},
+		{name: "long description", title: "Home", description: &long, err: ErrLongDescriptionCategory},
+		{name: "invalid slug", title: "Home", slug: &bad, err: ErrInvalidSlug},
+		{name: "name without slug", title: "家居", err: ErrInvalidSlug},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			category, err := factory.Create(tc.parentId, tc.slug, tc.title, tc.description)
+
+			assert.Nil(t, category)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestCategory_Changes(t *testing.T) {
+	category := NewCategory(nil, "home", "Home", nil)
+	parentId := uuid.New()
+
+	require.NoError(t, category.ChangeName("Home Decor"))
+	require.NoError(t, category.ChangeSlug("Home-Decor"))
+	require.NoError(t, category.Move(&parentId))
+	assert.Equal(t, "Home Decor", category.Name())
+	assert.Equal(t, "home-decor", category.Slug())
+	assert.Equal(t, &parentId, category.ParentId())
+
+	self := category.Id()
+	assert.ErrorIs(t, category.Move(&self), ErrCycleCategory)
+	assert.ErrorIs(t, category.ChangeName(""), ErrEmptyNameCategory)
+	assert.ErrorIs(t, category.ChangeSlug("home--decor"), ErrInvalidSlug)
+	require.NoError(t, category.Move(nil))
+	assert.Nil(t, category.ParentId())
+}
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Home":                    "home",
+		"  Kitchen & Dining  ":    "kitchen-dining",
+		"Decoración del Hogar":    "decoracion-del-hogar",
+		"DIY -- Crafts!":          "diy-crafts",
+		"家居":                      "",
+		strings.Repeat("ab ", 30): strings.TrimRight(strings.Repeat("ab-", 20), "-"),
+	}
+
+	for name, slug := range cases {
+		assert.Equal(t, slug, Slugify(name), name)
+	}
+}
+
+func TestBuildTree(t *testing.T) {
+	home := NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := NewCategory(&homeId, "kitchen", "Kitchen", nil)
+	kitchenId := kitchen.Id()
+	cabinets := NewCategory(&kitchenId, "cabinets", "Cabinets", nil)
+	orphanParent := uuid.New()
+	orphan := NewCategory(&orphanParent, "orphan", "Orphan", nil)
+
+	roots := BuildTree([]*Category{home, kitchen, cabinets, orphan})
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, home, roots[0].Category())
+	require.Len(t, roots[0].Children(), 1)
+	assert.Equal(t, kitchen, roots[0].Children()[0].Category())
+	assert.Equal(t, cabinets, roots[0].Children()[0].Children()[0].Category())
+	assert.Equal(t, orphan, roots[1].Category())
+}
+
+func TestCursor(t *testing.T) {
+	last := NewRankedPin(uuid.New(), 2901.123456789)
+	cursor := NewCursor(last)
+
+	parsed, err := ParseCursor(cursor.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, last.Score(), parsed.Score())
+	assert.Equal(t, last.PinId(), parsed.Id())
+
+	parsed, err = ParseCursor("%%%")
+
+	assert.Nil(t, parsed)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,67 @@
+package categories
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid category cursor")
+
+// Cursor marks the last pin of a category page. Pins are ordered by score and
+// then by id, both descending, so the pair locates the next page.
+type Cursor struct {
+	score float64
+	id    uuid.UUID
+}
+
+func NewCursor(last RankedPin) *Cursor {
+	return &Cursor{
+		score: last.Score(),
+		id:    last.PinId(),
+	}
+}
+
+// ParseCursor decodes a cursor previously returned by String.
+func ParseCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	score, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	value, err := strconv.ParseFloat(score, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	pinId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{
+		score: value,
+		id:    pinId,
+	}, nil
+}
+
+func (c *Cursor) Score() float64 {
+	return c.score
+}
+
+func (c *Cursor) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Cursor) String() string {
+	raw := fmt.Sprintf("%s:%s", strconv.FormatFloat(c.score, 'g', -1, 64), c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
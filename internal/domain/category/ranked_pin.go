@@ -0,0 +1,33 @@
+package categories
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// HotPeriod is how much newer a pin must be to outrank one with ten times its
+// engagement. Category pages rank pins by the log of their weighted saves,
+// comments and likes plus their age in these periods, so scores never change
+// with the clock and pages can be read with a cursor.
+const HotPeriod = 7 * 24 * time.Hour
+
+// RankedPin is a pin of a category with its score.
+type RankedPin struct {
+	pinId uuid.UUID
+	score float64
+}
+
+func NewRankedPin(pinId uuid.UUID, score float64) RankedPin {
+	return RankedPin{
+		pinId: pinId,
+		score: score,
+	}
+}
+
+func (r RankedPin) PinId() uuid.UUID {
+	return r.pinId
+}
+
+func (r RankedPin) Score() float64 {
+	return r.score
+}
@@ -0,0 +1,60 @@
+package categories
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidSlug = errors.New("slug must be 1 to 60 lowercase letters or digits, in words joined by single hyphens")
+
+const MaxLengthSlug = 60
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c", "ß", "ss",
+)
+
+// NewSlug validates a slug given by hand, lowercasing it.
+func NewSlug(slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" || len(slug) > MaxLengthSlug || strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-") || strings.Contains(slug, "--") {
+		return "", ErrInvalidSlug
+	}
+
+	for _, r := range slug {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return "", ErrInvalidSlug
+		}
+	}
+
+	return slug, nil
+}
+
+// Slugify turns a name into a slug: accents are dropped and every run of other
+// characters becomes a hyphen. Names with no latin letters or digits give an
+// empty slug, so those categories need one given by hand.
+func Slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range accents.Replace(strings.ToLower(name)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > MaxLengthSlug {
+		slug = strings.TrimRight(slug[:MaxLengthSlug], "-")
+	}
+	return slug
+}
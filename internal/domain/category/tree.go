@@ -0,0 +1,41 @@
+package categories
+
+import "github.com/google/uuid"
+
+// Node is a category with its subcategories.
+type Node struct {
+	category *Category
+	children []*Node
+}
+
+func (n *Node) Category() *Category {
+	return n.category
+}
+
+func (n *Node) Children() []*Node {
+	return n.children
+}
+
+// BuildTree arranges categories into their trees, keeping the order they come
+// in among siblings. Categories whose parent is missing from the list are
+// taken as roots.
+func BuildTree(categories []*Category) []*Node {
+	nodes := make(map[uuid.UUID]*Node, len(categories))
+	for _, c := range categories {
+		nodes[c.Id()] = &Node{category: c}
+	}
+
+	var roots []*Node
+	for _, c := range categories {
+		node := nodes[c.Id()]
+		if c.ParentId() != nil {
+			if parent, ok := nodes[*c.ParentId()]; ok {
+				parent.children = append(parent.children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	return roots
+}
@@ -0,0 +1,97 @@
+package comments
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrIdNilComment        = errors.New("comment id cannot be nil")
+	ErrNilPinIdComment     = errors.New("pin id cannot be nil")
+	ErrNilUserIdComment    = errors.New("user id cannot be nil")
+	ErrNotFoundComment     = errors.New("comment not found")
+	ErrEmptyContentComment = errors.New("content can't be empty")
+	ErrLongContentComment  = errors.New("content can't be longer than 500 characters")
+)
+
+type Comment struct {
+	*abstractions.AggregateRoot
+	pinId     uuid.UUID
+	userId    uuid.UUID
+	content   string
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
+}
+
+func NewComment(pinId, userId uuid.UUID, content string) *Comment {
+	return &Comment{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		pinId:         pinId,
+		userId:        userId,
+		content:       content,
+		createdAt:     time.Now(),
+		updatedAt:     time.Now(),
+	}
+}
+
+func (c *Comment) Id() uuid.UUID {
+	return c.AggregateRoot.Entity.Id
+}
+
+func (c *Comment) PinId() uuid.UUID {
+	return c.pinId
+}
+
+func (c *Comment) UserId() uuid.UUID {
+	return c.userId
+}
+
+func (c *Comment) Content() string {
+	return c.content
+}
+
+func (c *Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Comment) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Comment) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+func (c *Comment) ChangeContent(content string) error {
+	if content == "" {
+		return ErrEmptyContentComment
+	} else if len([]rune(content)) > 500 {
+		return ErrLongContentComment
+	}
+	c.content = content
+	return nil
+}
+
+func (c *Comment) Update() {
+	c.updatedAt = time.Now()
+}
+
+func (c *Comment) Delete() {
+	now := time.Now()
+	c.deletedAt = &now
+}
+
+func NewCommentFromDB(id, pinId, userId uuid.UUID, content string, createdAt, updatedAt time.Time, deletedAt *time.Time) *Comment {
+	return &Comment{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		pinId:         pinId,
+		userId:        userId,
+		content:       content,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		deletedAt:     deletedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package comments
+
+import (
+	"github.com/google/uuid"
+)
+
+type CommentFactory interface {
+	Create(pinId, userId uuid.UUID, content string) (*Comment, error)
+}
+
+type commentFactory struct{}
+
+func (c commentFactory) Create(pinId, userId uuid.UUID, content string) (*Comment, error) {
+	if pinId == uuid.Nil {
+		return nil, ErrNilPinIdComment
+	}
+
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdComment
+	}
+
+	if content == "" {
+		return nil, ErrEmptyContentComment
+	}
+
+	if len([]rune(content)) > 500 {
+		return nil, ErrLongContentComment
+	}
+
+	return NewComment(pinId, userId, content), nil
+}
+
+func NewCommentFactory() CommentFactory {
+	return &commentFactory{}
+}
@@ -0,0 +1,16 @@
+package comments
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type CommentRepository interface {
+	// GetListByPinId leaves out comments of users in a block with the viewer.
+	GetListByPinId(ctx context.Context, pinId, viewerId uuid.UUID) ([]*Comment, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Comment, error)
+
+	Create(ctx context.Context, c *Comment) (*Comment, error)
+	Update(ctx context.Context, c *Comment) error
+	Delete(ctx context.Context, c *Comment) error
+}
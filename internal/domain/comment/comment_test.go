@@ -0,0 +1,83 @@
+package comments
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommentFactory_Create(t *testing.T) {
+	factory := NewCommentFactory()
+	pinId, userId := uuid.New(), uuid.New()
+
+	comment, err := factory.Create(pinId, userId, "Love this @john_doe #kitchen")
+
+	require.NoError(t, err)
+	require.NotNil(t, comment)
+	assert.NotEqual(t, uuid.Nil, comment.Id())
+	assert.Equal(t, pinId, comment.PinId())
+	assert.Equal(t, userId, comment.UserId())
+	assert.Equal(t, "Love this @john_doe #kitchen", comment.Content())
+	assert.Nil(t, comment.DeletedAt())
+}
+
+func TestCommentFactory_Create_Errors(t *testing.T) {
+	factory := NewCommentFactory()
+	id := uuid.New()
+
+	cases := []struct {
+		name    string
+		pinId   uuid.UUID
+		userId  uuid.UUID
+		content string
+		err     error
+	}{
+		{"nil pin", uuid.Nil, id, "hi", ErrNilPinIdComment},
+		{"nil user", id, uuid.Nil, "hi", ErrNilUserIdComment},
+		{"empty content", id, id, "", ErrEmptyContentComment},
+		{"long content", id, id, strings.Repeat("a", 501), ErrLongContentComment},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			comment, err := factory.Create(tc.pinId, tc.userId, tc.content)
+
+			assert.Nil(t, comment)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestComment_ChangeContent(t *testing.T) {
+	comment := NewComment(uuid.New(), uuid.New(), "first")
+
+	assert.ErrorIs(t, comment.ChangeContent(""), ErrEmptyContentComment)
+	assert.ErrorIs(t, comment.ChangeContent(strings.Repeat("é", 501)), ErrLongContentComment)
+	assert.NoError(t, comment.ChangeContent(strings.Repeat("é", 500)))
+	assert.Equal(t, strings.Repeat("é", 500), comment.Content())
+}
+
+func TestComment_Delete(t *testing.T) {
+	comment := NewComment(uuid.New(), uuid.New(), "first")
+
+	comment.Delete()
+
+	require.NotNil(t, comment.DeletedAt())
+	assert.WithinDuration(t, time.Now(), *comment.DeletedAt(), time.Second)
+}
+
+func TestNewCommentFromDB(t *testing.T) {
+	id, pinId, userId := uuid.New(), uuid.New(), uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+
+	comment := NewCommentFromDB(id, pinId, userId, "saved", createdAt, createdAt, nil)
+
+	assert.Equal(t, id, comment.Id())
+	assert.Equal(t, pinId, comment.PinId())
+	assert.Equal(t, userId, comment.UserId())
+	assert.Equal(t, createdAt, comment.CreatedAt())
+	assert.Equal(t, createdAt, comment.UpdatedAt())
+}
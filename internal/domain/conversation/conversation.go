@@ -0,0 +1,149 @@
+package conversations
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+const MaxMembersConversation = 10
+
+var (
+	ErrIdNilConversation        = errors.New("conversation id cannot be nil")
+	ErrNilCreatorIdConversation = errors.New("creator id cannot be nil")
+	ErrNilMemberIdConversation  = errors.New("member id cannot be nil")
+	ErrFewMembersConversation   = errors.New("a conversation needs at least one other member")
+	ErrManyMembersConversation  = errors.New("a conversation cannot have more than 10 members")
+	ErrLongTitleConversation    = errors.New("title cannot be longer than 100 characters")
+	ErrTitleDirectConversation  = errors.New("only group conversations can have a title")
+	ErrNotFoundConversation     = errors.New("conversation not found")
+	ErrNotMemberConversation    = errors.New("user is not a member of the conversation")
+)
+
+// Conversation is a private thread between two users, or a small group of up
+// to MaxMembersConversation users. The creator is always a member.
+type Conversation struct {
+	*abstractions.AggregateRoot
+	creatorId     uuid.UUID
+	title         *string
+	isGroup       bool
+	members       []*Member
+	lastMessageAt *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func NewConversation(creatorId uuid.UUID, memberIds []uuid.UUID, title *string) *Conversation {
+	members := []*Member{NewMember(creatorId)}
+	seen := map[uuid.UUID]bool{creatorId: true}
+	for _, id := range memberIds {
+		if !seen[id] {
+			seen[id] = true
+			members = append(members, NewMember(id))
+		}
+	}
+
+	return &Conversation{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		creatorId:     creatorId,
+		title:         title,
+		isGroup:       len(members) > 2,
+		members:       members,
+		createdAt:     time.Now(),
+		updatedAt:     time.Now(),
+	}
+}
+
+func (c *Conversation) Id() uuid.UUID {
+	return c.AggregateRoot.Entity.Id
+}
+
+func (c *Conversation) CreatorId() uuid.UUID {
+	return c.creatorId
+}
+
+func (c *Conversation) Title() *string {
+	return c.title
+}
+
+func (c *Conversation) IsGroup() bool {
+	return c.isGroup
+}
+
+func (c *Conversation) Members() []*Member {
+	return c.members
+}
+
+func (c *Conversation) MemberIds() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(c.members))
+	for _, member := range c.members {
+		ids = append(ids, member.UserId())
+	}
+	return ids
+}
+
+func (c *Conversation) Member(userId uuid.UUID) *Member {
+	for _, member := range c.members {
+		if member.UserId() == userId {
+			return member
+		}
+	}
+	return nil
+}
+
+func (c *Conversation) IsMember(userId uuid.UUID) bool {
+	return c.Member(userId) != nil
+}
+
+func (c *Conversation) LastMessageAt() *time.Time {
+	return c.lastMessageAt
+}
+
+func (c *Conversation) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Conversation) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// MarkRead moves the member's read marker to readAt. Markers never move back.
+func (c *Conversation) MarkRead(userId uuid.UUID, readAt time.Time) error {
+	member := c.Member(userId)
+	if member == nil {
+		return ErrNotMemberConversation
+	}
+
+	if member.lastReadAt == nil || readAt.After(*member.lastReadAt) {
+		member.lastReadAt = &readAt
+	}
+	return nil
+}
+
+// Unread reports whether the member has messages they have not read yet.
+func (c *Conversation) Unread(userId uuid.UUID) bool {
+	member := c.Member(userId)
+	if member == nil || c.lastMessageAt == nil {
+		return false
+	}
+	return member.lastReadAt == nil || c.lastMessageAt.After(*member.lastReadAt)
+}
+
+func (c *Conversation) Touch(at time.Time) {
+	c.lastMessageAt = &at
+	c.updatedAt = at
+}
+
+func NewConversationFromDB(id, creatorId uuid.UUID, title *string, isGroup bool, members []*Member, lastMessageAt *time.Time, createdAt, updatedAt time.Time) *Conversation {
+	return &Conversation{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		creatorId:     creatorId,
+		title:         title,
+		isGroup:       isGroup,
+		members:       members,
+		lastMessageAt: lastMessageAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
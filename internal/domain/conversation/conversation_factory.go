@@ -0,0 +1,52 @@
+package conversations
+
+import (
+	"github.com/google/uuid"
+)
+
+type ConversationFactory interface {
+	Create(creatorId uuid.UUID, memberIds []uuid.UUID, title *string) (*Conversation, error)
+}
+
+type conversationFactory struct{}
+
+func (f conversationFactory) Create(creatorId uuid.UUID, memberIds []uuid.UUID, title *string) (*Conversation, error) {
+	if creatorId == uuid.Nil {
+		return nil, ErrNilCreatorIdConversation
+	}
+
+	others := make(map[uuid.UUID]bool)
+	for _, id := range memberIds {
+		if id == uuid.Nil {
+			return nil, ErrNilMemberIdConversation
+		}
+
+		if id != creatorId {
+			others[id] = true
+		}
+	}
+
+	if len(others) == 0 {
+		return nil, ErrFewMembersConversation
+	}
+
+	if len(others)+1 > MaxMembersConversation {
+		return nil, ErrManyMembersConversation
+	}
+
+	if title != nil {
+		if len(others) == 1 {
+			return nil, ErrTitleDirectConversation
+		}
+
+		if len([]rune(*title)) > 100 {
+			return nil, ErrLongTitleConversation
+		}
+	}
+
+	return NewConversation(creatorId, memberIds, title), nil
+}
+
+func NewConversationFactory() ConversationFactory {
+	return &conversationFactory{}
+}
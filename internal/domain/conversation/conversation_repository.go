@@ -0,0 +1,20 @@
+package conversations
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ConversationRepository interface {
+	// GetListByUserId returns the user's conversations, most recently active
+	// first.
+	GetListByUserId(ctx context.Context, userId uuid.UUID) ([]*Conversation, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Conversation, error)
+	// GetDirect returns the one to one conversation between two users, or nil
+	// when they have none yet.
+	GetDirect(ctx context.Context, userId, otherId uuid.UUID) (*Conversation, error)
+
+	Create(ctx context.Context, c *Conversation) (*Conversation, error)
+	MarkRead(ctx context.Context, conversationId, userId uuid.UUID, readAt time.Time) error
+}
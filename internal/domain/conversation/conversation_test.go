@@ -0,0 +1,82 @@
+package conversations
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConversationFactory_Create_Direct(t *testing.T) {
+	creatorId, otherId := uuid.New(), uuid.New()
+
+	conversation, err := NewConversationFactory().Create(creatorId, []uuid.UUID{otherId, otherId, creatorId}, nil)
+
+	require.NoError(t, err)
+	assert.False(t, conversation.IsGroup())
+	assert.Equal(t, []uuid.UUID{creatorId, otherId}, conversation.MemberIds())
+	assert.True(t, conversation.IsMember(otherId))
+	assert.False(t, conversation.IsMember(uuid.New()))
+}
+
+func TestConversationFactory_Create_Group(t *testing.T) {
+	title := "Kitchen ideas"
+
+	conversation, err := NewConversationFactory().Create(uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}, &title)
+
+	require.NoError(t, err)
+	assert.True(t, conversation.IsGroup())
+	assert.Len(t, conversation.Members(), 3)
+	assert.Equal(t, &title, conversation.Title())
+}
+
+func TestConversationFactory_Create_Errors(t *testing.T) {
+	factory := NewConversationFactory()
+	creatorId := uuid.New()
+	title := "Title"
+	long := strings.Repeat("a", 101)
+
+	var many []uuid.UUID
+	for range MaxMembersConversation {
+		many = append(many, uuid.New())
+	}
+
+	_, err := factory.Create(uuid.Nil, []uuid.UUID{uuid.New()}, nil)
+	assert.ErrorIs(t, err, ErrNilCreatorIdConversation)
+
+	_, err = factory.Create(creatorId, []uuid.UUID{uuid.Nil}, nil)
+	assert.ErrorIs(t, err, ErrNilMemberIdConversation)
+
+	_, err = factory.Create(creatorId, []uuid.UUID{creatorId}, nil)
+	assert.ErrorIs(t, err, ErrFewMembersConversation)
+
+	_, err = factory.Create(creatorId, many, nil)
+	assert.ErrorIs(t, err, ErrManyMembersConversation)
+
+	_, err = factory.Create(creatorId, []uuid.UUID{uuid.New()}, &title)
+	assert.ErrorIs(t, err, ErrTitleDirectConversation)
+
+	_, err = factory.Create(creatorId, []uuid.UUID{uuid.New(), uuid.New()}, &long)
+	assert.ErrorIs(t, err, ErrLongTitleConversation)
+}
+
+func TestConversation_MarkRead(t *testing.T) {
+	creatorId, otherId := uuid.New(), uuid.New()
+	conversation := NewConversation(creatorId, []uuid.UUID{otherId}, nil)
+	now := time.Now()
+
+	assert.False(t, conversation.Unread(otherId))
+
+	conversation.Touch(now)
+	assert.True(t, conversation.Unread(otherId))
+
+	require.NoError(t, conversation.MarkRead(otherId, now))
+	assert.False(t, conversation.Unread(otherId))
+
+	require.NoError(t, conversation.MarkRead(otherId, now.Add(-time.Hour)))
+	assert.Equal(t, now, *conversation.Member(otherId).LastReadAt())
+
+	assert.ErrorIs(t, conversation.MarkRead(uuid.New(), now), ErrNotMemberConversation)
+}
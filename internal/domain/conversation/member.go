@@ -0,0 +1,41 @@
+package conversations
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Member is a user taking part in a conversation. LastReadAt is how far they
+// have read; messages after it are unread for them.
+type Member struct {
+	userId     uuid.UUID
+	lastReadAt *time.Time
+	joinedAt   time.Time
+}
+
+func NewMember(userId uuid.UUID) *Member {
+	return &Member{
+		userId:   userId,
+		joinedAt: time.Now(),
+	}
+}
+
+func (m *Member) UserId() uuid.UUID {
+	return m.userId
+}
+
+func (m *Member) LastReadAt() *time.Time {
+	return m.lastReadAt
+}
+
+func (m *Member) JoinedAt() time.Time {
+	return m.joinedAt
+}
+
+func NewMemberFromDB(userId uuid.UUID, lastReadAt *time.Time, joinedAt time.Time) *Member {
+	return &Member{
+		userId:     userId,
+		lastReadAt: lastReadAt,
+		joinedAt:   joinedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package conversations
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilSenderIdMessage = errors.New("sender id cannot be nil")
+	ErrEmptyMessage       = errors.New("a message needs a body, a pin or a board")
+	ErrLongBodyMessage    = errors.New("body cannot be longer than 1000 characters")
+	ErrPinAndBoardMessage = errors.New("a message can share a pin or a board, not both")
+)
+
+// Message is sent to a conversation. It carries text, a shared pin or board,
+// or text together with one of them.
+type Message struct {
+	*abstractions.Entity
+	conversationId uuid.UUID
+	senderId       uuid.UUID
+	body           *string
+	pinId          *uuid.UUID
+	boardId        *uuid.UUID
+	createdAt      time.Time
+}
+
+func NewMessage(conversationId, senderId uuid.UUID, body *string, pinId, boardId *uuid.UUID) *Message {
+	return &Message{
+		Entity:         abstractions.NewEntity(uuid.New()),
+		conversationId: conversationId,
+		senderId:       senderId,
+		body:           body,
+		pinId:          pinId,
+		boardId:        boardId,
+		createdAt:      time.Now(),
+	}
+}
+
+func (m *Message) Id() uuid.UUID {
+	return m.Entity.Id
+}
+
+func (m *Message) ConversationId() uuid.UUID {
+	return m.conversationId
+}
+
+func (m *Message) SenderId() uuid.UUID {
+	return m.senderId
+}
+
+func (m *Message) Body() *string {
+	return m.body
+}
+
+func (m *Message) PinId() *uuid.UUID {
+	return m.pinId
+}
+
+func (m *Message) BoardId() *uuid.UUID {
+	return m.boardId
+}
+
+func (m *Message) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func NewMessageFromDB(id, conversationId, senderId uuid.UUID, body *string, pinId, boardId *uuid.UUID, createdAt time.Time) *Message {
+	return &Message{
+		Entity:         abstractions.NewEntity(id),
+		conversationId: conversationId,
+		senderId:       senderId,
+		body:           body,
+		pinId:          pinId,
+		boardId:        boardId,
+		createdAt:      createdAt,
+	}
+}
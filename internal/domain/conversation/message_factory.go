@@ -0,0 +1,44 @@
+package conversations
+
+import (
+	"github.com/google/uuid"
+	"strings"
+)
+
+type MessageFactory interface {
+	Create(conversationId, senderId uuid.UUID, body *string, pinId, boardId *uuid.UUID) (*Message, error)
+}
+
+type messageFactory struct{}
+
+func (f messageFactory) Create(conversationId, senderId uuid.UUID, body *string, pinId, boardId *uuid.UUID) (*Message, error) {
+	if conversationId == uuid.Nil {
+		return nil, ErrIdNilConversation
+	}
+
+	if senderId == uuid.Nil {
+		return nil, ErrNilSenderIdMessage
+	}
+
+	if body != nil && strings.TrimSpace(*body) == "" {
+		body = nil
+	}
+
+	if body != nil && len([]rune(*body)) > 1000 {
+		return nil, ErrLongBodyMessage
+	}
+
+	if pinId != nil && boardId != nil {
+		return nil, ErrPinAndBoardMessage
+	}
+
+	if body == nil && pinId == nil && boardId == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	return NewMessage(conversationId, senderId, body, pinId, boardId), nil
+}
+
+func NewMessageFactory() MessageFactory {
+	return &messageFactory{}
+}
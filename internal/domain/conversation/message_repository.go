@@ -0,0 +1,22 @@
+package conversations
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type MessageRepository interface {
+	// GetListByConversationId returns up to limit messages, newest first, with
+	// the id breaking ties between messages sent at the same instant. When
+	// before is set only messages that come after the one sent at before with
+	// id beforeId are returned.
+	GetListByConversationId(ctx context.Context, conversationId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*Message, error)
+	// CountUnreadByUserId returns, per conversation of the user, how many
+	// messages from others arrived after their read marker.
+	CountUnreadByUserId(ctx context.Context, userId uuid.UUID) (map[uuid.UUID]int, error)
+
+	// Create stores the message and moves the conversation's last activity
+	// to it.
+	Create(ctx context.Context, m *Message) (*Message, error)
+}
@@ -0,0 +1,55 @@
+package conversations
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestMessageFactory_Create(t *testing.T) {
+	conversationId, senderId, pinId := uuid.New(), uuid.New(), uuid.New()
+	body := "Look at this one"
+
+	message, err := NewMessageFactory().Create(conversationId, senderId, &body, &pinId, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, conversationId, message.ConversationId())
+	assert.Equal(t, senderId, message.SenderId())
+	assert.Equal(t, &body, message.Body())
+	assert.Equal(t, &pinId, message.PinId())
+	assert.Nil(t, message.BoardId())
+}
+
+func TestMessageFactory_Create_OnlyPin(t *testing.T) {
+	pinId := uuid.New()
+	blank := "   "
+
+	message, err := NewMessageFactory().Create(uuid.New(), uuid.New(), &blank, &pinId, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, message.Body())
+}
+
+func TestMessageFactory_Create_Errors(t *testing.T) {
+	factory := NewMessageFactory()
+	id := uuid.New()
+	body := "hi"
+	long := strings.Repeat("a", 1001)
+
+	_, err := factory.Create(uuid.Nil, id, &body, nil, nil)
+	assert.ErrorIs(t, err, ErrIdNilConversation)
+
+	_, err = factory.Create(id, uuid.Nil, &body, nil, nil)
+	assert.ErrorIs(t, err, ErrNilSenderIdMessage)
+
+	_, err = factory.Create(id, id, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrEmptyMessage)
+
+	_, err = factory.Create(id, id, &long, nil, nil)
+	assert.ErrorIs(t, err, ErrLongBodyMessage)
+
+	_, err = factory.Create(id, id, nil, &id, &id)
+	assert.ErrorIs(t, err, ErrPinAndBoardMessage)
+}
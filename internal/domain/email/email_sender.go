@@ -2,4 +2,6 @@ package email
 
 type Sender interface {
 	SendVerificationEmail(toEmail, token string) error
+	SendPasswordResetEmail(toEmail, token string) error
+	SendUnlockEmail(toEmail, token string) error
 }
@@ -1,19 +1,57 @@
 package email
 
 import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
 	"github.com/google/uuid"
 	"time"
 )
 
+const (
+	VerificationTTL = 24 * time.Hour
+	// ResendCooldown and MaxSendsPerDay bound how many verification and
+	// password reset emails an account gets, so asking for them again and
+	// again cannot be used to flood an inbox.
+	ResendCooldown = time.Minute
+	MaxSendsPerDay = 5
+)
+
+var (
+	ErrInvalidEmailVerification  = errors.New("invalid email verification token")
+	ErrExpiredEmailVerification  = errors.New("email verification token expired")
+	ErrUsedEmailVerification     = errors.New("email verification token already used")
+	ErrNotFoundEmailVerification = errors.New("email verification not found")
+)
+
+// EmailVerification proves a user owns their email once they follow the
+// emailed link. Only the hash of the token is kept.
 type EmailVerification struct {
 	Id         uuid.UUID  `json:"id"`
 	UserId     uuid.UUID  `json:"user_id"`
-	Token      string     `json:"token"`
+	TokenHash  string     `json:"-"`
 	CreatedAt  time.Time  `json:"created_at"`
 	ExpiresAt  time.Time  `json:"expires_at"`
 	VerifiedAt *time.Time `json:"verified_at,omitempty"`
 }
 
+// NewEmailVerification returns the verification along with the token to
+// email, which cannot be recovered from the verification afterward.
+func NewEmailVerification(userId uuid.UUID) (*EmailVerification, string, error) {
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &EmailVerification{
+		Id:        uuid.New(),
+		UserId:    userId,
+		TokenHash: tokens.Hash(secret),
+		CreatedAt: now,
+		ExpiresAt: now.Add(VerificationTTL),
+	}, secret, nil
+}
+
 func (ev *EmailVerification) IsExpired() bool {
 	return time.Now().After(ev.ExpiresAt)
 }
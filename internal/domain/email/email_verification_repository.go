@@ -1,9 +1,15 @@
 package email
 
-import "context"
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
 
 type EmailVerificationRepository interface {
 	Save(ctx context.Context, ev *EmailVerification) error
-	FindByToken(ctx context.Context, token string) (*EmailVerification, error)
+	FindByHash(ctx context.Context, hash string) (*EmailVerification, error)
 	MarkVerified(ctx context.Context, ev *EmailVerification) error
+	// CountSince counts the verifications sent to the user since the time.
+	CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error)
 }
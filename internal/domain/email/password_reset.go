@@ -0,0 +1,52 @@
+package email
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrInvalidPasswordReset  = errors.New("invalid password reset token")
+	ErrExpiredPasswordReset  = errors.New("password reset token expired")
+	ErrUsedPasswordReset     = errors.New("password reset token already used")
+	ErrNotFoundPasswordReset = errors.New("password reset not found")
+)
+
+// PasswordReset lets a user who forgot their password set a new one. Only the
+// hash of the token is kept; the token itself travels in the emailed link.
+type PasswordReset struct {
+	Id        uuid.UUID  `json:"id"`
+	UserId    uuid.UUID  `json:"user_id"`
+	TokenHash string     `json:"-"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+// NewPasswordReset returns the reset along with the token to email, which
+// cannot be recovered from the reset afterward.
+func NewPasswordReset(userId uuid.UUID, ttl time.Duration) (*PasswordReset, string, error) {
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &PasswordReset{
+		Id:        uuid.New(),
+		UserId:    userId,
+		TokenHash: tokens.Hash(secret),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}, secret, nil
+}
+
+func (pr *PasswordReset) IsExpired() bool {
+	return time.Now().After(pr.ExpiresAt)
+}
+
+func (pr *PasswordReset) IsUsed() bool {
+	return pr.UsedAt != nil
+}
@@ -0,0 +1,17 @@
+package email
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PasswordResetRepository interface {
+	Save(ctx context.Context, pr *PasswordReset) error
+	FindByHash(ctx context.Context, hash string) (*PasswordReset, error)
+	// MarkUsed reports false when the reset was used meanwhile, so a token
+	// cannot set a password twice.
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+	// CountSince counts the resets sent to the user since the time.
+	CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error)
+}
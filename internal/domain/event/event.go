@@ -0,0 +1,117 @@
+package events
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilSessionIdEvent = errors.New("event session id cannot be nil")
+	ErrNilPinIdEvent     = errors.New("event pin id cannot be nil")
+	ErrFutureEvent       = errors.New("event cannot happen in the future")
+	ErrStaleEvent        = errors.New("event is older than 24 hours")
+	ErrEmptyBatch        = errors.New("event batch cannot be empty")
+	ErrLargeBatch        = errors.New("event batch cannot hold more than 100 events")
+	ErrFullBuffer        = errors.New("event buffer is full, try again later")
+)
+
+const (
+	// MaxBatch is how many events a client may send at once.
+	MaxBatch = 100
+	// MaxAge is how late an event may arrive. Rollups look back as far, so
+	// late events still reach the aggregates.
+	MaxAge = 24 * time.Hour
+	// MaxSkew tolerates client clocks slightly ahead of ours.
+	MaxSkew = 5 * time.Minute
+)
+
+// Event is something a user did with a pin, as reported by a client. Events
+// are append-only and counted once per session, pin and kind.
+type Event struct {
+	id         uuid.UUID
+	userId     uuid.UUID
+	sessionId  uuid.UUID
+	pinId      uuid.UUID
+	kind       Kind
+	occurredAt time.Time
+	receivedAt time.Time
+}
+
+// NewEvent checks an event reported at receivedAt. Events must have happened
+// within MaxAge before it.
+func NewEvent(userId, sessionId, pinId uuid.UUID, kind Kind, occurredAt, receivedAt time.Time) (*Event, error) {
+	if sessionId == uuid.Nil {
+		return nil, ErrNilSessionIdEvent
+	} else if pinId == uuid.Nil {
+		return nil, ErrNilPinIdEvent
+	} else if _, err := ParseKind(string(kind)); err != nil {
+		return nil, err
+	} else if occurredAt.After(receivedAt.Add(MaxSkew)) {
+		return nil, ErrFutureEvent
+	} else if occurredAt.Before(receivedAt.Add(-MaxAge)) {
+		return nil, ErrStaleEvent
+	}
+
+	return &Event{
+		id:         uuid.New(),
+		userId:     userId,
+		sessionId:  sessionId,
+		pinId:      pinId,
+		kind:       kind,
+		occurredAt: occurredAt,
+		receivedAt: receivedAt,
+	}, nil
+}
+
+func (e *Event) Id() uuid.UUID {
+	return e.id
+}
+
+func (e *Event) UserId() uuid.UUID {
+	return e.userId
+}
+
+func (e *Event) SessionId() uuid.UUID {
+	return e.sessionId
+}
+
+func (e *Event) PinId() uuid.UUID {
+	return e.pinId
+}
+
+func (e *Event) Kind() Kind {
+	return e.kind
+}
+
+func (e *Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *Event) ReceivedAt() time.Time {
+	return e.receivedAt
+}
+
+// key identifies the events that count as one.
+type key struct {
+	sessionId uuid.UUID
+	pinId     uuid.UUID
+	kind      Kind
+}
+
+// Dedupe keeps the first event of each session, pin and kind.
+func Dedupe(list []*Event) []*Event {
+	seen := make(map[key]bool, len(list))
+	unique := make([]*Event, 0, len(list))
+	for _, e := range list {
+		k := key{sessionId: e.sessionId, pinId: e.pinId, kind: e.kind}
+		if seen[k] {
+			continue
+		}
+
+		seen[k] = true
+		unique = append(unique, e)
+	}
+
+	return unique
+}
@@ -0,0 +1,21 @@
+package events
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type EventRepository interface {
+	// CreateBatch appends the events, skipping the ones already recorded for
+	// their session, and returns how many were written.
+	CreateBatch(ctx context.Context, list []*Event) (int64, error)
+	// GetListVisiblePins returns the given pins the user can see, in no
+	// particular order: their own active pins and the public ones of users
+	// not in a block with them.
+	GetListVisiblePins(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error)
+	// Rollup counts the events that happened from one hour up to another
+	// into the hourly aggregates of their pins and boards, replacing what
+	// those hours held.
+	Rollup(ctx context.Context, from, to time.Time) error
+}
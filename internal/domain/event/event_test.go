@@ -0,0 +1,78 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseKind(t *testing.T) {
+	for _, kind := range []string{"impression", "closeup", "outbound_click", "save", "share"} {
+		k, err := ParseKind(kind)
+		require.NoError(t, err)
+		assert.Equal(t, Kind(kind), k)
+	}
+
+	_, err := ParseKind("hover")
+	assert.ErrorIs(t, err, ErrInvalidKindEvent)
+}
+
+func TestNewEvent(t *testing.T) {
+	now := time.Now()
+	userId, sessionId, pinId := uuid.New(), uuid.New(), uuid.New()
+
+	e, err := NewEvent(userId, sessionId, pinId, CloseupKind, now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, e.Id())
+	assert.Equal(t, userId, e.UserId())
+	assert.Equal(t, sessionId, e.SessionId())
+	assert.Equal(t, pinId, e.PinId())
+	assert.Equal(t, CloseupKind, e.Kind())
+	assert.Equal(t, now.Add(-time.Minute), e.OccurredAt())
+	assert.Equal(t, now, e.ReceivedAt())
+
+	_, err = NewEvent(userId, sessionId, pinId, ImpressionKind, now.Add(MaxSkew/2), now)
+	assert.NoError(t, err)
+}
+
+func TestNewEvent_Errors(t *testing.T) {
+	now := time.Now()
+	userId, sessionId, pinId := uuid.New(), uuid.New(), uuid.New()
+
+	cases := []struct {
+		sessionId  uuid.UUID
+		pinId      uuid.UUID
+		kind       Kind
+		occurredAt time.Time
+		err        error
+	}{
+		{uuid.Nil, pinId, SaveKind, now, ErrNilSessionIdEvent},
+		{sessionId, uuid.Nil, SaveKind, now, ErrNilPinIdEvent},
+		{sessionId, pinId, "hover", now, ErrInvalidKindEvent},
+		{sessionId, pinId, SaveKind, now.Add(MaxSkew + time.Minute), ErrFutureEvent},
+		{sessionId, pinId, SaveKind, now.Add(-MaxAge - time.Minute), ErrStaleEvent},
+	}
+
+	for _, c := range cases {
+		e, err := NewEvent(userId, c.sessionId, c.pinId, c.kind, c.occurredAt, now)
+		assert.ErrorIs(t, err, c.err)
+		assert.Nil(t, e)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	now := time.Now()
+	userId, sessionId, pinId := uuid.New(), uuid.New(), uuid.New()
+
+	first, _ := NewEvent(userId, sessionId, pinId, ImpressionKind, now.Add(-2*time.Minute), now)
+	again, _ := NewEvent(userId, sessionId, pinId, ImpressionKind, now.Add(-time.Minute), now)
+	closeup, _ := NewEvent(userId, sessionId, pinId, CloseupKind, now, now)
+	other, _ := NewEvent(userId, uuid.New(), pinId, ImpressionKind, now, now)
+
+	unique := Dedupe([]*Event{first, again, closeup, other})
+
+	assert.Equal(t, []*Event{first, closeup, other}, unique)
+	assert.Empty(t, Dedupe(nil))
+}
@@ -0,0 +1,24 @@
+package events
+
+import "errors"
+
+type Kind string
+
+const (
+	ImpressionKind    Kind = "impression"
+	CloseupKind       Kind = "closeup"
+	OutboundClickKind Kind = "outbound_click"
+	SaveKind          Kind = "save"
+	ShareKind         Kind = "share"
+)
+
+var ErrInvalidKindEvent = errors.New("event kind must be impression, closeup, outbound_click, save or share")
+
+func ParseKind(kind string) (Kind, error) {
+	switch k := Kind(kind); k {
+	case ImpressionKind, CloseupKind, OutboundClickKind, SaveKind, ShareKind:
+		return k, nil
+	default:
+		return "", ErrInvalidKindEvent
+	}
+}
@@ -0,0 +1,10 @@
+package events
+
+import "context"
+
+// Writer buffers events on their way to the repository, so ingesting a batch
+// does not wait on the database. Write fails with ErrFullBuffer when the
+// buffer cannot take the whole batch; nothing of it is kept then.
+type Writer interface {
+	Write(ctx context.Context, list []*Event) error
+}
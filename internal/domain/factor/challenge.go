@@ -0,0 +1,66 @@
+package factors
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MaxChallengeAttempts is how many wrong codes a challenge takes before it is
+// dropped and the user has to log in with their password again.
+const MaxChallengeAttempts = 5
+
+var (
+	ErrInvalidChallenge        = errors.New("invalid or expired two-factor challenge")
+	ErrNonPositiveTTLChallenge = errors.New("two-factor challenge ttl must be positive")
+)
+
+// Challenge stands between a correct password and the tokens of a user with
+// two-factor authentication on. The client gets its secret on login and trades
+// it, along with a code, for the tokens. Only its hash is kept.
+type Challenge struct {
+	hash      string
+	userId    uuid.UUID
+	expiresAt time.Time
+}
+
+// NewChallenge returns the challenge along with the secret to give the client.
+func NewChallenge(userId uuid.UUID, ttl time.Duration) (*Challenge, string, error) {
+	if userId == uuid.Nil {
+		return nil, "", ErrNilUserIdFactor
+	} else if ttl <= 0 {
+		return nil, "", ErrNonPositiveTTLChallenge
+	}
+
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Challenge{
+		hash:      tokens.Hash(secret),
+		userId:    userId,
+		expiresAt: time.Now().Add(ttl),
+	}, secret, nil
+}
+
+func NewChallengeFromStore(hash string, userId uuid.UUID, expiresAt time.Time) *Challenge {
+	return &Challenge{
+		hash:      hash,
+		userId:    userId,
+		expiresAt: expiresAt,
+	}
+}
+
+func (c *Challenge) Hash() string {
+	return c.hash
+}
+
+func (c *Challenge) UserId() uuid.UUID {
+	return c.userId
+}
+
+func (c *Challenge) ExpiresAt() time.Time {
+	return c.expiresAt
+}
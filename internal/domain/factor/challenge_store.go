@@ -0,0 +1,16 @@
+package factors
+
+import "context"
+
+// ChallengeStore keeps pending challenges until they expire.
+type ChallengeStore interface {
+	Save(ctx context.Context, challenge *Challenge) error
+	// Get returns ErrInvalidChallenge for unknown and expired challenges.
+	Get(ctx context.Context, hash string) (*Challenge, error)
+	// Fail counts a wrong code against the challenge and returns how many it
+	// has taken so far.
+	Fail(ctx context.Context, hash string) (int, error)
+	// Delete reports false when the challenge was gone already, so two
+	// concurrent answers cannot both be traded for tokens.
+	Delete(ctx context.Context, hash string) (bool, error)
+}
@@ -0,0 +1,112 @@
+package factors
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdFactor    = errors.New("factor user id cannot be nil")
+	ErrInvalidCodeFactor  = errors.New("invalid authentication code")
+	ErrConfirmedFactor    = errors.New("two-factor authentication is already enabled")
+	ErrNotConfirmedFactor = errors.New("two-factor authentication is not enabled")
+	ErrNotFoundFactor     = errors.New("two-factor authentication has not been set up")
+)
+
+// Factor is the TOTP authenticator of a user. It is enrolled unconfirmed and
+// only guards logins once the user proves their app holds the secret by
+// confirming it with a first code.
+type Factor struct {
+	userId      uuid.UUID
+	secret      string
+	lastCounter int64
+	createdAt   time.Time
+	confirmedAt *time.Time
+}
+
+func NewFactor(userId uuid.UUID) (*Factor, error) {
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdFactor
+	}
+
+	secret, err := NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Factor{
+		userId:    userId,
+		secret:    secret,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func NewFactorFromDB(userId uuid.UUID, secret string, lastCounter int64, createdAt time.Time, confirmedAt *time.Time) *Factor {
+	return &Factor{
+		userId:      userId,
+		secret:      secret,
+		lastCounter: lastCounter,
+		createdAt:   createdAt,
+		confirmedAt: confirmedAt,
+	}
+}
+
+func (f *Factor) UserId() uuid.UUID {
+	return f.userId
+}
+
+func (f *Factor) Secret() string {
+	return f.secret
+}
+
+// LastCounter is the period of the last code accepted. Codes of that period
+// or earlier ones are not accepted again.
+func (f *Factor) LastCounter() int64 {
+	return f.lastCounter
+}
+
+func (f *Factor) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func (f *Factor) ConfirmedAt() *time.Time {
+	return f.confirmedAt
+}
+
+func (f *Factor) IsConfirmed() bool {
+	return f.confirmedAt != nil
+}
+
+// Check returns the period of code if it is the code of a period around now
+// newer than the last one accepted, so a code cannot be used twice.
+func (f *Factor) Check(code string, now time.Time) (int64, error) {
+	counter, ok := match(f.secret, code, now)
+	if !ok || counter <= f.lastCounter {
+		return 0, ErrInvalidCodeFactor
+	}
+	return counter, nil
+}
+
+// Accept records counter as the last period a code was accepted for.
+func (f *Factor) Accept(counter int64) {
+	if counter > f.lastCounter {
+		f.lastCounter = counter
+	}
+}
+
+// Confirm turns the factor on with the first code the app shows.
+func (f *Factor) Confirm(code string, now time.Time) error {
+	if f.IsConfirmed() {
+		return ErrConfirmedFactor
+	}
+
+	counter, err := f.Check(code, now)
+	if err != nil {
+		return err
+	}
+
+	f.Accept(counter)
+	f.confirmedAt = &now
+	return nil
+}
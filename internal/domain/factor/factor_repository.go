@@ -0,0 +1,25 @@
+package factors
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type FactorRepository interface {
+	// GetByUser returns ErrNotFoundFactor when the user has no factor.
+	GetByUser(ctx context.Context, userId uuid.UUID) (*Factor, error)
+	// Save stores a new enrollment, replacing an unconfirmed one the user
+	// left behind.
+	Save(ctx context.Context, factor *Factor) error
+	// Confirm turns the factor on and replaces the recovery codes of the user
+	// with the ones hashed.
+	Confirm(ctx context.Context, factor *Factor, hashes []string) error
+	// Accept records the period of an accepted code. It reports false when a
+	// code of that period or a later one was accepted meanwhile.
+	Accept(ctx context.Context, userId uuid.UUID, counter int64) (bool, error)
+	// UseRecoveryCode reports false when no unused code has the hash.
+	UseRecoveryCode(ctx context.Context, userId uuid.UUID, hash string, at time.Time) (bool, error)
+	// Delete removes the factor and the recovery codes of the user.
+	Delete(ctx context.Context, userId uuid.UUID) error
+}
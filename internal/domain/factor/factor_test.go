@@ -0,0 +1,180 @@
+package factors
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors, base32 encoded.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tc := range cases {
+		code, err := Code(rfcSecret, time.Unix(tc.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tc.code, code, tc.unix)
+	}
+
+	_, err := Code("not base32!", time.Now())
+	assert.Error(t, err)
+}
+
+func TestNewTOTPSecret(t *testing.T) {
+	first, err := NewTOTPSecret()
+	require.NoError(t, err)
+	second, err := NewTOTPSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 32)
+	assert.NotEqual(t, first, second)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Pinterest", "ana@mail.com", rfcSecret)
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Pinterest:ana@mail.com", parsed.Path)
+	assert.Equal(t, rfcSecret, parsed.Query().Get("secret"))
+	assert.Equal(t, "Pinterest", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
+
+func TestNewFactor(t *testing.T) {
+	userId := uuid.New()
+
+	factor, err := NewFactor(userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, factor.UserId())
+	assert.Len(t, factor.Secret(), 32)
+	assert.Zero(t, factor.LastCounter())
+	assert.False(t, factor.IsConfirmed())
+
+	_, err = NewFactor(uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilUserIdFactor)
+}
+
+func TestFactor_Check(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	factor := NewFactorFromDB(uuid.New(), rfcSecret, 0, now, &now)
+
+	for _, offset := range []time.Duration{-Period, 0, Period} {
+		code, err := Code(rfcSecret, now.Add(offset))
+		require.NoError(t, err)
+
+		counter, err := factor.Check(code, now)
+		require.NoError(t, err)
+		assert.Equal(t, Counter(now.Add(offset)), counter)
+	}
+
+	for _, code := range []string{"", "12345", "1234567", "abcdef"} {
+		_, err := factor.Check(code, now)
+		assert.ErrorIs(t, err, ErrInvalidCodeFactor, code)
+	}
+
+	stale, err := Code(rfcSecret, now.Add(-2*Period))
+	require.NoError(t, err)
+	_, err = factor.Check(stale, now)
+	assert.ErrorIs(t, err, ErrInvalidCodeFactor)
+}
+
+func TestFactor_Check_Replay(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	factor := NewFactorFromDB(uuid.New(), rfcSecret, 0, now, &now)
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	counter, err := factor.Check(code, now)
+	require.NoError(t, err)
+	factor.Accept(counter)
+
+	_, err = factor.Check(code, now)
+	assert.ErrorIs(t, err, ErrInvalidCodeFactor)
+
+	earlier, err := Code(rfcSecret, now.Add(-Period))
+	require.NoError(t, err)
+	_, err = factor.Check(earlier, now)
+	assert.ErrorIs(t, err, ErrInvalidCodeFactor)
+
+	later, err := Code(rfcSecret, now.Add(Period))
+	require.NoError(t, err)
+	_, err = factor.Check(later, now)
+	assert.NoError(t, err)
+}
+
+func TestFactor_Confirm(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	factor := NewFactorFromDB(uuid.New(), rfcSecret, 0, now, nil)
+
+	assert.ErrorIs(t, factor.Confirm("000000", now), ErrInvalidCodeFactor)
+	assert.False(t, factor.IsConfirmed())
+
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+	require.NoError(t, factor.Confirm(code, now))
+
+	assert.True(t, factor.IsConfirmed())
+	assert.Equal(t, now, *factor.ConfirmedAt())
+	assert.Equal(t, Counter(now), factor.LastCounter())
+	assert.ErrorIs(t, factor.Confirm(code, now), ErrConfirmedFactor)
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, hashes, err := NewRecoveryCodes()
+
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodes)
+	require.Len(t, hashes, RecoveryCodes)
+
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		assert.Equal(t, HashRecoveryCode(code), hashes[i])
+		assert.NotContains(t, hashes[i], code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	assert.Equal(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("ABCDE FGHIJ"))
+	assert.Equal(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("abcdefghij"))
+	assert.NotEqual(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("abcde-fghik"))
+}
+
+func TestNewChallenge(t *testing.T) {
+	userId := uuid.New()
+
+	challenge, secret, err := NewChallenge(userId, 5*time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, challenge.UserId())
+	assert.NotEqual(t, secret, challenge.Hash())
+	assert.Len(t, challenge.Hash(), 64)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), challenge.ExpiresAt(), time.Second)
+
+	_, _, err = NewChallenge(uuid.Nil, time.Minute)
+	assert.ErrorIs(t, err, ErrNilUserIdFactor)
+
+	_, _, err = NewChallenge(userId, 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLChallenge)
+}
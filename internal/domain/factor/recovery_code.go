@@ -0,0 +1,44 @@
+package factors
+
+import (
+	"crypto/rand"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"strings"
+)
+
+// RecoveryCodes is how many recovery codes a user gets when they turn
+// two-factor authentication on. Each one logs in once in place of a code.
+const RecoveryCodes = 10
+
+// recoveryAlphabet has 32 symbols so every random byte maps to one evenly.
+const recoveryAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+// NewRecoveryCodes returns the codes to show the user once, as xxxxx-xxxxx,
+// along with the hashes to keep.
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodes)
+	hashes := make([]string, RecoveryCodes)
+
+	for i := range codes {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		for j := range b {
+			b[j] = recoveryAlphabet[b[j]&31]
+		}
+
+		codes[i] = string(b[:5]) + "-" + string(b[5:])
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes, which people add or drop
+// when typing a code back.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	return tokens.Hash(code)
+}
@@ -0,0 +1,65 @@
+package feeds
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid feed cursor")
+
+// Cursor marks the last entry of a feed page. Clients get it as an opaque
+// string and send it back to read the entries that come after it.
+type Cursor struct {
+	last Entry
+}
+
+func NewCursor(last Entry) *Cursor {
+	return &Cursor{
+		last: last,
+	}
+}
+
+// ParseCursor decodes a cursor previously returned by String.
+func ParseCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	unix, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	pinId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return NewCursor(NewEntry(pinId, time.UnixMicro(unix).UTC())), nil
+}
+
+func (c *Cursor) Last() Entry {
+	return c.last
+}
+
+// Includes reports whether e comes after the cursor, so belongs to the pages
+// still to be read.
+func (c *Cursor) Includes(e Entry) bool {
+	return c == nil || c.last.Before(e)
+}
+
+func (c *Cursor) String() string {
+	raw := fmt.Sprintf("%d:%s", c.last.CreatedAt().UnixMicro(), c.last.PinId())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
@@ -0,0 +1,16 @@
+package feeds
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+// Distributor keeps stored home feeds in step with new pins and follows.
+// Implementations must not fail the caller's command because a feed could not
+// be updated: feeds are checked against the database when they are read.
+type Distributor interface {
+	Distribute(ctx context.Context, pin *pins.Pin)
+	Followed(ctx context.Context, userId uuid.UUID, source Source)
+	Unfollowed(ctx context.Context, userId uuid.UUID, source Source)
+}
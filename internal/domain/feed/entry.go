@@ -0,0 +1,38 @@
+package feeds
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Entry is a pin in a home feed. Feeds are ordered newest first, with the pin
+// id breaking ties between pins created at the same instant.
+type Entry struct {
+	pinId     uuid.UUID
+	createdAt time.Time
+}
+
+func NewEntry(pinId uuid.UUID, createdAt time.Time) Entry {
+	return Entry{
+		pinId:     pinId,
+		createdAt: createdAt,
+	}
+}
+
+func (e Entry) PinId() uuid.UUID {
+	return e.pinId
+}
+
+func (e Entry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// Before reports whether e comes before other in a feed, that is whether it is
+// newer.
+func (e Entry) Before(other Entry) bool {
+	if !e.createdAt.Equal(other.createdAt) {
+		return e.createdAt.After(other.createdAt)
+	}
+
+	return e.pinId.String() > other.pinId.String()
+}
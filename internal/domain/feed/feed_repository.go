@@ -0,0 +1,34 @@
+package feeds
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// FeedRepository answers the feed questions that need the database. A pin is
+// reachable for a user when they follow its author, its board or one of its
+// tags.
+type FeedRepository interface {
+	// GetRecipients returns the users whose stored feed should get the pin:
+	// the followers of its author, unless the author has at least popularAt
+	// followers, and the followers of its board and tags. Users in a block
+	// with the author or who muted them are left out.
+	GetRecipients(ctx context.Context, pinId uuid.UUID, popularAt int) ([]uuid.UUID, error)
+	// GetList returns the feed of the user straight from the database, from
+	// every source they follow.
+	GetList(ctx context.Context, userId uuid.UUID, cursor *Cursor, limit int) ([]Entry, error)
+	// GetListFromPopular returns the pins of the followed authors with at
+	// least popularAt followers, which are never copied into stored feeds.
+	GetListFromPopular(ctx context.Context, userId uuid.UUID, popularAt int, cursor *Cursor, limit int) ([]Entry, error)
+	// GetListBySource returns the newest pins of a source.
+	GetListBySource(ctx context.Context, source Source, limit int) ([]Entry, error)
+	// GetListOrphaned returns the newest pins of a source that are no longer
+	// reachable for the user.
+	GetListOrphaned(ctx context.Context, userId uuid.UUID, source Source, limit int) ([]uuid.UUID, error)
+	// GetListVisible keeps the pins the user may see in their feed right now:
+	// reachable, public, not deleted and not written by someone in a block
+	// with the user or muted by them.
+	GetListVisible(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error)
+
+	IsPopular(ctx context.Context, userId uuid.UUID, popularAt int) (bool, error)
+}
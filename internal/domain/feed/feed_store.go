@@ -0,0 +1,22 @@
+package feeds
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// FeedStore keeps a precomputed home feed per user, filled as pins are
+// created. It is a cache: a missing feed is rebuilt from the database.
+type FeedStore interface {
+	Exists(ctx context.Context, userId uuid.UUID) (bool, error)
+	// Page returns up to limit entries that come after the cursor, newest
+	// first. A nil cursor starts at the top of the feed.
+	Page(ctx context.Context, userId uuid.UUID, cursor *Cursor, limit int) ([]Entry, error)
+
+	// Add puts the entries in the stored feed of every user given. Users
+	// without a stored feed are skipped, theirs is rebuilt when next read.
+	Add(ctx context.Context, userIds []uuid.UUID, entries []Entry) error
+	// Replace stores the whole feed of a user, as rebuilt from the database.
+	Replace(ctx context.Context, userId uuid.UUID, entries []Entry) error
+	Remove(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) error
+}
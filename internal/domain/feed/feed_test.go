@@ -0,0 +1,60 @@
+package feeds
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewSource(t *testing.T) {
+	id := uuid.New()
+
+	source, err := NewSource(BoardSource, id)
+
+	require.NoError(t, err)
+	assert.Equal(t, BoardSource, source.Kind())
+	assert.Equal(t, id, source.Id())
+
+	_, err = NewSource(UserSource, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilIdSource)
+}
+
+func TestEntry_Before(t *testing.T) {
+	now := time.Now()
+	low := uuid.MustParse("00000000-0000-4000-8000-000000000001")
+	high := uuid.MustParse("ffffffff-0000-4000-8000-000000000001")
+
+	assert.True(t, NewEntry(low, now).Before(NewEntry(high, now.Add(-time.Second))))
+	assert.False(t, NewEntry(high, now.Add(-time.Second)).Before(NewEntry(low, now)))
+	assert.True(t, NewEntry(high, now).Before(NewEntry(low, now)))
+	assert.False(t, NewEntry(low, now).Before(NewEntry(low, now)))
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	entry := NewEntry(uuid.New(), time.UnixMicro(time.Now().UnixMicro()))
+
+	cursor, err := ParseCursor(NewCursor(entry).String())
+
+	require.NoError(t, err)
+	assert.Equal(t, entry.PinId(), cursor.Last().PinId())
+	assert.True(t, entry.CreatedAt().Equal(cursor.Last().CreatedAt()))
+}
+
+func TestCursor_Includes(t *testing.T) {
+	now := time.Now()
+	cursor := NewCursor(NewEntry(uuid.New(), now))
+
+	assert.True(t, cursor.Includes(NewEntry(uuid.New(), now.Add(-time.Second))))
+	assert.False(t, cursor.Includes(NewEntry(uuid.New(), now.Add(time.Second))))
+	assert.False(t, cursor.Includes(cursor.Last()))
+	assert.True(t, (*Cursor)(nil).Includes(NewEntry(uuid.New(), now)))
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "not base64!", "MTIz", "YWJjOmRlZg"} {
+		_, err := ParseCursor(raw)
+		assert.ErrorIs(t, err, ErrInvalidCursor, raw)
+	}
+}
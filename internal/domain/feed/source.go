@@ -0,0 +1,42 @@
+package feeds
+
+import (
+	"errors"
+	"github.com/google/uuid"
+)
+
+type SourceKind string
+
+const (
+	UserSource  SourceKind = "user"
+	BoardSource SourceKind = "board"
+	TagSource   SourceKind = "tag"
+)
+
+var ErrNilIdSource = errors.New("feed source id cannot be nil")
+
+// Source is something a user follows that brings pins into their home feed:
+// another user, a board or a tag.
+type Source struct {
+	kind SourceKind
+	id   uuid.UUID
+}
+
+func NewSource(kind SourceKind, id uuid.UUID) (Source, error) {
+	if id == uuid.Nil {
+		return Source{}, ErrNilIdSource
+	}
+
+	return Source{
+		kind: kind,
+		id:   id,
+	}, nil
+}
+
+func (s Source) Kind() SourceKind {
+	return s.kind
+}
+
+func (s Source) Id() uuid.UUID {
+	return s.id
+}
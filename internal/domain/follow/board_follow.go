@@ -0,0 +1,59 @@
+package follows
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdBoardFollow  = errors.New("user id cannot be nil")
+	ErrNilBoardIdBoardFollow = errors.New("board id cannot be nil")
+	ErrOwnBoardFollow        = errors.New("users cannot follow their own boards")
+	ErrExistsBoardFollow     = errors.New("already following this board")
+	ErrNotFoundBoardFollow   = errors.New("not following this board")
+)
+
+// BoardFollow records that a user wants the pins of a board in their home
+// feed.
+type BoardFollow struct {
+	userId    uuid.UUID
+	boardId   uuid.UUID
+	createdAt time.Time
+}
+
+func NewBoardFollow(userId, boardId uuid.UUID) (*BoardFollow, error) {
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdBoardFollow
+	}
+
+	if boardId == uuid.Nil {
+		return nil, ErrNilBoardIdBoardFollow
+	}
+
+	return &BoardFollow{
+		userId:    userId,
+		boardId:   boardId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (f *BoardFollow) UserId() uuid.UUID {
+	return f.userId
+}
+
+func (f *BoardFollow) BoardId() uuid.UUID {
+	return f.boardId
+}
+
+func (f *BoardFollow) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func NewBoardFollowFromDB(userId, boardId uuid.UUID, createdAt time.Time) *BoardFollow {
+	return &BoardFollow{
+		userId:    userId,
+		boardId:   boardId,
+		createdAt: createdAt,
+	}
+}
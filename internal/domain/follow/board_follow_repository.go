@@ -0,0 +1,13 @@
+package follows
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type BoardFollowRepository interface {
+	Exists(ctx context.Context, userId, boardId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, f *BoardFollow) error
+	Delete(ctx context.Context, f *BoardFollow) error
+}
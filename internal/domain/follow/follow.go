@@ -0,0 +1,61 @@
+package follows
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilFollowerIdFollow = errors.New("follower id cannot be nil")
+	ErrNilFolloweeIdFollow = errors.New("followee id cannot be nil")
+	ErrSelfFollow          = errors.New("users cannot follow themselves")
+	ErrExistsFollow        = errors.New("already following this user")
+	ErrNotFoundFollow      = errors.New("not following this user")
+)
+
+type Follow struct {
+	followerId uuid.UUID
+	followeeId uuid.UUID
+	createdAt  time.Time
+}
+
+func NewFollow(followerId, followeeId uuid.UUID) (*Follow, error) {
+	if followerId == uuid.Nil {
+		return nil, ErrNilFollowerIdFollow
+	}
+
+	if followeeId == uuid.Nil {
+		return nil, ErrNilFolloweeIdFollow
+	}
+
+	if followerId == followeeId {
+		return nil, ErrSelfFollow
+	}
+
+	return &Follow{
+		followerId: followerId,
+		followeeId: followeeId,
+		createdAt:  time.Now(),
+	}, nil
+}
+
+func (f *Follow) FollowerId() uuid.UUID {
+	return f.followerId
+}
+
+func (f *Follow) FolloweeId() uuid.UUID {
+	return f.followeeId
+}
+
+func (f *Follow) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func NewFollowFromDB(followerId, followeeId uuid.UUID, createdAt time.Time) *Follow {
+	return &Follow{
+		followerId: followerId,
+		followeeId: followeeId,
+		createdAt:  createdAt,
+	}
+}
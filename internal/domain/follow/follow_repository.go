@@ -0,0 +1,16 @@
+package follows
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type FollowRepository interface {
+	GetFollowers(ctx context.Context, userId uuid.UUID) ([]*Follow, error)
+	GetFollowing(ctx context.Context, userId uuid.UUID) ([]*Follow, error)
+
+	Exists(ctx context.Context, followerId, followeeId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, f *Follow) error
+	Delete(ctx context.Context, f *Follow) error
+}
@@ -0,0 +1,67 @@
+package follows
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewFollow(t *testing.T) {
+	followerId, followeeId := uuid.New(), uuid.New()
+
+	follow, err := NewFollow(followerId, followeeId)
+
+	require.NoError(t, err)
+	assert.Equal(t, followerId, follow.FollowerId())
+	assert.Equal(t, followeeId, follow.FolloweeId())
+	assert.WithinDuration(t, time.Now(), follow.CreatedAt(), time.Second)
+}
+
+func TestNewFollow_Errors(t *testing.T) {
+	id := uuid.New()
+
+	_, err := NewFollow(uuid.Nil, id)
+	assert.ErrorIs(t, err, ErrNilFollowerIdFollow)
+
+	_, err = NewFollow(id, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilFolloweeIdFollow)
+
+	_, err = NewFollow(id, id)
+	assert.ErrorIs(t, err, ErrSelfFollow)
+}
+
+func TestNewBoardFollow(t *testing.T) {
+	userId, boardId := uuid.New(), uuid.New()
+
+	follow, err := NewBoardFollow(userId, boardId)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, follow.UserId())
+	assert.Equal(t, boardId, follow.BoardId())
+	assert.WithinDuration(t, time.Now(), follow.CreatedAt(), time.Second)
+
+	_, err = NewBoardFollow(uuid.Nil, boardId)
+	assert.ErrorIs(t, err, ErrNilUserIdBoardFollow)
+
+	_, err = NewBoardFollow(userId, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilBoardIdBoardFollow)
+}
+
+func TestNewTagFollow(t *testing.T) {
+	userId, tagId := uuid.New(), uuid.New()
+
+	follow, err := NewTagFollow(userId, tagId)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, follow.UserId())
+	assert.Equal(t, tagId, follow.TagId())
+	assert.WithinDuration(t, time.Now(), follow.CreatedAt(), time.Second)
+
+	_, err = NewTagFollow(uuid.Nil, tagId)
+	assert.ErrorIs(t, err, ErrNilUserIdTagFollow)
+
+	_, err = NewTagFollow(userId, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilTagIdTagFollow)
+}
@@ -0,0 +1,58 @@
+package follows
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdTagFollow = errors.New("user id cannot be nil")
+	ErrNilTagIdTagFollow  = errors.New("tag id cannot be nil")
+	ErrExistsTagFollow    = errors.New("already following this tag")
+	ErrNotFoundTagFollow  = errors.New("not following this tag")
+)
+
+// TagFollow records that a user wants pins tagged with a hashtag in their home
+// feed.
+type TagFollow struct {
+	userId    uuid.UUID
+	tagId     uuid.UUID
+	createdAt time.Time
+}
+
+func NewTagFollow(userId, tagId uuid.UUID) (*TagFollow, error) {
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdTagFollow
+	}
+
+	if tagId == uuid.Nil {
+		return nil, ErrNilTagIdTagFollow
+	}
+
+	return &TagFollow{
+		userId:    userId,
+		tagId:     tagId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (f *TagFollow) UserId() uuid.UUID {
+	return f.userId
+}
+
+func (f *TagFollow) TagId() uuid.UUID {
+	return f.tagId
+}
+
+func (f *TagFollow) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func NewTagFollowFromDB(userId, tagId uuid.UUID, createdAt time.Time) *TagFollow {
+	return &TagFollow{
+		userId:    userId,
+		tagId:     tagId,
+		createdAt: createdAt,
+	}
+}
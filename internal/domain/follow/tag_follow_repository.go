@@ -0,0 +1,13 @@
+package follows
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type TagFollowRepository interface {
+	Exists(ctx context.Context, userId, tagId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, f *TagFollow) error
+	Delete(ctx context.Context, f *TagFollow) error
+}
@@ -0,0 +1,91 @@
+package identities
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"time"
+)
+
+var (
+	ErrInvalidAuthorization        = errors.New("invalid or expired login state")
+	ErrNonPositiveTTLAuthorization = errors.New("login state ttl must be positive")
+)
+
+// Authorization is a login sent to a provider and waiting for it to send the
+// user back. State ties the callback to it, the verifier proves to the
+// provider the code is redeemed by whoever asked for it, and the nonce ties
+// the id token to it. Each one is redeemed once.
+type Authorization struct {
+	state     string
+	provider  string
+	verifier  string
+	nonce     string
+	expiresAt time.Time
+}
+
+func NewAuthorization(provider string, ttl time.Duration) (*Authorization, error) {
+	if provider == "" {
+		return nil, ErrEmptyProviderIdentity
+	} else if ttl <= 0 {
+		return nil, ErrNonPositiveTTLAuthorization
+	}
+
+	secrets := make([]string, 3)
+	for i := range secrets {
+		secret, err := tokens.NewSecret()
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = secret
+	}
+
+	return &Authorization{
+		state:     secrets[0],
+		provider:  provider,
+		verifier:  secrets[1],
+		nonce:     secrets[2],
+		expiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+func NewAuthorizationFromStore(state, provider, verifier, nonce string, expiresAt time.Time) *Authorization {
+	return &Authorization{
+		state:     state,
+		provider:  provider,
+		verifier:  verifier,
+		nonce:     nonce,
+		expiresAt: expiresAt,
+	}
+}
+
+func (a *Authorization) State() string {
+	return a.state
+}
+
+func (a *Authorization) Provider() string {
+	return a.provider
+}
+
+func (a *Authorization) Verifier() string {
+	return a.verifier
+}
+
+func (a *Authorization) Nonce() string {
+	return a.nonce
+}
+
+func (a *Authorization) ExpiresAt() time.Time {
+	return a.expiresAt
+}
+
+// CodeChallenge is the S256 challenge of the verifier, as RFC 7636 has it.
+func (a *Authorization) CodeChallenge() string {
+	return CodeChallenge(a.verifier)
+}
+
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,12 @@
+package identities
+
+import "context"
+
+// AuthorizationStore keeps logins sent to a provider until they expire.
+type AuthorizationStore interface {
+	Save(ctx context.Context, authorization *Authorization) error
+	// Take returns the authorization with the state and forgets it, so a
+	// callback cannot be replayed. It returns ErrInvalidAuthorization when
+	// there is none.
+	Take(ctx context.Context, state string) (*Authorization, error)
+}
@@ -0,0 +1,76 @@
+package identities
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrEmptyProviderIdentity  = errors.New("identity provider cannot be empty")
+	ErrEmptySubjectIdentity   = errors.New("identity subject cannot be empty")
+	ErrNilUserIdIdentity      = errors.New("identity user id cannot be nil")
+	ErrNotFoundIdentity       = errors.New("identity not found")
+	ErrExistsIdentity         = errors.New("identity is already linked")
+	ErrUnverifiedUserIdentity = errors.New("identity cannot be linked to a user who did not verify their email")
+)
+
+// Identity links the account of a user at an identity provider to their user
+// here. Subject is the id the provider gives the account, which unlike the
+// email never changes.
+type Identity struct {
+	provider  string
+	subject   string
+	userId    uuid.UUID
+	email     string
+	createdAt time.Time
+}
+
+func NewIdentity(provider, subject string, userId uuid.UUID, email string) (*Identity, error) {
+	if provider == "" {
+		return nil, ErrEmptyProviderIdentity
+	} else if subject == "" {
+		return nil, ErrEmptySubjectIdentity
+	} else if userId == uuid.Nil {
+		return nil, ErrNilUserIdIdentity
+	}
+
+	return &Identity{
+		provider:  provider,
+		subject:   subject,
+		userId:    userId,
+		email:     email,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func NewIdentityFromDB(provider, subject string, userId uuid.UUID, email string, createdAt time.Time) *Identity {
+	return &Identity{
+		provider:  provider,
+		subject:   subject,
+		userId:    userId,
+		email:     email,
+		createdAt: createdAt,
+	}
+}
+
+func (i *Identity) Provider() string {
+	return i.provider
+}
+
+func (i *Identity) Subject() string {
+	return i.subject
+}
+
+func (i *Identity) UserId() uuid.UUID {
+	return i.userId
+}
+
+// Email is the one the provider gave when the identity was linked.
+func (i *Identity) Email() string {
+	return i.email
+}
+
+func (i *Identity) CreatedAt() time.Time {
+	return i.createdAt
+}
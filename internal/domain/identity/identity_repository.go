@@ -0,0 +1,10 @@
+package identities
+
+import "context"
+
+type IdentityRepository interface {
+	// GetBySubject returns ErrNotFoundIdentity when the account is not linked.
+	GetBySubject(ctx context.Context, provider, subject string) (*Identity, error)
+	// Create returns ErrExistsIdentity when the account is linked already.
+	Create(ctx context.Context, identity *Identity) error
+}
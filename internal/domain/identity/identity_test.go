@@ -0,0 +1,90 @@
+package identities
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewIdentity(t *testing.T) {
+	userId := uuid.New()
+
+	identity, err := NewIdentity("google", "1234", userId, "john@doe.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, "google", identity.Provider())
+	assert.Equal(t, "1234", identity.Subject())
+	assert.Equal(t, userId, identity.UserId())
+	assert.Equal(t, "john@doe.com", identity.Email())
+	assert.WithinDuration(t, time.Now(), identity.CreatedAt(), time.Second)
+}
+
+func TestNewIdentity_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		provider string
+		subject  string
+		userId   uuid.UUID
+		err      error
+	}{
+		{"empty provider", "", "1234", uuid.New(), ErrEmptyProviderIdentity},
+		{"empty subject", "google", "", uuid.New(), ErrEmptySubjectIdentity},
+		{"nil user", "google", "1234", uuid.Nil, ErrNilUserIdIdentity},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			identity, err := NewIdentity(tc.provider, tc.subject, tc.userId, "")
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, identity)
+		})
+	}
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// RFC 7636, appendix B.
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestNewAuthorization(t *testing.T) {
+	authorization, err := NewAuthorization("google", time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, "google", authorization.Provider())
+	assert.NotEmpty(t, authorization.State())
+	assert.NotEmpty(t, authorization.Nonce())
+	assert.GreaterOrEqual(t, len(authorization.Verifier()), 43)
+	assert.NotEqual(t, authorization.State(), authorization.Verifier())
+	assert.Equal(t, CodeChallenge(authorization.Verifier()), authorization.CodeChallenge())
+	assert.WithinDuration(t, time.Now().Add(time.Minute), authorization.ExpiresAt(), time.Second)
+
+	_, err = NewAuthorization("", time.Minute)
+	assert.ErrorIs(t, err, ErrEmptyProviderIdentity)
+
+	_, err = NewAuthorization("google", 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLAuthorization)
+}
+
+func TestNewSignup(t *testing.T) {
+	claims := &Claims{Subject: "1234", Email: "john@doe.com", EmailVerified: true, GivenName: "John", FamilyName: "Doe"}
+
+	signup, secret, err := NewSignup("google", claims, time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, tokens.Hash(secret), signup.Hash())
+	assert.Equal(t, "google", signup.Provider())
+	assert.Equal(t, "1234", signup.Subject())
+	assert.Equal(t, "john@doe.com", signup.Email())
+	assert.Equal(t, "John", signup.GivenName())
+	assert.Equal(t, "Doe", signup.FamilyName())
+	assert.WithinDuration(t, time.Now().Add(time.Minute), signup.ExpiresAt(), time.Second)
+
+	_, _, err = NewSignup("google", &Claims{}, time.Minute)
+	assert.ErrorIs(t, err, ErrEmptySubjectIdentity)
+
+	_, _, err = NewSignup("google", claims, 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLSignup)
+}
@@ -0,0 +1,37 @@
+package identities
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrUnknownProvider = errors.New("identity provider is not configured")
+	ErrProviderDenied  = errors.New("identity provider did not authorize the login")
+	ErrIdToken         = errors.New("identity provider returned an invalid id token")
+	ErrNonce           = errors.New("id token was not issued for this login")
+	ErrUnverifiedEmail = errors.New("identity provider did not verify the email")
+)
+
+// Claims is what an identity provider tells about the account that logged in.
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+	Nonce         string
+}
+
+// Provider is an OpenID Connect provider, logged in with through the
+// authorization code flow with PKCE.
+type Provider interface {
+	Name() string
+	// AuthCodeURL is the page of the provider the user is sent to. The code
+	// challenge is the S256 one of the verifier later handed to Exchange.
+	AuthCodeURL(ctx context.Context, state, codeChallenge, nonce string) (string, error)
+	// Exchange trades the code the provider sent back for the claims of the
+	// id token, once its signature, issuer, audience and expiry check out.
+	// The nonce is left to the caller.
+	Exchange(ctx context.Context, code, verifier string) (*Claims, error)
+}
@@ -0,0 +1,93 @@
+package identities
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"time"
+)
+
+var (
+	ErrInvalidSignup        = errors.New("invalid or expired signup")
+	ErrNonPositiveTTLSignup = errors.New("signup ttl must be positive")
+)
+
+// Signup is a provider account with no user here yet. Providers do not tell
+// everything a user needs, like their birth date or country, so the client
+// gets its secret and trades it, along with the rest, for the new user. Only
+// its hash is kept.
+type Signup struct {
+	hash       string
+	provider   string
+	subject    string
+	email      string
+	givenName  string
+	familyName string
+	expiresAt  time.Time
+}
+
+// NewSignup returns the signup of the account along with the secret to give
+// the client.
+func NewSignup(provider string, claims *Claims, ttl time.Duration) (*Signup, string, error) {
+	if provider == "" {
+		return nil, "", ErrEmptyProviderIdentity
+	} else if claims.Subject == "" {
+		return nil, "", ErrEmptySubjectIdentity
+	} else if ttl <= 0 {
+		return nil, "", ErrNonPositiveTTLSignup
+	}
+
+	secret, err := tokens.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Signup{
+		hash:       tokens.Hash(secret),
+		provider:   provider,
+		subject:    claims.Subject,
+		email:      claims.Email,
+		givenName:  claims.GivenName,
+		familyName: claims.FamilyName,
+		expiresAt:  time.Now().Add(ttl),
+	}, secret, nil
+}
+
+func NewSignupFromStore(hash, provider, subject, email, givenName, familyName string, expiresAt time.Time) *Signup {
+	return &Signup{
+		hash:       hash,
+		provider:   provider,
+		subject:    subject,
+		email:      email,
+		givenName:  givenName,
+		familyName: familyName,
+		expiresAt:  expiresAt,
+	}
+}
+
+func (s *Signup) Hash() string {
+	return s.hash
+}
+
+func (s *Signup) Provider() string {
+	return s.provider
+}
+
+func (s *Signup) Subject() string {
+	return s.subject
+}
+
+func (s *Signup) Email() string {
+	return s.email
+}
+
+func (s *Signup) GivenName() string {
+	return s.givenName
+}
+
+func (s *Signup) FamilyName() string {
+	return s.familyName
+}
+
+func (s *Signup) ExpiresAt() time.Time {
+	return s.expiresAt
+}
@@ -0,0 +1,13 @@
+package identities
+
+import "context"
+
+// SignupStore keeps signups waiting to be completed until they expire.
+type SignupStore interface {
+	Save(ctx context.Context, signup *Signup) error
+	// Get returns ErrInvalidSignup for unknown and expired signups.
+	Get(ctx context.Context, hash string) (*Signup, error)
+	// Delete reports false when the signup was gone already, so it cannot
+	// create two users.
+	Delete(ctx context.Context, hash string) (bool, error)
+}
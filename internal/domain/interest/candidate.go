@@ -0,0 +1,57 @@
+package interests
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Candidate is a pin that may be shown to a user, with what the rankers look
+// at: its tags, when it was created and the language and country of its
+// author.
+type Candidate struct {
+	pinId     uuid.UUID
+	tagIds    []uuid.UUID
+	language  shared.Language
+	country   shared.Country
+	createdAt time.Time
+}
+
+func NewCandidate(pinId uuid.UUID, tagIds []uuid.UUID, language shared.Language, country shared.Country, createdAt time.Time) Candidate {
+	return Candidate{
+		pinId:     pinId,
+		tagIds:    tagIds,
+		language:  language,
+		country:   country,
+		createdAt: createdAt,
+	}
+}
+
+func (c Candidate) PinId() uuid.UUID {
+	return c.pinId
+}
+
+func (c Candidate) TagIds() []uuid.UUID {
+	return c.tagIds
+}
+
+func (c Candidate) Language() shared.Language {
+	return c.language
+}
+
+func (c Candidate) Country() shared.Country {
+	return c.country
+}
+
+func (c Candidate) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// newer reports whether c comes before other in chronological order, newest
+// first with the pin id breaking ties, as feeds are ordered.
+func (c Candidate) newer(other Candidate) bool {
+	if !c.createdAt.Equal(other.createdAt) {
+		return c.createdAt.After(other.createdAt)
+	}
+	return c.pinId.String() > other.pinId.String()
+}
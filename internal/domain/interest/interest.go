@@ -0,0 +1,73 @@
+package interests
+
+import (
+	"github.com/google/uuid"
+	"math"
+)
+
+// MaxInterests caps the interests read into a profile, strongest first.
+const MaxInterests = 500
+
+// Interest is how much one signal tied a user to a tag: how many pins with the
+// tag they saved or liked, or 1 when they follow it. PinTitle is the title of
+// the last pin behind it, when there is one, to explain the ranking.
+type Interest struct {
+	tagId    uuid.UUID
+	tag      string
+	signal   Signal
+	weight   float64
+	pinTitle *string
+}
+
+func NewInterest(tagId uuid.UUID, tag string, signal Signal, weight float64, pinTitle *string) Interest {
+	return Interest{
+		tagId:    tagId,
+		tag:      tag,
+		signal:   signal,
+		weight:   weight,
+		pinTitle: pinTitle,
+	}
+}
+
+func (i Interest) TagId() uuid.UUID {
+	return i.tagId
+}
+
+func (i Interest) Tag() string {
+	return i.tag
+}
+
+func (i Interest) Signal() Signal {
+	return i.signal
+}
+
+func (i Interest) Weight() float64 {
+	return i.weight
+}
+
+func (i Interest) PinTitle() *string {
+	return i.pinTitle
+}
+
+// Affinity is what the interest adds to the score of a pin with its tag. It
+// grows logarithmically so a tag saved a hundred times does not drown out
+// everything else.
+func (i Interest) Affinity() float64 {
+	return i.signal.Weight() * math.Log1p(i.weight)
+}
+
+// Explanation tells the user why a pin with the tag was ranked up.
+func (i Interest) Explanation() string {
+	switch {
+	case i.signal == FollowSignal:
+		return "because you follow #" + i.tag
+	case i.pinTitle != nil && i.signal == SaveSignal:
+		return `because you saved "` + *i.pinTitle + `"`
+	case i.pinTitle != nil && i.signal == LikeSignal:
+		return `because you liked "` + *i.pinTitle + `"`
+	case i.signal == SaveSignal:
+		return "because you saved pins tagged #" + i.tag
+	default:
+		return "because you liked pins tagged #" + i.tag
+	}
+}
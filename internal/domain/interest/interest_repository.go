@@ -0,0 +1,23 @@
+package interests
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type InterestRepository interface {
+	// GetProfile returns the interests of the user and the language and
+	// country of their profile.
+	GetProfile(ctx context.Context, userId uuid.UUID) (*Profile, error)
+	// GetCandidates describes the pins given, in no particular order. Pins
+	// that do not exist are left out.
+	GetCandidates(ctx context.Context, pinIds []uuid.UUID) ([]Candidate, error)
+	// AddPin adds 1 to the interest of the user, for the signal, in every
+	// tag of the pin, and remembers the pin as the last one behind them.
+	AddPin(ctx context.Context, userId uuid.UUID, signal Signal, pinId uuid.UUID) error
+	// RemovePin takes back what AddPin added, dropping the interests that
+	// reach 0.
+	RemovePin(ctx context.Context, userId uuid.UUID, signal Signal, pinId uuid.UUID) error
+	AddTag(ctx context.Context, userId, tagId uuid.UUID) error
+	RemoveTag(ctx context.Context, userId, tagId uuid.UUID) error
+}
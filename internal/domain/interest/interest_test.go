@@ -0,0 +1,102 @@
+package interests
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestInterest_Explanation(t *testing.T) {
+	title := "Walnut cabinets"
+	tagId := uuid.New()
+
+	cases := []struct {
+		interest Interest
+		want     string
+	}{
+		{NewInterest(tagId, "kitchen", FollowSignal, 1, nil), "because you follow #kitchen"},
+		{NewInterest(tagId, "kitchen", SaveSignal, 2, &title), `because you saved "Walnut cabinets"`},
+		{NewInterest(tagId, "kitchen", LikeSignal, 2, &title), `because you liked "Walnut cabinets"`},
+		{NewInterest(tagId, "kitchen", SaveSignal, 2, nil), "because you saved pins tagged #kitchen"},
+		{NewInterest(tagId, "kitchen", LikeSignal, 2, nil), "because you liked pins tagged #kitchen"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, c.interest.Explanation())
+	}
+}
+
+func TestInterest_Affinity(t *testing.T) {
+	tagId := uuid.New()
+
+	follow := NewInterest(tagId, "kitchen", FollowSignal, 1, nil)
+	save := NewInterest(tagId, "kitchen", SaveSignal, 1, nil)
+	like := NewInterest(tagId, "kitchen", LikeSignal, 1, nil)
+	many := NewInterest(tagId, "kitchen", LikeSignal, 100, nil)
+
+	assert.Greater(t, follow.Affinity(), save.Affinity())
+	assert.Greater(t, save.Affinity(), like.Affinity())
+	assert.Less(t, many.Affinity(), 100*like.Affinity())
+	assert.Zero(t, Signal("view").Weight())
+}
+
+func TestNewRanker(t *testing.T) {
+	ranker, err := NewRanker("")
+	require.NoError(t, err)
+	assert.IsType(t, InterestRanker{}, ranker)
+
+	ranker, err = NewRanker(ChronologicalRankerName)
+	require.NoError(t, err)
+	assert.IsType(t, ChronologicalRanker{}, ranker)
+
+	_, err = NewRanker("random")
+	assert.ErrorIs(t, err, ErrUnknownRanker)
+}
+
+func TestChronologicalRanker_Rank(t *testing.T) {
+	now := time.Now()
+	older := NewCandidate(uuid.New(), nil, shared.English, shared.UnitedStates, now.Add(-time.Hour))
+	newer := NewCandidate(uuid.New(), nil, shared.English, shared.UnitedStates, now)
+
+	ranked := ChronologicalRanker{}.Rank(NewProfile(uuid.New(), shared.English, shared.UnitedStates, nil), []Candidate{older, newer}, now)
+
+	require.Len(t, ranked, 2)
+	assert.Equal(t, newer.PinId(), ranked[0].Candidate().PinId())
+	assert.Equal(t, older.PinId(), ranked[1].Candidate().PinId())
+}
+
+func TestInterestRanker_Rank(t *testing.T) {
+	now := time.Now()
+	kitchenId, gardenId := uuid.New(), uuid.New()
+	profile := NewProfile(uuid.New(), shared.Spanish, shared.Spain, []Interest{
+		NewInterest(kitchenId, "kitchen", SaveSignal, 4, nil),
+		NewInterest(gardenId, "garden", FollowSignal, 1, nil),
+		NewInterest(gardenId, "garden", LikeSignal, 1, nil),
+	})
+
+	plain := NewCandidate(uuid.New(), nil, shared.English, shared.UnitedStates, now)
+	local := NewCandidate(uuid.New(), nil, shared.Spanish, shared.Spain, now)
+	foreign := NewCandidate(uuid.New(), nil, shared.English, shared.Spain, now)
+	kitchen := NewCandidate(uuid.New(), []uuid.UUID{kitchenId}, shared.English, shared.UnitedStates, now.Add(-time.Hour))
+	garden := NewCandidate(uuid.New(), []uuid.UUID{gardenId}, shared.English, shared.UnitedStates, now.Add(-time.Hour))
+	stale := NewCandidate(uuid.New(), []uuid.UUID{gardenId}, shared.English, shared.UnitedStates, now.Add(-30*24*time.Hour))
+
+	ranked := NewInterestRanker(RecencyHalfLife).Rank(profile, []Candidate{stale, plain, foreign, local, kitchen, garden}, now)
+
+	require.Len(t, ranked, 6)
+	order := make([]uuid.UUID, len(ranked))
+	for i, r := range ranked {
+		order[i] = r.Candidate().PinId()
+	}
+	assert.Equal(t, []uuid.UUID{kitchen.PinId(), garden.PinId(), local.PinId(), foreign.PinId(), plain.PinId(), stale.PinId()}, order)
+
+	assert.Equal(t, "because you saved pins tagged #kitchen", ranked[0].Explanation())
+	assert.Equal(t, "because you follow #garden", ranked[1].Explanation())
+	assert.Equal(t, "because it is in your language", ranked[2].Explanation())
+	assert.Equal(t, "because it is from your country", ranked[3].Explanation())
+	assert.Equal(t, "new from what you follow", ranked[4].Explanation())
+	assert.InDelta(t, 1.0, ranked[4].Score(), 1e-9)
+}
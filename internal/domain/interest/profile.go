@@ -0,0 +1,46 @@
+package interests
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// Profile is the interest vector of a user: their interests by tag, and the
+// language and country of their profile.
+type Profile struct {
+	userId    uuid.UUID
+	language  shared.Language
+	country   shared.Country
+	interests map[uuid.UUID][]Interest
+}
+
+func NewProfile(userId uuid.UUID, language shared.Language, country shared.Country, interests []Interest) *Profile {
+	byTag := make(map[uuid.UUID][]Interest)
+	for _, i := range interests {
+		byTag[i.tagId] = append(byTag[i.tagId], i)
+	}
+
+	return &Profile{
+		userId:    userId,
+		language:  language,
+		country:   country,
+		interests: byTag,
+	}
+}
+
+func (p *Profile) UserId() uuid.UUID {
+	return p.userId
+}
+
+func (p *Profile) Language() shared.Language {
+	return p.language
+}
+
+func (p *Profile) Country() shared.Country {
+	return p.country
+}
+
+// Interests returns the interests of the user in a tag, if any.
+func (p *Profile) Interests(tagId uuid.UUID) []Interest {
+	return p.interests[tagId]
+}
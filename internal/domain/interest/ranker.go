@@ -0,0 +1,147 @@
+package interests
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+var ErrUnknownRanker = errors.New("unknown ranker")
+
+// Names of the rankers, as set in the configuration.
+const (
+	InterestRankerName      = "interest"
+	ChronologicalRankerName = "chronological"
+)
+
+// Ranked is a candidate placed by a ranker, with its score and a short reason
+// for debugging.
+type Ranked struct {
+	candidate   Candidate
+	score       float64
+	explanation string
+}
+
+func NewRanked(candidate Candidate, score float64, explanation string) Ranked {
+	return Ranked{
+		candidate:   candidate,
+		score:       score,
+		explanation: explanation,
+	}
+}
+
+func (r Ranked) Candidate() Candidate {
+	return r.candidate
+}
+
+func (r Ranked) Score() float64 {
+	return r.score
+}
+
+func (r Ranked) Explanation() string {
+	return r.explanation
+}
+
+// Ranker orders candidates for a user, best first. Rankers are pluggable, so
+// the model behind the feed can be swapped without touching the feed.
+type Ranker interface {
+	Rank(profile *Profile, candidates []Candidate, now time.Time) []Ranked
+}
+
+// NewRanker returns the ranker with the given name. An empty name means the
+// interest ranker.
+func NewRanker(name string) (Ranker, error) {
+	switch name {
+	case "", InterestRankerName:
+		return NewInterestRanker(RecencyHalfLife), nil
+	case ChronologicalRankerName:
+		return ChronologicalRanker{}, nil
+	default:
+		return nil, ErrUnknownRanker
+	}
+}
+
+// ChronologicalRanker keeps candidates newest first, as feeds were before
+// ranking.
+type ChronologicalRanker struct{}
+
+func (ChronologicalRanker) Rank(_ *Profile, candidates []Candidate, _ time.Time) []Ranked {
+	sorted := append([]Candidate(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].newer(sorted[j])
+	})
+
+	ranked := make([]Ranked, len(sorted))
+	for i, c := range sorted {
+		ranked[i] = NewRanked(c, 0, "newest first")
+	}
+
+	return ranked
+}
+
+// RecencyHalfLife is how long it takes a pin to lose half of its score to age
+// in the interest ranker.
+const RecencyHalfLife = 24 * time.Hour
+
+// Boosts of a pin whose author shares the language or the country of the user.
+const (
+	LanguageBoost = 0.5
+	CountryBoost  = 0.25
+)
+
+// InterestRanker scores a candidate by how close it is to the interests of the
+// user, then lets it fade with age so fresh pins still surface:
+// (1 + affinities + boosts) * 2^(-age / halfLife).
+type InterestRanker struct {
+	halfLife time.Duration
+}
+
+func NewInterestRanker(halfLife time.Duration) InterestRanker {
+	return InterestRanker{
+		halfLife: halfLife,
+	}
+}
+
+func (r InterestRanker) Rank(profile *Profile, candidates []Candidate, now time.Time) []Ranked {
+	ranked := make([]Ranked, len(candidates))
+	for i, c := range candidates {
+		relevance, explanation := 1.0, "new from what you follow"
+
+		best := 0.0
+		for _, tagId := range c.tagIds {
+			for _, interest := range profile.Interests(tagId) {
+				affinity := interest.Affinity()
+				relevance += affinity
+				if affinity > best {
+					best, explanation = affinity, interest.Explanation()
+				}
+			}
+		}
+
+		if c.language == profile.language {
+			relevance += LanguageBoost
+			if best == 0 {
+				explanation = "because it is in your language"
+			}
+		}
+		if c.country == profile.country {
+			relevance += CountryBoost
+			if best == 0 && c.language != profile.language {
+				explanation = "because it is from your country"
+			}
+		}
+
+		age := max(now.Sub(c.createdAt), 0)
+		ranked[i] = NewRanked(c, relevance*math.Exp2(-age.Hours()/r.halfLife.Hours()), explanation)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].candidate.newer(ranked[j].candidate)
+	})
+
+	return ranked
+}
@@ -0,0 +1,31 @@
+package interests
+
+// Signal is what a user did that tells about their interests.
+type Signal string
+
+const (
+	SaveSignal   Signal = "save"
+	LikeSignal   Signal = "like"
+	FollowSignal Signal = "follow"
+)
+
+// Weights of each signal in an interest. Following a tag is asked for, so it
+// says the most; saving a pin says more than liking it.
+const (
+	FollowWeight = 4.0
+	SaveWeight   = 3.0
+	LikeWeight   = 1.5
+)
+
+func (s Signal) Weight() float64 {
+	switch s {
+	case FollowSignal:
+		return FollowWeight
+	case SaveSignal:
+		return SaveWeight
+	case LikeSignal:
+		return LikeWeight
+	default:
+		return 0
+	}
+}
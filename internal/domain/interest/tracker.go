@@ -0,0 +1,16 @@
+package interests
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// Tracker keeps interest profiles up to date as users save and like pins and
+// follow tags. Like feeds.Distributor, implementations must not fail the
+// caller's command because a profile could not be updated.
+type Tracker interface {
+	Track(ctx context.Context, userId uuid.UUID, signal Signal, pinId uuid.UUID)
+	Untrack(ctx context.Context, userId uuid.UUID, signal Signal, pinId uuid.UUID)
+	FollowedTag(ctx context.Context, userId, tagId uuid.UUID)
+	UnfollowedTag(ctx context.Context, userId, tagId uuid.UUID)
+}
@@ -0,0 +1,143 @@
+package mentions
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+type SourceType string
+
+const (
+	PinSource     SourceType = "pin"
+	CommentSource SourceType = "comment"
+)
+
+var (
+	ErrNotASourceType         = errors.New("is not a mention source type")
+	ErrNilSourceIdMention     = errors.New("source id cannot be nil")
+	ErrNilAuthorIdMention     = errors.New("author id cannot be nil")
+	ErrNilMentionedIdMention  = errors.New("mentioned user id cannot be nil")
+	ErrInvalidPositionMention = errors.New("mention offset and length must be positive")
+)
+
+// Mention records that the author of a pin description or a comment tagged
+// another user with @username. Offset and length point at the mention inside
+// the source text, counted in runes.
+type Mention struct {
+	*abstractions.Entity
+	sourceType      SourceType
+	sourceId        uuid.UUID
+	authorId        uuid.UUID
+	mentionedUserId uuid.UUID
+	offset          int
+	length          int
+	createdAt       time.Time
+}
+
+func NewMention(sourceType SourceType, sourceId, authorId, mentionedUserId uuid.UUID, offset, length int) (*Mention, error) {
+	if _, err := ParseSourceType(string(sourceType)); err != nil {
+		return nil, err
+	}
+
+	if sourceId == uuid.Nil {
+		return nil, ErrNilSourceIdMention
+	}
+
+	if authorId == uuid.Nil {
+		return nil, ErrNilAuthorIdMention
+	}
+
+	if mentionedUserId == uuid.Nil {
+		return nil, ErrNilMentionedIdMention
+	}
+
+	if offset < 0 || length <= 0 {
+		return nil, ErrInvalidPositionMention
+	}
+
+	return &Mention{
+		Entity:          abstractions.NewEntity(uuid.New()),
+		sourceType:      sourceType,
+		sourceId:        sourceId,
+		authorId:        authorId,
+		mentionedUserId: mentionedUserId,
+		offset:          offset,
+		length:          length,
+		createdAt:       time.Now(),
+	}, nil
+}
+
+func (m *Mention) Id() uuid.UUID {
+	return m.Entity.Id
+}
+
+func (m *Mention) SourceType() SourceType {
+	return m.sourceType
+}
+
+func (m *Mention) SourceId() uuid.UUID {
+	return m.sourceId
+}
+
+func (m *Mention) AuthorId() uuid.UUID {
+	return m.authorId
+}
+
+func (m *Mention) MentionedUserId() uuid.UUID {
+	return m.mentionedUserId
+}
+
+func (m *Mention) Offset() int {
+	return m.offset
+}
+
+func (m *Mention) Length() int {
+	return m.length
+}
+
+func (m *Mention) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (s SourceType) String() string {
+	switch s {
+	case PinSource:
+		return "Pin"
+	case CommentSource:
+		return "Comment"
+	default:
+		return "Unknown"
+	}
+}
+
+func ParseSourceType(s string) (SourceType, error) {
+	switch s {
+	case "pin", "Pin":
+		return PinSource, nil
+	case "comment", "Comment":
+		return CommentSource, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotASourceType, s)
+	}
+}
+
+func NewMentionFromDB(id uuid.UUID, sourceType string, sourceId, authorId, mentionedUserId uuid.UUID, offset, length int, createdAt time.Time) (*Mention, error) {
+	source, err := ParseSourceType(sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mention{
+		Entity:          abstractions.NewEntity(id),
+		sourceType:      source,
+		sourceId:        sourceId,
+		authorId:        authorId,
+		mentionedUserId: mentionedUserId,
+		offset:          offset,
+		length:          length,
+		createdAt:       createdAt,
+	}, nil
+}
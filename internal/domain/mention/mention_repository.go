@@ -0,0 +1,14 @@
+package mentions
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type MentionRepository interface {
+	GetListBySource(ctx context.Context, sourceType SourceType, sourceId uuid.UUID) ([]*Mention, error)
+
+	// Replace swaps every mention stored for the source with the given ones, so
+	// editing a text never leaves stale mentions behind.
+	Replace(ctx context.Context, sourceType SourceType, sourceId uuid.UUID, mentions []*Mention) error
+}
@@ -0,0 +1,78 @@
+package mentions
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewMention(t *testing.T) {
+	sourceId, authorId, mentionedId := uuid.New(), uuid.New(), uuid.New()
+
+	mention, err := NewMention(CommentSource, sourceId, authorId, mentionedId, 4, 9)
+
+	require.NoError(t, err)
+	require.NotNil(t, mention)
+	assert.NotEqual(t, uuid.Nil, mention.Id())
+	assert.Equal(t, CommentSource, mention.SourceType())
+	assert.Equal(t, sourceId, mention.SourceId())
+	assert.Equal(t, authorId, mention.AuthorId())
+	assert.Equal(t, mentionedId, mention.MentionedUserId())
+	assert.Equal(t, 4, mention.Offset())
+	assert.Equal(t, 9, mention.Length())
+	assert.WithinDuration(t, time.Now(), mention.CreatedAt(), time.Second)
+}
+
+func TestNewMention_Errors(t *testing.T) {
+	id := uuid.New()
+
+	cases := []struct {
+		name       string
+		sourceType SourceType
+		sourceId   uuid.UUID
+		authorId   uuid.UUID
+		mentioned  uuid.UUID
+		offset     int
+		length     int
+		err        error
+	}{
+		{"invalid source", SourceType("board"), id, id, id, 0, 5, ErrNotASourceType},
+		{"nil source", PinSource, uuid.Nil, id, id, 0, 5, ErrNilSourceIdMention},
+		{"nil author", PinSource, id, uuid.Nil, id, 0, 5, ErrNilAuthorIdMention},
+		{"nil mentioned", PinSource, id, id, uuid.Nil, 0, 5, ErrNilMentionedIdMention},
+		{"negative offset", PinSource, id, id, id, -1, 5, ErrInvalidPositionMention},
+		{"empty length", PinSource, id, id, id, 0, 0, ErrInvalidPositionMention},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mention, err := NewMention(tc.sourceType, tc.sourceId, tc.authorId, tc.mentioned, tc.offset, tc.length)
+
+			assert.Nil(t, mention)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewMentionFromDB(t *testing.T) {
+	id, sourceId, authorId, mentionedId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+
+	mention, err := NewMentionFromDB(id, "pin", sourceId, authorId, mentionedId, 0, 6, createdAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, id, mention.Id())
+	assert.Equal(t, PinSource, mention.SourceType())
+	assert.Equal(t, createdAt, mention.CreatedAt())
+
+	_, err = NewMentionFromDB(id, "board", sourceId, authorId, mentionedId, 0, 6, createdAt)
+	assert.ErrorIs(t, err, ErrNotASourceType)
+}
+
+func TestSourceType_String(t *testing.T) {
+	assert.Equal(t, "Pin", PinSource.String())
+	assert.Equal(t, "Comment", CommentSource.String())
+	assert.Equal(t, "Unknown", SourceType("board").String())
+}
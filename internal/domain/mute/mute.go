@@ -0,0 +1,63 @@
+package mutes
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilMuterIdMute = errors.New("muter id cannot be nil")
+	ErrNilMutedIdMute = errors.New("muted id cannot be nil")
+	ErrSelfMute       = errors.New("users cannot mute themselves")
+	ErrExistsMute     = errors.New("user is already muted")
+	ErrNotFoundMute   = errors.New("user is not muted")
+)
+
+// Mute hides the muted user's pins from the muter's feed only. Unlike a block
+// it is one sided and the muted user is not told or otherwise affected.
+type Mute struct {
+	muterId   uuid.UUID
+	mutedId   uuid.UUID
+	createdAt time.Time
+}
+
+func NewMute(muterId, mutedId uuid.UUID) (*Mute, error) {
+	if muterId == uuid.Nil {
+		return nil, ErrNilMuterIdMute
+	}
+
+	if mutedId == uuid.Nil {
+		return nil, ErrNilMutedIdMute
+	}
+
+	if muterId == mutedId {
+		return nil, ErrSelfMute
+	}
+
+	return &Mute{
+		muterId:   muterId,
+		mutedId:   mutedId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (m *Mute) MuterId() uuid.UUID {
+	return m.muterId
+}
+
+func (m *Mute) MutedId() uuid.UUID {
+	return m.mutedId
+}
+
+func (m *Mute) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func NewMuteFromDB(muterId, mutedId uuid.UUID, createdAt time.Time) *Mute {
+	return &Mute{
+		muterId:   muterId,
+		mutedId:   mutedId,
+		createdAt: createdAt,
+	}
+}
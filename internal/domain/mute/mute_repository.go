@@ -0,0 +1,15 @@
+package mutes
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type MuteRepository interface {
+	// GetListByMuterId returns the users the muter has muted, newest first.
+	GetListByMuterId(ctx context.Context, muterId uuid.UUID) ([]*Mute, error)
+	Exists(ctx context.Context, muterId, mutedId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, m *Mute) error
+	Delete(ctx context.Context, m *Mute) error
+}
@@ -0,0 +1,33 @@
+package mutes
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewMute(t *testing.T) {
+	muterId, mutedId := uuid.New(), uuid.New()
+
+	mute, err := NewMute(muterId, mutedId)
+
+	require.NoError(t, err)
+	assert.Equal(t, muterId, mute.MuterId())
+	assert.Equal(t, mutedId, mute.MutedId())
+	assert.WithinDuration(t, time.Now(), mute.CreatedAt(), time.Second)
+}
+
+func TestNewMute_Errors(t *testing.T) {
+	id := uuid.New()
+
+	_, err := NewMute(uuid.Nil, id)
+	assert.ErrorIs(t, err, ErrNilMuterIdMute)
+
+	_, err = NewMute(id, uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilMutedIdMute)
+
+	_, err = NewMute(id, id)
+	assert.ErrorIs(t, err, ErrSelfMute)
+}
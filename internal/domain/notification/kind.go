@@ -0,0 +1,51 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Kind string
+
+const (
+	FollowKind  Kind = "follow"
+	SaveKind    Kind = "save"
+	CommentKind Kind = "comment"
+	MentionKind Kind = "mention"
+)
+
+var ErrNotAKind = errors.New("is not a notification kind")
+
+func (k Kind) String() string {
+	switch k {
+	case FollowKind:
+		return "Follow"
+	case SaveKind:
+		return "Save"
+	case CommentKind:
+		return "Comment"
+	case MentionKind:
+		return "Mention"
+	default:
+		return "Unknown"
+	}
+}
+
+func ParseKind(k string) (Kind, error) {
+	switch k {
+	case "follow", "Follow":
+		return FollowKind, nil
+	case "save", "Save":
+		return SaveKind, nil
+	case "comment", "Comment":
+		return CommentKind, nil
+	case "mention", "Mention":
+		return MentionKind, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotAKind, k)
+	}
+}
+
+func ListKinds() []Kind {
+	return []Kind{FollowKind, SaveKind, CommentKind, MentionKind}
+}
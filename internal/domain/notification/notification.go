@@ -0,0 +1,105 @@
+package notifications
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrIdNilNotification          = errors.New("notification id cannot be nil")
+	ErrNotFoundNotification       = errors.New("notification not found")
+	ErrNilRecipientNotification   = errors.New("recipient id cannot be nil")
+	ErrNilActorNotification       = errors.New("actor id cannot be nil")
+	ErrSelfNotification           = errors.New("users are not notified about their own actions")
+	ErrMissingPinNotification     = errors.New("save, comment and mention notifications need a pin id")
+	ErrMissingCommentNotification = errors.New("comment notifications need a comment id")
+)
+
+// Notification tells the recipient that the actor did something that concerns
+// them. PinId is set for saves, comments and mentions, CommentId for comments
+// and for mentions made inside a comment.
+type Notification struct {
+	*abstractions.AggregateRoot
+	recipientId uuid.UUID
+	actorId     uuid.UUID
+	kind        Kind
+	pinId       *uuid.UUID
+	commentId   *uuid.UUID
+	readAt      *time.Time
+	createdAt   time.Time
+}
+
+func NewNotification(recipientId, actorId uuid.UUID, kind Kind, pinId, commentId *uuid.UUID) *Notification {
+	return &Notification{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		recipientId:   recipientId,
+		actorId:       actorId,
+		kind:          kind,
+		pinId:         pinId,
+		commentId:     commentId,
+		createdAt:     time.Now(),
+	}
+}
+
+func (n *Notification) Id() uuid.UUID {
+	return n.AggregateRoot.Entity.Id
+}
+
+func (n *Notification) RecipientId() uuid.UUID {
+	return n.recipientId
+}
+
+func (n *Notification) ActorId() uuid.UUID {
+	return n.actorId
+}
+
+func (n *Notification) Kind() Kind {
+	return n.kind
+}
+
+func (n *Notification) PinId() *uuid.UUID {
+	return n.pinId
+}
+
+func (n *Notification) CommentId() *uuid.UUID {
+	return n.commentId
+}
+
+func (n *Notification) ReadAt() *time.Time {
+	return n.readAt
+}
+
+func (n *Notification) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+func (n *Notification) IsRead() bool {
+	return n.readAt != nil
+}
+
+func (n *Notification) MarkRead() {
+	if n.readAt == nil {
+		now := time.Now()
+		n.readAt = &now
+	}
+}
+
+func NewNotificationFromDB(id, recipientId, actorId uuid.UUID, kind string, pinId, commentId *uuid.UUID, readAt *time.Time, createdAt time.Time) (*Notification, error) {
+	k, err := ParseKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		recipientId:   recipientId,
+		actorId:       actorId,
+		kind:          k,
+		pinId:         pinId,
+		commentId:     commentId,
+		readAt:        readAt,
+		createdAt:     createdAt,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package notifications
+
+import (
+	"github.com/google/uuid"
+)
+
+type NotificationFactory interface {
+	Create(recipientId, actorId uuid.UUID, kind Kind, pinId, commentId *uuid.UUID) (*Notification, error)
+}
+
+type notificationFactory struct{}
+
+func (f notificationFactory) Create(recipientId, actorId uuid.UUID, kind Kind, pinId, commentId *uuid.UUID) (*Notification, error) {
+	if recipientId == uuid.Nil {
+		return nil, ErrNilRecipientNotification
+	}
+
+	if actorId == uuid.Nil {
+		return nil, ErrNilActorNotification
+	}
+
+	if recipientId == actorId {
+		return nil, ErrSelfNotification
+	}
+
+	if _, err := ParseKind(string(kind)); err != nil {
+		return nil, err
+	}
+
+	if kind != FollowKind && pinId == nil {
+		return nil, ErrMissingPinNotification
+	}
+
+	if kind == CommentKind && commentId == nil {
+		return nil, ErrMissingCommentNotification
+	}
+
+	return NewNotification(recipientId, actorId, kind, pinId, commentId), nil
+}
+
+func NewNotificationFactory() NotificationFactory {
+	return &notificationFactory{}
+}
@@ -0,0 +1,29 @@
+package notifications
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type NotificationRepository interface {
+	// GetListByRecipient returns up to limit notifications, newest first, with
+	// the id breaking ties between notifications created at the same instant.
+	// When before is set only notifications that come after the one created at
+	// before with id beforeId are returned.
+	GetListByRecipient(ctx context.Context, recipientId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*Notification, error)
+	// GetListSince returns, oldest first, up to limit notifications created
+	// after the notification with id sinceId. It is how a reconnecting client
+	// catches up on what it missed.
+	GetListSince(ctx context.Context, recipientId, sinceId uuid.UUID, limit int) ([]*Notification, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Notification, error)
+	CountUnread(ctx context.Context, recipientId uuid.UUID) (int, error)
+
+	Create(ctx context.Context, n *Notification) (*Notification, error)
+	MarkRead(ctx context.Context, n *Notification) error
+	MarkAllRead(ctx context.Context, recipientId uuid.UUID, readAt time.Time) (int64, error)
+
+	// Prune deletes notifications created before olderThan and, for every
+	// recipient, everything past their keepPerRecipient newest ones.
+	Prune(ctx context.Context, olderThan time.Time, keepPerRecipient int) (int64, error)
+}
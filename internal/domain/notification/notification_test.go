@@ -0,0 +1,93 @@
+package notifications
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNotificationFactory_Create(t *testing.T) {
+	factory := NewNotificationFactory()
+	recipientId, actorId, pinId, commentId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	n, err := factory.Create(recipientId, actorId, CommentKind, &pinId, &commentId)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, n.Id())
+	assert.Equal(t, recipientId, n.RecipientId())
+	assert.Equal(t, actorId, n.ActorId())
+	assert.Equal(t, CommentKind, n.Kind())
+	assert.Equal(t, &pinId, n.PinId())
+	assert.Equal(t, &commentId, n.CommentId())
+	assert.False(t, n.IsRead())
+}
+
+func TestNotificationFactory_Create_Errors(t *testing.T) {
+	factory := NewNotificationFactory()
+	id, other := uuid.New(), uuid.New()
+
+	cases := []struct {
+		name        string
+		recipientId uuid.UUID
+		actorId     uuid.UUID
+		kind        Kind
+		pinId       *uuid.UUID
+		commentId   *uuid.UUID
+		err         error
+	}{
+		{"nil recipient", uuid.Nil, id, FollowKind, nil, nil, ErrNilRecipientNotification},
+		{"nil actor", id, uuid.Nil, FollowKind, nil, nil, ErrNilActorNotification},
+		{"self", id, id, FollowKind, nil, nil, ErrSelfNotification},
+		{"unknown kind", id, other, Kind("like"), nil, nil, ErrNotAKind},
+		{"save without pin", id, other, SaveKind, nil, nil, ErrMissingPinNotification},
+		{"comment without comment", id, other, CommentKind, &id, nil, ErrMissingCommentNotification},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := factory.Create(tc.recipientId, tc.actorId, tc.kind, tc.pinId, tc.commentId)
+
+			assert.Nil(t, n)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNotification_MarkRead(t *testing.T) {
+	n := NewNotification(uuid.New(), uuid.New(), FollowKind, nil, nil)
+
+	n.MarkRead()
+	require.True(t, n.IsRead())
+	first := *n.ReadAt()
+
+	n.MarkRead()
+	assert.Equal(t, first, *n.ReadAt())
+}
+
+func TestNewNotificationFromDB(t *testing.T) {
+	id, recipientId, actorId := uuid.New(), uuid.New(), uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+
+	n, err := NewNotificationFromDB(id, recipientId, actorId, "follow", nil, nil, nil, createdAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, id, n.Id())
+	assert.Equal(t, FollowKind, n.Kind())
+	assert.Equal(t, createdAt, n.CreatedAt())
+
+	_, err = NewNotificationFromDB(id, recipientId, actorId, "like", nil, nil, nil, createdAt)
+	assert.ErrorIs(t, err, ErrNotAKind)
+}
+
+func TestKind(t *testing.T) {
+	for _, k := range ListKinds() {
+		parsed, err := ParseKind(string(k))
+		require.NoError(t, err)
+		assert.Equal(t, k, parsed)
+		assert.NotEqual(t, "Unknown", k.String())
+	}
+
+	assert.Equal(t, "Unknown", Kind("like").String())
+}
@@ -0,0 +1,13 @@
+package notifications
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// Notifier is what other features use to tell a user that something happened.
+// Implementations must not fail the caller's command because a notification
+// could not be delivered.
+type Notifier interface {
+	Notify(ctx context.Context, recipientId, actorId uuid.UUID, kind Kind, pinId, commentId *uuid.UUID)
+}
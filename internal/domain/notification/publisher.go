@@ -0,0 +1,14 @@
+package notifications
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// Publisher pushes notification events to the live connections of the
+// recipient. Delivery is best effort: the inbox stays the source of truth and
+// clients catch up from it when they reconnect.
+type Publisher interface {
+	PublishNotification(ctx context.Context, n *Notification) error
+	PublishUnreadCount(ctx context.Context, recipientId uuid.UUID, count int) error
+}
@@ -0,0 +1,76 @@
+package passkeys
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Flags of the authenticator data.
+const (
+	FlagUserPresent  byte = 0x01
+	FlagUserVerified byte = 0x04
+	FlagAttested     byte = 0x40
+	FlagExtensions   byte = 0x80
+)
+
+var ErrInvalidAuthenticatorData = errors.New("authenticator data is invalid")
+
+// AuthenticatorData is what the authenticator signs: the relying party it
+// answered, whether the user was present and verified, and its signature
+// counter. On registration it carries the new credential as well.
+type AuthenticatorData struct {
+	RPIdHash     []byte
+	Flags        byte
+	SignCount    uint32
+	AAGUID       []byte
+	CredentialId []byte
+	PublicKey    []byte
+}
+
+func parseAuthenticatorData(data []byte) (*AuthenticatorData, error) {
+	if len(data) < 37 {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	ad := &AuthenticatorData{
+		RPIdHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rest := data[37:]
+
+	if ad.Flags&FlagAttested != 0 {
+		if len(rest) < 18 {
+			return nil, ErrInvalidAuthenticatorData
+		}
+		ad.AAGUID = rest[:16]
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if length == 0 || length > 1023 || len(rest) < length {
+			return nil, ErrInvalidAuthenticatorData
+		}
+		ad.CredentialId = rest[:length]
+		rest = rest[length:]
+
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrInvalidAuthenticatorData
+		}
+		ad.PublicKey = rest[:len(rest)-len(after)]
+		rest = after
+	}
+
+	if ad.Flags&FlagExtensions != 0 {
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrInvalidAuthenticatorData
+		}
+		rest = after
+	}
+
+	if len(rest) != 0 {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	return ad, nil
+}
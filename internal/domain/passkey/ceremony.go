@@ -0,0 +1,78 @@
+package passkeys
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Kinds of ceremony. A challenge handed out for one is not accepted by the
+// other.
+const (
+	RegistrationCeremony = "registration"
+	LoginCeremony        = "login"
+)
+
+var (
+	ErrInvalidCeremony        = errors.New("invalid or expired passkey challenge")
+	ErrNonPositiveTTLCeremony = errors.New("passkey challenge ttl must be positive")
+)
+
+// Ceremony is a registration or login started and waiting for the answer of
+// the authenticator. Its challenge is signed by the authenticator, which
+// proves the answer is fresh; each one is accepted once. Logins do not know
+// their user until the authenticator answers.
+type Ceremony struct {
+	kind      string
+	challenge string
+	userId    uuid.UUID
+	expiresAt time.Time
+}
+
+func NewCeremony(kind string, userId uuid.UUID, ttl time.Duration) (*Ceremony, error) {
+	if ttl <= 0 {
+		return nil, ErrNonPositiveTTLCeremony
+	} else if kind == RegistrationCeremony && userId == uuid.Nil {
+		return nil, ErrNilUserIdCredential
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return &Ceremony{
+		kind:      kind,
+		challenge: base64.RawURLEncoding.EncodeToString(b),
+		userId:    userId,
+		expiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+func NewCeremonyFromStore(kind, challenge string, userId uuid.UUID, expiresAt time.Time) *Ceremony {
+	return &Ceremony{
+		kind:      kind,
+		challenge: challenge,
+		userId:    userId,
+		expiresAt: expiresAt,
+	}
+}
+
+func (c *Ceremony) Kind() string {
+	return c.kind
+}
+
+// Challenge is base64url encoded, as it comes back in the client data.
+func (c *Ceremony) Challenge() string {
+	return c.challenge
+}
+
+func (c *Ceremony) UserId() uuid.UUID {
+	return c.userId
+}
+
+func (c *Ceremony) ExpiresAt() time.Time {
+	return c.expiresAt
+}
@@ -0,0 +1,12 @@
+package passkeys
+
+import "context"
+
+// CeremonyStore keeps ceremonies until they expire.
+type CeremonyStore interface {
+	Save(ctx context.Context, ceremony *Ceremony) error
+	// Take returns the ceremony of the kind with the challenge and forgets
+	// it, so its challenge cannot be answered twice. It returns
+	// ErrInvalidCeremony when there is none.
+	Take(ctx context.Context, kind, challenge string) (*Ceremony, error)
+}
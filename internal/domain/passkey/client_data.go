@@ -0,0 +1,32 @@
+package passkeys
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	createType = "webauthn.create"
+	getType    = "webauthn.get"
+)
+
+var ErrInvalidClientData = errors.New("client data is invalid")
+
+// ClientData is what the browser signs over along with the authenticator: the
+// ceremony, its challenge and the origin of the page that ran it.
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+	raw         []byte
+}
+
+func ParseClientData(raw []byte) (*ClientData, error) {
+	cd := &ClientData{}
+	if err := json.Unmarshal(raw, cd); err != nil || cd.Challenge == "" {
+		return nil, ErrInvalidClientData
+	}
+	cd.raw = raw
+	return cd, nil
+}
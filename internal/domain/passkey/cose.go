@@ -0,0 +1,121 @@
+package passkeys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// COSE algorithms passkeys may be created with, in order of preference.
+const (
+	ES256 int64 = -7
+	EdDSA int64 = -8
+	RS256 int64 = -257
+)
+
+// Algorithms lists the COSE algorithms accepted, most preferred first.
+var Algorithms = []int64{ES256, EdDSA, RS256}
+
+var (
+	ErrUnsupportedKey = errors.New("credential public key is not an ES256, EdDSA or RS256 key")
+	ErrSignature      = errors.New("assertion signature is invalid")
+)
+
+const (
+	coseKty = 1
+	coseAlg = 3
+	coseCrv = -1
+	coseX   = -2
+	coseY   = -3
+	coseN   = -1
+	coseE   = -2
+
+	ktyOKP = 1
+	ktyEC2 = 2
+	ktyRSA = 3
+
+	crvP256    = 1
+	crvEd25519 = 6
+)
+
+// publicKey is a credential public key, parsed from its COSE encoding.
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+func parsePublicKey(data []byte) (*publicKey, error) {
+	item, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, ErrMalformedCBOR
+	}
+
+	fields, ok := item.(map[any]any)
+	if !ok {
+		return nil, ErrUnsupportedKey
+	}
+
+	kty, _ := fields[int64(coseKty)].(int64)
+	alg, _ := fields[int64(coseAlg)].(int64)
+
+	switch {
+	case kty == ktyEC2 && alg == ES256:
+		crv, _ := fields[int64(coseCrv)].(int64)
+		x, _ := fields[int64(coseX)].([]byte)
+		y, _ := fields[int64(coseY)].([]byte)
+		if crv != crvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: key}, nil
+	case kty == ktyOKP && alg == EdDSA:
+		crv, _ := fields[int64(coseCrv)].(int64)
+		x, _ := fields[int64(coseX)].([]byte)
+		if crv != crvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case kty == ktyRSA && alg == RS256:
+		n, _ := fields[int64(coseN)].([]byte)
+		e, _ := fields[int64(coseE)].([]byte)
+		if len(e) == 0 || len(e) > 4 {
+			return nil, ErrUnsupportedKey
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if key.N.BitLen() < 2048 {
+			return nil, ErrUnsupportedKey
+		}
+		return &publicKey{alg: alg, key: key}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+func (k *publicKey) verify(data, signature []byte) error {
+	ok := false
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(key, data, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	if !ok {
+		return ErrSignature
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package passkeys
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// Passkeys are named by their users to tell them apart, DefaultName when they
+// do not pick a name.
+const (
+	DefaultName   = "Passkey"
+	MaxNameLength = 100
+)
+
+var (
+	ErrNilUserIdCredential  = errors.New("credential user id cannot be nil")
+	ErrLongNameCredential   = errors.New("passkey name is too long")
+	ErrNotFoundCredential   = errors.New("passkey not found")
+	ErrExistsCredential     = errors.New("passkey is already registered")
+	ErrClonedCredential     = errors.New("passkey signature counter went backwards, it may have been cloned")
+	ErrInvalidIdCredential  = errors.New("passkey id is invalid")
+	ErrUserHandleCredential = errors.New("passkey belongs to another user")
+)
+
+// Credential is a passkey of a user: the public key an authenticator created
+// for this service, under the id it names it by.
+type Credential struct {
+	id         []byte
+	userId     uuid.UUID
+	publicKey  []byte
+	signCount  uint32
+	name       string
+	createdAt  time.Time
+	lastUsedAt *time.Time
+}
+
+// NewCredential keeps the credential the authenticator data of a registration
+// carries.
+func NewCredential(userId uuid.UUID, ad *AuthenticatorData, name string) (*Credential, error) {
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdCredential
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultName
+	} else if len(name) > MaxNameLength {
+		return nil, ErrLongNameCredential
+	}
+
+	return &Credential{
+		id:        ad.CredentialId,
+		userId:    userId,
+		publicKey: ad.PublicKey,
+		signCount: ad.SignCount,
+		name:      name,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func NewCredentialFromDB(id []byte, userId uuid.UUID, publicKey []byte, signCount uint32, name string, createdAt time.Time, lastUsedAt *time.Time) *Credential {
+	return &Credential{
+		id:         id,
+		userId:     userId,
+		publicKey:  publicKey,
+		signCount:  signCount,
+		name:       name,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+	}
+}
+
+// ParseCredentialId reads an id the way browsers encode it, base64url without
+// padding.
+func ParseCredentialId(id string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil || len(b) == 0 {
+		return nil, ErrInvalidIdCredential
+	}
+	return b, nil
+}
+
+func (c *Credential) Id() []byte {
+	return c.id
+}
+
+// EncodedId is the id as browsers encode it.
+func (c *Credential) EncodedId() string {
+	return base64.RawURLEncoding.EncodeToString(c.id)
+}
+
+func (c *Credential) UserId() uuid.UUID {
+	return c.userId
+}
+
+// PublicKey is the COSE encoded public key.
+func (c *Credential) PublicKey() []byte {
+	return c.publicKey
+}
+
+func (c *Credential) SignCount() uint32 {
+	return c.signCount
+}
+
+func (c *Credential) Name() string {
+	return c.name
+}
+
+func (c *Credential) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Credential) LastUsedAt() *time.Time {
+	return c.lastUsedAt
+}
+
+// Use records a login with the counter the authenticator signed. A counter
+// that did not go past the stored one means two authenticators hold the key.
+// Authenticators that do not count, like most synced passkeys, always sign 0.
+func (c *Credential) Use(signCount uint32, now time.Time) error {
+	if (signCount != 0 || c.signCount != 0) && signCount <= c.signCount {
+		return ErrClonedCredential
+	}
+
+	c.signCount = signCount
+	c.lastUsedAt = &now
+	return nil
+}
@@ -0,0 +1,20 @@
+package passkeys
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type CredentialRepository interface {
+	// GetById returns ErrNotFoundCredential when no passkey has the id.
+	GetById(ctx context.Context, id []byte) (*Credential, error)
+	GetByUser(ctx context.Context, userId uuid.UUID) ([]*Credential, error)
+	// Create returns ErrExistsCredential when the id is taken.
+	Create(ctx context.Context, credential *Credential) error
+	// Use stores the counter and last use of the credential. It reports false
+	// when another login moved the counter from previous meanwhile.
+	Use(ctx context.Context, credential *Credential, previous uint32) (bool, error)
+	// Delete returns ErrNotFoundCredential when the user has no passkey with
+	// the id.
+	Delete(ctx context.Context, userId uuid.UUID, id []byte) error
+}
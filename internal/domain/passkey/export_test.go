@@ -0,0 +1,11 @@
+package passkeys
+
+// KeyAlgorithm is the COSE algorithm of an encoded public key, for the tests
+// that run outside the package.
+func KeyAlgorithm(data []byte) (int64, error) {
+	key, err := parsePublicKey(data)
+	if err != nil {
+		return 0, err
+	}
+	return key.alg, nil
+}
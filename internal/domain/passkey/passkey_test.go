@@ -0,0 +1,173 @@
+package passkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCBOR(t *testing.T) {
+	value := map[any]any{
+		int64(1):  int64(2),
+		int64(-1): int64(-300),
+		"bytes":   []byte{1, 2, 3},
+		"text":    "hello",
+		"list":    []any{int64(70000), int64(1) << 40, true, false, nil},
+		"map":     map[any]any{},
+	}
+
+	decoded, rest, err := decodeCBOR(encodeCBOR(value))
+
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, value, decoded)
+}
+
+func TestCBOR_Malformed(t *testing.T) {
+	deep := make([]byte, maxCBORDepth+2)
+	for i := range deep {
+		deep[i] = 0x81
+	}
+
+	cases := map[string][]byte{
+		"empty":             {},
+		"truncated string":  {0x45, 1, 2},
+		"truncated head":    {0x19, 1},
+		"indefinite length": {0x5f, 0x41, 1, 0xff},
+		"float":             {0xfa, 0, 0, 0, 0},
+		"array key":         {0xa1, 0x80, 0x01},
+		"too deep":          deep,
+		"huge array":        {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+
+	for name, data := range cases {
+		_, _, err := decodeCBOR(data)
+		assert.ErrorIs(t, err, ErrMalformedCBOR, name)
+	}
+}
+
+func TestPublicKey_EdDSA(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := parsePublicKey(encodeCBOR(map[any]any{
+		int64(coseKty): int64(ktyOKP),
+		int64(coseAlg): EdDSA,
+		int64(coseCrv): int64(crvEd25519),
+		int64(coseX):   []byte(pub),
+	}))
+	require.NoError(t, err)
+
+	assert.NoError(t, key.verify([]byte("data"), ed25519.Sign(priv, []byte("data"))))
+	assert.ErrorIs(t, key.verify([]byte("other"), ed25519.Sign(priv, []byte("data"))), ErrSignature)
+}
+
+func TestPublicKey_RS256(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := parsePublicKey(encodeCBOR(map[any]any{
+		int64(coseKty): int64(ktyRSA),
+		int64(coseAlg): RS256,
+		int64(coseN):   priv.N.Bytes(),
+		int64(coseE):   big.NewInt(int64(priv.E)).Bytes(),
+	}))
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("data"))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, priv, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	assert.NoError(t, key.verify([]byte("data"), signature))
+}
+
+func TestPublicKey_Unsupported(t *testing.T) {
+	cases := []map[any]any{
+		{int64(coseKty): int64(ktyEC2), int64(coseAlg): int64(-35), int64(coseCrv): int64(2)},
+		{int64(coseKty): int64(ktyEC2), int64(coseAlg): ES256, int64(coseCrv): int64(crvP256), int64(coseX): make([]byte, 32), int64(coseY): make([]byte, 32)},
+		{int64(coseKty): int64(ktyRSA), int64(coseAlg): RS256, int64(coseN): []byte{1, 2, 3}, int64(coseE): []byte{1, 0, 1}},
+		{int64(coseKty): int64(ktyOKP), int64(coseAlg): EdDSA, int64(coseCrv): int64(crvEd25519), int64(coseX): []byte{1}},
+	}
+
+	for _, fields := range cases {
+		_, err := parsePublicKey(encodeCBOR(fields))
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+	}
+}
+
+func TestCredential_Use(t *testing.T) {
+	now := time.Now()
+
+	counting := NewCredentialFromDB([]byte{1}, uuid.New(), nil, 5, "Phone", now, nil)
+	require.NoError(t, counting.Use(6, now))
+	assert.Equal(t, uint32(6), counting.SignCount())
+	assert.Equal(t, now, *counting.LastUsedAt())
+	assert.ErrorIs(t, counting.Use(6, now), ErrClonedCredential)
+	assert.ErrorIs(t, counting.Use(2, now), ErrClonedCredential)
+	assert.ErrorIs(t, counting.Use(0, now), ErrClonedCredential)
+
+	synced := NewCredentialFromDB([]byte{2}, uuid.New(), nil, 0, "Laptop", now, nil)
+	require.NoError(t, synced.Use(0, now))
+	require.NoError(t, synced.Use(0, now))
+	require.NoError(t, synced.Use(1, now))
+	assert.Equal(t, uint32(1), synced.SignCount())
+}
+
+func TestNewCredential_Errors(t *testing.T) {
+	ad := &AuthenticatorData{CredentialId: []byte{1}}
+
+	_, err := NewCredential(uuid.Nil, ad, "")
+	assert.ErrorIs(t, err, ErrNilUserIdCredential)
+
+	long := make([]byte, MaxNameLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	_, err = NewCredential(uuid.New(), ad, string(long))
+	assert.ErrorIs(t, err, ErrLongNameCredential)
+
+	credential, err := NewCredential(uuid.New(), ad, "  Phone  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", credential.Name())
+}
+
+func TestParseCredentialId(t *testing.T) {
+	credential := NewCredentialFromDB([]byte{0xfb, 0xff}, uuid.New(), nil, 0, "Phone", time.Now(), nil)
+
+	id, err := ParseCredentialId(credential.EncodedId())
+	require.NoError(t, err)
+	assert.Equal(t, credential.Id(), id)
+
+	for _, encoded := range []string{"", "not base64!", "+/8="} {
+		_, err = ParseCredentialId(encoded)
+		assert.ErrorIs(t, err, ErrInvalidIdCredential, encoded)
+	}
+}
+
+func TestNewCeremony(t *testing.T) {
+	userId := uuid.New()
+
+	ceremony, err := NewCeremony(RegistrationCeremony, userId, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, RegistrationCeremony, ceremony.Kind())
+	assert.Equal(t, userId, ceremony.UserId())
+	assert.Len(t, ceremony.Challenge(), 43)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), ceremony.ExpiresAt(), time.Second)
+
+	login, err := NewCeremony(LoginCeremony, uuid.Nil, time.Minute)
+	require.NoError(t, err)
+	assert.NotEqual(t, ceremony.Challenge(), login.Challenge())
+
+	_, err = NewCeremony(RegistrationCeremony, uuid.Nil, time.Minute)
+	assert.ErrorIs(t, err, ErrNilUserIdCredential)
+
+	_, err = NewCeremony(LoginCeremony, uuid.Nil, 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLCeremony)
+}
@@ -0,0 +1,79 @@
+package passkeytest
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// EncodeCBOR encodes the subset of CBOR authenticators send, so tests can
+// build attestation objects and keys by hand. Map keys are sorted the
+// canonical way, shorter encodings first.
+func EncodeCBOR(v any) []byte {
+	switch value := v.(type) {
+	case int:
+		return encodeInt(int64(value))
+	case int64:
+		return encodeInt(value)
+	case []byte:
+		return append(encodeHead(2, uint64(len(value))), value...)
+	case string:
+		return append(encodeHead(3, uint64(len(value))), value...)
+	case []any:
+		out := encodeHead(4, uint64(len(value)))
+		for _, item := range value {
+			out = append(out, EncodeCBOR(item)...)
+		}
+		return out
+	case map[any]any:
+		keys := make([][]byte, 0, len(value))
+		encoded := make(map[string][]byte, len(value))
+		for k, item := range value {
+			key := EncodeCBOR(k)
+			keys = append(keys, key)
+			encoded[string(key)] = EncodeCBOR(item)
+		}
+		sort.Slice(keys, func(a, b int) bool {
+			if len(keys[a]) != len(keys[b]) {
+				return len(keys[a]) < len(keys[b])
+			}
+			return string(keys[a]) < string(keys[b])
+		})
+
+		out := encodeHead(5, uint64(len(value)))
+		for _, key := range keys {
+			out = append(out, key...)
+			out = append(out, encoded[string(key)]...)
+		}
+		return out
+	case bool:
+		if value {
+			return []byte{0xf5}
+		}
+		return []byte{0xf4}
+	default:
+		return []byte{0xf6}
+	}
+}
+
+func encodeInt(n int64) []byte {
+	if n < 0 {
+		return encodeHead(1, uint64(-1-n))
+	}
+	return encodeHead(0, uint64(n))
+}
+
+func encodeHead(major byte, arg uint64) []byte {
+	head := major << 5
+	switch {
+	case arg < 24:
+		return []byte{head | byte(arg)}
+	case arg <= 0xff:
+		return []byte{head | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{head | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{head | 26}, uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64([]byte{head | 27}, arg)
+	}
+}
@@ -0,0 +1,144 @@
+package passkeytest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+)
+
+const (
+	createType = "webauthn.create"
+	getType    = "webauthn.get"
+)
+
+// SoftwareAuthenticator is an ES256 authenticator kept in memory, which
+// answers ceremonies the way a browser and a platform authenticator would. It
+// holds a single passkey and its key is never stored.
+type SoftwareAuthenticator struct {
+	rpId         string
+	origin       string
+	key          *ecdsa.PrivateKey
+	credentialId []byte
+	userHandle   []byte
+	signCount    uint32
+	// Counting makes each assertion sign a counter one past the last one.
+	// Without it the counter stays at 0, as with synced passkeys.
+	Counting bool
+}
+
+func NewSoftwareAuthenticator(rpId, origin string) (*SoftwareAuthenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &SoftwareAuthenticator{
+		rpId:         rpId,
+		origin:       origin,
+		key:          key,
+		credentialId: id,
+		Counting:     true,
+	}, nil
+}
+
+func (a *SoftwareAuthenticator) CredentialId() []byte {
+	return a.credentialId
+}
+
+// SetSignCount moves the counter, as a clone of the key would.
+func (a *SoftwareAuthenticator) SetSignCount(n uint32) {
+	a.signCount = n
+}
+
+// Create answers a registration with the challenge, for the user with the
+// handle. It returns the client data JSON and the attestation object.
+func (a *SoftwareAuthenticator) Create(challenge string, userHandle []byte) ([]byte, []byte, error) {
+	a.userHandle = userHandle
+
+	clientData, err := a.clientData(createType, challenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := encodeES256Key(&a.key.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authData := a.authenticatorData(passkeys.FlagUserPresent | passkeys.FlagUserVerified | passkeys.FlagAttested)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialId)))
+	authData = append(authData, a.credentialId...)
+	authData = append(authData, publicKey...)
+
+	attestationObject := EncodeCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": authData,
+	})
+
+	return clientData, attestationObject, nil
+}
+
+// Get answers a login with the challenge. It returns the client data JSON,
+// the authenticator data, the signature and the user handle.
+func (a *SoftwareAuthenticator) Get(challenge string) ([]byte, []byte, []byte, []byte, error) {
+	clientData, err := a.clientData(getType, challenge)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if a.Counting {
+		a.signCount++
+	}
+	authData := a.authenticatorData(passkeys.FlagUserPresent | passkeys.FlagUserVerified)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return clientData, authData, signature, a.userHandle, nil
+}
+
+func (a *SoftwareAuthenticator) clientData(ceremonyType, challenge string) ([]byte, error) {
+	return json.Marshal(passkeys.ClientData{
+		Type:      ceremonyType,
+		Challenge: challenge,
+		Origin:    a.origin,
+	})
+}
+
+func (a *SoftwareAuthenticator) authenticatorData(flags byte) []byte {
+	rpIdHash := sha256.Sum256([]byte(a.rpId))
+	data := append(rpIdHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+// encodeES256Key is the COSE encoding of a P-256 public key: key type EC2,
+// algorithm ES256, curve P-256 and the x and y coordinates.
+func encodeES256Key(key *ecdsa.PublicKey) ([]byte, error) {
+	point, err := key.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeCBOR(map[any]any{
+		int64(1):  int64(2),
+		int64(3):  passkeys.ES256,
+		int64(-1): int64(1),
+		int64(-2): point[1:33],
+		int64(-3): point[33:],
+	}), nil
+}
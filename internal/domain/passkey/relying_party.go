@@ -0,0 +1,147 @@
+package passkeys
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCeremonyType           = errors.New("client data is not of the ceremony answered")
+	ErrOrigin                 = errors.New("origin is not allowed")
+	ErrRelyingParty           = errors.New("authenticator answered another relying party")
+	ErrUserNotVerified        = errors.New("authenticator did not verify the user")
+	ErrUnsupportedAttestation = errors.New("only attestation of format none is accepted")
+	ErrNoCredential           = errors.New("registration carries no credential")
+)
+
+// RelyingParty is this service as authenticators see it. Id is the domain
+// passkeys are bound to, and Origins the pages allowed to run ceremonies.
+// Both registrations and logins need the user to be verified by the
+// authenticator, with a PIN or biometrics, as passkeys stand in for the
+// password.
+type RelyingParty struct {
+	id      string
+	name    string
+	origins map[string]bool
+}
+
+func NewRelyingParty(id, name string, origins []string) *RelyingParty {
+	allowed := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		allowed[origin] = true
+	}
+
+	return &RelyingParty{
+		id:      id,
+		name:    name,
+		origins: allowed,
+	}
+}
+
+func (rp *RelyingParty) Id() string {
+	return rp.id
+}
+
+func (rp *RelyingParty) Name() string {
+	return rp.name
+}
+
+// VerifyRegistration checks the answer of an authenticator to a registration
+// and returns the authenticator data holding the new credential. The
+// challenge of the client data must have been checked against the ceremony.
+// Attestation is not asked for, so only the none format is accepted.
+func (rp *RelyingParty) VerifyRegistration(cd *ClientData, attestationObject []byte) (*AuthenticatorData, error) {
+	if err := rp.checkClientData(cd, createType); err != nil {
+		return nil, err
+	}
+
+	item, rest, err := decodeCBOR(attestationObject)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrMalformedCBOR
+	}
+
+	fields, ok := item.(map[any]any)
+	if !ok {
+		return nil, ErrMalformedCBOR
+	}
+
+	format, _ := fields["fmt"].(string)
+	statement, _ := fields["attStmt"].(map[any]any)
+	if format != "none" || len(statement) != 0 {
+		return nil, ErrUnsupportedAttestation
+	}
+
+	raw, _ := fields["authData"].([]byte)
+	ad, err := rp.checkAuthenticatorData(raw)
+	if err != nil {
+		return nil, err
+	} else if ad.Flags&FlagAttested == 0 {
+		return nil, ErrNoCredential
+	}
+
+	if _, err = parsePublicKey(ad.PublicKey); err != nil {
+		return nil, err
+	}
+
+	return ad, nil
+}
+
+// VerifyAssertion checks the answer of an authenticator to a login was signed
+// with the credential, and returns the authenticator data it signed. As with
+// registrations, the challenge must have been checked already.
+func (rp *RelyingParty) VerifyAssertion(credential *Credential, cd *ClientData, authenticatorData, signature []byte) (*AuthenticatorData, error) {
+	if err := rp.checkClientData(cd, getType); err != nil {
+		return nil, err
+	}
+
+	ad, err := rp.checkAuthenticatorData(authenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parsePublicKey(credential.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(cd.raw)
+	signed := append(append([]byte(nil), authenticatorData...), clientDataHash[:]...)
+	if err = key.verify(signed, signature); err != nil {
+		return nil, err
+	}
+
+	return ad, nil
+}
+
+func (rp *RelyingParty) checkClientData(cd *ClientData, ceremonyType string) error {
+	if cd.Type != ceremonyType {
+		return ErrCeremonyType
+	} else if !rp.origins[cd.Origin] || cd.CrossOrigin {
+		return ErrOrigin
+	}
+	return nil
+}
+
+func (rp *RelyingParty) checkAuthenticatorData(raw []byte) (*AuthenticatorData, error) {
+	ad, err := parseAuthenticatorData(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	rpIdHash := sha256.Sum256([]byte(rp.id))
+	if !bytes.Equal(ad.RPIdHash, rpIdHash[:]) {
+		return nil, ErrRelyingParty
+	} else if ad.Flags&FlagUserPresent == 0 || ad.Flags&FlagUserVerified == 0 {
+		return nil, ErrUserNotVerified
+	}
+
+	return ad, nil
+}
+
+// UserHandle is the id passkeys store for the user, the raw bytes of their
+// id, so it tells nothing about them.
+func UserHandle(userId uuid.UUID) []byte {
+	return userId[:]
+}
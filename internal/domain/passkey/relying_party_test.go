@@ -0,0 +1,140 @@
+package passkeys_test
+
+import (
+	"crypto/sha256"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey/passkeytest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testRPId   = "pinterest.test"
+	testOrigin = "https://pinterest.test"
+)
+
+func newTestRelyingParty() *passkeys.RelyingParty {
+	return passkeys.NewRelyingParty(testRPId, "Pinterest", []string{testOrigin})
+}
+
+func register(t *testing.T, rp *passkeys.RelyingParty, authenticator *passkeytest.SoftwareAuthenticator, userId uuid.UUID) *passkeys.Credential {
+	clientData, attestation, err := authenticator.Create("challenge", passkeys.UserHandle(userId))
+	require.NoError(t, err)
+	cd, err := passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+
+	ad, err := rp.VerifyRegistration(cd, attestation)
+	require.NoError(t, err)
+
+	credential, err := passkeys.NewCredential(userId, ad, "")
+	require.NoError(t, err)
+	return credential
+}
+
+func TestRelyingParty_VerifyRegistration(t *testing.T) {
+	rp := newTestRelyingParty()
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	userId := uuid.New()
+
+	credential := register(t, rp, authenticator, userId)
+
+	assert.Equal(t, authenticator.CredentialId(), credential.Id())
+	assert.Equal(t, userId, credential.UserId())
+	assert.Equal(t, passkeys.DefaultName, credential.Name())
+	assert.Zero(t, credential.SignCount())
+
+	alg, err := passkeys.KeyAlgorithm(credential.PublicKey())
+	require.NoError(t, err)
+	assert.Equal(t, passkeys.ES256, alg)
+}
+
+func TestRelyingParty_VerifyRegistration_Errors(t *testing.T) {
+	rp := newTestRelyingParty()
+	userHandle := passkeys.UserHandle(uuid.New())
+
+	other, err := passkeytest.NewSoftwareAuthenticator("evil.test", testOrigin)
+	require.NoError(t, err)
+	clientData, attestation, err := other.Create("challenge", userHandle)
+	require.NoError(t, err)
+	cd, err := passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+	_, err = rp.VerifyRegistration(cd, attestation)
+	assert.ErrorIs(t, err, passkeys.ErrRelyingParty)
+
+	phishing, err := passkeytest.NewSoftwareAuthenticator(testRPId, "https://evil.test")
+	require.NoError(t, err)
+	clientData, attestation, err = phishing.Create("challenge", userHandle)
+	require.NoError(t, err)
+	cd, err = passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+	_, err = rp.VerifyRegistration(cd, attestation)
+	assert.ErrorIs(t, err, passkeys.ErrOrigin)
+
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	clientData, attestation, err = authenticator.Create("challenge", userHandle)
+	require.NoError(t, err)
+	cd, err = passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+
+	_, err = rp.VerifyRegistration(cd, attestation[:len(attestation)-1])
+	assert.ErrorIs(t, err, passkeys.ErrMalformedCBOR)
+
+	packed := passkeytest.EncodeCBOR(map[any]any{"fmt": "packed", "attStmt": map[any]any{"alg": passkeys.ES256}, "authData": []byte{}})
+	_, err = rp.VerifyRegistration(cd, packed)
+	assert.ErrorIs(t, err, passkeys.ErrUnsupportedAttestation)
+
+	rpIdHash := sha256.Sum256([]byte(testRPId))
+	unverified := passkeytest.EncodeCBOR(map[any]any{"fmt": "none", "attStmt": map[any]any{}, "authData": append(rpIdHash[:], passkeys.FlagUserPresent, 0, 0, 0, 0)})
+	_, err = rp.VerifyRegistration(cd, unverified)
+	assert.ErrorIs(t, err, passkeys.ErrUserNotVerified)
+
+	getData, _, _, _, err := authenticator.Get("challenge")
+	require.NoError(t, err)
+	cd, err = passkeys.ParseClientData(getData)
+	require.NoError(t, err)
+	_, err = rp.VerifyRegistration(cd, attestation)
+	assert.ErrorIs(t, err, passkeys.ErrCeremonyType)
+}
+
+func TestRelyingParty_VerifyAssertion(t *testing.T) {
+	rp := newTestRelyingParty()
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	credential := register(t, rp, authenticator, uuid.New())
+
+	clientData, authData, signature, userHandle, err := authenticator.Get("challenge")
+	require.NoError(t, err)
+	cd, err := passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+
+	ad, err := rp.VerifyAssertion(credential, cd, authData, signature)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), ad.SignCount)
+	assert.Equal(t, passkeys.UserHandle(credential.UserId()), userHandle)
+
+	signature[len(signature)-1] ^= 1
+	_, err = rp.VerifyAssertion(credential, cd, authData, signature)
+	assert.ErrorIs(t, err, passkeys.ErrSignature)
+}
+
+func TestRelyingParty_VerifyAssertion_OtherKey(t *testing.T) {
+	rp := newTestRelyingParty()
+	authenticator, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	other, err := passkeytest.NewSoftwareAuthenticator(testRPId, testOrigin)
+	require.NoError(t, err)
+	credential := register(t, rp, authenticator, uuid.New())
+
+	clientData, authData, signature, _, err := other.Get("challenge")
+	require.NoError(t, err)
+	cd, err := passkeys.ParseClientData(clientData)
+	require.NoError(t, err)
+
+	_, err = rp.VerifyAssertion(credential, cd, authData, signature)
+	assert.ErrorIs(t, err, passkeys.ErrSignature)
+}
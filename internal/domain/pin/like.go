@@ -0,0 +1,56 @@
+package pins
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdLike = errors.New("user id cannot be nil")
+	ErrExistsLike    = errors.New("pin already liked")
+	ErrNotFoundLike  = errors.New("pin not liked")
+)
+
+// Like records that a user liked a pin.
+type Like struct {
+	pinId     uuid.UUID
+	userId    uuid.UUID
+	createdAt time.Time
+}
+
+func NewLike(pinId, userId uuid.UUID) (*Like, error) {
+	if pinId == uuid.Nil {
+		return nil, ErrIdNilPin
+	}
+
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdLike
+	}
+
+	return &Like{
+		pinId:     pinId,
+		userId:    userId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (l *Like) PinId() uuid.UUID {
+	return l.pinId
+}
+
+func (l *Like) UserId() uuid.UUID {
+	return l.userId
+}
+
+func (l *Like) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+func NewLikeFromDB(pinId, userId uuid.UUID, createdAt time.Time) *Like {
+	return &Like{
+		pinId:     pinId,
+		userId:    userId,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,13 @@
+package pins
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type LikeRepository interface {
+	Exists(ctx context.Context, pinId, userId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, l *Like) error
+	Delete(ctx context.Context, l *Like) error
+}
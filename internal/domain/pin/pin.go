@@ -13,7 +13,11 @@ var (
 	ErrNilBoardIdPin      = errors.New("board id cannot be nil")
 	ErrEmptyTitlePin      = errors.New("title can't be empty")
 	ErrLongTitlePin       = errors.New("title can't be longer than 100 characters")
-	ErrLongDescriptionPin = errors.New("title can't be longer than 100 characters")
+	ErrLongDescriptionPin = errors.New("description can't be longer than 500 characters")
+	ErrIdNilPin           = errors.New("pin id cannot be nil")
+	ErrNotFoundPin        = errors.New("pin not found")
+	ErrForbiddenPin       = errors.New("pin does not belong to the user")
+	ErrForbiddenBoardPin  = errors.New("board does not belong to the user")
 	ErrManyTagsPin        = errors.New("a pin cannot have more than 10 tags")
 )
 
@@ -71,8 +75,8 @@ func (p *Pin) Description() *string {
 	return p.description
 }
 
-func (p *Pin) Image() string {
-	return *p.image
+func (p *Pin) Image() *string {
+	return p.image
 }
 
 func (p *Pin) SaveCount() int {
@@ -131,6 +135,18 @@ func (p *Pin) ChangeImage(image *string) {
 	p.image = image
 }
 
+func (p *Pin) ChangeVisibility(visibility bool) {
+	p.visibility = visibility
+}
+
+func (p *Pin) ChangeTags(tags []Tag) error {
+	if len(tags) > 10 {
+		return ErrManyTagsPin
+	}
+	p.tags = tags
+	return nil
+}
+
 func (p *Pin) PlusSaveCount() {
 	p.saveCount++
 }
@@ -143,7 +159,7 @@ func (p *Pin) PlusLikeCount() {
 	p.likeCount++
 }
 
-func (p *Pin) LessLkeCount() {
+func (p *Pin) LessLikeCount() {
 	p.likeCount--
 }
 
@@ -176,3 +192,22 @@ func (p *Pin) Delete() {
 func (p *Pin) Restore() {
 	p.deletedAt = nil
 }
+
+func NewPinFromDB(id, userId, boardId uuid.UUID, title string, description, image *string, saveCount, likeCount, commentCount int, visibility bool, tags []Tag, createdAt, updatedAt time.Time, deletedAt *time.Time) *Pin {
+	return &Pin{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		userId:        userId,
+		boardId:       boardId,
+		title:         title,
+		description:   description,
+		image:         image,
+		saveCount:     saveCount,
+		likeCount:     likeCount,
+		commentCount:  commentCount,
+		visibility:    visibility,
+		tags:          tags,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		deletedAt:     deletedAt,
+	}
+}
@@ -9,9 +9,13 @@ type PinRepository interface {
 	GetAll(ctx context.Context) ([]*Pin, error)
 	GetList(ctx context.Context) ([]*Pin, error)
 	GetListByUserId(ctx context.Context, id uuid.UUID) ([]*Pin, error)
-	GetListByName(ctx context.Context, id uuid.UUID) ([]*Pin, error)
-	GetListByTag(ctx context.Context, tag string) ([]*Pin, error)
-	GetById(ctx context.Context, id uuid.UUID) ([]*Pin, error)
+	GetListByName(ctx context.Context, name string) ([]*Pin, error)
+	// GetListByTag leaves out pins of users in a block with the viewer.
+	GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*Pin, error)
+	// GetListByIds returns the pins in no particular order, skipping deleted
+	// and unknown ids.
+	GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*Pin, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Pin, error)
 
 	ExistById(ctx context.Context, id uuid.UUID) (bool, error)
 
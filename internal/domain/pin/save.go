@@ -0,0 +1,67 @@
+package pins
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdSave  = errors.New("user id cannot be nil")
+	ErrNilBoardIdSave = errors.New("board id cannot be nil")
+	ErrExistsSave     = errors.New("pin already saved to this board")
+)
+
+// Save records that a user saved a pin to one of their boards.
+type Save struct {
+	pinId     uuid.UUID
+	userId    uuid.UUID
+	boardId   uuid.UUID
+	createdAt time.Time
+}
+
+func NewSave(pinId, userId, boardId uuid.UUID) (*Save, error) {
+	if pinId == uuid.Nil {
+		return nil, ErrIdNilPin
+	}
+
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdSave
+	}
+
+	if boardId == uuid.Nil {
+		return nil, ErrNilBoardIdSave
+	}
+
+	return &Save{
+		pinId:     pinId,
+		userId:    userId,
+		boardId:   boardId,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (s *Save) PinId() uuid.UUID {
+	return s.pinId
+}
+
+func (s *Save) UserId() uuid.UUID {
+	return s.userId
+}
+
+func (s *Save) BoardId() uuid.UUID {
+	return s.boardId
+}
+
+func (s *Save) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func NewSaveFromDB(pinId, userId, boardId uuid.UUID, createdAt time.Time) *Save {
+	return &Save{
+		pinId:     pinId,
+		userId:    userId,
+		boardId:   boardId,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,13 @@
+package pins
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type SaveRepository interface {
+	Exists(ctx context.Context, pinId, boardId uuid.UUID) (bool, error)
+
+	Create(ctx context.Context, s *Save) error
+	Delete(ctx context.Context, s *Save) error
+}
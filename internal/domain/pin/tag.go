@@ -7,7 +7,10 @@ import (
 	"time"
 )
 
-var ErrAlreadyDeletedTag = errors.New("already deleted tag")
+var (
+	ErrAlreadyDeletedTag = errors.New("already deleted tag")
+	ErrNotFoundTag       = errors.New("tag not found")
+)
 
 type Tag struct {
 	*abstractions.Entity
@@ -50,3 +53,12 @@ func (t *Tag) Delete() error {
 
 	return nil
 }
+
+func NewTagFromDB(id uuid.UUID, name string, createdAt time.Time, deletedAt *time.Time) *Tag {
+	return &Tag{
+		Entity:    abstractions.NewEntity(id),
+		name:      name,
+		createdAt: createdAt,
+		deletedAt: deletedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package pins
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type TagRepository interface {
+	GetByName(ctx context.Context, name string) (*Tag, error)
+	GetListByPinId(ctx context.Context, pinId uuid.UUID) ([]Tag, error)
+
+	// GetOrCreate returns the tag with the given name, creating it when it does
+	// not exist yet.
+	GetOrCreate(ctx context.Context, name string) (*Tag, error)
+}
@@ -0,0 +1,34 @@
+package pins
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// View records that a user opened a pin. Views are only kept for as long as
+// trends look back.
+type View struct {
+	pinId     uuid.UUID
+	userId    uuid.UUID
+	createdAt time.Time
+}
+
+func NewView(pinId, userId uuid.UUID) *View {
+	return &View{
+		pinId:     pinId,
+		userId:    userId,
+		createdAt: time.Now(),
+	}
+}
+
+func (v *View) PinId() uuid.UUID {
+	return v.pinId
+}
+
+func (v *View) UserId() uuid.UUID {
+	return v.userId
+}
+
+func (v *View) CreatedAt() time.Time {
+	return v.createdAt
+}
@@ -0,0 +1,13 @@
+package pins
+
+import (
+	"context"
+	"time"
+)
+
+type ViewRepository interface {
+	Create(ctx context.Context, v *View) error
+	// DeleteBefore drops the views older than the given time and returns how
+	// many were dropped.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
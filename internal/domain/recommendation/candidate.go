@@ -0,0 +1,63 @@
+package recommendations
+
+import (
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+// Weights of each signal in the score of a related pin. Sharing a tag is the
+// strongest hint, being saved to the same boards comes next, and similar board
+// names only nudge the order.
+const (
+	TagWeight   = 3.0
+	SaveWeight  = 2.0
+	BoardWeight = 1.0
+)
+
+// Candidate is a pin that may be related to another one, with the signals
+// linking both: the tags they share, the boards holding both of them and how
+// similar the names of their boards are, from 0 to 1.
+type Candidate struct {
+	pinId           uuid.UUID
+	sharedTags      int
+	coSaves         int
+	boardSimilarity float64
+	createdAt       time.Time
+}
+
+func NewCandidate(pinId uuid.UUID, sharedTags, coSaves int, boardSimilarity float64, createdAt time.Time) Candidate {
+	return Candidate{
+		pinId:           pinId,
+		sharedTags:      sharedTags,
+		coSaves:         coSaves,
+		boardSimilarity: boardSimilarity,
+		createdAt:       createdAt,
+	}
+}
+
+func (c Candidate) PinId() uuid.UUID {
+	return c.pinId
+}
+
+func (c Candidate) SharedTags() int {
+	return c.sharedTags
+}
+
+func (c Candidate) CoSaves() int {
+	return c.coSaves
+}
+
+func (c Candidate) BoardSimilarity() float64 {
+	return c.boardSimilarity
+}
+
+func (c Candidate) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// Score weighs the signals of the candidate. Co-saves grow logarithmically so
+// a pair saved together on many boards does not drown out the tags.
+func (c Candidate) Score() float64 {
+	return TagWeight*float64(c.sharedTags) + SaveWeight*math.Log1p(float64(c.coSaves)) + BoardWeight*c.boardSimilarity
+}
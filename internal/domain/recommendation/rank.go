@@ -0,0 +1,39 @@
+package recommendations
+
+import (
+	"github.com/google/uuid"
+	"sort"
+)
+
+// Rank orders candidates by score, newest first on ties, and returns the ids
+// of the best limit of them. Candidates without any signal are left out.
+func Rank(candidates []Candidate, limit int) []uuid.UUID {
+	ranked := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Score() > 0 {
+			ranked = append(ranked, c)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		si, sj := ranked[i].Score(), ranked[j].Score()
+		if si != sj {
+			return si > sj
+		}
+		if !ranked[i].createdAt.Equal(ranked[j].createdAt) {
+			return ranked[i].createdAt.After(ranked[j].createdAt)
+		}
+		return ranked[i].pinId.String() > ranked[j].pinId.String()
+	})
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, c := range ranked {
+		ids[i] = c.pinId
+	}
+
+	return ids
+}
@@ -0,0 +1,38 @@
+package recommendations
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCandidate_Score(t *testing.T) {
+	id, now := uuid.New(), time.Now()
+	candidate := NewCandidate(id, 2, 3, 0.5, now)
+
+	assert.Equal(t, id, candidate.PinId())
+	assert.Equal(t, 2, candidate.SharedTags())
+	assert.Equal(t, 3, candidate.CoSaves())
+	assert.Equal(t, 0.5, candidate.BoardSimilarity())
+	assert.Equal(t, now, candidate.CreatedAt())
+	assert.InDelta(t, 2*TagWeight+SaveWeight*math.Log(4)+0.5*BoardWeight, candidate.Score(), 1e-9)
+	assert.Zero(t, NewCandidate(id, 0, 0, 0, now).Score())
+}
+
+func TestRank(t *testing.T) {
+	now := time.Now()
+	tagged := NewCandidate(uuid.New(), 1, 0, 0, now)
+	saved := NewCandidate(uuid.New(), 0, 1, 0, now)
+	named := NewCandidate(uuid.New(), 0, 0, 0.4, now)
+	both := NewCandidate(uuid.New(), 1, 1, 0, now)
+	older := NewCandidate(uuid.New(), 1, 0, 0, now.Add(-time.Hour))
+	none := NewCandidate(uuid.New(), 0, 0, 0, now)
+
+	ranked := Rank([]Candidate{none, named, older, saved, tagged, both}, 10)
+
+	assert.Equal(t, []uuid.UUID{both.PinId(), tagged.PinId(), older.PinId(), saved.PinId(), named.PinId()}, ranked)
+	assert.Equal(t, []uuid.UUID{both.PinId(), tagged.PinId()}, Rank([]Candidate{tagged, both, saved}, 2))
+	assert.Empty(t, Rank(nil, 10))
+}
@@ -0,0 +1,13 @@
+package recommendations
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// RelatedCache keeps the ranked related pins of each pin, shared by every
+// viewer. Get reports false when the pin has no entry yet or it expired.
+type RelatedCache interface {
+	Get(ctx context.Context, pinId uuid.UUID) ([]uuid.UUID, bool, error)
+	Set(ctx context.Context, pinId uuid.UUID, related []uuid.UUID) error
+}
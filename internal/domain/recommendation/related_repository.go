@@ -0,0 +1,21 @@
+package recommendations
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type RelatedRepository interface {
+	// GetCandidates returns the public, active pins linked to the pin by a
+	// shared tag, a board holding both of them or a similar board name. Each
+	// signal contributes at most limit candidates.
+	GetCandidates(ctx context.Context, pinId uuid.UUID, limit int) ([]Candidate, error)
+	// GetListActive returns the pins created, edited or saved since the given
+	// time, most recently touched first.
+	GetListActive(ctx context.Context, since time.Time, limit int) ([]uuid.UUID, error)
+	// GetListVisible returns the given pins the viewer may be recommended, in
+	// no particular order: public and active pins of other users the viewer
+	// has not blocked or been blocked by.
+	GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,67 @@
+package searches
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid search cursor")
+
+// Cursor marks the last result of a search page. Results are ordered by rank
+// and then by id, both descending, so the pair locates the next page.
+type Cursor struct {
+	rank float64
+	id   uuid.UUID
+}
+
+func NewCursor(last Result) *Cursor {
+	return &Cursor{
+		rank: last.Rank(),
+		id:   last.Id(),
+	}
+}
+
+// ParseCursor decodes a cursor previously returned by String.
+func ParseCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	rank, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	value, err := strconv.ParseFloat(rank, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	resultId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{
+		rank: value,
+		id:   resultId,
+	}, nil
+}
+
+func (c *Cursor) Rank() float64 {
+	return c.rank
+}
+
+func (c *Cursor) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Cursor) String() string {
+	raw := fmt.Sprintf("%s:%s", strconv.FormatFloat(c.rank, 'g', -1, 64), c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
@@ -0,0 +1,61 @@
+package searches
+
+type Facet string
+
+const (
+	TagFacet       Facet = "tag"
+	BoardFacet     Facet = "board"
+	CountryFacet   Facet = "country"
+	LanguageFacet  Facet = "language"
+	CreatedAtFacet Facet = "created_at"
+	HasImageFacet  Facet = "has_image"
+)
+
+// Created-at buckets count the results created in the last day, week, month
+// and year as of the search. They overlap: a pin from today is in all four.
+const (
+	DayBucket   = "day"
+	WeekBucket  = "week"
+	MonthBucket = "month"
+	YearBucket  = "year"
+)
+
+// MaxBuckets caps the tag and board buckets, the facets with an open number
+// of values. The most frequent ones are kept.
+const MaxBuckets = 10
+
+// Bucket counts the results of a search sharing a value of a facet: a tag
+// name, a board id, a country or language code, a created-at bucket or
+// "true"/"false" for images. Label is a name to show for ids, like the name
+// of a board, and may be empty.
+type Bucket struct {
+	facet Facet
+	value string
+	label string
+	count int
+}
+
+func NewBucket(facet Facet, value, label string, count int) Bucket {
+	return Bucket{
+		facet: facet,
+		value: value,
+		label: label,
+		count: count,
+	}
+}
+
+func (b Bucket) Facet() Facet {
+	return b.facet
+}
+
+func (b Bucket) Value() string {
+	return b.value
+}
+
+func (b Bucket) Label() string {
+	return b.label
+}
+
+func (b Bucket) Count() int {
+	return b.count
+}
@@ -0,0 +1,70 @@
+package searches
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"time"
+)
+
+var ErrInvalidRangeFilters = errors.New("search created-at range ends before it starts")
+
+// Filters narrow a search. Tag, board and image only apply to pins, so
+// setting any of them leaves boards and users out. Country is the creator's:
+// the owner of a pin or board, or the user found. Created-at is a half-open
+// range, from inclusive and to exclusive.
+type Filters struct {
+	tag         *string
+	boardId     *uuid.UUID
+	country     *shared.Country
+	createdFrom *time.Time
+	createdTo   *time.Time
+	hasImage    *bool
+}
+
+func NewFilters(tag *string, boardId *uuid.UUID, country *shared.Country, createdFrom, createdTo *time.Time, hasImage *bool) (Filters, error) {
+	if tag != nil {
+		name, err := shared.NewHashtag(*tag)
+		if err != nil {
+			return Filters{}, err
+		}
+		tag = &name
+	}
+
+	if createdFrom != nil && createdTo != nil && !createdFrom.Before(*createdTo) {
+		return Filters{}, ErrInvalidRangeFilters
+	}
+
+	return Filters{
+		tag:         tag,
+		boardId:     boardId,
+		country:     country,
+		createdFrom: createdFrom,
+		createdTo:   createdTo,
+		hasImage:    hasImage,
+	}, nil
+}
+
+func (f Filters) Tag() *string {
+	return f.tag
+}
+
+func (f Filters) BoardId() *uuid.UUID {
+	return f.boardId
+}
+
+func (f Filters) Country() *shared.Country {
+	return f.country
+}
+
+func (f Filters) CreatedFrom() *time.Time {
+	return f.createdFrom
+}
+
+func (f Filters) CreatedTo() *time.Time {
+	return f.createdTo
+}
+
+func (f Filters) HasImage() *bool {
+	return f.hasImage
+}
@@ -0,0 +1,96 @@
+package searches
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"strings"
+	"unicode/utf8"
+)
+
+const MaxLengthQuery = 200
+
+var (
+	ErrEmptyQuery   = errors.New("search query cannot be empty")
+	ErrLongQuery    = errors.New("search query is too long")
+	ErrKindNotFound = errors.New("search kind not found")
+)
+
+// Query is what a viewer searches for. Text follows web search syntax: quoted
+// phrases, OR and a leading - to exclude a word. With a language only content
+// in it is searched, stemmed in it; without one words are stemmed in the
+// viewer's own language. No kinds means every kind.
+type Query struct {
+	text     string
+	kinds    []Kind
+	language *shared.Language
+	filters  Filters
+	viewerId uuid.UUID
+	after    *Cursor
+	limit    int
+}
+
+func NewQuery(text string, kinds []Kind, language *shared.Language, filters Filters, viewerId uuid.UUID, after *Cursor, limit int) (*Query, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrEmptyQuery
+	} else if utf8.RuneCountInString(text) > MaxLengthQuery {
+		return nil, ErrLongQuery
+	}
+
+	if len(kinds) == 0 {
+		kinds = []Kind{PinKind, BoardKind, UserKind}
+	}
+
+	return &Query{
+		text:     text,
+		kinds:    kinds,
+		language: language,
+		filters:  filters,
+		viewerId: viewerId,
+		after:    after,
+		limit:    limit,
+	}, nil
+}
+
+// ParseKind reads a kind given by a client, singular or plural.
+func ParseKind(kind string) (Kind, error) {
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case "pin", "pins":
+		return PinKind, nil
+	case "board", "boards":
+		return BoardKind, nil
+	case "user", "users":
+		return UserKind, nil
+	default:
+		return "", ErrKindNotFound
+	}
+}
+
+func (q *Query) Text() string {
+	return q.text
+}
+
+func (q *Query) Kinds() []Kind {
+	return q.kinds
+}
+
+func (q *Query) Language() *shared.Language {
+	return q.language
+}
+
+func (q *Query) Filters() Filters {
+	return q.filters
+}
+
+func (q *Query) ViewerId() uuid.UUID {
+	return q.viewerId
+}
+
+func (q *Query) After() *Cursor {
+	return q.after
+}
+
+func (q *Query) Limit() int {
+	return q.limit
+}
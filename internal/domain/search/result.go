@@ -0,0 +1,54 @@
+package searches
+
+import (
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	PinKind   Kind = "pin"
+	BoardKind Kind = "board"
+	UserKind  Kind = "user"
+)
+
+// Result is a pin, board or user matching a search. Title is the pin title,
+// board name or username; Snippet is the matching text escaped as HTML, with
+// the matched words wrapped in <mark> tags.
+type Result struct {
+	kind    Kind
+	id      uuid.UUID
+	title   string
+	snippet string
+	rank    float64
+}
+
+func NewResult(kind Kind, id uuid.UUID, title, snippet string, rank float64) Result {
+	return Result{
+		kind:    kind,
+		id:      id,
+		title:   title,
+		snippet: snippet,
+		rank:    rank,
+	}
+}
+
+func (r Result) Kind() Kind {
+	return r.kind
+}
+
+func (r Result) Id() uuid.UUID {
+	return r.id
+}
+
+func (r Result) Title() string {
+	return r.title
+}
+
+func (r Result) Snippet() string {
+	return r.snippet
+}
+
+func (r Result) Rank() float64 {
+	return r.rank
+}
@@ -0,0 +1,15 @@
+package searches
+
+import (
+	"context"
+	"time"
+)
+
+// SearchRepository ranks the pins, boards and users matching a query, leaving
+// out what the viewer may not see, and returns the page after the query's
+// cursor. GetFacets counts every match, not only a page, by facet value;
+// created-at buckets are counted back from now.
+type SearchRepository interface {
+	Search(ctx context.Context, query *Query) ([]Result, error)
+	GetFacets(ctx context.Context, query *Query, now time.Time) ([]Bucket, error)
+}
@@ -0,0 +1,125 @@
+package searches
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewResult(t *testing.T) {
+	id := uuid.New()
+	result := NewResult(PinKind, id, "Kitchen", "<mark>kitchen</mark> ideas", 0.25)
+
+	assert.Equal(t, PinKind, result.Kind())
+	assert.Equal(t, id, result.Id())
+	assert.Equal(t, "Kitchen", result.Title())
+	assert.Equal(t, "<mark>kitchen</mark> ideas", result.Snippet())
+	assert.Equal(t, 0.25, result.Rank())
+}
+
+func TestCursor(t *testing.T) {
+	last := NewResult(BoardKind, uuid.New(), "Kitchen", "", 0.1+0.2)
+	cursor := NewCursor(last)
+
+	parsed, err := ParseCursor(cursor.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, last.Rank(), parsed.Rank())
+	assert.Equal(t, last.Id(), parsed.Id())
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	for _, s := range []string{"%%%", "bm9jb2xvbg", "YWJjOmRlZg", "MC41Om5vdC1hLXV1aWQ"} {
+		cursor, err := ParseCursor(s)
+
+		assert.Nil(t, cursor)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	}
+}
+
+func TestNewQuery(t *testing.T) {
+	viewerId, language := uuid.New(), shared.Spanish
+
+	query, err := NewQuery("  cocina  ", nil, &language, Filters{}, viewerId, nil, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, "cocina", query.Text())
+	assert.Equal(t, []Kind{PinKind, BoardKind, UserKind}, query.Kinds())
+	assert.Equal(t, &language, query.Language())
+	assert.Equal(t, viewerId, query.ViewerId())
+	assert.Nil(t, query.After())
+	assert.Equal(t, 20, query.Limit())
+
+	query, err = NewQuery("cocina", []Kind{BoardKind}, nil, Filters{}, viewerId, nil, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Kind{BoardKind}, query.Kinds())
+}
+
+func TestNewQuery_Invalid(t *testing.T) {
+	query, err := NewQuery("   ", nil, nil, Filters{}, uuid.New(), nil, 20)
+	assert.Nil(t, query)
+	assert.ErrorIs(t, err, ErrEmptyQuery)
+
+	query, err = NewQuery(strings.Repeat("a", MaxLengthQuery+1), nil, nil, Filters{}, uuid.New(), nil, 20)
+	assert.Nil(t, query)
+	assert.ErrorIs(t, err, ErrLongQuery)
+}
+
+func TestParseKind(t *testing.T) {
+	cases := map[string]Kind{"pin": PinKind, "Pins": PinKind, "boards": BoardKind, " user ": UserKind}
+	for s, want := range cases {
+		kind, err := ParseKind(s)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, kind)
+	}
+
+	_, err := ParseKind("tags")
+	assert.ErrorIs(t, err, ErrKindNotFound)
+}
+
+func TestNewFilters(t *testing.T) {
+	tag, boardId, country, hasImage := "#Kitchen", uuid.New(), shared.Bolivia, true
+	from := time.Now().Add(-24 * time.Hour)
+	to := from.Add(time.Hour)
+
+	filters, err := NewFilters(&tag, &boardId, &country, &from, &to, &hasImage)
+
+	require.NoError(t, err)
+	assert.Equal(t, "kitchen", *filters.Tag())
+	assert.Equal(t, boardId, *filters.BoardId())
+	assert.Equal(t, shared.Bolivia, *filters.Country())
+	assert.Equal(t, from, *filters.CreatedFrom())
+	assert.Equal(t, to, *filters.CreatedTo())
+	assert.True(t, *filters.HasImage())
+
+	filters, err = NewFilters(nil, nil, nil, nil, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, Filters{}, filters)
+}
+
+func TestNewFilters_Invalid(t *testing.T) {
+	tag := "#"
+	_, err := NewFilters(&tag, nil, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, shared.ErrEmptyHashtag)
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	_, err = NewFilters(nil, nil, nil, &from, &to, nil)
+	assert.ErrorIs(t, err, ErrInvalidRangeFilters)
+}
+
+func TestNewBucket(t *testing.T) {
+	bucket := NewBucket(BoardFacet, "id", "Kitchen", 4)
+
+	assert.Equal(t, BoardFacet, bucket.Facet())
+	assert.Equal(t, "id", bucket.Value())
+	assert.Equal(t, "Kitchen", bucket.Label())
+	assert.Equal(t, 4, bucket.Count())
+}
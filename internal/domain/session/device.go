@@ -0,0 +1,55 @@
+package sessions
+
+import "strings"
+
+// browsers and platforms are matched in order, so the ones whose user agents
+// also name others come first: Edge and Opera claim to be Chrome, Chrome
+// claims to be Safari, and Android and iOS claim to be Linux and macOS.
+var (
+	browsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"CriOS/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	platforms = []struct{ token, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Macintosh", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceName describes the device of a user agent for people to recognize,
+// like "Firefox on Windows". User agents it cannot tell are named "Unknown
+// device".
+func DeviceName(userAgent string) string {
+	browser := match(userAgent, browsers)
+	platform := match(userAgent, platforms)
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	default:
+		return "Unknown device"
+	}
+}
+
+func match(userAgent string, candidates []struct{ token, name string }) string {
+	for _, candidate := range candidates {
+		if strings.Contains(userAgent, candidate.token) {
+			return candidate.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,89 @@
+package sessions
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// maxUserAgent bounds the user agent kept, as clients choose what they send.
+const maxUserAgent = 512
+
+var (
+	ErrNilUserIdSession = errors.New("session user id cannot be nil")
+	ErrNotFoundSession  = errors.New("session not found")
+)
+
+// Session is a login on a device. It lasts as long as its refresh token
+// family, whose id is the id of the session, and every access token of it
+// carries that id as its jti.
+type Session struct {
+	id         uuid.UUID
+	userId     uuid.UUID
+	device     string
+	userAgent  string
+	ip         string
+	createdAt  time.Time
+	lastSeenAt time.Time
+}
+
+func NewSession(userId uuid.UUID, userAgent, ip string) (*Session, error) {
+	if userId == uuid.Nil {
+		return nil, ErrNilUserIdSession
+	}
+
+	if len(userAgent) > maxUserAgent {
+		userAgent = userAgent[:maxUserAgent]
+	}
+
+	now := time.Now()
+	return &Session{
+		id:         uuid.New(),
+		userId:     userId,
+		device:     DeviceName(userAgent),
+		userAgent:  userAgent,
+		ip:         ip,
+		createdAt:  now,
+		lastSeenAt: now,
+	}, nil
+}
+
+func NewSessionFromDB(id, userId uuid.UUID, device, userAgent, ip string, createdAt, lastSeenAt time.Time) *Session {
+	return &Session{
+		id:         id,
+		userId:     userId,
+		device:     device,
+		userAgent:  userAgent,
+		ip:         ip,
+		createdAt:  createdAt,
+		lastSeenAt: lastSeenAt,
+	}
+}
+
+func (s *Session) Id() uuid.UUID {
+	return s.id
+}
+
+func (s *Session) UserId() uuid.UUID {
+	return s.userId
+}
+
+func (s *Session) Device() string {
+	return s.device
+}
+
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+func (s *Session) IP() string {
+	return s.ip
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
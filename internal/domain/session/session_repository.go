@@ -0,0 +1,18 @@
+package sessions
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type SessionRepository interface {
+	// GetById returns ErrNotFoundSession when no session has the id.
+	GetById(ctx context.Context, id uuid.UUID) (*Session, error)
+	// GetActiveByUser lists the sessions of the user that can still refresh
+	// at now, most recently seen first.
+	GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*Session, error)
+	Create(ctx context.Context, session *Session) error
+	// Touch records the session was seen at from the ip.
+	Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error
+}
@@ -0,0 +1,13 @@
+package sessions
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SessionRevoker turns away the access tokens of revoked sessions. Revoking
+// the refresh tokens of a session only stops it from refreshing, so its
+// access tokens are listed here for ttl, the longest they live.
+type SessionRevoker interface {
+	RevokeSessions(ids []uuid.UUID, ttl time.Duration) error
+}
@@ -0,0 +1,56 @@
+package sessions
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSession(t *testing.T) {
+	userId := uuid.New()
+	userAgent := "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0"
+
+	session, err := NewSession(userId, userAgent, "203.0.113.7")
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, session.Id())
+	assert.Equal(t, userId, session.UserId())
+	assert.Equal(t, "Firefox on Windows", session.Device())
+	assert.Equal(t, userAgent, session.UserAgent())
+	assert.Equal(t, "203.0.113.7", session.IP())
+	assert.WithinDuration(t, time.Now(), session.CreatedAt(), time.Second)
+	assert.Equal(t, session.CreatedAt(), session.LastSeenAt())
+}
+
+func TestNewSession_Errors(t *testing.T) {
+	session, err := NewSession(uuid.Nil, "", "")
+	assert.ErrorIs(t, err, ErrNilUserIdSession)
+	assert.Nil(t, session)
+
+	session, err = NewSession(uuid.New(), strings.Repeat("a", 1000), "")
+	require.NoError(t, err)
+	assert.Len(t, session.UserAgent(), maxUserAgent)
+}
+
+func TestDeviceName(t *testing.T) {
+	cases := []struct {
+		userAgent string
+		device    string
+	}{
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36", "Chrome on macOS"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36 Edg/130.0.0.0", "Edge on Windows"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1", "Safari on iPhone"},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Mobile Safari/537.36", "Chrome on Android"},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0", "Firefox on Linux"},
+		{"PinterestApp/3.2 (Android 14)", "Android"},
+		{"curl/8.5.0", "Unknown device"},
+		{"", "Unknown device"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.device, DeviceName(tc.userAgent), tc.userAgent)
+	}
+}
@@ -0,0 +1,188 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type TextEntityType string
+
+const (
+	MentionEntity TextEntityType = "mention"
+	HashtagEntity TextEntityType = "hashtag"
+)
+
+var (
+	ErrNotATextEntityType = errors.New("is not a text entity type")
+	ErrEmptyHashtag       = errors.New("hashtag cannot be empty")
+	ErrLongHashtag        = errors.New("hashtag is too long, maximum size is 30")
+	ErrInvalidHashtag     = errors.New("hashtag contains invalid characters")
+)
+
+// TextEntity is a @mention or #hashtag found inside a free text. Offset and
+// length are counted in runes (Unicode code points) and include the sigil;
+// stored mentions keep these, and only the DTOs sent to clients convert them
+// to UTF-16 code units.
+type TextEntity struct {
+	entityType TextEntityType
+	offset     int
+	length     int
+	value      string
+}
+
+func NewTextEntity(entityType TextEntityType, offset, length int, value string) TextEntity {
+	return TextEntity{
+		entityType: entityType,
+		offset:     offset,
+		length:     length,
+		value:      value,
+	}
+}
+
+func (entity TextEntity) Type() TextEntityType {
+	return entity.entityType
+}
+
+func (entity TextEntity) Offset() int {
+	return entity.offset
+}
+
+func (entity TextEntity) Length() int {
+	return entity.length
+}
+
+func (entity TextEntity) Value() string {
+	return entity.value
+}
+
+func (entityType TextEntityType) String() string {
+	switch entityType {
+	case MentionEntity:
+		return "Mention"
+	case HashtagEntity:
+		return "Hashtag"
+	default:
+		return "Unknown"
+	}
+}
+
+func ParseTextEntityType(entityType string) (TextEntityType, error) {
+	switch entityType {
+	case "mention", "Mention":
+		return MentionEntity, nil
+	case "hashtag", "Hashtag":
+		return HashtagEntity, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotATextEntityType, entityType)
+	}
+}
+
+// NewHashtag normalizes a tag name the same way ParseTextEntities does, so
+// "#Cabinets" in a description and "cabinets" in a tag list end up as one tag.
+func NewHashtag(name string) (string, error) {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "#"))
+	if name == "" {
+		return "", ErrEmptyHashtag
+	}
+	if len([]rune(name)) > 30 {
+		return "", fmt.Errorf("%w: got %s", ErrLongHashtag, name)
+	}
+
+	hasLetter := false
+	for _, r := range name {
+		if !isHashtagRune(r) {
+			return "", fmt.Errorf("%w: got %s", ErrInvalidHashtag, name)
+		}
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		}
+	}
+	if !hasLetter {
+		return "", fmt.Errorf("%w: got %s", ErrInvalidHashtag, name)
+	}
+
+	return name, nil
+}
+
+// ParseTextEntities scans text for @mentions and #hashtags. A sigil only starts
+// an entity at the beginning of the text or after a character that cannot be
+// part of a word, so e-mail addresses and URL fragments are left alone.
+// Mentions must be valid usernames; hashtags are lower-cased and need at least
+// one letter. Invalid candidates are skipped.
+func ParseTextEntities(text string) []TextEntity {
+	var entities []TextEntity
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		sigil := runes[i]
+		if sigil != '@' && sigil != '#' {
+			continue
+		}
+		if i > 0 && !isEntityBoundary(runes[i-1]) {
+			continue
+		}
+
+		j := i + 1
+		if sigil == '@' {
+			for j < len(runes) && isUsernameRune(runes[j]) {
+				j++
+			}
+			for j > i+1 && runes[j-1] == '.' {
+				j--
+			}
+
+			username, err := NewUsername(string(runes[i+1 : j]))
+			if err != nil {
+				continue
+			}
+
+			entities = append(entities, NewTextEntity(MentionEntity, i, j-i, username.String()))
+		} else {
+			for j < len(runes) && isHashtagRune(runes[j]) {
+				j++
+			}
+
+			tag, err := NewHashtag(string(runes[i+1 : j]))
+			if err != nil {
+				continue
+			}
+
+			entities = append(entities, NewTextEntity(HashtagEntity, i, j-i, tag))
+		}
+
+		i = j - 1
+	}
+
+	return entities
+}
+
+// UniqueValues returns the distinct values of the entities of the given type,
+// in order of first appearance.
+func UniqueValues(entities []TextEntity, entityType TextEntityType) []string {
+	var values []string
+
+	seen := make(map[string]bool)
+	for _, entity := range entities {
+		if entity.entityType != entityType || seen[entity.value] {
+			continue
+		}
+		seen[entity.value] = true
+		values = append(values, entity.value)
+	}
+
+	return values
+}
+
+func isUsernameRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '.'
+}
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func isEntityBoundary(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '@' && r != '#' && r != '.' && r != '/'
+}
@@ -0,0 +1,115 @@
+package shared
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseTextEntities(t *testing.T) {
+	text := "Kitchen ideas with @john_doe and @ana.maria. #DIY #home_decor"
+
+	entities := ParseTextEntities(text)
+
+	require.Len(t, entities, 4)
+
+	assert.Equal(t, MentionEntity, entities[0].Type())
+	assert.Equal(t, "john_doe", entities[0].Value())
+	assert.Equal(t, 19, entities[0].Offset())
+	assert.Equal(t, 9, entities[0].Length())
+
+	assert.Equal(t, MentionEntity, entities[1].Type())
+	assert.Equal(t, "ana.maria", entities[1].Value())
+	assert.Equal(t, 33, entities[1].Offset())
+	assert.Equal(t, 10, entities[1].Length())
+
+	assert.Equal(t, HashtagEntity, entities[2].Type())
+	assert.Equal(t, "diy", entities[2].Value())
+	assert.Equal(t, 45, entities[2].Offset())
+	assert.Equal(t, 4, entities[2].Length())
+
+	assert.Equal(t, HashtagEntity, entities[3].Type())
+	assert.Equal(t, "home_decor", entities[3].Value())
+	assert.Equal(t, 50, entities[3].Offset())
+	assert.Equal(t, 11, entities[3].Length())
+}
+
+func TestParseTextEntities_RuneOffsets(t *testing.T) {
+	text := "Café ☕ #café @pierre"
+
+	entities := ParseTextEntities(text)
+
+	require.Len(t, entities, 2)
+	assert.Equal(t, "café", entities[0].Value())
+	assert.Equal(t, 7, entities[0].Offset())
+	assert.Equal(t, 5, entities[0].Length())
+	assert.Equal(t, "pierre", entities[1].Value())
+	assert.Equal(t, 13, entities[1].Offset())
+	assert.Equal(t, 7, entities[1].Length())
+
+	runes := []rune(text)
+	assert.Equal(t, "#café", string(runes[entities[0].Offset():entities[0].Offset()+entities[0].Length()]))
+}
+
+func TestParseTextEntities_Ignored(t *testing.T) {
+	cases := []string{
+		"write to john@doe.com",
+		"see https://example.com/#section",
+		"@ab is too short",
+		"@" + "averyveryverylongusernamethatdoesnotfit",
+		"issue #123",
+		"##double and @@double",
+		"no entities at all",
+		"",
+	}
+
+	for _, text := range cases {
+		t.Run(text, func(t *testing.T) {
+			assert.Empty(t, ParseTextEntities(text))
+		})
+	}
+}
+
+func TestUniqueValues(t *testing.T) {
+	entities := ParseTextEntities("@john #a1 @ana #A1 @john #b2")
+
+	assert.Equal(t, []string{"john", "ana"}, UniqueValues(entities, MentionEntity))
+	assert.Equal(t, []string{"a1", "b2"}, UniqueValues(entities, HashtagEntity))
+}
+
+func TestNewHashtag(t *testing.T) {
+	tag, err := NewHashtag(" #Cabinets ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "cabinets", tag)
+}
+
+func TestNewHashtag_Errors(t *testing.T) {
+	_, err := NewHashtag("")
+	assert.ErrorIs(t, err, ErrEmptyHashtag)
+
+	_, err = NewHashtag("thisisaveryveryverylonghashtagname")
+	assert.ErrorIs(t, err, ErrLongHashtag)
+
+	_, err = NewHashtag("two words")
+	assert.ErrorIs(t, err, ErrInvalidHashtag)
+
+	_, err = NewHashtag("2024")
+	assert.ErrorIs(t, err, ErrInvalidHashtag)
+}
+
+func TestParseTextEntityType(t *testing.T) {
+	entityType, err := ParseTextEntityType("mention")
+	require.NoError(t, err)
+	assert.Equal(t, MentionEntity, entityType)
+	assert.Equal(t, "Mention", entityType.String())
+
+	entityType, err = ParseTextEntityType("Hashtag")
+	require.NoError(t, err)
+	assert.Equal(t, HashtagEntity, entityType)
+	assert.Equal(t, "Hashtag", entityType.String())
+
+	_, err = ParseTextEntityType("link")
+	assert.ErrorIs(t, err, ErrNotATextEntityType)
+	assert.Equal(t, "Unknown", TextEntityType("link").String())
+}
@@ -0,0 +1,84 @@
+package suggestions
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const MaxLengthPrefix = 50
+
+var (
+	ErrEmptyPrefix  = errors.New("autocomplete prefix cannot be empty")
+	ErrLongPrefix   = errors.New("autocomplete prefix is too long")
+	ErrKindNotFound = errors.New("autocomplete kind not found")
+)
+
+// Prefix is what the user typed so far, lower cased. A leading @ asks for
+// users only and a leading # for tags only, as in mention and hashtag fields.
+type Prefix struct {
+	value string
+	kinds []Kind
+}
+
+func NewPrefix(text string) (Prefix, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	kinds := []Kind{UserKind, TagKind, BoardKind}
+
+	if rest, ok := strings.CutPrefix(text, "@"); ok {
+		text, kinds = rest, []Kind{UserKind}
+	} else if rest, ok = strings.CutPrefix(text, "#"); ok {
+		text, kinds = rest, []Kind{TagKind}
+	}
+
+	if text == "" {
+		return Prefix{}, ErrEmptyPrefix
+	} else if utf8.RuneCountInString(text) > MaxLengthPrefix {
+		return Prefix{}, ErrLongPrefix
+	}
+
+	return Prefix{
+		value: text,
+		kinds: kinds,
+	}, nil
+}
+
+// ParseKind reads a kind given by a client, singular or plural.
+func ParseKind(kind string) (Kind, error) {
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case "user", "users":
+		return UserKind, nil
+	case "tag", "tags":
+		return TagKind, nil
+	case "board", "boards":
+		return BoardKind, nil
+	default:
+		return "", ErrKindNotFound
+	}
+}
+
+func (p Prefix) Value() string {
+	return p.value
+}
+
+// Kinds are the kinds the prefix may complete.
+func (p Prefix) Kinds() []Kind {
+	return p.kinds
+}
+
+// Includes reports whether the prefix may complete kind.
+func (p Prefix) Includes(kind Kind) bool {
+	for _, k := range p.kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Pattern is the prefix as a LIKE pattern, with its wildcards escaped so an
+// underscore in a username is matched literally.
+func (p Prefix) Pattern() string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(p.value)
+	return escaped + "%"
+}
@@ -0,0 +1,55 @@
+package suggestions
+
+import (
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	UserKind  Kind = "user"
+	TagKind   Kind = "tag"
+	BoardKind Kind = "board"
+)
+
+// Suggestion completes what a user is typing. Text is what gets inserted: a
+// username, a tag name or a board name. Label is shown next to it, like the
+// display name of a user, and may be empty. Popularity orders suggestions of
+// the same kind: followers of a user, pins of a tag, pins of a board.
+type Suggestion struct {
+	kind       Kind
+	id         uuid.UUID
+	text       string
+	label      string
+	popularity int
+}
+
+func NewSuggestion(kind Kind, id uuid.UUID, text, label string, popularity int) Suggestion {
+	return Suggestion{
+		kind:       kind,
+		id:         id,
+		text:       text,
+		label:      label,
+		popularity: popularity,
+	}
+}
+
+func (s Suggestion) Kind() Kind {
+	return s.kind
+}
+
+func (s Suggestion) Id() uuid.UUID {
+	return s.id
+}
+
+func (s Suggestion) Text() string {
+	return s.text
+}
+
+func (s Suggestion) Label() string {
+	return s.label
+}
+
+func (s Suggestion) Popularity() int {
+	return s.popularity
+}
@@ -0,0 +1,15 @@
+package suggestions
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// SuggestionRepository completes a prefix with the most popular users, tags
+// and boards starting with it. Users in a block with the viewer are left out,
+// and only the viewer's own boards are suggested.
+type SuggestionRepository interface {
+	GetUsers(ctx context.Context, prefix Prefix, viewerId uuid.UUID, limit int) ([]Suggestion, error)
+	GetTags(ctx context.Context, prefix Prefix, limit int) ([]Suggestion, error)
+	GetBoards(ctx context.Context, prefix Prefix, ownerId uuid.UUID, limit int) ([]Suggestion, error)
+}
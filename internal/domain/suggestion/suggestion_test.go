@@ -0,0 +1,78 @@
+package suggestions
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestNewSuggestion(t *testing.T) {
+	id := uuid.New()
+	suggestion := NewSuggestion(UserKind, id, "carlos_c", "Carlos Clavijo", 12)
+
+	assert.Equal(t, UserKind, suggestion.Kind())
+	assert.Equal(t, id, suggestion.Id())
+	assert.Equal(t, "carlos_c", suggestion.Text())
+	assert.Equal(t, "Carlos Clavijo", suggestion.Label())
+	assert.Equal(t, 12, suggestion.Popularity())
+}
+
+func TestNewPrefix(t *testing.T) {
+	cases := []struct {
+		text  string
+		value string
+		kinds []Kind
+	}{
+		{text: " Car ", value: "car", kinds: []Kind{UserKind, TagKind, BoardKind}},
+		{text: "@Car", value: "car", kinds: []Kind{UserKind}},
+		{text: "#Kitchen", value: "kitchen", kinds: []Kind{TagKind}},
+	}
+
+	for _, tc := range cases {
+		prefix, err := NewPrefix(tc.text)
+
+		require.NoError(t, err)
+		assert.Equal(t, tc.value, prefix.Value())
+		assert.Equal(t, tc.kinds, prefix.Kinds())
+	}
+}
+
+func TestNewPrefix_Invalid(t *testing.T) {
+	for _, text := range []string{"", "  ", "@", "#"} {
+		_, err := NewPrefix(text)
+		assert.ErrorIs(t, err, ErrEmptyPrefix)
+	}
+
+	_, err := NewPrefix(strings.Repeat("a", MaxLengthPrefix+1))
+	assert.ErrorIs(t, err, ErrLongPrefix)
+}
+
+func TestPrefix_Includes(t *testing.T) {
+	prefix, err := NewPrefix("@car")
+	require.NoError(t, err)
+
+	assert.True(t, prefix.Includes(UserKind))
+	assert.False(t, prefix.Includes(TagKind))
+}
+
+func TestPrefix_Pattern(t *testing.T) {
+	prefix, err := NewPrefix(`car_los%\`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `car\_los\%\\%`, prefix.Pattern())
+}
+
+func TestParseKind(t *testing.T) {
+	cases := map[string]Kind{"user": UserKind, "Tags": TagKind, " boards ": BoardKind}
+	for s, want := range cases {
+		kind, err := ParseKind(s)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, kind)
+	}
+
+	_, err := ParseKind("pins")
+	assert.ErrorIs(t, err, ErrKindNotFound)
+}
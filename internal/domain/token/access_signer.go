@@ -0,0 +1,11 @@
+package tokens
+
+import "time"
+
+// AccessSigner signs the short-lived access tokens handed out along with
+// refresh tokens, for the session they belong to and carrying the role of
+// the user.
+type AccessSigner interface {
+	Generate(userID, sessionID, role string) (string, error)
+	TTL() time.Duration
+}
@@ -0,0 +1,120 @@
+package tokens
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrNilUserIdRefreshToken = errors.New("refresh token user id cannot be nil")
+	ErrInvalidRefreshToken   = errors.New("refresh token is invalid")
+	ErrExpiredRefreshToken   = errors.New("refresh token has expired")
+	ErrRevokedRefreshToken   = errors.New("refresh token has been revoked")
+	ErrReusedRefreshToken    = errors.New("refresh token was already used, every token of its session has been revoked")
+	ErrNotFoundRefreshToken  = errors.New("refresh token not found")
+	ErrNonPositiveTTLRefresh = errors.New("refresh token ttl must be positive")
+)
+
+// RefreshToken trades for a new access token once. Every refresh rotates it
+// for a new one of the same family, which starts at login. Presenting a used
+// token again means it leaked, so its whole family is revoked.
+type RefreshToken struct {
+	id        uuid.UUID
+	userId    uuid.UUID
+	familyId  uuid.UUID
+	hash      string
+	expiresAt time.Time
+	createdAt time.Time
+	usedAt    *time.Time
+	revokedAt *time.Time
+}
+
+// NewRefreshToken starts a family when familyId is nil. It returns the secret
+// to give the client; only its hash is kept.
+func NewRefreshToken(userId, familyId uuid.UUID, ttl time.Duration) (*RefreshToken, string, error) {
+	if userId == uuid.Nil {
+		return nil, "", ErrNilUserIdRefreshToken
+	} else if ttl <= 0 {
+		return nil, "", ErrNonPositiveTTLRefresh
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if familyId == uuid.Nil {
+		familyId = uuid.New()
+	}
+
+	now := time.Now()
+	return &RefreshToken{
+		id:        uuid.New(),
+		userId:    userId,
+		familyId:  familyId,
+		hash:      Hash(secret),
+		expiresAt: now.Add(ttl),
+		createdAt: now,
+	}, secret, nil
+}
+
+func NewRefreshTokenFromDB(id, userId, familyId uuid.UUID, hash string, expiresAt, createdAt time.Time, usedAt, revokedAt *time.Time) *RefreshToken {
+	return &RefreshToken{
+		id:        id,
+		userId:    userId,
+		familyId:  familyId,
+		hash:      hash,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+		usedAt:    usedAt,
+		revokedAt: revokedAt,
+	}
+}
+
+func (t *RefreshToken) Id() uuid.UUID {
+	return t.id
+}
+
+func (t *RefreshToken) UserId() uuid.UUID {
+	return t.userId
+}
+
+func (t *RefreshToken) FamilyId() uuid.UUID {
+	return t.familyId
+}
+
+func (t *RefreshToken) Hash() string {
+	return t.hash
+}
+
+func (t *RefreshToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *RefreshToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *RefreshToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+func (t *RefreshToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+// Check tells whether the token can be redeemed at now. A used token is
+// reported before anything else, as it is the one sign of theft.
+func (t *RefreshToken) Check(now time.Time) error {
+	switch {
+	case t.usedAt != nil:
+		return ErrReusedRefreshToken
+	case t.revokedAt != nil:
+		return ErrRevokedRefreshToken
+	case !now.Before(t.expiresAt):
+		return ErrExpiredRefreshToken
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,17 @@
+package tokens
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type RefreshTokenRepository interface {
+	GetByHash(ctx context.Context, hash string) (*RefreshToken, error)
+	Create(ctx context.Context, token *RefreshToken) error
+	// MarkUsed reports false when the token was used meanwhile, so two
+	// concurrent refreshes cannot both rotate it.
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+	RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error
+	RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,75 @@
+package tokens
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewSecret(t *testing.T) {
+	first, err := NewSecret()
+	require.NoError(t, err)
+	second, err := NewSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 43)
+	assert.NotEqual(t, first, second)
+	assert.Len(t, Hash(first), 64)
+	assert.Equal(t, Hash(first), Hash(first))
+	assert.NotEqual(t, Hash(first), Hash(second))
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	userId := uuid.New()
+
+	token, secret, err := NewRefreshToken(userId, uuid.Nil, time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, token.UserId())
+	assert.NotEqual(t, uuid.Nil, token.FamilyId())
+	assert.Equal(t, Hash(secret), token.Hash())
+	assert.NotContains(t, token.Hash(), secret)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt(), time.Second)
+	assert.Nil(t, token.UsedAt())
+	assert.Nil(t, token.RevokedAt())
+
+	rotated, _, err := NewRefreshToken(userId, token.FamilyId(), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, token.FamilyId(), rotated.FamilyId())
+	assert.NotEqual(t, token.Id(), rotated.Id())
+}
+
+func TestNewRefreshToken_Errors(t *testing.T) {
+	_, _, err := NewRefreshToken(uuid.Nil, uuid.Nil, time.Hour)
+	assert.ErrorIs(t, err, ErrNilUserIdRefreshToken)
+
+	_, _, err = NewRefreshToken(uuid.New(), uuid.Nil, 0)
+	assert.ErrorIs(t, err, ErrNonPositiveTTLRefresh)
+}
+
+func TestRefreshToken_Check(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	cases := []struct {
+		name      string
+		expiresAt time.Time
+		usedAt    *time.Time
+		revokedAt *time.Time
+		err       error
+	}{
+		{"Valid", now.Add(time.Hour), nil, nil, nil},
+		{"Expired", now, nil, nil, ErrExpiredRefreshToken},
+		{"Revoked", now.Add(time.Hour), nil, &earlier, ErrRevokedRefreshToken},
+		{"Reused", now.Add(time.Hour), &earlier, &earlier, ErrReusedRefreshToken},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			token := NewRefreshTokenFromDB(uuid.New(), uuid.New(), uuid.New(), "hash", c.expiresAt, earlier, c.usedAt, c.revokedAt)
+			assert.ErrorIs(t, token.Check(now), c.err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package trends
+
+import (
+	"math"
+	"time"
+)
+
+type Kind string
+
+const (
+	PinKind Kind = "pins"
+	TagKind Kind = "tags"
+)
+
+// Weights of each kind of engagement in a trend score. Saving a pin says the
+// most about it; a view alone says the least.
+const (
+	SaveWeight    = 5.0
+	CommentWeight = 4.0
+	LikeWeight    = 3.0
+	ViewWeight    = 1.0
+)
+
+// Score is how hot a pin or a tag is within a segment. Member is the pin id or
+// the tag name.
+type Score struct {
+	segment Segment
+	member  string
+	value   float64
+}
+
+func NewScore(segment Segment, member string, value float64) Score {
+	return Score{
+		segment: segment,
+		member:  member,
+		value:   value,
+	}
+}
+
+func (s Score) Segment() Segment {
+	return s.segment
+}
+
+func (s Score) Member() string {
+	return s.member
+}
+
+func (s Score) Value() float64 {
+	return s.value
+}
+
+// DecayRate is the hourly rate at which an engagement loses weight, so that it
+// is worth half as much after halfLife: weight * e^(-rate * age in hours).
+func DecayRate(halfLife time.Duration) float64 {
+	return math.Ln2 / halfLife.Hours()
+}
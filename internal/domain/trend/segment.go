@@ -0,0 +1,51 @@
+package trends
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+// Segment is the audience a trend was measured on, keyed by the country and
+// language of the users engaging with the pins. Global covers everyone.
+type Segment string
+
+const Global Segment = "global"
+
+func NewSegment(country *shared.Country, language *shared.Language) Segment {
+	switch {
+	case country != nil && language != nil:
+		return Segment("country:" + string(*country) + ":language:" + string(*language))
+	case country != nil:
+		return Segment("country:" + string(*country))
+	case language != nil:
+		return Segment("language:" + string(*language))
+	default:
+		return Global
+	}
+}
+
+// ParseSegment builds the segment of an optional country and language given by
+// a client, by code or by name. Empty values leave the segment unsplit.
+func ParseSegment(country, language string) (Segment, error) {
+	var (
+		c *shared.Country
+		l *shared.Language
+	)
+
+	if country != "" {
+		parsed, err := shared.ParseCountry(country)
+		if err != nil {
+			return "", err
+		}
+		c = &parsed
+	}
+
+	if language != "" {
+		parsed, err := shared.ParseLanguage(language)
+		if err != nil {
+			return "", err
+		}
+		l = &parsed
+	}
+
+	return NewSegment(c, l), nil
+}
@@ -0,0 +1,16 @@
+package trends
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// TrendRepository scores the engagement since a moment, decayed at rate up to
+// now, keeping the best limit members of each segment. GetListVisible keeps
+// the pins a viewer may see.
+type TrendRepository interface {
+	GetPinScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]Score, error)
+	GetTagScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]Score, error)
+	GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error)
+}
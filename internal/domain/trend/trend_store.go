@@ -0,0 +1,12 @@
+package trends
+
+import (
+	"context"
+)
+
+// TrendStore keeps the latest scores of every segment. Replace swaps all the
+// scores of a kind at once; Top reads the best of a segment, highest first.
+type TrendStore interface {
+	Replace(ctx context.Context, kind Kind, scores []Score) error
+	Top(ctx context.Context, kind Kind, segment Segment, limit int) ([]Score, error)
+}
@@ -0,0 +1,50 @@
+package trends
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewSegment(t *testing.T) {
+	country, language := shared.UnitedStates, shared.English
+
+	assert.Equal(t, Global, NewSegment(nil, nil))
+	assert.Equal(t, Segment("country:US"), NewSegment(&country, nil))
+	assert.Equal(t, Segment("language:EN"), NewSegment(nil, &language))
+	assert.Equal(t, Segment("country:US:language:EN"), NewSegment(&country, &language))
+}
+
+func TestParseSegment(t *testing.T) {
+	segment, err := ParseSegment("", "")
+	require.NoError(t, err)
+	assert.Equal(t, Global, segment)
+
+	segment, err = ParseSegment("Bolivia", "ES")
+	require.NoError(t, err)
+	assert.Equal(t, Segment("country:BO:language:ES"), segment)
+
+	_, err = ParseSegment("XX", "")
+	assert.ErrorIs(t, err, shared.ErrNotACountry)
+
+	_, err = ParseSegment("", "XX")
+	assert.ErrorIs(t, err, shared.ErrNotALanguage)
+}
+
+func TestNewScore(t *testing.T) {
+	score := NewScore(Global, "kitchen", 2.5)
+
+	assert.Equal(t, Global, score.Segment())
+	assert.Equal(t, "kitchen", score.Member())
+	assert.Equal(t, 2.5, score.Value())
+}
+
+func TestDecayRate(t *testing.T) {
+	rate := DecayRate(12 * time.Hour)
+
+	assert.InDelta(t, 0.5, math.Exp(-rate*12), 1e-9)
+	assert.InDelta(t, 0.25, math.Exp(-rate*24), 1e-9)
+}
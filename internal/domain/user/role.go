@@ -0,0 +1,67 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Role is what a user may do besides handling their own things. Each role
+// includes the ones below it: admins may do what moderators may, and
+// moderators what users may.
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+var (
+	ErrNotARole  = errors.New("is not a role")
+	ErrOwnRole   = errors.New("users cannot change their own role")
+	ErrOutranked = errors.New("users cannot act on users of their own role or a higher one")
+)
+
+func ParseRole(role string) (Role, error) {
+	switch Role(role) {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return Role(role), nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotARole, role)
+	}
+}
+
+func ListRoles() []Role {
+	return []Role{
+		RoleUser, RoleModerator, RoleAdmin,
+	}
+}
+
+func (role Role) String() string {
+	return string(role)
+}
+
+// Includes reports whether the role may do what other may. Unknown roles
+// include nothing and are included by nothing.
+func (role Role) Includes(other Role) bool {
+	return role.rank() > 0 && other.rank() > 0 && role.rank() >= other.rank()
+}
+
+// Manages reports whether the role may act on the accounts of users of other:
+// admins on anyone's, the other roles on those of lower roles only.
+func (role Role) Manages(other Role) bool {
+	return role.rank() > 0 && other.rank() > 0 && (role == RoleAdmin || role.rank() > other.rank())
+}
+
+func (role Role) rank() int {
+	switch role {
+	case RoleUser:
+		return 1
+	case RoleModerator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,13 @@
+package users
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// RoleRepository keeps the role of each user next to the user. Both return
+// ErrNotFoundUser for unknown users.
+type RoleRepository interface {
+	GetRole(ctx context.Context, id uuid.UUID) (Role, error)
+	ChangeRole(ctx context.Context, id uuid.UUID, role Role) error
+}
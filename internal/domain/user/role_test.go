@@ -0,0 +1,68 @@
+package users
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseRole(t *testing.T) {
+	for _, role := range ListRoles() {
+		parsed, err := ParseRole(role.String())
+		require.NoError(t, err)
+		assert.Equal(t, role, parsed)
+	}
+
+	_, err := ParseRole("root")
+	assert.ErrorIs(t, err, ErrNotARole)
+
+	_, err = ParseRole("")
+	assert.ErrorIs(t, err, ErrNotARole)
+}
+
+func TestRole_Includes(t *testing.T) {
+	cases := []struct {
+		role     Role
+		other    Role
+		includes bool
+	}{
+		{RoleUser, RoleUser, true},
+		{RoleUser, RoleModerator, false},
+		{RoleUser, RoleAdmin, false},
+		{RoleModerator, RoleUser, true},
+		{RoleModerator, RoleModerator, true},
+		{RoleModerator, RoleAdmin, false},
+		{RoleAdmin, RoleUser, true},
+		{RoleAdmin, RoleModerator, true},
+		{RoleAdmin, RoleAdmin, true},
+		{Role("root"), RoleUser, false},
+		{RoleAdmin, Role("root"), false},
+		{Role(""), Role(""), false},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.includes, tc.role.Includes(tc.other), "%s includes %s", tc.role, tc.other)
+	}
+}
+
+func TestRole_Manages(t *testing.T) {
+	cases := []struct {
+		role    Role
+		other   Role
+		manages bool
+	}{
+		{RoleUser, RoleUser, false},
+		{RoleModerator, RoleUser, true},
+		{RoleModerator, RoleModerator, false},
+		{RoleModerator, RoleAdmin, false},
+		{RoleAdmin, RoleUser, true},
+		{RoleAdmin, RoleModerator, true},
+		{RoleAdmin, RoleAdmin, true},
+		{RoleAdmin, Role("root"), false},
+		{Role("root"), RoleUser, false},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.manages, tc.role.Manages(tc.other), "%s manages %s", tc.role, tc.other)
+	}
+}
@@ -28,6 +28,7 @@ type User struct {
 	createdAt   time.Time
 	updatedAt   time.Time
 	deletedAt   *time.Time
+	verifiedAt  *time.Time
 }
 
 var (
@@ -44,6 +45,8 @@ var (
 	ErrAlreadyDeletedUser     = errors.New("user already deleted")
 	ErrAlreadyRestoredUser    = errors.New("user already restored")
 	ErrInvalidCredentialsUser = errors.New("invalid credentials")
+	ErrAlreadyVerifiedUser    = errors.New("user email already verified")
+	ErrNotVerifiedUser        = errors.New("user email not verified")
 )
 
 func NewUser(firstName, lastName string, username shared.Username, email shared.Email, password shared.Password, gender shared.Gender, birth shared.BirthDate, country shared.Country, language shared.Language, phone *shared.Phone) *User {
@@ -141,6 +144,15 @@ func (u *User) DeletedAt() *time.Time {
 	return u.deletedAt
 }
 
+// VerifiedAt is when the user proved they own their email, nil until then.
+func (u *User) VerifiedAt() *time.Time {
+	return u.verifiedAt
+}
+
+func (u *User) IsVerified() bool {
+	return u.verifiedAt != nil
+}
+
 func (u *User) ChangeFirstName(name string) error {
 	if name == "" {
 		return ErrEmptyFirstNameUser
@@ -262,6 +274,17 @@ func (u *User) Delete() error {
 	return nil
 }
 
+func (u *User) Verify() error {
+	if u.verifiedAt != nil {
+		return ErrAlreadyVerifiedUser
+	}
+
+	now := time.Now()
+	u.verifiedAt = &now
+
+	return nil
+}
+
 func (u *User) Restore() error {
 	if u.deletedAt == nil {
 		return ErrAlreadyRestoredUser
@@ -272,7 +295,7 @@ func (u *User) Restore() error {
 	return nil
 }
 
-func NewUserFromDB(id uuid.UUID, firstName, lastName, username, email, password, gender string, birth time.Time, country, language string, phone, information, profilePic, webSite *string, visibility bool, lastLoginAt time.Time, createdAt, updatedAt time.Time, deletedAt *time.Time) (*User, error) {
+func NewUserFromDB(id uuid.UUID, firstName, lastName, username, email, password, gender string, birth time.Time, country, language string, phone, information, profilePic, webSite *string, visibility bool, lastLoginAt time.Time, createdAt, updatedAt time.Time, deletedAt, verifiedAt *time.Time) (*User, error) {
 	Username, err := shared.NewUsername(username)
 	if err != nil {
 		return nil, err
@@ -338,5 +361,6 @@ func NewUserFromDB(id uuid.UUID, firstName, lastName, username, email, password,
 		createdAt:     createdAt,
 		updatedAt:     updatedAt,
 		deletedAt:     deletedAt,
+		verifiedAt:    verifiedAt,
 	}, nil
 }
@@ -13,7 +13,8 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetListByCountry(ctx context.Context, country string) ([]*User, error)
 	GetListByLanguage(ctx context.Context, language string) ([]*User, error)
-	GetListLikeUsername(ctx context.Context, name string) ([]*User, error)
+	// GetListLikeUsername leaves out users in a block with the viewer.
+	GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*User, error)
 
 	ExistsById(ctx context.Context, id uuid.UUID) (bool, error)
 	ExistsByUserName(ctx context.Context, username string) (bool, error)
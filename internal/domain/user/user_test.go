@@ -293,6 +293,12 @@ func TestNewUser(t *testing.T) {
 	err = usr.Restore()
 
 	assert.ErrorIs(t, err, ErrAlreadyRestoredUser)
+
+	assert.False(t, usr.IsVerified())
+	assert.NoError(t, usr.Verify())
+	assert.True(t, usr.IsVerified())
+	assert.WithinDuration(t, time.Now(), *usr.VerifiedAt(), time.Second)
+	assert.ErrorIs(t, usr.Verify(), ErrAlreadyVerifiedUser)
 }
 
 func TestNewUserFromDB(t *testing.T) {
@@ -315,52 +321,52 @@ func TestNewUserFromDB(t *testing.T) {
 	updatedAt := time.Now()
 	deletedAt := time.Now().AddDate(0, 0, 1)
 
-	usr, err := NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err := NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrEmptyUsername)
 
 	userName = "carlosclavijo"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrEmptyEmail)
 
 	email = "john@doe.com"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.Error(t, shared.ErrEmptyPassword)
 
 	password = "5Trong!."
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrNotAGender)
 
 	gender = "Male"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrUnderTwelve)
 
 	birth = time.Now().AddDate(-20, 0, 1)
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrNotACountry)
 
 	country = "Bolivia"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrNotALanguage)
 
 	language = "Spanish"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrNotNumericPhoneNumber)
 
 	phone = "+591-70926048"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 	assert.Nil(t, usr)
 	assert.ErrorIs(t, err, shared.ErrInvalidWebsite)
 
 	website = "https://www.randomwebsite.com/profile?v=id"
-	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt)
+	usr, err = NewUserFromDB(id, firstName, lastName, userName, email, password, gender, birth, country, language, &phone, &information, &profilePic, &website, true, lastLoginAt, createdAt, updatedAt, &deletedAt, nil)
 
 	require.NotNil(t, usr)
 	require.NoError(t, err)
@@ -1,15 +1,46 @@
 package infrastructure
 
 import (
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/google/uuid"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	DBConfig     persistence.DBConfig
-	JWTSecret    string
-	EmailService services.EmailService
+	DBConfig              persistence.DBConfig
+	JWT                   services.JWTSettings
+	Auth                  services.AuthSettings
+	Login                 attempts.Policy
+	MFA                   services.MFASettings
+	Passkeys              services.PasskeySettings
+	OIDC                  services.OIDCSettings
+	EmailService          services.EmailService
+	Verification          services.VerificationSettings
+	NotificationRetention NotificationRetention
+	Streams               services.StreamSettings
+	Feed                  services.FeedSettings
+	Related               services.RelatedSettings
+	Trends                services.TrendSettings
+	Events                services.EventSettings
+	Analytics             services.AnalyticsSettings
+	Admins                []uuid.UUID
+}
+
+// NotificationRetention bounds how many notifications are kept. Anything older
+// than MaxAge, or past the newest KeepPerUser of a recipient, is pruned every
+// Interval.
+type NotificationRetention struct {
+	MaxAge      time.Duration
+	KeepPerUser int
+	Interval    time.Duration
 }
 
 func LoadConfig(v *services.VaultClient) *Config {
@@ -35,9 +66,289 @@ func LoadConfig(v *services.VaultClient) *Config {
 		AppUrl:   secret["APP_URL"].(string),
 	}
 
+	verification := services.VerificationSettings{
+		Policy:      optionalPolicy(secret, "EMAIL_VERIFICATION_POLICY"),
+		RedirectUrl: optionalString(secret, "EMAIL_VERIFIED_REDIRECT_URL", "http://localhost:3000/login?verified=true"),
+	}
+
+	retention := NotificationRetention{
+		MaxAge:      time.Duration(optionalInt(secret, "NOTIFICATIONS_RETENTION_DAYS", 90)) * 24 * time.Hour,
+		KeepPerUser: optionalInt(secret, "NOTIFICATIONS_KEEP_PER_USER", 500),
+		Interval:    time.Duration(optionalInt(secret, "NOTIFICATIONS_PRUNE_INTERVAL_MINUTES", 60)) * time.Minute,
+	}
+
+	streams := services.StreamSettings{
+		Origins: optionalList(secret, "STREAM_ORIGINS", "http://localhost:3000"),
+	}
+
+	feed := services.FeedSettings{
+		PopularFollowers: optionalInt(secret, "FEED_POPULAR_FOLLOWERS", 10000),
+		MaxSize:          optionalInt(secret, "FEED_MAX_SIZE", 800),
+		TTL:              time.Duration(optionalInt(secret, "FEED_TTL_HOURS", 72)) * time.Hour,
+		RankerName:       optionalRanker(secret, "FEED_RANKER"),
+	}
+
+	related := services.RelatedSettings{
+		Size:     optionalInt(secret, "RELATED_PINS_SIZE", 100),
+		TTL:      time.Duration(optionalInt(secret, "RELATED_PINS_TTL_HOURS", 24)) * time.Hour,
+		Interval: time.Duration(optionalInt(secret, "RELATED_PINS_REFRESH_MINUTES", 30)) * time.Minute,
+	}
+
+	trends := services.TrendSettings{
+		HalfLife: time.Duration(optionalInt(secret, "TRENDS_HALF_LIFE_HOURS", 24)) * time.Hour,
+		Window:   time.Duration(optionalInt(secret, "TRENDS_WINDOW_DAYS", 7)) * 24 * time.Hour,
+		Interval: time.Duration(optionalInt(secret, "TRENDS_REFRESH_MINUTES", 15)) * time.Minute,
+		Size:     optionalInt(secret, "TRENDS_SIZE", 100),
+	}
+
+	events := services.EventSettings{
+		BufferSize:     optionalInt(secret, "EVENTS_BUFFER_SIZE", 10000),
+		BatchSize:      optionalInt(secret, "EVENTS_BATCH_SIZE", 500),
+		FlushInterval:  time.Duration(optionalInt(secret, "EVENTS_FLUSH_SECONDS", 5)) * time.Second,
+		RollupInterval: time.Duration(optionalInt(secret, "EVENTS_ROLLUP_MINUTES", 60)) * time.Minute,
+	}
+
+	auth := services.AuthSettings{
+		AccessTTL:  time.Duration(optionalInt(secret, "AUTH_ACCESS_TTL_MINUTES", 15)) * time.Minute,
+		RefreshTTL: time.Duration(optionalInt(secret, "AUTH_REFRESH_TTL_DAYS", 30)) * 24 * time.Hour,
+		ResetTTL:   time.Duration(optionalInt(secret, "AUTH_RESET_TTL_MINUTES", 60)) * time.Minute,
+	}
+
+	login := attempts.Policy{
+		FreeAttempts:    optionalInt(secret, "LOGIN_FREE_ATTEMPTS", 3),
+		IPFreeAttempts:  optionalInt(secret, "LOGIN_IP_FREE_ATTEMPTS", 20),
+		BaseDelay:       time.Duration(optionalInt(secret, "LOGIN_BASE_DELAY_SECONDS", 1)) * time.Second,
+		MaxDelay:        time.Duration(optionalInt(secret, "LOGIN_MAX_DELAY_SECONDS", 60)) * time.Second,
+		LockoutAttempts: optionalInt(secret, "LOGIN_LOCKOUT_ATTEMPTS", 10),
+		LockoutDuration: time.Duration(optionalInt(secret, "LOGIN_LOCKOUT_MINUTES", 15)) * time.Minute,
+		UnlockTTL:       time.Duration(optionalInt(secret, "LOGIN_UNLOCK_TTL_MINUTES", 60)) * time.Minute,
+		Window:          time.Duration(optionalInt(secret, "LOGIN_ATTEMPT_WINDOW_MINUTES", 15)) * time.Minute,
+	}
+
+	mfa := services.MFASettings{
+		Issuer:       optionalString(secret, "MFA_ISSUER", "Pinterest"),
+		ChallengeTTL: time.Duration(optionalInt(secret, "MFA_CHALLENGE_TTL_MINUTES", 5)) * time.Minute,
+	}
+
+	passkeys := services.PasskeySettings{
+		RPId:         optionalString(secret, "WEBAUTHN_RP_ID", "localhost"),
+		RPName:       optionalString(secret, "WEBAUTHN_RP_NAME", "Pinterest"),
+		Origins:      optionalList(secret, "WEBAUTHN_ORIGINS", "http://localhost:3000"),
+		ChallengeTTL: time.Duration(optionalInt(secret, "WEBAUTHN_CHALLENGE_TTL_MINUTES", 5)) * time.Minute,
+	}
+
+	oidc := services.OIDCSettings{
+		Providers: optionalProviders(secret, "OIDC_PROVIDERS"),
+		StateTTL:  time.Duration(optionalInt(secret, "OIDC_STATE_TTL_MINUTES", 10)) * time.Minute,
+		SignupTTL: time.Duration(optionalInt(secret, "OIDC_SIGNUP_TTL_MINUTES", 30)) * time.Minute,
+	}
+
+	jwt := services.JWTSettings{
+		Secret:       optionalString(secret, "JWT_SECRET", ""),
+		Issuer:       optionalString(secret, "JWT_ISSUER", "pinterest-services"),
+		Audience:     optionalString(secret, "JWT_AUDIENCE", "pinterest-services"),
+		SigningKeyId: optionalString(secret, "JWT_SIGNING_KEY", ""),
+		Keys:         optionalJWTKeys(secret, "JWT_KEYS"),
+	}
+
+	analytics := services.AnalyticsSettings{
+		CacheTTL: time.Duration(optionalInt(secret, "ANALYTICS_CACHE_MINUTES", 15)) * time.Minute,
+	}
+
 	return &Config{
-		DBConfig:     dbConfig,
-		JWTSecret:    secret["JWT_SECRET"].(string),
-		EmailService: emailConfig,
+		DBConfig:              dbConfig,
+		JWT:                   jwt,
+		Auth:                  auth,
+		Login:                 login,
+		MFA:                   mfa,
+		Passkeys:              passkeys,
+		OIDC:                  oidc,
+		EmailService:          emailConfig,
+		Verification:          verification,
+		NotificationRetention: retention,
+		Streams:               streams,
+		Feed:                  feed,
+		Related:               related,
+		Trends:                trends,
+		Events:                events,
+		Analytics:             analytics,
+		Admins:                optionalIds(secret, "ADMIN_USER_IDS"),
+	}
+}
+
+// optionalInt reads a positive integer secret, falling back to def when the key
+// is missing. Vault may hand numbers back as strings or as JSON numbers.
+func optionalInt(secret map[string]any, key string, def int) int {
+	value, ok := secret[key]
+	if !ok || value == nil {
+		return def
+	}
+
+	n, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil || n <= 0 {
+		log.Printf("ignoring invalid %s=%v, using %d", key, value, def)
+		return def
+	}
+
+	return n
+}
+
+// optionalString reads a string secret, falling back to def when the key is
+// missing or empty.
+func optionalString(secret map[string]any, key, def string) string {
+	value, ok := secret[key]
+	if !ok || value == nil || fmt.Sprint(value) == "" {
+		return def
 	}
+
+	return fmt.Sprint(value)
+}
+
+// optionalList reads a comma separated list of strings, falling back to def
+// when the key is missing or lists nothing.
+func optionalList(secret map[string]any, key, def string) []string {
+	var list []string
+	for _, part := range strings.Split(optionalString(secret, key, def), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+
+	if len(list) == 0 {
+		return strings.Split(def, ",")
+	}
+	return list
+}
+
+// optionalJWTKeys reads the token keys, a map of key id to PEM encoded key,
+// skipping the ones that do not parse.
+func optionalJWTKeys(secret map[string]any, key string) []*services.JWTKey {
+	value, ok := secret[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	ids := make([]string, 0, len(value))
+	for id := range value {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var keys []*services.JWTKey
+	for _, id := range ids {
+		k, err := services.ParseJWTKey(id, fmt.Sprint(value[id]))
+		if err != nil {
+			log.Printf("ignoring invalid %s entry %q: %v", key, id, err)
+			continue
+		}
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// optionalProviders reads the identity providers, a map of provider name to
+// its issuer, client_id, client_secret, redirect_url and, optionally, scopes
+// and response_mode. Providers missing the issuer, client or redirect are
+// skipped.
+func optionalProviders(secret map[string]any, key string) []services.OIDCProviderSettings {
+	value, ok := secret[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var providers []services.OIDCProviderSettings
+	for _, name := range names {
+		entry, ok := value[name].(map[string]any)
+		if !ok {
+			log.Printf("ignoring invalid %s entry %q", key, name)
+			continue
+		}
+
+		provider := services.OIDCProviderSettings{
+			Name:         name,
+			Issuer:       optionalString(entry, "issuer", ""),
+			ClientId:     optionalString(entry, "client_id", ""),
+			ClientSecret: optionalString(entry, "client_secret", ""),
+			RedirectURL:  optionalString(entry, "redirect_url", ""),
+			ResponseMode: optionalString(entry, "response_mode", ""),
+		}
+		if _, ok := entry["scopes"]; ok {
+			provider.Scopes = strings.Fields(strings.ReplaceAll(optionalString(entry, "scopes", ""), ",", " "))
+		}
+
+		if provider.Issuer == "" || provider.ClientId == "" || provider.RedirectURL == "" {
+			log.Printf("ignoring invalid %s entry %q", key, name)
+			continue
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers
+}
+
+// optionalRanker reads the name of a feed ranker, falling back to the default
+// one when the key is missing or names no ranker.
+func optionalRanker(secret map[string]any, key string) string {
+	value, ok := secret[key]
+	if !ok || value == nil {
+		return interests.InterestRankerName
+	}
+
+	name := fmt.Sprint(value)
+	if _, err := interests.NewRanker(name); err != nil {
+		log.Printf("ignoring invalid %s=%v, using %s", key, value, interests.InterestRankerName)
+		return interests.InterestRankerName
+	}
+
+	return name
+}
+
+// optionalPolicy reads what unverified users may do, falling back to allowing
+// everything when the key is missing or names no policy.
+func optionalPolicy(secret map[string]any, key string) string {
+	value, ok := secret[key]
+	if !ok || value == nil {
+		return services.VerificationPolicyAllow
+	}
+
+	switch policy := fmt.Sprint(value); policy {
+	case services.VerificationPolicyAllow, services.VerificationPolicyBlockLogin, services.VerificationPolicyBlockWrites:
+		return policy
+	default:
+		log.Printf("ignoring invalid %s=%v, using %s", key, value, services.VerificationPolicyAllow)
+		return services.VerificationPolicyAllow
+	}
+}
+
+// optionalIds reads a comma separated list of user ids, skipping the ones that
+// do not parse. A missing key means nobody.
+func optionalIds(secret map[string]any, key string) []uuid.UUID {
+	value, ok := secret[key]
+	if !ok || value == nil {
+		return nil
+	}
+
+	var ids []uuid.UUID
+	for _, part := range strings.Split(fmt.Sprint(value), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(part)
+		if err != nil {
+			log.Printf("ignoring invalid %s entry %q", key, part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids
 }
@@ -0,0 +1,20 @@
+package analytics
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	pins "github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+type AnalyticsHandler struct {
+	repository analytics.AnalyticsRepository
+	pinRepo    pins.PinRepository
+	cache      analytics.ReportCache
+}
+
+func NewAnalyticsHandler(repository analytics.AnalyticsRepository, pinRepo pins.PinRepository, cache analytics.ReportCache) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		repository: repository,
+		pinRepo:    pinRepo,
+		cache:      cache,
+	}
+}
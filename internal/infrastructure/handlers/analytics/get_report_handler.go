@@ -0,0 +1,91 @@
+package analytics
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"time"
+)
+
+// HandleGetReport sums up the engagement on the owner's content, or on one of
+// their pins, over the queried days. Without dates it covers the last
+// DefaultDays; with only one, the range extends DefaultDays from it. Reports
+// are cached per owner, pin and range.
+func (h *AnalyticsHandler) HandleGetReport(ctx context.Context, query queries.GetReportQuery) (*dto.ReportDTO, error) {
+	rng, err := reportRange(query.From, query.To, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if query.PinId != nil {
+		pin, err := h.pinRepo.GetById(ctx, *query.PinId)
+		if err != nil {
+			return nil, err
+		} else if pin.UserId() != query.OwnerId {
+			return nil, analytics.ErrNotOwnerPin
+		}
+	}
+
+	key := analytics.ReportKey(query.OwnerId, query.PinId, rng)
+	if report, cached, err := h.cache.Get(ctx, key); err == nil && cached {
+		return mappers.MapToReportDTO(report), nil
+	}
+
+	report, err := h.report(ctx, query, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	// A failed write only means the next request builds the report again.
+	_ = h.cache.Set(ctx, key, report)
+
+	return mappers.MapToReportDTO(report), nil
+}
+
+func (h *AnalyticsHandler) report(ctx context.Context, query queries.GetReportQuery, rng analytics.Range) (*analytics.Report, error) {
+	days, err := h.repository.GetDaily(ctx, query.OwnerId, query.PinId, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		topPins   []analytics.PinStats
+		topBoards []analytics.BoardStats
+	)
+
+	if query.PinId == nil {
+		if topPins, err = h.repository.GetTopPins(ctx, query.OwnerId, rng, analytics.TopLimit); err != nil {
+			return nil, err
+		}
+		if topBoards, err = h.repository.GetTopBoards(ctx, query.OwnerId, rng, analytics.TopLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	countries, err := h.repository.GetAudience(ctx, query.OwnerId, query.PinId, analytics.CountryDimension, rng, analytics.TopLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	languages, err := h.repository.GetAudience(ctx, query.OwnerId, query.PinId, analytics.LanguageDimension, rng, analytics.TopLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.NewReport(rng, days, topPins, topBoards, countries, languages), nil
+}
+
+func reportRange(from, to *time.Time, now time.Time) (analytics.Range, error) {
+	switch {
+	case from == nil && to == nil:
+		return analytics.DefaultRange(now), nil
+	case from == nil:
+		return analytics.NewRange(to.AddDate(0, 0, 1-analytics.DefaultDays), *to)
+	case to == nil:
+		return analytics.NewRange(*from, from.AddDate(0, 0, analytics.DefaultDays-1))
+	default:
+		return analytics.NewRange(*from, *to)
+	}
+}
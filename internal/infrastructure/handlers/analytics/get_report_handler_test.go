@@ -0,0 +1,297 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	pins "github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockAnalyticsRepository struct {
+	mock.Mock
+}
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+type MockReportCache struct {
+	mock.Mock
+}
+
+func newTestRange(t *testing.T) (analytics.Range, *time.Time, *time.Time) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+	rng, err := analytics.NewRange(from, to)
+	require.NoError(t, err)
+	return rng, &from, &to
+}
+
+func TestAnalyticsHandler_HandleGetReport(t *testing.T) {
+	ctx := context.Background()
+	repository, cache := new(MockAnalyticsRepository), new(MockReportCache)
+	handler := NewAnalyticsHandler(repository, new(MockPinRepository), cache)
+
+	ownerId, pinId, boardId := uuid.New(), uuid.New(), uuid.New()
+	rng, from, to := newTestRange(t)
+	key := analytics.ReportKey(ownerId, nil, rng)
+	stats := analytics.NewStats(100, 5, 2, 2, 1)
+
+	cache.On("Get", ctx, key).Return(nil, false, nil)
+	repository.On("GetDaily", ctx, ownerId, (*uuid.UUID)(nil), rng).Return([]analytics.Day{analytics.NewDay(*to, stats)}, nil)
+	repository.On("GetTopPins", ctx, ownerId, rng, analytics.TopLimit).Return([]analytics.PinStats{analytics.NewPinStats(pinId, "Walnut cabinets", stats)}, nil)
+	repository.On("GetTopBoards", ctx, ownerId, rng, analytics.TopLimit).Return([]analytics.BoardStats{analytics.NewBoardStats(boardId, "Kitchen", stats)}, nil)
+	repository.On("GetAudience", ctx, ownerId, (*uuid.UUID)(nil), analytics.CountryDimension, rng, analytics.TopLimit).Return([]analytics.Segment{analytics.NewSegment("BO", 7)}, nil)
+	repository.On("GetAudience", ctx, ownerId, (*uuid.UUID)(nil), analytics.LanguageDimension, rng, analytics.TopLimit).Return([]analytics.Segment{analytics.NewSegment("es", 7)}, nil)
+	cache.On("Set", ctx, key, mock.Anything).Return(nil)
+
+	report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId, From: from, To: to})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2025-11-01", report.From)
+	assert.Equal(t, "2025-11-03", report.To)
+	require.Len(t, report.Daily, 3)
+	assert.Zero(t, report.Daily[0].Stats.Impressions)
+	assert.Equal(t, 100, report.Daily[2].Stats.Impressions)
+	assert.Equal(t, 100, report.Totals.Impressions)
+	assert.InDelta(t, 0.1, report.Totals.EngagementRate, 1e-9)
+	require.Len(t, report.TopPins, 1)
+	assert.Equal(t, pinId, report.TopPins[0].PinId)
+	require.Len(t, report.TopBoards, 1)
+	assert.Equal(t, "Kitchen", report.TopBoards[0].Name)
+	require.Len(t, report.Audience.Countries, 1)
+	assert.Equal(t, "BO", report.Audience.Countries[0].Code)
+	assert.Equal(t, 7, report.Audience.Languages[0].Users)
+	repository.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestAnalyticsHandler_HandleGetReport_Cached(t *testing.T) {
+	ctx := context.Background()
+	repository, cache := new(MockAnalyticsRepository), new(MockReportCache)
+	handler := NewAnalyticsHandler(repository, new(MockPinRepository), cache)
+
+	ownerId := uuid.New()
+	rng := analytics.DefaultRange(time.Now())
+	cached := analytics.NewReport(rng, nil, nil, nil, nil, nil)
+
+	cache.On("Get", ctx, analytics.ReportKey(ownerId, nil, rng)).Return(cached, true, nil)
+
+	report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId})
+
+	require.NoError(t, err)
+	assert.Len(t, report.Daily, analytics.DefaultDays)
+	repository.AssertNotCalled(t, "GetDaily", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnalyticsHandler_HandleGetReport_Pin(t *testing.T) {
+	ctx := context.Background()
+	repository, pinRepo, cache := new(MockAnalyticsRepository), new(MockPinRepository), new(MockReportCache)
+	handler := NewAnalyticsHandler(repository, pinRepo, cache)
+
+	ownerId := uuid.New()
+	pin := newTestPin(ownerId)
+	pinId := pin.Id()
+	rng, from, to := newTestRange(t)
+	key := analytics.ReportKey(ownerId, &pinId, rng)
+
+	pinRepo.On("GetById", ctx, pinId).Return(pin, nil)
+	cache.On("Get", ctx, key).Return(nil, false, errors.New("redis down"))
+	repository.On("GetDaily", ctx, ownerId, &pinId, rng).Return(nil, nil)
+	repository.On("GetAudience", ctx, ownerId, &pinId, mock.Anything, rng, analytics.TopLimit).Return(nil, nil)
+	cache.On("Set", ctx, key, mock.Anything).Return(errors.New("redis down"))
+
+	report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId, PinId: &pinId, From: from, To: to})
+
+	require.NoError(t, err)
+	assert.Nil(t, report.TopPins)
+	assert.Nil(t, report.TopBoards)
+	assert.Empty(t, report.Audience.Countries)
+	repository.AssertNotCalled(t, "GetTopPins", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+}
+
+func TestAnalyticsHandler_HandleGetReport_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	repository, pinRepo, cache := new(MockAnalyticsRepository), new(MockPinRepository), new(MockReportCache)
+	handler := NewAnalyticsHandler(repository, pinRepo, cache)
+
+	pin := newTestPin(uuid.New())
+	pinId := pin.Id()
+
+	pinRepo.On("GetById", ctx, pinId).Return(pin, nil)
+
+	report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: uuid.New(), PinId: &pinId})
+
+	assert.ErrorIs(t, err, analytics.ErrNotOwnerPin)
+	assert.Nil(t, report)
+	cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestAnalyticsHandler_HandleGetReport_Errors(t *testing.T) {
+	ctx := context.Background()
+	ownerId := uuid.New()
+	rng, from, to := newTestRange(t)
+
+	t.Run("Range", func(t *testing.T) {
+		handler := NewAnalyticsHandler(new(MockAnalyticsRepository), new(MockPinRepository), new(MockReportCache))
+
+		report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId, From: to, To: from})
+
+		assert.ErrorIs(t, err, analytics.ErrInvalidRange)
+		assert.Nil(t, report)
+	})
+
+	t.Run("PinNotFound", func(t *testing.T) {
+		pinRepo := new(MockPinRepository)
+		handler := NewAnalyticsHandler(new(MockAnalyticsRepository), pinRepo, new(MockReportCache))
+		pinId := uuid.New()
+
+		pinRepo.On("GetById", ctx, pinId).Return(nil, pins.ErrNotFoundPin)
+
+		report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId, PinId: &pinId})
+
+		assert.ErrorIs(t, err, pins.ErrNotFoundPin)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Repository", func(t *testing.T) {
+		repository, cache := new(MockAnalyticsRepository), new(MockReportCache)
+		handler := NewAnalyticsHandler(repository, new(MockPinRepository), cache)
+		dbErr := errors.New("database error")
+
+		cache.On("Get", ctx, mock.Anything).Return(nil, false, nil)
+		repository.On("GetDaily", ctx, ownerId, (*uuid.UUID)(nil), rng).Return(nil, dbErr)
+
+		report, err := handler.HandleGetReport(ctx, queries.GetReportQuery{OwnerId: ownerId, From: from, To: to})
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, report)
+		cache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportRange(t *testing.T) {
+	now := time.Date(2025, 11, 30, 12, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC)
+
+	rng, err := reportRange(nil, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, analytics.DefaultRange(now), rng)
+
+	rng, err = reportRange(&day, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, day, rng.From())
+	assert.Equal(t, analytics.DefaultDays, rng.Days())
+
+	rng, err = reportRange(nil, &day, now)
+	require.NoError(t, err)
+	assert.Equal(t, day, rng.To())
+	assert.Equal(t, analytics.DefaultDays, rng.Days())
+}
+
+func newTestPin(userId uuid.UUID) *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(uuid.New(), userId, uuid.New(), "Pin", nil, nil, 0, 0, 0, true, nil, now, now, nil)
+}
+
+func (m *MockAnalyticsRepository) GetDaily(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, rng analytics.Range) ([]analytics.Day, error) {
+	args := m.Called(ctx, ownerId, pinId, rng)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]analytics.Day), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) GetTopPins(ctx context.Context, ownerId uuid.UUID, rng analytics.Range, limit int) ([]analytics.PinStats, error) {
+	args := m.Called(ctx, ownerId, rng, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]analytics.PinStats), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) GetTopBoards(ctx context.Context, ownerId uuid.UUID, rng analytics.Range, limit int) ([]analytics.BoardStats, error) {
+	args := m.Called(ctx, ownerId, rng, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]analytics.BoardStats), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) GetAudience(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, dimension analytics.Dimension, rng analytics.Range, limit int) ([]analytics.Segment, error) {
+	args := m.Called(ctx, ownerId, pinId, dimension, rng, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]analytics.Segment), args.Error(1)
+}
+
+func (m *MockReportCache) Get(ctx context.Context, key string) (*analytics.Report, bool, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*analytics.Report), args.Bool(1), args.Error(2)
+}
+
+func (m *MockReportCache) Set(ctx context.Context, key string, report *analytics.Report) error {
+	args := m.Called(ctx, key, report)
+	return args.Error(0)
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
@@ -0,0 +1,23 @@
+package categories
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+const (
+	DefaultCategoryLimit = 25
+	MaxCategoryLimit     = 50
+)
+
+type CategoryHandler struct {
+	repository categories.CategoryRepository
+	pinRepo    pins.PinRepository
+}
+
+func NewCategoryHandler(repository categories.CategoryRepository, pinRepo pins.PinRepository) *CategoryHandler {
+	return &CategoryHandler{
+		repository: repository,
+		pinRepo:    pinRepo,
+	}
+}
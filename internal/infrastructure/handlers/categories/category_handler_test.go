@@ -0,0 +1,302 @@
+package categories
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+func newTestPin() *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(uuid.New(), uuid.New(), uuid.New(), "Pin", nil, nil, 0, 0, 0, true, nil, now, now, nil)
+}
+
+func TestCategoryHandler_HandleGetTree(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockPinRepository))
+
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := categories.NewCategory(&homeId, "kitchen", "Kitchen", nil)
+	style := categories.NewCategory(nil, "style", "Style", nil)
+	repository.On("GetAll", ctx).Return([]*categories.Category{home, kitchen, style}, nil)
+
+	tree, err := handler.HandleGetTree(ctx, queries.GetCategoryTreeQuery{})
+
+	require.NoError(t, err)
+	require.Len(t, tree, 2)
+	assert.Equal(t, "home", tree[0].Slug)
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, "kitchen", tree[0].Children[0].Slug)
+	assert.Empty(t, tree[0].Children[0].Children)
+	assert.Equal(t, "style", tree[1].Slug)
+	repository.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleGetBySlug(t *testing.T) {
+	ctx := context.Background()
+	repository, pinRepo := new(MockCategoryRepository), new(MockPinRepository)
+	handler := NewCategoryHandler(repository, pinRepo)
+
+	viewerId := uuid.New()
+	home := categories.NewCategory(nil, "home", "Home", nil)
+	homeId := home.Id()
+	kitchen := categories.NewCategory(&homeId, "kitchen", "Kitchen", nil)
+	kitchenId := kitchen.Id()
+	cabinets := categories.NewCategory(&kitchenId, "cabinets", "Cabinets", nil)
+	first, second, extra := newTestPin(), newTestPin(), newTestPin()
+	ranked := []categories.RankedPin{
+		categories.NewRankedPin(first.Id(), 3),
+		categories.NewRankedPin(second.Id(), 2),
+		categories.NewRankedPin(extra.Id(), 1),
+	}
+
+	repository.On("GetBySlug", ctx, "kitchen").Return(kitchen, nil)
+	repository.On("GetPath", ctx, kitchenId).Return([]*categories.Category{home, kitchen}, nil)
+	repository.On("GetChildren", ctx, kitchenId).Return([]*categories.Category{cabinets}, nil)
+	repository.On("GetRankedPins", ctx, kitchenId, viewerId, (*categories.Cursor)(nil), 3).Return(ranked, nil)
+	pinRepo.On("GetListByIds", ctx, []uuid.UUID{first.Id(), second.Id()}).Return([]*pins.Pin{second, first}, nil)
+
+	page, err := handler.HandleGetBySlug(ctx, queries.GetCategoryBySlugQuery{ViewerId: viewerId, Slug: "Kitchen", Limit: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, kitchenId, page.Category.Id)
+	require.Len(t, page.Path, 2)
+	assert.Equal(t, "home", page.Path[0].Slug)
+	require.Len(t, page.Children, 1)
+	assert.Equal(t, "cabinets", page.Children[0].Slug)
+	require.Len(t, page.Pins, 2)
+	assert.Equal(t, first.Id(), page.Pins[0].Id)
+	assert.Equal(t, second.Id(), page.Pins[1].Id)
+	require.NotNil(t, page.NextCursor)
+
+	cursor, err := categories.ParseCursor(*page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, second.Id(), cursor.Id())
+	assert.Equal(t, 2.0, cursor.Score())
+	repository.AssertExpectations(t)
+	pinRepo.AssertExpectations(t)
+}
+
+func TestCategoryHandler_HandleGetBySlug_Empty(t *testing.T) {
+	ctx := context.Background()
+	repository, pinRepo := new(MockCategoryRepository), new(MockPinRepository)
+	handler := NewCategoryHandler(repository, pinRepo)
+
+	viewerId := uuid.New()
+	category := categories.NewCategory(nil, "home", "Home", nil)
+	after := categories.NewCursor(categories.NewRankedPin(uuid.New(), 5))
+
+	repository.On("GetBySlug", ctx, "home").Return(category, nil)
+	repository.On("GetPath", ctx, category.Id()).Return([]*categories.Category{category}, nil)
+	repository.On("GetChildren", ctx, category.Id()).Return(nil, nil)
+	repository.On("GetRankedPins", ctx, category.Id(), viewerId, mock.MatchedBy(func(c *categories.Cursor) bool {
+		return c.Id() == after.Id() && c.Score() == 5
+	}), DefaultCategoryLimit+1).Return(nil, nil)
+
+	page, err := handler.HandleGetBySlug(ctx, queries.GetCategoryBySlugQuery{ViewerId: viewerId, Slug: "home", Cursor: after.String()})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Pins)
+	assert.Empty(t, page.Children)
+	assert.Nil(t, page.NextCursor)
+	pinRepo.AssertNotCalled(t, "GetListByIds", mock.Anything, mock.Anything)
+}
+
+func TestCategoryHandler_HandleGetBySlug_Errors(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockPinRepository))
+
+	repository.On("GetBySlug", ctx, "nowhere").Return(nil, categories.ErrNotFoundCategory)
+
+	page, err := handler.HandleGetBySlug(ctx, queries.GetCategoryBySlugQuery{Slug: "nowhere"})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, categories.ErrNotFoundCategory)
+
+	page, err = handler.HandleGetBySlug(ctx, queries.GetCategoryBySlugQuery{Slug: "home", Cursor: "%%%"})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, categories.ErrInvalidCursor)
+	repository.AssertNumberOfCalls(t, "GetBySlug", 1)
+}
+
+func TestCategoryHandler_HandleGetTags(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockCategoryRepository)
+	handler := NewCategoryHandler(repository, new(MockPinRepository))
+
+	category := categories.NewCategory(nil, "kitchen", "Kitchen", nil)
+	cabinets, pantry := pins.NewTag("cabinets"), pins.NewTag("pantry")
+	repository.On("GetById", ctx, category.Id()).Return(category, nil)
+	repository.On("GetTags", ctx, category.Id()).Return([]pins.Tag{*cabinets, *pantry}, nil)
+
+	tags, err := handler.HandleGetTags(ctx, queries.GetCategoryTagsQuery{Id: category.Id()})
+
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, cabinets.Id(), tags[0].Id)
+	assert.Equal(t, "pantry", tags[1].Name)
+	repository.AssertExpectations(t)
+}
+
+func (m *MockCategoryRepository) GetAll(ctx context.Context) ([]*categories.Category, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetById(ctx context.Context, id uuid.UUID) (*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetBySlug(ctx context.Context, slug string) (*categories.Category, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetPath(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetChildren(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*categories.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetHeight(ctx context.Context, id uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (m *MockCategoryRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	return false, nil
+}
+
+func (m *MockCategoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockCategoryRepository) GetTags(ctx context.Context, id uuid.UUID) ([]pins.Tag, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pins.Tag), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ExistsTag(ctx context.Context, id, tagId uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockCategoryRepository) AddTag(ctx context.Context, id, tagId uuid.UUID) error {
+	return nil
+}
+
+func (m *MockCategoryRepository) RemoveTag(ctx context.Context, id, tagId uuid.UUID) error {
+	return nil
+}
+
+func (m *MockCategoryRepository) GetRankedPins(ctx context.Context, id, viewerId uuid.UUID, after *categories.Cursor, limit int) ([]categories.RankedPin, error) {
+	args := m.Called(ctx, id, viewerId, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]categories.RankedPin), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, c *categories.Category) error {
+	return nil
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, c *categories.Category) error {
+	return nil
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, c *categories.Category) error {
+	return nil
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package categories
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	pinDto "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	pinMappers "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// HandleGetBySlug browses a category: its path from the root, its
+// subcategories and a page of the pins tagged into it or any subcategory,
+// best first. One extra pin is read to tell whether another page follows.
+func (h *CategoryHandler) HandleGetBySlug(ctx context.Context, query queries.GetCategoryBySlugQuery) (*dto.CategoryPageDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultCategoryLimit
+	} else if limit > MaxCategoryLimit {
+		limit = MaxCategoryLimit
+	}
+
+	var after *categories.Cursor
+	if query.Cursor != "" {
+		cursor, err := categories.ParseCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+
+	category, err := h.repository.GetBySlug(ctx, strings.ToLower(query.Slug))
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := h.repository.GetPath(ctx, category.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := h.repository.GetChildren(ctx, category.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	ranked, err := h.repository.GetRankedPins(ctx, category.Id(), query.ViewerId, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dto.CategoryPageDTO{
+		Category: mappers.MapToCategoryDTO(category),
+		Path:     mappers.MapToCategoryDTOs(path),
+		Children: mappers.MapToCategoryDTOs(children),
+		Pins:     make([]*pinDto.PinDTO, 0, min(len(ranked), limit)),
+	}
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+		next := categories.NewCursor(ranked[limit-1]).String()
+		page.NextCursor = &next
+	}
+
+	if len(ranked) == 0 {
+		return page, nil
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, r := range ranked {
+		ids[i] = r.PinId()
+	}
+
+	pinsList, err := h.pinRepo.GetListByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uuid.UUID]*pins.Pin, len(pinsList))
+	for _, p := range pinsList {
+		byId[p.Id()] = p
+	}
+
+	for _, id := range ids {
+		if p, ok := byId[id]; ok {
+			page.Pins = append(page.Pins, pinMappers.MapToPinDTO(p))
+		}
+	}
+
+	return page, nil
+}
@@ -0,0 +1,28 @@
+package categories
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+)
+
+// HandleGetTags lists the tags mapped directly into a category.
+func (h *CategoryHandler) HandleGetTags(ctx context.Context, query queries.GetCategoryTagsQuery) ([]*dto.TagDTO, error) {
+	category, err := h.repository.GetById(ctx, query.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := h.repository.GetTags(ctx, category.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	tagsDto := make([]*dto.TagDTO, 0, len(tags))
+	for _, tag := range tags {
+		tagsDto = append(tagsDto, mappers.MapToTagDTO(&tag))
+	}
+
+	return tagsDto, nil
+}
@@ -0,0 +1,18 @@
+package categories
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+)
+
+func (h *CategoryHandler) HandleGetTree(ctx context.Context, _ queries.GetCategoryTreeQuery) ([]*dto.CategoryNodeDTO, error) {
+	list, err := h.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapToCategoryNodeDTOs(categories.BuildTree(list)), nil
+}
@@ -0,0 +1,15 @@
+package conversations
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+
+type ConversationHandler struct {
+	repository  conversations.ConversationRepository
+	messageRepo conversations.MessageRepository
+}
+
+func NewConversationHandler(repository conversations.ConversationRepository, messageRepo conversations.MessageRepository) *ConversationHandler {
+	return &ConversationHandler{
+		repository:  repository,
+		messageRepo: messageRepo,
+	}
+}
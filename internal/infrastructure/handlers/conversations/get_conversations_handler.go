@@ -0,0 +1,27 @@
+package conversations
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/queries"
+)
+
+func (h *ConversationHandler) HandleGetList(ctx context.Context, query queries.GetConversationsQuery) ([]*dto.ConversationDTO, error) {
+	conversationsList, err := h.repository.GetListByUserId(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := h.messageRepo.CountUnreadByUserId(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationsDto := make([]*dto.ConversationDTO, 0, len(conversationsList))
+	for _, conversation := range conversationsList {
+		conversationsDto = append(conversationsDto, mappers.MapToConversationDTO(conversation, unread[conversation.Id()]))
+	}
+
+	return conversationsDto, nil
+}
@@ -0,0 +1,51 @@
+package conversations
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+)
+
+const (
+	DefaultMessagesLimit = 50
+	MaxMessagesLimit     = 100
+)
+
+func (h *ConversationHandler) HandleGetMessages(ctx context.Context, query queries.GetMessagesQuery) (*dto.MessagesPageDTO, error) {
+	conversation, err := h.repository.GetById(ctx, query.ConversationId)
+	if err != nil {
+		return nil, err
+	} else if !conversation.IsMember(query.UserId) {
+		return nil, conversations.ErrNotFoundConversation
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultMessagesLimit
+	} else if limit > MaxMessagesLimit {
+		limit = MaxMessagesLimit
+	}
+
+	messagesList, err := h.messageRepo.GetListByConversationId(ctx, query.ConversationId, query.Before, query.BeforeId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dto.MessagesPageDTO{
+		Messages: make([]*dto.MessageDTO, 0, len(messagesList)),
+	}
+
+	for _, message := range messagesList {
+		page.Messages = append(page.Messages, mappers.MapToMessageDTO(message))
+	}
+
+	if len(messagesList) == limit {
+		last := messagesList[len(messagesList)-1]
+		createdAt, id := last.CreatedAt(), last.Id()
+		page.NextBefore, page.NextBeforeId = &createdAt, &id
+	}
+
+	return page, nil
+}
@@ -0,0 +1,140 @@
+package conversations
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockMessageRepository struct {
+	mock.Mock
+}
+
+func TestConversationHandler_HandleGetList(t *testing.T) {
+	ctx := context.Background()
+
+	repository, messageRepo := new(MockRepository), new(MockMessageRepository)
+	handler := NewConversationHandler(repository, messageRepo)
+
+	userId := uuid.New()
+	list := []*conversations.Conversation{
+		conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil),
+		conversations.NewConversation(uuid.New(), []uuid.UUID{userId, uuid.New()}, nil),
+	}
+
+	repository.On("GetListByUserId", ctx, userId).Return(list, nil)
+	messageRepo.On("CountUnreadByUserId", ctx, userId).Return(map[uuid.UUID]int{list[1].Id(): 4}, nil)
+
+	conversationsList, err := handler.HandleGetList(ctx, queries.GetConversationsQuery{UserId: userId})
+
+	require.NoError(t, err)
+	require.Len(t, conversationsList, 2)
+	assert.Equal(t, 0, conversationsList[0].UnreadCount)
+	assert.Equal(t, 4, conversationsList[1].UnreadCount)
+	assert.True(t, conversationsList[1].IsGroup)
+	repository.AssertExpectations(t)
+	messageRepo.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleGetMessages(t *testing.T) {
+	ctx := context.Background()
+
+	repository, messageRepo := new(MockRepository), new(MockMessageRepository)
+	handler := NewConversationHandler(repository, messageRepo)
+
+	userId := uuid.New()
+	conversation := conversations.NewConversation(userId, []uuid.UUID{uuid.New()}, nil)
+	body := "hello"
+	list := []*conversations.Message{
+		conversations.NewMessage(conversation.Id(), userId, &body, nil, nil),
+		conversations.NewMessage(conversation.Id(), userId, &body, nil, nil),
+	}
+
+	repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+	messageRepo.On("GetListByConversationId", ctx, conversation.Id(), (*time.Time)(nil), uuid.Nil, 2).Return(list, nil)
+
+	page, err := handler.HandleGetMessages(ctx, queries.GetMessagesQuery{ConversationId: conversation.Id(), UserId: userId, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, page.Messages, 2)
+	require.NotNil(t, page.NextBefore)
+	assert.Equal(t, list[1].CreatedAt(), *page.NextBefore)
+	require.NotNil(t, page.NextBeforeId)
+	assert.Equal(t, list[1].Id(), *page.NextBeforeId)
+	repository.AssertExpectations(t)
+	messageRepo.AssertExpectations(t)
+}
+
+func TestConversationHandler_HandleGetMessages_NotMember(t *testing.T) {
+	ctx := context.Background()
+
+	repository, messageRepo := new(MockRepository), new(MockMessageRepository)
+	handler := NewConversationHandler(repository, messageRepo)
+
+	conversation := conversations.NewConversation(uuid.New(), []uuid.UUID{uuid.New()}, nil)
+	repository.On("GetById", ctx, conversation.Id()).Return(conversation, nil)
+
+	page, err := handler.HandleGetMessages(ctx, queries.GetMessagesQuery{ConversationId: conversation.Id(), UserId: uuid.New()})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, conversations.ErrNotFoundConversation)
+	messageRepo.AssertNotCalled(t, "GetListByConversationId", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *MockRepository) GetListByUserId(ctx context.Context, userId uuid.UUID) ([]*conversations.Conversation, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*conversations.Conversation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*conversations.Conversation), args.Error(1)
+}
+
+func (m *MockRepository) GetDirect(ctx context.Context, userId, otherId uuid.UUID) (*conversations.Conversation, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) Create(ctx context.Context, c *conversations.Conversation) (*conversations.Conversation, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) MarkRead(ctx context.Context, conversationId, userId uuid.UUID, readAt time.Time) error {
+	return nil
+}
+
+func (m *MockMessageRepository) GetListByConversationId(ctx context.Context, conversationId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*conversations.Message, error) {
+	args := m.Called(ctx, conversationId, before, beforeId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*conversations.Message), args.Error(1)
+}
+
+func (m *MockMessageRepository) CountUnreadByUserId(ctx context.Context, userId uuid.UUID) (map[uuid.UUID]int, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]int), args.Error(1)
+}
+
+func (m *MockMessageRepository) Create(ctx context.Context, msg *conversations.Message) (*conversations.Message, error) {
+	return nil, nil
+}
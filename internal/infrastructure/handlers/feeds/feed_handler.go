@@ -0,0 +1,29 @@
+package feeds
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+)
+
+type FeedHandler struct {
+	repository   feeds.FeedRepository
+	store        feeds.FeedStore
+	pinRepo      pins.PinRepository
+	interestRepo interests.InterestRepository
+	ranker       interests.Ranker
+	popularAt    int
+	maxSize      int
+}
+
+func NewFeedHandler(repository feeds.FeedRepository, store feeds.FeedStore, pinRepo pins.PinRepository, interestRepo interests.InterestRepository, ranker interests.Ranker, popularAt, maxSize int) *FeedHandler {
+	return &FeedHandler{
+		repository:   repository,
+		store:        store,
+		pinRepo:      pinRepo,
+		interestRepo: interestRepo,
+		ranker:       ranker,
+		popularAt:    popularAt,
+		maxSize:      maxSize,
+	}
+}
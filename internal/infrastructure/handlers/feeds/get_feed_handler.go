@@ -0,0 +1,212 @@
+package feeds
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultFeedLimit = 25
+	MaxFeedLimit     = 100
+)
+
+// HandleGetFeed reads a page of the home feed. The stored feed is merged with
+// the pins of followed popular authors, and with the database once the stored
+// feed runs out or cannot be read. Every pin is checked against the current
+// follows, blocks and mutes of the user before it is shown. Pages are cut in
+// chronological order, so cursors stay stable, and then re-ranked for the user.
+func (h *FeedHandler) HandleGetFeed(ctx context.Context, query queries.GetFeedQuery) (*dto.FeedPageDTO, error) {
+	var cursor *feeds.Cursor
+	if query.Cursor != "" {
+		parsed, err := feeds.ParseCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = parsed
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultFeedLimit
+	} else if limit > MaxFeedLimit {
+		limit = MaxFeedLimit
+	}
+
+	// Each source is asked for one entry more than the page, so a full answer
+	// tells there may be more past it.
+	want := limit + 1
+
+	stored, err := h.storedPage(ctx, query.UserId, cursor, want)
+	sources := [][]feeds.Entry{stored}
+	if err != nil || len(stored) < want {
+		fromDB, err := h.repository.GetList(ctx, query.UserId, cursor, want)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, fromDB)
+	}
+
+	popular, err := h.repository.GetListFromPopular(ctx, query.UserId, h.popularAt, cursor, want)
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, popular)
+
+	merged, truncated := mergeEntries(sources, want)
+
+	pinIds := make([]uuid.UUID, len(merged))
+	for i, entry := range merged {
+		pinIds[i] = entry.PinId()
+	}
+
+	visibleIds, err := h.repository.GetListVisible(ctx, query.UserId, pinIds)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[uuid.UUID]bool, len(visibleIds))
+	for _, id := range visibleIds {
+		visible[id] = true
+	}
+
+	var (
+		pageIds []uuid.UUID
+		last    *feeds.Entry
+	)
+	for i, entry := range merged {
+		if len(pageIds) == limit {
+			truncated = true
+			break
+		}
+
+		last = &merged[i]
+		if visible[entry.PinId()] {
+			pageIds = append(pageIds, entry.PinId())
+		}
+	}
+
+	feed := &dto.FeedPageDTO{
+		Pins: make([]*dto.FeedPinDTO, 0, len(pageIds)),
+	}
+
+	if len(pageIds) > 0 {
+		ranked, err := h.rank(ctx, query.UserId, pageIds)
+		if err != nil {
+			return nil, err
+		}
+
+		pinsList, err := h.pinRepo.GetListByIds(ctx, pageIds)
+		if err != nil {
+			return nil, err
+		}
+
+		byId := make(map[uuid.UUID]*pins.Pin, len(pinsList))
+		for _, pin := range pinsList {
+			byId[pin.Id()] = pin
+		}
+
+		for _, r := range ranked {
+			pin, ok := byId[r.Candidate().PinId()]
+			if !ok {
+				continue
+			}
+
+			feedPin := &dto.FeedPinDTO{PinDTO: mappers.MapToPinDTO(pin)}
+			if query.Explain {
+				score, explanation := r.Score(), r.Explanation()
+				feedPin.Score, feedPin.Explanation = &score, &explanation
+			}
+			feed.Pins = append(feed.Pins, feedPin)
+		}
+	}
+
+	if truncated && last != nil {
+		next := feeds.NewCursor(*last).String()
+		feed.NextCursor = &next
+	}
+
+	return feed, nil
+}
+
+// rank orders the pins of a page with the ranker, for the interest profile of
+// the user.
+func (h *FeedHandler) rank(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]interests.Ranked, error) {
+	profile, err := h.interestRepo.GetProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := h.interestRepo.GetCandidates(ctx, pinIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.ranker.Rank(profile, candidates, time.Now()), nil
+}
+
+// storedPage reads from the stored feed, rebuilding it from the database first
+// when the user has none.
+func (h *FeedHandler) storedPage(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	exist, err := h.store.Exists(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		entries, err := h.repository.GetList(ctx, userId, nil, h.maxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = h.store.Replace(ctx, userId, entries); err != nil {
+			return nil, err
+		}
+	}
+
+	return h.store.Page(ctx, userId, cursor, limit)
+}
+
+// mergeEntries combines the sources into one feed, newest first and without
+// duplicates. A source that returned want entries may have more past its last
+// one, so nothing older than the newest of those last entries is kept: the
+// next page reads it again, in full. truncated tells whether that happened.
+func mergeEntries(sources [][]feeds.Entry, want int) ([]feeds.Entry, bool) {
+	var boundary *feeds.Entry
+	for _, source := range sources {
+		if len(source) < want {
+			continue
+		}
+
+		last := source[len(source)-1]
+		if boundary == nil || last.Before(*boundary) {
+			boundary = &last
+		}
+	}
+
+	var merged []feeds.Entry
+	seen := make(map[uuid.UUID]bool)
+	for _, source := range sources {
+		for _, entry := range source {
+			if seen[entry.PinId()] || (boundary != nil && boundary.Before(entry)) {
+				continue
+			}
+
+			seen[entry.PinId()] = true
+			merged = append(merged, entry)
+		}
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Before(merged[j])
+	})
+
+	return merged, boundary != nil
+}
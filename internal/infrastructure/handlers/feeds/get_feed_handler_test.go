@@ -0,0 +1,370 @@
+package feeds
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockStore struct {
+	mock.Mock
+}
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+type MockInterestRepository struct {
+	mock.Mock
+}
+
+func newTestEntries(now time.Time, n int) []feeds.Entry {
+	entries := make([]feeds.Entry, n)
+	for i := range entries {
+		entries[i] = feeds.NewEntry(uuid.New(), now.Add(-time.Duration(i)*time.Minute))
+	}
+	return entries
+}
+
+func newTestPins(entries ...feeds.Entry) []*pins.Pin {
+	pinsList := make([]*pins.Pin, len(entries))
+	for i, entry := range entries {
+		pinsList[i] = pins.NewPinFromDB(entry.PinId(), uuid.New(), uuid.New(), "Pin", nil, nil, 0, 0, 0, true, nil, entry.CreatedAt(), entry.CreatedAt(), nil)
+	}
+	return pinsList
+}
+
+func newTestCandidates(entries ...feeds.Entry) []interests.Candidate {
+	candidates := make([]interests.Candidate, len(entries))
+	for i, entry := range entries {
+		candidates[i] = interests.NewCandidate(entry.PinId(), nil, shared.English, shared.UnitedStates, entry.CreatedAt())
+	}
+	return candidates
+}
+
+func TestFeedHandler_HandleGetFeed(t *testing.T) {
+	ctx := context.Background()
+	repository, store, pinRepo, interestRepo := new(MockRepository), new(MockStore), new(MockPinRepository), new(MockInterestRepository)
+	handler := NewFeedHandler(repository, store, pinRepo, interestRepo, interests.ChronologicalRanker{}, 1000, 500)
+
+	userId := uuid.New()
+	now := time.Now()
+	entries := newTestEntries(now, 6)
+	stored := []feeds.Entry{entries[0], entries[2], entries[3]}
+	popular := []feeds.Entry{entries[1], entries[2]}
+	hidden := entries[3]
+
+	store.On("Exists", ctx, userId).Return(true, nil)
+	store.On("Page", ctx, userId, (*feeds.Cursor)(nil), 3).Return(stored, nil)
+	repository.On("GetListFromPopular", ctx, userId, 1000, (*feeds.Cursor)(nil), 3).Return(popular, nil)
+	repository.On("GetListVisible", ctx, userId, []uuid.UUID{entries[0].PinId(), entries[1].PinId(), entries[2].PinId(), hidden.PinId()}).
+		Return([]uuid.UUID{entries[0].PinId(), entries[1].PinId(), entries[2].PinId()}, nil)
+	interestRepo.On("GetProfile", ctx, userId).Return(interests.NewProfile(userId, shared.English, shared.UnitedStates, nil), nil)
+	interestRepo.On("GetCandidates", ctx, []uuid.UUID{entries[0].PinId(), entries[1].PinId()}).Return(newTestCandidates(entries[1], entries[0]), nil)
+	pinRepo.On("GetListByIds", ctx, []uuid.UUID{entries[0].PinId(), entries[1].PinId()}).Return(newTestPins(entries[1], entries[0]), nil)
+
+	feed, err := handler.HandleGetFeed(ctx, queries.GetFeedQuery{UserId: userId, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, feed.Pins, 2)
+	assert.Equal(t, entries[0].PinId(), feed.Pins[0].Id)
+	assert.Equal(t, entries[1].PinId(), feed.Pins[1].Id)
+	assert.Nil(t, feed.Pins[0].Explanation)
+	require.NotNil(t, feed.NextCursor)
+
+	cursor, err := feeds.ParseCursor(*feed.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, entries[1].PinId(), cursor.Last().PinId())
+	repository.AssertExpectations(t)
+	store.AssertExpectations(t)
+	pinRepo.AssertExpectations(t)
+}
+
+func TestFeedHandler_HandleGetFeed_RebuildsMissingFeed(t *testing.T) {
+	ctx := context.Background()
+	repository, store, pinRepo, interestRepo := new(MockRepository), new(MockStore), new(MockPinRepository), new(MockInterestRepository)
+	handler := NewFeedHandler(repository, store, pinRepo, interestRepo, interests.ChronologicalRanker{}, 1000, 500)
+
+	userId := uuid.New()
+	entries := newTestEntries(time.Now(), 2)
+	ids := []uuid.UUID{entries[0].PinId(), entries[1].PinId()}
+
+	store.On("Exists", ctx, userId).Return(false, nil)
+	repository.On("GetList", ctx, userId, (*feeds.Cursor)(nil), 500).Return(entries, nil)
+	store.On("Replace", ctx, userId, entries).Return(nil)
+	store.On("Page", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetList", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetListFromPopular", ctx, userId, 1000, (*feeds.Cursor)(nil), 26).Return(nil, nil)
+	repository.On("GetListVisible", ctx, userId, ids).Return(ids, nil)
+	interestRepo.On("GetProfile", ctx, userId).Return(interests.NewProfile(userId, shared.English, shared.UnitedStates, nil), nil)
+	interestRepo.On("GetCandidates", ctx, ids).Return(newTestCandidates(entries...), nil)
+	pinRepo.On("GetListByIds", ctx, ids).Return(newTestPins(entries...), nil)
+
+	feed, err := handler.HandleGetFeed(ctx, queries.GetFeedQuery{UserId: userId})
+
+	require.NoError(t, err)
+	assert.Len(t, feed.Pins, 2)
+	assert.Nil(t, feed.NextCursor)
+	repository.AssertExpectations(t)
+	store.AssertExpectations(t)
+	pinRepo.AssertExpectations(t)
+}
+
+func TestFeedHandler_HandleGetFeed_InvalidCursor(t *testing.T) {
+	handler := NewFeedHandler(new(MockRepository), new(MockStore), new(MockPinRepository), new(MockInterestRepository), interests.ChronologicalRanker{}, 1000, 500)
+
+	feed, err := handler.HandleGetFeed(context.Background(), queries.GetFeedQuery{UserId: uuid.New(), Cursor: "%%%"})
+
+	assert.Nil(t, feed)
+	assert.ErrorIs(t, err, feeds.ErrInvalidCursor)
+}
+
+func TestFeedHandler_HandleGetFeed_Ranked(t *testing.T) {
+	ctx := context.Background()
+	repository, store, pinRepo, interestRepo := new(MockRepository), new(MockStore), new(MockPinRepository), new(MockInterestRepository)
+	handler := NewFeedHandler(repository, store, pinRepo, interestRepo, interests.NewInterestRanker(interests.RecencyHalfLife), 1000, 500)
+
+	userId, kitchenId := uuid.New(), uuid.New()
+	entries := newTestEntries(time.Now(), 2)
+	ids := []uuid.UUID{entries[0].PinId(), entries[1].PinId()}
+	title := "Walnut cabinets"
+	profile := interests.NewProfile(userId, shared.English, shared.UnitedStates, []interests.Interest{
+		interests.NewInterest(kitchenId, "kitchen", interests.SaveSignal, 3, &title),
+	})
+	candidates := []interests.Candidate{
+		interests.NewCandidate(entries[0].PinId(), nil, shared.English, shared.UnitedStates, entries[0].CreatedAt()),
+		interests.NewCandidate(entries[1].PinId(), []uuid.UUID{kitchenId}, shared.Spanish, shared.Spain, entries[1].CreatedAt()),
+	}
+
+	store.On("Exists", ctx, userId).Return(true, nil)
+	store.On("Page", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetList", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetListFromPopular", ctx, userId, 1000, (*feeds.Cursor)(nil), 26).Return(nil, nil)
+	repository.On("GetListVisible", ctx, userId, ids).Return(ids, nil)
+	interestRepo.On("GetProfile", ctx, userId).Return(profile, nil)
+	interestRepo.On("GetCandidates", ctx, ids).Return(candidates, nil)
+	pinRepo.On("GetListByIds", ctx, ids).Return(newTestPins(entries...), nil)
+
+	feed, err := handler.HandleGetFeed(ctx, queries.GetFeedQuery{UserId: userId, Explain: true})
+
+	require.NoError(t, err)
+	require.Len(t, feed.Pins, 2)
+	assert.Equal(t, entries[1].PinId(), feed.Pins[0].Id)
+	require.NotNil(t, feed.Pins[0].Explanation)
+	assert.Equal(t, `because you saved "Walnut cabinets"`, *feed.Pins[0].Explanation)
+	assert.Equal(t, entries[0].PinId(), feed.Pins[1].Id)
+	assert.Equal(t, "because it is in your language", *feed.Pins[1].Explanation)
+	assert.Greater(t, *feed.Pins[0].Score, *feed.Pins[1].Score)
+	assert.Nil(t, feed.NextCursor)
+	interestRepo.AssertExpectations(t)
+}
+
+func TestFeedHandler_HandleGetFeed_ProfileError(t *testing.T) {
+	ctx := context.Background()
+	repository, store, pinRepo, interestRepo := new(MockRepository), new(MockStore), new(MockPinRepository), new(MockInterestRepository)
+	handler := NewFeedHandler(repository, store, pinRepo, interestRepo, interests.ChronologicalRanker{}, 1000, 500)
+
+	userId := uuid.New()
+	entries := newTestEntries(time.Now(), 1)
+	ids := []uuid.UUID{entries[0].PinId()}
+
+	store.On("Exists", ctx, userId).Return(true, nil)
+	store.On("Page", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetList", ctx, userId, (*feeds.Cursor)(nil), 26).Return(entries, nil)
+	repository.On("GetListFromPopular", ctx, userId, 1000, (*feeds.Cursor)(nil), 26).Return(nil, nil)
+	repository.On("GetListVisible", ctx, userId, ids).Return(ids, nil)
+	interestRepo.On("GetProfile", ctx, userId).Return(nil, users.ErrNotFoundUser)
+
+	feed, err := handler.HandleGetFeed(ctx, queries.GetFeedQuery{UserId: userId})
+
+	assert.Nil(t, feed)
+	assert.ErrorIs(t, err, users.ErrNotFoundUser)
+	pinRepo.AssertNotCalled(t, "GetListByIds", mock.Anything, mock.Anything)
+}
+
+func TestMergeEntries_StopsAtFullSource(t *testing.T) {
+	entries := newTestEntries(time.Now(), 5)
+
+	full := []feeds.Entry{entries[0], entries[2]}
+	partial := []feeds.Entry{entries[1], entries[3], entries[4]}
+
+	merged, truncated := mergeEntries([][]feeds.Entry{full, partial}, 2)
+
+	assert.True(t, truncated)
+	assert.Equal(t, []feeds.Entry{entries[0], entries[1], entries[2]}, merged)
+}
+
+func (m *MockRepository) GetRecipients(ctx context.Context, pinId uuid.UUID, popularAt int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, pinId, popularAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetList(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListFromPopular(ctx context.Context, userId uuid.UUID, popularAt int, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, popularAt, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListBySource(ctx context.Context, source feeds.Source, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, source, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockRepository) GetListOrphaned(ctx context.Context, userId uuid.UUID, source feeds.Source, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userId, source, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetListVisible(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) IsPopular(ctx context.Context, userId uuid.UUID, popularAt int) (bool, error) {
+	args := m.Called(ctx, userId, popularAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockStore) Exists(ctx context.Context, userId uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockStore) Page(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	args := m.Called(ctx, userId, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]feeds.Entry), args.Error(1)
+}
+
+func (m *MockStore) Add(ctx context.Context, userIds []uuid.UUID, entries []feeds.Entry) error {
+	args := m.Called(ctx, userIds, entries)
+	return args.Error(0)
+}
+
+func (m *MockStore) Replace(ctx context.Context, userId uuid.UUID, entries []feeds.Entry) error {
+	args := m.Called(ctx, userId, entries)
+	return args.Error(0)
+}
+
+func (m *MockStore) Remove(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) error {
+	args := m.Called(ctx, userId, pinIds)
+	return args.Error(0)
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockInterestRepository) GetProfile(ctx context.Context, userId uuid.UUID) (*interests.Profile, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*interests.Profile), args.Error(1)
+}
+
+func (m *MockInterestRepository) GetCandidates(ctx context.Context, pinIds []uuid.UUID) ([]interests.Candidate, error) {
+	args := m.Called(ctx, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]interests.Candidate), args.Error(1)
+}
+
+func (m *MockInterestRepository) AddPin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	return nil
+}
+
+func (m *MockInterestRepository) RemovePin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	return nil
+}
+
+func (m *MockInterestRepository) AddTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	return nil
+}
+
+func (m *MockInterestRepository) RemoveTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	return nil
+}
@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/queries"
+)
+
+const (
+	DefaultInboxLimit = 20
+	MaxInboxLimit     = 100
+)
+
+func (h *NotificationHandler) HandleGetInbox(ctx context.Context, query queries.GetInboxQuery) (*dto.InboxDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultInboxLimit
+	} else if limit > MaxInboxLimit {
+		limit = MaxInboxLimit
+	}
+
+	notificationList, err := h.repository.GetListByRecipient(ctx, query.UserId, query.Before, query.BeforeId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := h.repository.CountUnread(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	inbox := &dto.InboxDTO{
+		Notifications: make([]*dto.NotificationResponse, 0, len(notificationList)),
+		UnreadCount:   unread,
+	}
+
+	for _, notification := range notificationList {
+		notificationDto := mappers.MapToNotificationDTO(notification)
+		inbox.Notifications = append(inbox.Notifications, mappers.MapToNotificationResponse(notificationDto, notification.ReadAt(), notification.CreatedAt()))
+	}
+
+	if len(notificationList) == limit {
+		last := notificationList[len(notificationList)-1]
+		createdAt, id := last.CreatedAt(), last.Id()
+		inbox.NextBefore, inbox.NextBeforeId = &createdAt, &id
+	}
+
+	return inbox, nil
+}
@@ -0,0 +1,140 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/queries"
+	notifications "github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func TestNotificationHandler_HandleGetInbox(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewNotificationHandler(mockRepository)
+
+	userId := uuid.New()
+	list := []*notifications.Notification{
+		notifications.NewNotification(userId, uuid.New(), notifications.FollowKind, nil, nil),
+		notifications.NewNotification(userId, uuid.New(), notifications.FollowKind, nil, nil),
+	}
+
+	qry := queries.GetInboxQuery{
+		UserId: userId,
+		Limit:  2,
+	}
+
+	mockRepository.On("GetListByRecipient", ctx, userId, (*time.Time)(nil), uuid.Nil, 2).Return(list, nil)
+	mockRepository.On("CountUnread", ctx, userId).Return(5, nil)
+
+	inbox, err := handler.HandleGetInbox(ctx, qry)
+
+	require.NoError(t, err)
+	require.Len(t, inbox.Notifications, 2)
+	assert.Equal(t, 5, inbox.UnreadCount)
+	require.NotNil(t, inbox.NextBefore)
+	assert.Equal(t, list[1].CreatedAt(), *inbox.NextBefore)
+	require.NotNil(t, inbox.NextBeforeId)
+	assert.Equal(t, list[1].Id(), *inbox.NextBeforeId)
+
+	mockRepository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleGetInbox_LastPage(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewNotificationHandler(mockRepository)
+
+	userId := uuid.New()
+	before, beforeId := time.Now(), uuid.New()
+	qry := queries.GetInboxQuery{
+		UserId:   userId,
+		Before:   &before,
+		BeforeId: beforeId,
+		Limit:    1000,
+	}
+
+	mockRepository.On("GetListByRecipient", ctx, userId, &before, beforeId, MaxInboxLimit).Return([]*notifications.Notification{}, nil)
+	mockRepository.On("CountUnread", ctx, userId).Return(0, nil)
+
+	inbox, err := handler.HandleGetInbox(ctx, qry)
+
+	require.NoError(t, err)
+	assert.Empty(t, inbox.Notifications)
+	assert.Nil(t, inbox.NextBefore)
+	assert.Nil(t, inbox.NextBeforeId)
+
+	mockRepository.AssertExpectations(t)
+}
+
+func TestNotificationHandler_HandleGetInbox_Error(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewNotificationHandler(mockRepository)
+
+	qry := queries.GetInboxQuery{
+		UserId: uuid.New(),
+	}
+
+	mockRepository.On("GetListByRecipient", ctx, qry.UserId, (*time.Time)(nil), uuid.Nil, DefaultInboxLimit).Return(nil, errors.New("new error"))
+
+	inbox, err := handler.HandleGetInbox(ctx, qry)
+
+	require.Nil(t, inbox)
+	require.Error(t, err)
+
+	mockRepository.AssertExpectations(t)
+}
+
+func (m *MockRepository) GetListByRecipient(ctx context.Context, recipientId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	args := m.Called(ctx, recipientId, before, beforeId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.Notification), args.Error(1)
+}
+
+func (m *MockRepository) GetListSince(ctx context.Context, recipientId, sinceId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	args := m.Called(ctx, recipientId, sinceId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.Notification), args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*notifications.Notification, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) CountUnread(ctx context.Context, recipientId uuid.UUID) (int, error) {
+	args := m.Called(ctx, recipientId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, n *notifications.Notification) (*notifications.Notification, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) MarkRead(ctx context.Context, n *notifications.Notification) error {
+	return nil
+}
+
+func (m *MockRepository) MarkAllRead(ctx context.Context, recipientId uuid.UUID, readAt time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockRepository) Prune(ctx context.Context, olderThan time.Time, keepPerRecipient int) (int64, error) {
+	return 0, nil
+}
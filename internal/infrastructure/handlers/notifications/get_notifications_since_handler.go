@@ -0,0 +1,23 @@
+package notifications
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/queries"
+)
+
+func (h *NotificationHandler) HandleGetSince(ctx context.Context, query queries.GetNotificationsSinceQuery) ([]*dto.NotificationResponse, error) {
+	notificationList, err := h.repository.GetListSince(ctx, query.UserId, query.LastEventId, MaxInboxLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationResponses := make([]*dto.NotificationResponse, 0, len(notificationList))
+	for _, notification := range notificationList {
+		notificationDto := mappers.MapToNotificationDTO(notification)
+		notificationResponses = append(notificationResponses, mappers.MapToNotificationResponse(notificationDto, notification.ReadAt(), notification.CreatedAt()))
+	}
+
+	return notificationResponses, nil
+}
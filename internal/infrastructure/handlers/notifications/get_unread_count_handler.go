@@ -0,0 +1,10 @@
+package notifications
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/queries"
+)
+
+func (h *NotificationHandler) HandleGetUnreadCount(ctx context.Context, query queries.GetUnreadCountQuery) (int, error) {
+	return h.repository.CountUnread(ctx, query.UserId)
+}
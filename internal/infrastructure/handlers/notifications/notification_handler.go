@@ -0,0 +1,13 @@
+package notifications
+
+import notifications "github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+
+type NotificationHandler struct {
+	repository notifications.NotificationRepository
+}
+
+func NewNotificationHandler(repository notifications.NotificationRepository) *NotificationHandler {
+	return &NotificationHandler{
+		repository: repository,
+	}
+}
@@ -0,0 +1,22 @@
+package passkeys
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/queries"
+)
+
+func (h *PasskeyHandler) HandleGetByUser(ctx context.Context, query queries.GetPasskeysQuery) ([]*dto.PasskeyDTO, error) {
+	credentials, err := h.repository.GetByUser(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeyDtos := make([]*dto.PasskeyDTO, 0, len(credentials))
+	for _, credential := range credentials {
+		passkeyDtos = append(passkeyDtos, mappers.MapToPasskeyDTO(credential))
+	}
+
+	return passkeyDtos, nil
+}
@@ -0,0 +1,83 @@
+package passkeys
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/queries"
+	passkeys "github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func TestPasskeyHandler_HandleGetByUser(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewPasskeyHandler(mockRepository)
+
+	userId := uuid.New()
+	now := time.Now()
+	list := []*passkeys.Credential{
+		passkeys.NewCredentialFromDB([]byte{1, 2, 3}, userId, []byte{9}, 0, "Phone", now, nil),
+		passkeys.NewCredentialFromDB([]byte{4, 5, 6}, userId, []byte{8}, 4, "Laptop", now, &now),
+	}
+
+	mockRepository.On("GetByUser", ctx, userId).Return(list, nil)
+
+	passkeyDtos, err := handler.HandleGetByUser(ctx, queries.GetPasskeysQuery{UserId: userId})
+
+	require.NoError(t, err)
+	require.Len(t, passkeyDtos, 2)
+	assert.Equal(t, "AQID", passkeyDtos[0].Id)
+	assert.Equal(t, "Phone", passkeyDtos[0].Name)
+	assert.Nil(t, passkeyDtos[0].LastUsedAt)
+	assert.Equal(t, "Laptop", passkeyDtos[1].Name)
+	assert.Equal(t, &now, passkeyDtos[1].LastUsedAt)
+}
+
+func TestPasskeyHandler_HandleGetByUser_Error(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewPasskeyHandler(mockRepository)
+
+	userId := uuid.New()
+	mockRepository.On("GetByUser", ctx, userId).Return(nil, errors.New("database error"))
+
+	passkeyDtos, err := handler.HandleGetByUser(ctx, queries.GetPasskeysQuery{UserId: userId})
+
+	assert.Error(t, err)
+	assert.Nil(t, passkeyDtos)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id []byte) (*passkeys.Credential, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) GetByUser(ctx context.Context, userId uuid.UUID) ([]*passkeys.Credential, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*passkeys.Credential), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, credential *passkeys.Credential) error {
+	return nil
+}
+
+func (m *MockRepository) Use(ctx context.Context, credential *passkeys.Credential, previous uint32) (bool, error) {
+	return false, nil
+}
+
+func (m *MockRepository) Delete(ctx context.Context, userId uuid.UUID, id []byte) error {
+	return nil
+}
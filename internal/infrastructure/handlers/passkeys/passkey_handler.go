@@ -0,0 +1,13 @@
+package passkeys
+
+import passkeys "github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+
+type PasskeyHandler struct {
+	repository passkeys.CredentialRepository
+}
+
+func NewPasskeyHandler(repository passkeys.CredentialRepository) *PasskeyHandler {
+	return &PasskeyHandler{
+		repository: repository,
+	}
+}
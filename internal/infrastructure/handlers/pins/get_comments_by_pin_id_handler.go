@@ -0,0 +1,36 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+)
+
+func (h *PinHandler) HandleGetCommentsByPinId(ctx context.Context, query queries.GetListCommentsByPinIdQuery) ([]*dto.CommentResponse, error) {
+	var commentsResponse []*dto.CommentResponse
+
+	pin, err := h.visiblePin(ctx, query.PinId, query.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	commentsList, err := h.commentRepo.GetListByPinId(ctx, query.PinId, query.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range commentsList {
+		mentionsList, err := h.mentionRepo.GetListBySource(ctx, mentions.CommentSource, comment.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		entities := mappers.MapToTextEntityDTOs(comment.Content(), mentionsList, pin.Tags())
+		commentDto := mappers.MapToCommentDTO(comment, entities)
+		commentsResponse = append(commentsResponse, mappers.MapToCommentResponse(commentDto, comment.CreatedAt(), comment.UpdatedAt(), comment.DeletedAt()))
+	}
+
+	return commentsResponse, nil
+}
@@ -0,0 +1,29 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+)
+
+func (h *PinHandler) HandleGetById(ctx context.Context, query queries.GetPinByIdQuery) (*dto.PinResponse, error) {
+	pin, err := h.visiblePin(ctx, query.Id, query.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	pinDto := mappers.MapToPinDTO(pin)
+
+	if pin.Description() != nil {
+		mentionsList, err := h.mentionRepo.GetListBySource(ctx, mentions.PinSource, pin.Id())
+		if err != nil {
+			return nil, err
+		}
+		pinDto.DescriptionEntities = mappers.MapToTextEntityDTOs(*pin.Description(), mentionsList, pin.Tags())
+	}
+
+	pinResponse := mappers.MapToPinResponse(pinDto, pin.CreatedAt(), pin.UpdatedAt(), pin.DeletedAt())
+	return pinResponse, nil
+}
@@ -0,0 +1,29 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+)
+
+func (h *PinHandler) HandleGetListByTag(ctx context.Context, query queries.GetListPinsByTagQuery) ([]*dto.PinDTO, error) {
+	var pinsDto []*dto.PinDTO
+
+	tag, err := shared.NewHashtag(query.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	pinsList, err := h.repository.GetListByTag(ctx, tag, query.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pin := range pinsList {
+		pinsDto = append(pinsDto, mappers.MapToPinDTO(pin))
+	}
+
+	return pinsDto, nil
+}
@@ -0,0 +1,91 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	pins "github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultRelatedLimit = 25
+	MaxRelatedLimit     = 50
+)
+
+// HandleGetRelated returns the pins most related to a pin. The ranking is read
+// from the cache the refresh job fills, and computed and cached on a miss. It
+// is shared by every viewer, so the viewer's own pins and the pins they may not
+// see are dropped afterwards.
+func (h *PinHandler) HandleGetRelated(ctx context.Context, query queries.GetRelatedPinsQuery) ([]*dto.PinDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultRelatedLimit
+	} else if limit > MaxRelatedLimit {
+		limit = MaxRelatedLimit
+	}
+
+	pin, err := h.visiblePin(ctx, query.Id, query.ViewerId)
+	if err != nil {
+		return nil, err
+	} else if !pin.Visibility() && pin.UserId() != query.ViewerId {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	related, cached, err := h.relatedCache.Get(ctx, pin.Id())
+	if err != nil || !cached {
+		candidates, err := h.relatedRepo.GetCandidates(ctx, pin.Id(), h.relatedSize)
+		if err != nil {
+			return nil, err
+		}
+
+		related = recommendations.Rank(candidates, h.relatedSize)
+		// A failed write only means the next request ranks the pin again.
+		_ = h.relatedCache.Set(ctx, pin.Id(), related)
+	}
+
+	visibleIds, err := h.relatedRepo.GetListVisible(ctx, query.ViewerId, related)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[uuid.UUID]bool, len(visibleIds))
+	for _, id := range visibleIds {
+		visible[id] = true
+	}
+
+	var pageIds []uuid.UUID
+	for _, id := range related {
+		if len(pageIds) == limit {
+			break
+		}
+		if visible[id] {
+			pageIds = append(pageIds, id)
+		}
+	}
+
+	pinsDto := make([]*dto.PinDTO, 0, len(pageIds))
+	if len(pageIds) == 0 {
+		return pinsDto, nil
+	}
+
+	pinsList, err := h.repository.GetListByIds(ctx, pageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uuid.UUID]*pins.Pin, len(pinsList))
+	for _, p := range pinsList {
+		byId[p.Id()] = p
+	}
+
+	for _, id := range pageIds {
+		if p, ok := byId[id]; ok {
+			pinsDto = append(pinsDto, mappers.MapToPinDTO(p))
+		}
+	}
+
+	return pinsDto, nil
+}
@@ -0,0 +1,237 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	pins "github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+type MockRelatedRepository struct {
+	mock.Mock
+}
+
+type MockRelatedCache struct {
+	mock.Mock
+}
+
+func newTestPin(userId uuid.UUID, visibility bool) *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(uuid.New(), userId, uuid.New(), "Pin", nil, nil, 0, 0, 0, visibility, nil, now, now, nil)
+}
+
+func TestPinHandler_HandleGetRelated(t *testing.T) {
+	ctx := context.Background()
+	repository, blockRepo, relatedRepo, cache := new(MockPinRepository), new(MockBlockRepository), new(MockRelatedRepository), new(MockRelatedCache)
+	handler := NewPinHandler(repository, nil, nil, blockRepo, relatedRepo, cache, 10)
+
+	viewerId := uuid.New()
+	pin := newTestPin(uuid.New(), true)
+	first, hidden, second, third := newTestPin(uuid.New(), true), newTestPin(viewerId, true), newTestPin(uuid.New(), true), newTestPin(uuid.New(), true)
+	related := []uuid.UUID{first.Id(), hidden.Id(), second.Id(), third.Id()}
+
+	repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	blockRepo.On("ExistsAmong", ctx, []uuid.UUID{viewerId, pin.UserId()}).Return(false, nil)
+	cache.On("Get", ctx, pin.Id()).Return(related, true, nil)
+	relatedRepo.On("GetListVisible", ctx, viewerId, related).Return([]uuid.UUID{third.Id(), second.Id(), first.Id()}, nil)
+	repository.On("GetListByIds", ctx, []uuid.UUID{first.Id(), second.Id()}).Return([]*pins.Pin{second, first}, nil)
+
+	pinsList, err := handler.HandleGetRelated(ctx, queries.GetRelatedPinsQuery{Id: pin.Id(), ViewerId: viewerId, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, pinsList, 2)
+	assert.Equal(t, first.Id(), pinsList[0].Id)
+	assert.Equal(t, second.Id(), pinsList[1].Id)
+	relatedRepo.AssertNotCalled(t, "GetCandidates", mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertExpectations(t)
+	blockRepo.AssertExpectations(t)
+	relatedRepo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestPinHandler_HandleGetRelated_CacheMiss(t *testing.T) {
+	ctx := context.Background()
+	repository, blockRepo, relatedRepo, cache := new(MockPinRepository), new(MockBlockRepository), new(MockRelatedRepository), new(MockRelatedCache)
+	handler := NewPinHandler(repository, nil, nil, blockRepo, relatedRepo, cache, 10)
+
+	viewerId := uuid.New()
+	pin := newTestPin(uuid.New(), true)
+	strong, weak := newTestPin(uuid.New(), true), newTestPin(uuid.New(), true)
+	candidates := []recommendations.Candidate{
+		recommendations.NewCandidate(weak.Id(), 0, 1, 0, weak.CreatedAt()),
+		recommendations.NewCandidate(strong.Id(), 2, 0, 0, strong.CreatedAt()),
+	}
+	ranked := []uuid.UUID{strong.Id(), weak.Id()}
+
+	repository.On("GetById", ctx, pin.Id()).Return(pin, nil)
+	blockRepo.On("ExistsAmong", ctx, []uuid.UUID{viewerId, pin.UserId()}).Return(false, nil)
+	cache.On("Get", ctx, pin.Id()).Return(nil, false, nil)
+	relatedRepo.On("GetCandidates", ctx, pin.Id(), 10).Return(candidates, nil)
+	cache.On("Set", ctx, pin.Id(), ranked).Return(nil)
+	relatedRepo.On("GetListVisible", ctx, viewerId, ranked).Return(ranked, nil)
+	repository.On("GetListByIds", ctx, ranked).Return([]*pins.Pin{weak, strong}, nil)
+
+	pinsList, err := handler.HandleGetRelated(ctx, queries.GetRelatedPinsQuery{Id: pin.Id(), ViewerId: viewerId})
+
+	require.NoError(t, err)
+	require.Len(t, pinsList, 2)
+	assert.Equal(t, strong.Id(), pinsList[0].Id)
+	assert.Equal(t, weak.Id(), pinsList[1].Id)
+	repository.AssertExpectations(t)
+	relatedRepo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestPinHandler_HandleGetRelated_NotVisible(t *testing.T) {
+	viewerId := uuid.New()
+	cases := []struct {
+		name    string
+		pin     *pins.Pin
+		blocked bool
+	}{
+		{name: "blocked", pin: newTestPin(uuid.New(), true), blocked: true},
+		{name: "private", pin: newTestPin(uuid.New(), false)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			repository, blockRepo, relatedRepo, cache := new(MockPinRepository), new(MockBlockRepository), new(MockRelatedRepository), new(MockRelatedCache)
+			handler := NewPinHandler(repository, nil, nil, blockRepo, relatedRepo, cache, 10)
+
+			repository.On("GetById", ctx, tc.pin.Id()).Return(tc.pin, nil)
+			blockRepo.On("ExistsAmong", ctx, []uuid.UUID{viewerId, tc.pin.UserId()}).Return(tc.blocked, nil)
+
+			pinsList, err := handler.HandleGetRelated(ctx, queries.GetRelatedPinsQuery{Id: tc.pin.Id(), ViewerId: viewerId})
+
+			assert.Nil(t, pinsList)
+			assert.ErrorIs(t, err, pins.ErrNotFoundPin)
+			cache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockBlockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	return nil, nil
+}
+
+func (m *MockBlockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockBlockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userIds)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBlockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockBlockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	return nil
+}
+
+func (m *MockRelatedRepository) GetCandidates(ctx context.Context, pinId uuid.UUID, limit int) ([]recommendations.Candidate, error) {
+	args := m.Called(ctx, pinId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]recommendations.Candidate), args.Error(1)
+}
+
+func (m *MockRelatedRepository) GetListActive(ctx context.Context, since time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRelatedRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, viewerId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRelatedCache) Get(ctx context.Context, pinId uuid.UUID) ([]uuid.UUID, bool, error) {
+	args := m.Called(ctx, pinId)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]uuid.UUID), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRelatedCache) Set(ctx context.Context, pinId uuid.UUID, related []uuid.UUID) error {
+	args := m.Called(ctx, pinId, related)
+	return args.Error(0)
+}
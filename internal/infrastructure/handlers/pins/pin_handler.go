@@ -0,0 +1,51 @@
+package pins
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	pins "github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+	"github.com/google/uuid"
+)
+
+type PinHandler struct {
+	repository   pins.PinRepository
+	commentRepo  comments.CommentRepository
+	mentionRepo  mentions.MentionRepository
+	blockRepo    blocks.BlockRepository
+	relatedRepo  recommendations.RelatedRepository
+	relatedCache recommendations.RelatedCache
+	relatedSize  int
+}
+
+func NewPinHandler(repository pins.PinRepository, commentRepo comments.CommentRepository, mentionRepo mentions.MentionRepository, blockRepo blocks.BlockRepository, relatedRepo recommendations.RelatedRepository, relatedCache recommendations.RelatedCache, relatedSize int) *PinHandler {
+	return &PinHandler{
+		repository:   repository,
+		commentRepo:  commentRepo,
+		mentionRepo:  mentionRepo,
+		blockRepo:    blockRepo,
+		relatedRepo:  relatedRepo,
+		relatedCache: relatedCache,
+		relatedSize:  relatedSize,
+	}
+}
+
+// visiblePin loads a pin for the viewer. Pins of users in a block with the
+// viewer are reported as not found.
+func (h *PinHandler) visiblePin(ctx context.Context, id, viewerId uuid.UUID) (*pins.Pin, error) {
+	pin, err := h.repository.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := h.blockRepo.ExistsAmong(ctx, []uuid.UUID{viewerId, pin.UserId()})
+	if err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, pins.ErrNotFoundPin
+	}
+
+	return pin, nil
+}
@@ -0,0 +1,104 @@
+package searches
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"time"
+)
+
+// HandleSearch returns a page of the pins, boards and users matching the
+// query and its filters, best match first. One extra result is read to tell
+// whether another page follows. The first page also counts the matches by
+// facet; later pages share those counts, so they are left out.
+func (h *SearchHandler) HandleSearch(ctx context.Context, query queries.SearchQuery) (*dto.SearchPageDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	} else if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	var kinds []searches.Kind
+	for _, t := range query.Types {
+		kind, err := searches.ParseKind(t)
+		if err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, kind)
+	}
+
+	var language *shared.Language
+	if query.Language != "" {
+		parsed, err := shared.ParseLanguage(query.Language)
+		if err != nil {
+			return nil, err
+		}
+		language = &parsed
+	}
+
+	var country *shared.Country
+	if query.Country != "" {
+		parsed, err := shared.ParseCountry(query.Country)
+		if err != nil {
+			return nil, err
+		}
+		country = &parsed
+	}
+
+	var tag *string
+	if query.Tag != "" {
+		tag = &query.Tag
+	}
+
+	filters, err := searches.NewFilters(tag, query.BoardId, country, query.CreatedFrom, query.CreatedTo, query.HasImage)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *searches.Cursor
+	if query.Cursor != "" {
+		cursor, err := searches.ParseCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+
+	search, err := searches.NewQuery(query.Text, kinds, language, filters, query.ViewerId, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := h.repository.Search(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dto.SearchPageDTO{
+		Results: make([]*dto.SearchResultDTO, 0, min(len(results), limit)),
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+		next := searches.NewCursor(results[limit-1]).String()
+		page.NextCursor = &next
+	}
+
+	for _, result := range results {
+		page.Results = append(page.Results, mappers.MapToSearchResultDTO(result))
+	}
+
+	if after == nil {
+		buckets, err := h.repository.GetFacets(ctx, search, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		page.Facets = mappers.MapToSearchFacetsDTO(buckets)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,185 @@
+package searches
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockSearchRepository struct {
+	mock.Mock
+}
+
+func TestSearchHandler_HandleSearch(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSearchRepository)
+	handler := NewSearchHandler(repository)
+
+	viewerId := uuid.New()
+	first := searches.NewResult(searches.PinKind, uuid.New(), "Kitchen", "<mark>Kitchen</mark>", 0.9)
+	second := searches.NewResult(searches.BoardKind, uuid.New(), "Kitchens", "<mark>Kitchens</mark>", 0.5)
+	extra := searches.NewResult(searches.UserKind, uuid.New(), "kitchen", "<mark>kitchen</mark>", 0.1)
+
+	repository.On("Search", ctx, mock.MatchedBy(func(q *searches.Query) bool {
+		return q.Text() == "kitchen" && q.ViewerId() == viewerId && q.Limit() == 3 &&
+			*q.Language() == shared.English && assert.ObjectsAreEqual([]searches.Kind{searches.PinKind, searches.BoardKind}, q.Kinds())
+	})).Return([]searches.Result{first, second, extra}, nil)
+	repository.On("GetFacets", ctx, mock.AnythingOfType("*searches.Query"), mock.AnythingOfType("time.Time")).
+		Return([]searches.Bucket{
+			searches.NewBucket(searches.LanguageFacet, "EN", "", 3),
+			searches.NewBucket(searches.CreatedAtFacet, searches.WeekBucket, "", 1),
+		}, nil)
+
+	page, err := handler.HandleSearch(ctx, queries.SearchQuery{
+		ViewerId: viewerId,
+		Text:     "kitchen",
+		Types:    []string{"pins", "boards"},
+		Language: "EN",
+		Limit:    2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Results, 2)
+	assert.Equal(t, "pin", page.Results[0].Kind)
+	assert.Equal(t, first.Id(), page.Results[0].Id)
+	assert.Equal(t, "<mark>Kitchen</mark>", page.Results[0].Snippet)
+	assert.Equal(t, second.Id(), page.Results[1].Id)
+	require.NotNil(t, page.NextCursor)
+
+	cursor, err := searches.ParseCursor(*page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, second.Id(), cursor.Id())
+	assert.Equal(t, second.Rank(), cursor.Rank())
+
+	require.NotNil(t, page.Facets)
+	require.Len(t, page.Facets.Languages, 1)
+	assert.Equal(t, "EN", page.Facets.Languages[0].Value)
+	assert.Equal(t, "English", page.Facets.Languages[0].Label)
+	assert.Equal(t, 3, page.Facets.Languages[0].Count)
+	require.Len(t, page.Facets.CreatedAt, 1)
+	assert.Equal(t, searches.WeekBucket, page.Facets.CreatedAt[0].Value)
+	assert.Empty(t, page.Facets.Tags)
+	repository.AssertExpectations(t)
+}
+
+func TestSearchHandler_HandleSearch_Filters(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSearchRepository)
+	handler := NewSearchHandler(repository)
+
+	boardId, hasImage := uuid.New(), true
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	matches := func(q *searches.Query) bool {
+		f := q.Filters()
+		return *f.Tag() == "recipes" && *f.BoardId() == boardId && *f.Country() == shared.Mexico &&
+			f.CreatedFrom().Equal(from) && f.CreatedTo() == nil && *f.HasImage()
+	}
+	repository.On("Search", ctx, mock.MatchedBy(matches)).Return(nil, nil)
+	repository.On("GetFacets", ctx, mock.MatchedBy(matches), mock.AnythingOfType("time.Time")).
+		Return([]searches.Bucket{searches.NewBucket(searches.BoardFacet, boardId.String(), "Dinner", 0)}, nil)
+
+	page, err := handler.HandleSearch(ctx, queries.SearchQuery{
+		ViewerId:    uuid.New(),
+		Text:        "tacos",
+		Tag:         "#Recipes",
+		BoardId:     &boardId,
+		Country:     "Mexico",
+		CreatedFrom: &from,
+		HasImage:    &hasImage,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Results)
+	require.Len(t, page.Facets.Boards, 1)
+	assert.Equal(t, "Dinner", page.Facets.Boards[0].Label)
+	repository.AssertExpectations(t)
+}
+
+func TestSearchHandler_HandleSearch_FacetsError(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSearchRepository)
+	handler := NewSearchHandler(repository)
+
+	repository.On("Search", ctx, mock.Anything).Return(nil, nil)
+	repository.On("GetFacets", ctx, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	page, err := handler.HandleSearch(ctx, queries.SearchQuery{ViewerId: uuid.New(), Text: "kitchen"})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, assert.AnError)
+	repository.AssertExpectations(t)
+}
+
+func TestSearchHandler_HandleSearch_LastPage(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSearchRepository)
+	handler := NewSearchHandler(repository)
+
+	after := searches.NewCursor(searches.NewResult(searches.PinKind, uuid.New(), "Kitchen", "", 0.9))
+	repository.On("Search", ctx, mock.MatchedBy(func(q *searches.Query) bool {
+		return q.After().Id() == after.Id() && q.Limit() == DefaultSearchLimit+1 && q.Language() == nil
+	})).Return(nil, nil)
+
+	page, err := handler.HandleSearch(ctx, queries.SearchQuery{ViewerId: uuid.New(), Text: "kitchen", Cursor: after.String()})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Results)
+	assert.Nil(t, page.NextCursor)
+	assert.Nil(t, page.Facets)
+	repository.AssertExpectations(t)
+	repository.AssertNotCalled(t, "GetFacets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSearchHandler_HandleSearch_Invalid(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	cases := []struct {
+		name  string
+		query queries.SearchQuery
+		err   error
+	}{
+		{name: "empty text", query: queries.SearchQuery{Text: "  "}, err: searches.ErrEmptyQuery},
+		{name: "unknown type", query: queries.SearchQuery{Text: "kitchen", Types: []string{"tags"}}, err: searches.ErrKindNotFound},
+		{name: "unknown language", query: queries.SearchQuery{Text: "kitchen", Language: "Klingon"}, err: shared.ErrNotALanguage},
+		{name: "bad cursor", query: queries.SearchQuery{Text: "kitchen", Cursor: "%%%"}, err: searches.ErrInvalidCursor},
+		{name: "unknown country", query: queries.SearchQuery{Text: "kitchen", Country: "Atlantis"}, err: shared.ErrNotACountry},
+		{name: "bad tag", query: queries.SearchQuery{Text: "kitchen", Tag: "#"}, err: shared.ErrEmptyHashtag},
+		{name: "inverted range", query: queries.SearchQuery{Text: "kitchen", CreatedFrom: &to, CreatedTo: &from}, err: searches.ErrInvalidRangeFilters},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository := new(MockSearchRepository)
+			handler := NewSearchHandler(repository)
+
+			page, err := handler.HandleSearch(context.Background(), tc.query)
+
+			assert.Nil(t, page)
+			assert.ErrorIs(t, err, tc.err)
+			repository.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (m *MockSearchRepository) Search(ctx context.Context, query *searches.Query) ([]searches.Result, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]searches.Result), args.Error(1)
+}
+
+func (m *MockSearchRepository) GetFacets(ctx context.Context, query *searches.Query, now time.Time) ([]searches.Bucket, error) {
+	args := m.Called(ctx, query, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]searches.Bucket), args.Error(1)
+}
@@ -0,0 +1,20 @@
+package searches
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+)
+
+const (
+	DefaultSearchLimit = 25
+	MaxSearchLimit     = 50
+)
+
+type SearchHandler struct {
+	repository searches.SearchRepository
+}
+
+func NewSearchHandler(repository searches.SearchRepository) *SearchHandler {
+	return &SearchHandler{
+		repository: repository,
+	}
+}
@@ -0,0 +1,25 @@
+package sessions
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/queries"
+	"time"
+)
+
+// HandleGetByUser lists the sessions of the user that can still refresh,
+// most recently seen first.
+func (h *SessionHandler) HandleGetByUser(ctx context.Context, query queries.GetSessionsQuery) ([]*dto.SessionDTO, error) {
+	list, err := h.repository.GetActiveByUser(ctx, query.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	sessionDtos := make([]*dto.SessionDTO, 0, len(list))
+	for _, session := range list {
+		sessionDtos = append(sessionDtos, mappers.MapToSessionDTO(session, query.CurrentId))
+	}
+
+	return sessionDtos, nil
+}
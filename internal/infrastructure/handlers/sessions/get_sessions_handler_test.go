@@ -0,0 +1,80 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/queries"
+	sessions "github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func TestSessionHandler_HandleGetByUser(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewSessionHandler(mockRepository)
+
+	userId, currentId := uuid.New(), uuid.New()
+	now := time.Now()
+	list := []*sessions.Session{
+		sessions.NewSessionFromDB(currentId, userId, "Firefox on Linux", "Mozilla/5.0", "203.0.113.7", now, now),
+		sessions.NewSessionFromDB(uuid.New(), userId, "Safari on iPhone", "Mozilla/5.0", "198.51.100.2", now, now.Add(-time.Hour)),
+	}
+
+	mockRepository.On("GetActiveByUser", ctx, userId, mock.Anything).Return(list, nil)
+
+	sessionDtos, err := handler.HandleGetByUser(ctx, queries.GetSessionsQuery{UserId: userId, CurrentId: currentId})
+
+	require.NoError(t, err)
+	require.Len(t, sessionDtos, 2)
+	assert.Equal(t, currentId, sessionDtos[0].Id)
+	assert.Equal(t, "Firefox on Linux", sessionDtos[0].Device)
+	assert.Equal(t, "203.0.113.7", sessionDtos[0].IP)
+	assert.True(t, sessionDtos[0].Current)
+	assert.Equal(t, "Safari on iPhone", sessionDtos[1].Device)
+	assert.False(t, sessionDtos[1].Current)
+}
+
+func TestSessionHandler_HandleGetByUser_Error(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepository := new(MockRepository)
+	handler := NewSessionHandler(mockRepository)
+
+	userId := uuid.New()
+	mockRepository.On("GetActiveByUser", ctx, userId, mock.Anything).Return(nil, errors.New("database error"))
+
+	sessionDtos, err := handler.HandleGetByUser(ctx, queries.GetSessionsQuery{UserId: userId})
+
+	assert.Error(t, err)
+	assert.Nil(t, sessionDtos)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	args := m.Called(ctx, userId, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*sessions.Session), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, session *sessions.Session) error {
+	return nil
+}
+
+func (m *MockRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	return nil
+}
@@ -0,0 +1,13 @@
+package sessions
+
+import sessions "github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+
+type SessionHandler struct {
+	repository sessions.SessionRepository
+}
+
+func NewSessionHandler(repository sessions.SessionRepository) *SessionHandler {
+	return &SessionHandler{
+		repository: repository,
+	}
+}
@@ -0,0 +1,65 @@
+package suggestions
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+)
+
+// HandleAutocomplete completes what the viewer is typing with up to limit
+// users, tags and boards each, most popular first. Kinds left out by the
+// prefix or by query.Types come back empty without touching the database.
+func (h *SuggestionHandler) HandleAutocomplete(ctx context.Context, query queries.AutocompleteQuery) (*dto.AutocompleteDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultAutocompleteLimit
+	} else if limit > MaxAutocompleteLimit {
+		limit = MaxAutocompleteLimit
+	}
+
+	prefix, err := suggestions.NewPrefix(query.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[suggestions.Kind]bool)
+	for _, t := range query.Types {
+		kind, err := suggestions.ParseKind(t)
+		if err != nil {
+			return nil, err
+		}
+		wanted[kind] = true
+	}
+
+	include := func(kind suggestions.Kind) bool {
+		return prefix.Includes(kind) && (len(wanted) == 0 || wanted[kind])
+	}
+
+	var usersList, tagsList, boardsList []suggestions.Suggestion
+
+	if include(suggestions.UserKind) {
+		if usersList, err = h.repository.GetUsers(ctx, prefix, query.ViewerId, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if include(suggestions.TagKind) {
+		if tagsList, err = h.repository.GetTags(ctx, prefix, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if include(suggestions.BoardKind) {
+		if boardsList, err = h.repository.GetBoards(ctx, prefix, query.ViewerId, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.AutocompleteDTO{
+		Users:  mappers.MapToSuggestionDTOs(usersList),
+		Tags:   mappers.MapToSuggestionDTOs(tagsList),
+		Boards: mappers.MapToSuggestionDTOs(boardsList),
+	}, nil
+}
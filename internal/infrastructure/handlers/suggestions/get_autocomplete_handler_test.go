@@ -0,0 +1,122 @@
+package suggestions
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockSuggestionRepository struct {
+	mock.Mock
+}
+
+func TestSuggestionHandler_HandleAutocomplete(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSuggestionRepository)
+	handler := NewSuggestionHandler(repository)
+
+	viewerId := uuid.New()
+	prefix, err := suggestions.NewPrefix("kit")
+	require.NoError(t, err)
+	user := suggestions.NewSuggestion(suggestions.UserKind, uuid.New(), "kitty", "Kitty Smith", 10)
+	tag := suggestions.NewSuggestion(suggestions.TagKind, uuid.New(), "kitchen", "", 40)
+	board := suggestions.NewSuggestion(suggestions.BoardKind, uuid.New(), "Kitchen ideas", "", 3)
+
+	repository.On("GetUsers", ctx, prefix, viewerId, DefaultAutocompleteLimit).Return([]suggestions.Suggestion{user}, nil)
+	repository.On("GetTags", ctx, prefix, DefaultAutocompleteLimit).Return([]suggestions.Suggestion{tag}, nil)
+	repository.On("GetBoards", ctx, prefix, viewerId, DefaultAutocompleteLimit).Return([]suggestions.Suggestion{board}, nil)
+
+	result, err := handler.HandleAutocomplete(ctx, queries.AutocompleteQuery{ViewerId: viewerId, Text: "Kit"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Users, 1)
+	assert.Equal(t, "kitty", result.Users[0].Text)
+	assert.Equal(t, "Kitty Smith", result.Users[0].Label)
+	require.Len(t, result.Tags, 1)
+	assert.Equal(t, 40, result.Tags[0].Popularity)
+	require.Len(t, result.Boards, 1)
+	assert.Equal(t, board.Id(), result.Boards[0].Id)
+	repository.AssertExpectations(t)
+}
+
+func TestSuggestionHandler_HandleAutocomplete_Mention(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSuggestionRepository)
+	handler := NewSuggestionHandler(repository)
+
+	viewerId := uuid.New()
+	prefix, err := suggestions.NewPrefix("@kit")
+	require.NoError(t, err)
+
+	repository.On("GetUsers", ctx, prefix, viewerId, MaxAutocompleteLimit).Return(nil, nil)
+
+	result, err := handler.HandleAutocomplete(ctx, queries.AutocompleteQuery{ViewerId: viewerId, Text: "@kit", Limit: 100})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Users)
+	assert.Empty(t, result.Tags)
+	assert.Empty(t, result.Boards)
+	repository.AssertExpectations(t)
+	repository.AssertNotCalled(t, "GetTags", mock.Anything, mock.Anything, mock.Anything)
+	repository.AssertNotCalled(t, "GetBoards", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSuggestionHandler_HandleAutocomplete_Types(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSuggestionRepository)
+	handler := NewSuggestionHandler(repository)
+
+	prefix, err := suggestions.NewPrefix("kit")
+	require.NoError(t, err)
+
+	repository.On("GetTags", ctx, prefix, 3).Return(nil, nil)
+
+	_, err = handler.HandleAutocomplete(ctx, queries.AutocompleteQuery{ViewerId: uuid.New(), Text: "kit", Types: []string{"tags"}, Limit: 3})
+
+	require.NoError(t, err)
+	repository.AssertExpectations(t)
+	repository.AssertNotCalled(t, "GetUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSuggestionHandler_HandleAutocomplete_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repository := new(MockSuggestionRepository)
+	handler := NewSuggestionHandler(repository)
+
+	result, err := handler.HandleAutocomplete(ctx, queries.AutocompleteQuery{Text: " "})
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, suggestions.ErrEmptyPrefix)
+
+	result, err = handler.HandleAutocomplete(ctx, queries.AutocompleteQuery{Text: "kit", Types: []string{"pins"}})
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, suggestions.ErrKindNotFound)
+}
+
+func (m *MockSuggestionRepository) GetUsers(ctx context.Context, prefix suggestions.Prefix, viewerId uuid.UUID, limit int) ([]suggestions.Suggestion, error) {
+	args := m.Called(ctx, prefix, viewerId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]suggestions.Suggestion), args.Error(1)
+}
+
+func (m *MockSuggestionRepository) GetTags(ctx context.Context, prefix suggestions.Prefix, limit int) ([]suggestions.Suggestion, error) {
+	args := m.Called(ctx, prefix, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]suggestions.Suggestion), args.Error(1)
+}
+
+func (m *MockSuggestionRepository) GetBoards(ctx context.Context, prefix suggestions.Prefix, ownerId uuid.UUID, limit int) ([]suggestions.Suggestion, error) {
+	args := m.Called(ctx, prefix, ownerId, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]suggestions.Suggestion), args.Error(1)
+}
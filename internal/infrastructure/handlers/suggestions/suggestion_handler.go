@@ -0,0 +1,20 @@
+package suggestions
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+)
+
+const (
+	DefaultAutocompleteLimit = 5
+	MaxAutocompleteLimit     = 20
+)
+
+type SuggestionHandler struct {
+	repository suggestions.SuggestionRepository
+}
+
+func NewSuggestionHandler(repository suggestions.SuggestionRepository) *SuggestionHandler {
+	return &SuggestionHandler{
+		repository: repository,
+	}
+}
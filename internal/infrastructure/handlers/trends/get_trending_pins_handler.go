@@ -0,0 +1,78 @@
+package trends
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+)
+
+// HandleGetTrendingPins returns the hottest pins of the segment asked for. The
+// ranking is shared by every viewer, so pins of users in a block with the
+// viewer, muted by them or hidden since the last refresh are dropped.
+func (h *TrendHandler) HandleGetTrendingPins(ctx context.Context, query queries.GetTrendingPinsQuery) ([]*dto.PinDTO, error) {
+	limit := clampLimit(query.Limit)
+
+	segment, err := trends.ParseSegment(query.Country, query.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := h.store.Top(ctx, trends.PinKind, segment, h.size)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]uuid.UUID, 0, len(scores))
+	for _, score := range scores {
+		if id, err := uuid.Parse(score.Member()); err == nil {
+			ranked = append(ranked, id)
+		}
+	}
+
+	visibleIds, err := h.repository.GetListVisible(ctx, query.ViewerId, ranked)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[uuid.UUID]bool, len(visibleIds))
+	for _, id := range visibleIds {
+		visible[id] = true
+	}
+
+	var pageIds []uuid.UUID
+	for _, id := range ranked {
+		if len(pageIds) == limit {
+			break
+		}
+		if visible[id] {
+			pageIds = append(pageIds, id)
+		}
+	}
+
+	pinsDto := make([]*dto.PinDTO, 0, len(pageIds))
+	if len(pageIds) == 0 {
+		return pinsDto, nil
+	}
+
+	pinsList, err := h.pinRepo.GetListByIds(ctx, pageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uuid.UUID]*pins.Pin, len(pinsList))
+	for _, p := range pinsList {
+		byId[p.Id()] = p
+	}
+
+	for _, id := range pageIds {
+		if p, ok := byId[id]; ok {
+			pinsDto = append(pinsDto, mappers.MapToPinDTO(p))
+		}
+	}
+
+	return pinsDto, nil
+}
@@ -0,0 +1,32 @@
+package trends
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+)
+
+// HandleGetTrendingTags returns the hottest tags of the segment asked for,
+// highest score first.
+func (h *TrendHandler) HandleGetTrendingTags(ctx context.Context, query queries.GetTrendingTagsQuery) ([]*dto.TrendingTagDTO, error) {
+	segment, err := trends.ParseSegment(query.Country, query.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := h.store.Top(ctx, trends.TagKind, segment, clampLimit(query.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*dto.TrendingTagDTO, 0, len(scores))
+	for _, score := range scores {
+		tags = append(tags, &dto.TrendingTagDTO{
+			Name:  score.Member(),
+			Score: score.Value(),
+		})
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,36 @@
+package trends
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+)
+
+const (
+	DefaultTrendingLimit = 25
+	MaxTrendingLimit     = 50
+)
+
+type TrendHandler struct {
+	repository trends.TrendRepository
+	store      trends.TrendStore
+	pinRepo    pins.PinRepository
+	size       int
+}
+
+func NewTrendHandler(repository trends.TrendRepository, store trends.TrendStore, pinRepo pins.PinRepository, size int) *TrendHandler {
+	return &TrendHandler{
+		repository: repository,
+		store:      store,
+		pinRepo:    pinRepo,
+		size:       size,
+	}
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultTrendingLimit
+	} else if limit > MaxTrendingLimit {
+		return MaxTrendingLimit
+	}
+	return limit
+}
@@ -0,0 +1,183 @@
+package trends
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockTrendRepository struct {
+	mock.Mock
+}
+
+type MockTrendStore struct {
+	mock.Mock
+}
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+func newTestPin() *pins.Pin {
+	now := time.Now()
+	return pins.NewPinFromDB(uuid.New(), uuid.New(), uuid.New(), "Pin", nil, nil, 0, 0, 0, true, nil, now, now, nil)
+}
+
+func TestTrendHandler_HandleGetTrendingPins(t *testing.T) {
+	ctx := context.Background()
+	repository, store, pinRepo := new(MockTrendRepository), new(MockTrendStore), new(MockPinRepository)
+	handler := NewTrendHandler(repository, store, pinRepo, 100)
+
+	viewerId := uuid.New()
+	first, hidden, second, third := newTestPin(), newTestPin(), newTestPin(), newTestPin()
+	segment := trends.Segment("country:BO:language:ES")
+	ranked := []uuid.UUID{first.Id(), hidden.Id(), second.Id(), third.Id()}
+	scores := make([]trends.Score, 0, len(ranked))
+	for i, id := range ranked {
+		scores = append(scores, trends.NewScore(segment, id.String(), float64(len(ranked)-i)))
+	}
+
+	store.On("Top", ctx, trends.PinKind, segment, 100).Return(scores, nil)
+	repository.On("GetListVisible", ctx, viewerId, ranked).Return([]uuid.UUID{third.Id(), second.Id(), first.Id()}, nil)
+	pinRepo.On("GetListByIds", ctx, []uuid.UUID{first.Id(), second.Id()}).Return([]*pins.Pin{second, first}, nil)
+
+	pinsList, err := handler.HandleGetTrendingPins(ctx, queries.GetTrendingPinsQuery{ViewerId: viewerId, Country: "BO", Language: "Spanish", Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, pinsList, 2)
+	assert.Equal(t, first.Id(), pinsList[0].Id)
+	assert.Equal(t, second.Id(), pinsList[1].Id)
+	store.AssertExpectations(t)
+	repository.AssertExpectations(t)
+	pinRepo.AssertExpectations(t)
+}
+
+func TestTrendHandler_HandleGetTrendingPins_InvalidSegment(t *testing.T) {
+	ctx := context.Background()
+	store := new(MockTrendStore)
+	handler := NewTrendHandler(new(MockTrendRepository), store, new(MockPinRepository), 100)
+
+	pinsList, err := handler.HandleGetTrendingPins(ctx, queries.GetTrendingPinsQuery{ViewerId: uuid.New(), Country: "Atlantis"})
+
+	assert.Nil(t, pinsList)
+	assert.ErrorIs(t, err, shared.ErrNotACountry)
+	store.AssertNotCalled(t, "Top", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTrendHandler_HandleGetTrendingTags(t *testing.T) {
+	ctx := context.Background()
+	store := new(MockTrendStore)
+	handler := NewTrendHandler(new(MockTrendRepository), store, new(MockPinRepository), 100)
+
+	store.On("Top", ctx, trends.TagKind, trends.Global, MaxTrendingLimit).Return([]trends.Score{
+		trends.NewScore(trends.Global, "kitchen", 3),
+		trends.NewScore(trends.Global, "garden", 1.5),
+	}, nil)
+
+	tags, err := handler.HandleGetTrendingTags(ctx, queries.GetTrendingTagsQuery{Limit: 500})
+
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "kitchen", tags[0].Name)
+	assert.Equal(t, 3.0, tags[0].Score)
+	assert.Equal(t, "garden", tags[1].Name)
+	store.AssertExpectations(t)
+}
+
+func TestTrendHandler_HandleGetTrendingTags_InvalidSegment(t *testing.T) {
+	ctx := context.Background()
+	handler := NewTrendHandler(new(MockTrendRepository), new(MockTrendStore), new(MockPinRepository), 100)
+
+	tags, err := handler.HandleGetTrendingTags(ctx, queries.GetTrendingTagsQuery{Language: "Klingon"})
+
+	assert.Nil(t, tags)
+	assert.ErrorIs(t, err, shared.ErrNotALanguage)
+}
+
+func (m *MockTrendRepository) GetPinScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	return nil, nil
+}
+
+func (m *MockTrendRepository) GetTagScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	return nil, nil
+}
+
+func (m *MockTrendRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, viewerId, pinIds)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockTrendStore) Replace(ctx context.Context, kind trends.Kind, scores []trends.Score) error {
+	return nil
+}
+
+func (m *MockTrendStore) Top(ctx context.Context, kind trends.Kind, segment trends.Segment, limit int) ([]trends.Score, error) {
+	args := m.Called(ctx, kind, segment, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trends.Score), args.Error(1)
+}
+
+func (m *MockPinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pins.Pin), args.Error(1)
+}
+
+func (m *MockPinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *MockPinRepository) Create(ctx context.Context, pin *pins.Pin) (*pins.Pin, error) {
+	return nil, nil
+}
+
+func (m *MockPinRepository) Update(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
+
+func (m *MockPinRepository) Delete(ctx context.Context, pin *pins.Pin) error {
+	return nil
+}
@@ -0,0 +1,18 @@
+package users
+
+import (
+	blocks "github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	mutes "github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+)
+
+type BlockHandler struct {
+	repository blocks.BlockRepository
+	muteRepo   mutes.MuteRepository
+}
+
+func NewBlockHandler(repository blocks.BlockRepository, muteRepo mutes.MuteRepository) *BlockHandler {
+	return &BlockHandler{
+		repository: repository,
+		muteRepo:   muteRepo,
+	}
+}
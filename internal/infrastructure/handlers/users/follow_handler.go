@@ -0,0 +1,13 @@
+package users
+
+import follows "github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+
+type FollowHandler struct {
+	repository follows.FollowRepository
+}
+
+func NewFollowHandler(repository follows.FollowRepository) *FollowHandler {
+	return &FollowHandler{
+		repository: repository,
+	}
+}
@@ -0,0 +1,22 @@
+package users
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+)
+
+func (h *BlockHandler) HandleGetBlocked(ctx context.Context, query queries.GetBlockedQuery) ([]*dto.BlockDTO, error) {
+	blocksList, err := h.repository.GetListByBlockerId(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	blockDtos := make([]*dto.BlockDTO, 0, len(blocksList))
+	for _, block := range blocksList {
+		blockDtos = append(blockDtos, mappers.MapToBlockDTO(block))
+	}
+
+	return blockDtos, nil
+}
@@ -0,0 +1,22 @@
+package users
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+)
+
+func (h *FollowHandler) HandleGetFollowers(ctx context.Context, query queries.GetFollowersQuery) ([]*dto.FollowDTO, error) {
+	followList, err := h.repository.GetFollowers(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	followDtos := make([]*dto.FollowDTO, 0, len(followList))
+	for _, follow := range followList {
+		followDtos = append(followDtos, mappers.MapToFollowDTO(follow))
+	}
+
+	return followDtos, nil
+}
@@ -0,0 +1,22 @@
+package users
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+)
+
+func (h *BlockHandler) HandleGetMuted(ctx context.Context, query queries.GetMutedQuery) ([]*dto.MuteDTO, error) {
+	mutesList, err := h.muteRepo.GetListByMuterId(ctx, query.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	muteDtos := make([]*dto.MuteDTO, 0, len(mutesList))
+	for _, mute := range mutesList {
+		muteDtos = append(muteDtos, mappers.MapToMuteDTO(mute))
+	}
+
+	return muteDtos, nil
+}
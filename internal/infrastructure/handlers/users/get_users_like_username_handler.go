@@ -8,7 +8,7 @@ import (
 )
 
 func (h *UserHandler) HandleGetListLikeUsername(context context.Context, query queries.GetUsersLikeUsernameQuery) ([]*dto.UserDTO, error) {
-	users, err := h.repository.GetListLikeUsername(context, query.Username)
+	users, err := h.repository.GetListLikeUsername(context, query.Username, query.ViewerId)
 
 	if err != nil {
 		return nil, err
@@ -110,6 +110,17 @@ func (m *MockRepository) GetListByLanguage(ctx context.Context, language string)
 	return usersList, args.Error(1)
 }
 
+func (m *MockRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
+	args := m.Called(ctx, name, viewerId)
+
+	var usersList []*users.User
+	if args.Get(0) != nil {
+		usersList = args.Get(0).([]*users.User)
+	}
+
+	return usersList, args.Error(1)
+}
+
 func (m *MockRepository) ExistsById(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(ctx, id)
 
@@ -147,12 +158,12 @@ func (m *MockRepository) Create(ctx context.Context, u *users.User) (*users.User
 	return nil, nil
 }
 
-func (m *MockRepository) Update(ctx context.Context, u *users.User) (*users.User, error) {
-	return nil, nil
+func (m *MockRepository) Update(ctx context.Context, u *users.User) error {
+	return nil
 }
 
-func (m *MockRepository) Delete(ctx context.Context, u *users.User) (*users.User, error) {
-	return nil, nil
+func (m *MockRepository) Delete(ctx context.Context, u *users.User) error {
+	return nil
 }
 
 func listUsers() []*users.User {
@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/handlers"
+	"time"
+)
+
+// RollupEvents counts ingested events into the hourly pin and board stats
+// every interval. It blocks, so it is meant to be started on its own goroutine.
+func RollupEvents(ctx context.Context, handler *handlers.EventHandler, interval time.Duration) {
+	Every(ctx, interval, func(ctx context.Context) {
+		// Errors are logged by the handler; the next tick recounts the same hours.
+		_ = handler.HandleRollup(ctx, commands.RollupEventsCommand{Now: time.Now()})
+	})
+}
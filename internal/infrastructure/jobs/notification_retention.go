@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/handlers"
+	"time"
+)
+
+// PruneNotifications keeps the notifications table bounded. It blocks, so it
+// is meant to be started on its own goroutine.
+func PruneNotifications(ctx context.Context, handler *handlers.NotificationHandler, maxAge time.Duration, keepPerUser int, interval time.Duration) {
+	Every(ctx, interval, func(ctx context.Context) {
+		cmd := commands.PruneNotificationsCommand{
+			OlderThan:        time.Now().Add(-maxAge),
+			KeepPerRecipient: keepPerUser,
+		}
+
+		// Errors are logged by the handler; the next tick simply tries again.
+		_, _ = handler.HandlePrune(ctx, cmd)
+	})
+}
@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/recommendation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/recommendation/handlers"
+	"time"
+)
+
+// relatedPinsBatch caps how many pins one run refreshes. Pins left out are
+// ranked when first requested.
+const relatedPinsBatch = 1000
+
+// RefreshRelatedPins ranks again the related pins of the pins touched lately.
+// Each run looks back two intervals so a slow run leaves no gap. It blocks, so
+// it is meant to be started on its own goroutine.
+func RefreshRelatedPins(ctx context.Context, handler *handlers.RecommendationHandler, interval time.Duration) {
+	Every(ctx, interval, func(ctx context.Context) {
+		cmd := commands.RefreshRelatedPinsCommand{
+			Since: time.Now().Add(-2 * interval),
+			Limit: relatedPinsBatch,
+		}
+
+		// Errors are logged by the handler; the next tick simply tries again.
+		_, _ = handler.HandleRefresh(ctx, cmd)
+	})
+}
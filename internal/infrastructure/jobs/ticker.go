@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// Every runs fn straight away and then once per interval until ctx is done.
+// Runs never overlap: a slow run delays the next tick instead of stacking up.
+func Every(ctx context.Context, interval time.Duration, fn func(context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runs := 0
+	done := make(chan struct{})
+	go func() {
+		Every(ctx, time.Millisecond, func(ctx context.Context) {
+			runs++
+			if runs == 3 {
+				cancel()
+			}
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Every did not stop after the context was cancelled")
+	}
+
+	assert.Equal(t, 3, runs)
+}
@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/handlers"
+	"time"
+)
+
+// RefreshTrends scores trending pins and tags every interval. It blocks, so it
+// is meant to be started on its own goroutine.
+func RefreshTrends(ctx context.Context, handler *handlers.TrendHandler, interval time.Duration) {
+	Every(ctx, interval, func(ctx context.Context) {
+		// Errors are logged by the handler; the next tick simply tries again.
+		_ = handler.HandleRefresh(ctx, commands.RefreshTrendsCommand{Now: time.Now()})
+	})
+}
@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetAnalyticsDaily = `SELECT DATE_TRUNC('day', s.hour) AS day, SUM(s.impressions), SUM(s.closeups), SUM(s.outbound_clicks), SUM(s.saves), SUM(s.shares)
+							  FROM pin_stats_hourly s
+							  JOIN pins p ON p.id = s.pin_id
+							  WHERE p.user_id = $1 AND ($2::uuid IS NULL OR p.id = $2::uuid)
+							  AND s.hour >= $3 AND s.hour < $4
+							  GROUP BY day
+							  ORDER BY day`
+	QueryGetAnalyticsTopPins = `SELECT p.id, p.title, SUM(s.impressions), SUM(s.closeups), SUM(s.outbound_clicks), SUM(s.saves), SUM(s.shares)
+								FROM pin_stats_hourly s
+								JOIN pins p ON p.id = s.pin_id
+								WHERE p.user_id = $1 AND p.deleted_at IS NULL
+								AND s.hour >= $2 AND s.hour < $3
+								GROUP BY p.id, p.title
+								ORDER BY SUM(s.closeups + s.outbound_clicks + s.saves + s.shares) DESC, SUM(s.impressions) DESC, p.id
+								LIMIT $4`
+	QueryGetAnalyticsTopBoards = `SELECT b.id, b.name, SUM(s.impressions), SUM(s.closeups), SUM(s.outbound_clicks), SUM(s.saves), SUM(s.shares)
+								  FROM board_stats_hourly s
+								  JOIN boards b ON b.id = s.board_id
+								  WHERE b.user_id = $1 AND b.deleted_at IS NULL
+								  AND s.hour >= $2 AND s.hour < $3
+								  GROUP BY b.id, b.name
+								  ORDER BY SUM(s.closeups + s.outbound_clicks + s.saves + s.shares) DESC, SUM(s.impressions) DESC, b.id
+								  LIMIT $4`
+	// The audience is read from the raw events, as only they know who came
+	// across a pin. Owners looking at their own pins are not part of it.
+	QueryGetAnalyticsAudienceByCountry = `SELECT u.country, COUNT(DISTINCT e.user_id)
+										  FROM events e
+										  JOIN pins p ON p.id = e.pin_id
+										  JOIN users u ON u.id = e.user_id
+										  WHERE p.user_id = $1 AND ($2::uuid IS NULL OR p.id = $2::uuid) AND e.user_id <> $1
+										  AND e.occurred_at >= $3 AND e.occurred_at < $4
+										  GROUP BY u.country
+										  ORDER BY COUNT(DISTINCT e.user_id) DESC, u.country
+										  LIMIT $5`
+	QueryGetAnalyticsAudienceByLanguage = `SELECT u.language, COUNT(DISTINCT e.user_id)
+										   FROM events e
+										   JOIN pins p ON p.id = e.pin_id
+										   JOIN users u ON u.id = e.user_id
+										   WHERE p.user_id = $1 AND ($2::uuid IS NULL OR p.id = $2::uuid) AND e.user_id <> $1
+										   AND e.occurred_at >= $3 AND e.occurred_at < $4
+										   GROUP BY u.language
+										   ORDER BY COUNT(DISTINCT e.user_id) DESC, u.language
+										   LIMIT $5`
+)
+
+type analyticsRepository struct {
+	DB *sql.DB
+}
+
+func NewAnalyticsRepository(db *sql.DB) analytics.AnalyticsRepository {
+	return &analyticsRepository{
+		DB: db,
+	}
+}
+
+func (r analyticsRepository) GetDaily(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, rng analytics.Range) ([]analytics.Day, error) {
+	var (
+		days                                                 []analytics.Day
+		date                                                 time.Time
+		impressions, closeups, outboundClicks, saves, shares int
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetAnalyticsDaily, ownerId, pinId, rng.From(), rng.End())
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&date, &impressions, &closeups, &outboundClicks, &saves, &shares); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		days = append(days, analytics.NewDay(date, analytics.NewStats(impressions, closeups, outboundClicks, saves, shares)))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return days, nil
+}
+
+func (r analyticsRepository) GetTopPins(ctx context.Context, ownerId uuid.UUID, rng analytics.Range, limit int) ([]analytics.PinStats, error) {
+	var (
+		pinsList                                             []analytics.PinStats
+		id                                                   uuid.UUID
+		title                                                string
+		impressions, closeups, outboundClicks, saves, shares int
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetAnalyticsTopPins, ownerId, rng.From(), rng.End(), limit)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &title, &impressions, &closeups, &outboundClicks, &saves, &shares); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		pinsList = append(pinsList, analytics.NewPinStats(id, title, analytics.NewStats(impressions, closeups, outboundClicks, saves, shares)))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return pinsList, nil
+}
+
+func (r analyticsRepository) GetTopBoards(ctx context.Context, ownerId uuid.UUID, rng analytics.Range, limit int) ([]analytics.BoardStats, error) {
+	var (
+		boardsList                                           []analytics.BoardStats
+		id                                                   uuid.UUID
+		name                                                 string
+		impressions, closeups, outboundClicks, saves, shares int
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetAnalyticsTopBoards, ownerId, rng.From(), rng.End(), limit)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &name, &impressions, &closeups, &outboundClicks, &saves, &shares); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		boardsList = append(boardsList, analytics.NewBoardStats(id, name, analytics.NewStats(impressions, closeups, outboundClicks, saves, shares)))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return boardsList, nil
+}
+
+func (r analyticsRepository) GetAudience(ctx context.Context, ownerId uuid.UUID, pinId *uuid.UUID, dimension analytics.Dimension, rng analytics.Range, limit int) ([]analytics.Segment, error) {
+	var (
+		segments []analytics.Segment
+		code     string
+		users    int
+	)
+
+	query := QueryGetAnalyticsAudienceByCountry
+	if dimension == analytics.LanguageDimension {
+		query = QueryGetAnalyticsAudienceByLanguage
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, ownerId, pinId, rng.From(), rng.End(), limit)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&code, &users); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		segments = append(segments, analytics.NewSegment(code, users))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return segments, nil
+}
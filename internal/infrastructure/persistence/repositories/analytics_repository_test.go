@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func analyticsRange(t *testing.T) analytics.Range {
+	rng, err := analytics.NewRange(time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 7, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	return rng
+}
+
+func TestAnalyticsRepository_GetDaily(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	ownerId, pinId := uuid.New(), uuid.New()
+	rng := analyticsRange(t)
+	day := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsDaily)).WithArgs(ownerId, &pinId, rng.From(), rng.End()).WillReturnRows(
+		sqlmock.NewRows([]string{"day", "impressions", "closeups", "outbound_clicks", "saves", "shares"}).
+			AddRow(day, 120, 8, 3, 2, 1),
+	)
+
+	days, err := repo.GetDaily(ctx, ownerId, &pinId, rng)
+
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, day, days[0].Date())
+	assert.Equal(t, 120, days[0].Stats().Impressions())
+	assert.Equal(t, 14, days[0].Stats().Engagements())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetDaily_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsDaily)).WillReturnError(ErrDatabase)
+
+	days, err := repo.GetDaily(ctx, uuid.New(), nil, analyticsRange(t))
+
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.Nil(t, days)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetTopPins(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	ownerId, pinId := uuid.New(), uuid.New()
+	rng := analyticsRange(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsTopPins)).WithArgs(ownerId, rng.From(), rng.End(), 10).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "title", "impressions", "closeups", "outbound_clicks", "saves", "shares"}).
+			AddRow(pinId, "Walnut cabinets", 300, 20, 5, 9, 2),
+	)
+
+	pinsList, err := repo.GetTopPins(ctx, ownerId, rng, 10)
+
+	require.NoError(t, err)
+	require.Len(t, pinsList, 1)
+	assert.Equal(t, pinId, pinsList[0].PinId())
+	assert.Equal(t, "Walnut cabinets", pinsList[0].Title())
+	assert.Equal(t, 9, pinsList[0].Stats().Saves())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetTopBoards(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	ownerId, boardId := uuid.New(), uuid.New()
+	rng := analyticsRange(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsTopBoards)).WithArgs(ownerId, rng.From(), rng.End(), 10).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "impressions", "closeups", "outbound_clicks", "saves", "shares"}).
+			AddRow(boardId, "Kitchen", 500, 30, 10, 12, 3),
+	)
+
+	boardsList, err := repo.GetTopBoards(ctx, ownerId, rng, 10)
+
+	require.NoError(t, err)
+	require.Len(t, boardsList, 1)
+	assert.Equal(t, boardId, boardsList[0].BoardId())
+	assert.Equal(t, "Kitchen", boardsList[0].Name())
+	assert.Equal(t, 10, boardsList[0].Stats().OutboundClicks())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetAudience(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	ownerId := uuid.New()
+	rng := analyticsRange(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsAudienceByCountry)).WithArgs(ownerId, nil, rng.From(), rng.End(), 10).WillReturnRows(
+		sqlmock.NewRows([]string{"country", "count"}).AddRow("BO", 40).AddRow("AR", 12),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsAudienceByLanguage)).WithArgs(ownerId, nil, rng.From(), rng.End(), 10).WillReturnRows(
+		sqlmock.NewRows([]string{"language", "count"}).AddRow("es", 52),
+	)
+
+	countries, err := repo.GetAudience(ctx, ownerId, nil, analytics.CountryDimension, rng, 10)
+	require.NoError(t, err)
+	languages, err := repo.GetAudience(ctx, ownerId, nil, analytics.LanguageDimension, rng, 10)
+	require.NoError(t, err)
+
+	require.Len(t, countries, 2)
+	assert.Equal(t, "BO", countries[0].Code())
+	assert.Equal(t, 40, countries[0].Users())
+	require.Len(t, languages, 1)
+	assert.Equal(t, "es", languages[0].Code())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetAudience_ScanError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAnalyticsRepository(db)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAnalyticsAudienceByCountry)).WillReturnRows(
+		sqlmock.NewRows([]string{"country", "count"}).AddRow("BO", "many"),
+	)
+
+	segments, err := repo.GetAudience(ctx, uuid.New(), nil, analytics.CountryDimension, analyticsRange(t), 10)
+
+	assert.ErrorIs(t, err, ErrScan)
+	assert.Nil(t, segments)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetListBlocksByBlockerId = `SELECT blocked_id, created_at
+									 FROM user_blocks
+									 WHERE blocker_id = $1
+									 ORDER BY created_at DESC`
+	QueryExistBlock = `SELECT EXISTS(
+							SELECT 1
+							FROM user_blocks
+							WHERE blocker_id = $1 AND blocked_id = $2)`
+	QueryExistBlockAmong = `SELECT EXISTS(
+								SELECT 1
+								FROM user_blocks
+								WHERE blocker_id = ANY($1) AND blocked_id = ANY($1))`
+	QueryCreateBlock = `INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+						VALUES ($1, $2, $3)
+						ON CONFLICT DO NOTHING`
+	QueryDeleteFollowsBetween = `DELETE FROM follows
+								 WHERE (follower_id = $1 AND followee_id = $2) OR (follower_id = $2 AND followee_id = $1)`
+	QueryDeleteBlock = `DELETE FROM user_blocks
+						WHERE blocker_id = $1 AND blocked_id = $2`
+)
+
+type blockRepository struct {
+	DB *sql.DB
+}
+
+func NewBlockRepository(db *sql.DB) blocks.BlockRepository {
+	return &blockRepository{
+		DB: db,
+	}
+}
+
+func (r blockRepository) GetListByBlockerId(ctx context.Context, blockerId uuid.UUID) ([]*blocks.Block, error) {
+	var (
+		blocksList []*blocks.Block
+		blockedId  uuid.UUID
+		createdAt  time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListBlocksByBlockerId, blockerId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&blockedId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		blocksList = append(blocksList, blocks.NewBlockFromDB(blockerId, blockedId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return blocksList, nil
+}
+
+func (r blockRepository) Exists(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistBlock, blockerId, blockedId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r blockRepository) ExistsAmong(ctx context.Context, userIds []uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistBlockAmong, pq.Array(userIds)).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r blockRepository) Create(ctx context.Context, b *blocks.Block) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, QueryCreateBlock, b.BlockerId(), b.BlockedId(), b.CreatedAt()); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryDeleteFollowsBetween, b.BlockerId(), b.BlockedId()); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r blockRepository) Delete(ctx context.Context, b *blocks.Block) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteBlock, b.BlockerId(), b.BlockedId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
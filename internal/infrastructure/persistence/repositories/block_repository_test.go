@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestBlockRepository_Create_DropsFollows(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewBlockRepository(db)
+	block, err := blocks.NewBlock(uuid.New(), uuid.New())
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateBlock)).WithArgs(block.BlockerId(), block.BlockedId(), block.CreatedAt()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteFollowsBetween)).WithArgs(block.BlockerId(), block.BlockedId()).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = repo.Create(ctx, block)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_ExistsAmong(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewBlockRepository(db)
+	userIds := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistBlockAmong)).WithArgs(pq.Array(userIds)).WillReturnRows(
+		sqlmock.NewRows([]string{"exists"}).AddRow(true),
+	)
+
+	exist, err := repo.ExistsAmong(ctx, userIds)
+
+	require.NoError(t, err)
+	assert.True(t, exist)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/google/uuid"
+)
+
+const (
+	QueryExistBoardFollow = `SELECT EXISTS(
+							SELECT 1
+							FROM board_follows
+							WHERE user_id = $1 AND board_id = $2)`
+	QueryCreateBoardFollow = `INSERT INTO board_follows (user_id, board_id, created_at)
+							VALUES ($1, $2, $3)
+							ON CONFLICT DO NOTHING`
+	QueryDeleteBoardFollow = `DELETE FROM board_follows
+							WHERE user_id = $1 AND board_id = $2`
+)
+
+type boardFollowRepository struct {
+	DB *sql.DB
+}
+
+func NewBoardFollowRepository(db *sql.DB) follows.BoardFollowRepository {
+	return &boardFollowRepository{
+		DB: db,
+	}
+}
+
+func (r boardFollowRepository) Exists(ctx context.Context, userId, boardId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistBoardFollow, userId, boardId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r boardFollowRepository) Create(ctx context.Context, f *follows.BoardFollow) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateBoardFollow, f.UserId(), f.BoardId(), f.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r boardFollowRepository) Delete(ctx context.Context, f *follows.BoardFollow) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteBoardFollow, f.UserId(), f.BoardId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -275,8 +275,8 @@ func (r boardRepository) Create(ctx context.Context, b *boards.Board) (*boards.B
 }
 
 func (r boardRepository) Update(ctx context.Context, b *boards.Board) error {
-	err := r.DB.QueryRowContext(ctx, QueryCreateBoard,
-		b.Id(), b.UserId(), b.Name(), b.Description(), b.Visibility(), b.PinCount(), b.Portrait(), b.CreatedAt(), b.UpdatedAt(),
+	_, err := r.DB.ExecContext(ctx, QueryUpdateBoard,
+		b.Id(), b.Name(), b.Description(), b.Visibility(), b.PinCount(), b.Portrait(), b.UpdatedAt(),
 	)
 
 	if err != nil {
@@ -287,7 +287,7 @@ func (r boardRepository) Update(ctx context.Context, b *boards.Board) error {
 }
 
 func (r boardRepository) Delete(ctx context.Context, b *boards.Board) error {
-	err := r.DB.QueryRowContext(ctx, QueryDeleteBoard, b.Id(), b.DeletedAt())
+	_, err := r.DB.ExecContext(ctx, QueryDeleteBoard, b.Id(), b.DeletedAt())
 	if err != nil {
 		return fmt.Errorf(got, ErrQuery, err)
 	}
@@ -0,0 +1,365 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetAllCategories = `WITH RECURSIVE tree AS (
+								SELECT id, parent_id, slug, name, description, created_at, updated_at, ARRAY[LOWER(name)]::text[] AS names
+								FROM categories
+								WHERE parent_id IS NULL
+								UNION ALL
+								SELECT c.id, c.parent_id, c.slug, c.name, c.description, c.created_at, c.updated_at, t.names || LOWER(c.name)::text
+								FROM categories c
+								JOIN tree t ON t.id = c.parent_id)
+							 SELECT id, parent_id, slug, name, description, created_at, updated_at
+							 FROM tree
+							 ORDER BY names`
+	QueryGetCategoryById = `SELECT id, parent_id, slug, name, description, created_at, updated_at
+							FROM categories
+							WHERE id = $1`
+	QueryGetCategoryBySlug = `SELECT id, parent_id, slug, name, description, created_at, updated_at
+							  FROM categories
+							  WHERE slug = $1`
+	QueryGetCategoryPath = `WITH RECURSIVE ancestors AS (
+								SELECT id, parent_id, slug, name, description, created_at, updated_at, 0 AS depth
+								FROM categories
+								WHERE id = $1
+								UNION ALL
+								SELECT c.id, c.parent_id, c.slug, c.name, c.description, c.created_at, c.updated_at, a.depth + 1
+								FROM categories c
+								JOIN ancestors a ON a.parent_id = c.id)
+							SELECT id, parent_id, slug, name, description, created_at, updated_at
+							FROM ancestors
+							ORDER BY depth DESC`
+	QueryGetCategoryChildren = `SELECT id, parent_id, slug, name, description, created_at, updated_at
+								FROM categories
+								WHERE parent_id = $1
+								ORDER BY LOWER(name)`
+	QueryGetCategoryHeight = `WITH RECURSIVE subtree AS (
+								SELECT id, 1 AS level
+								FROM categories
+								WHERE id = $1
+								UNION ALL
+								SELECT c.id, s.level + 1
+								FROM categories c
+								JOIN subtree s ON c.parent_id = s.id)
+							  SELECT COALESCE(MAX(level), 0)
+							  FROM subtree`
+	QueryExistCategoryBySlug = `SELECT EXISTS(
+								SELECT 1
+								FROM categories
+								WHERE slug = $1)`
+	QueryHasCategoryChildren = `SELECT EXISTS(
+								SELECT 1
+								FROM categories
+								WHERE parent_id = $1)`
+	QueryGetCategoryTags = `SELECT t.id, t.name, t.created_at, t.deleted_at
+							FROM tags t
+							JOIN categories_tags ct ON ct.tag_id = t.id
+							WHERE ct.category_id = $1
+							ORDER BY t.name`
+	QueryExistCategoryTag = `SELECT EXISTS(
+								SELECT 1
+								FROM categories_tags
+								WHERE category_id = $1 AND tag_id = $2)`
+	QueryCreateCategoryTag = `INSERT INTO categories_tags (category_id, tag_id)
+							  VALUES ($1, $2)
+							  ON CONFLICT DO NOTHING`
+	QueryDeleteCategoryTag = `DELETE FROM categories_tags
+							  WHERE category_id = $1 AND tag_id = $2`
+	// QueryGetCategoryRankedPins scores the pins tagged into category $1 or
+	// its subcategories that viewer $2 may see: the log of their saves,
+	// comments and likes weighted by $7, $8 and $9, plus their age in periods
+	// of $6 seconds. Pages start after score $3 and pin $4 and hold $5 pins.
+	QueryGetCategoryRankedPins = `WITH RECURSIVE subtree AS (
+									SELECT id
+									FROM categories
+									WHERE id = $1
+									UNION ALL
+									SELECT c.id
+									FROM categories c
+									JOIN subtree s ON c.parent_id = s.id),
+								 ranked AS (
+									SELECT p.id,
+										(LOG(1 + $7::float8 * COALESCE(p.save_count, 0) + $8::float8 * COALESCE(p.comment_count, 0) + $9::float8 * COALESCE(p.like_count, 0)) +
+											EXTRACT(EPOCH FROM p.created_at) / $6::float8)::float8 AS score
+									FROM pins p
+									JOIN boards b ON b.id = p.board_id
+									WHERE p.deleted_at IS NULL AND b.deleted_at IS NULL AND ((p.visibility AND b.visibility) OR p.user_id = $2)
+									AND EXISTS(
+										SELECT 1
+										FROM pins_tags pt
+										JOIN tags t ON t.id = pt.tag_id
+										JOIN categories_tags ct ON ct.tag_id = pt.tag_id
+										JOIN subtree s ON s.id = ct.category_id
+										WHERE pt.pin_id = p.id AND t.deleted_at IS NULL)
+									AND NOT EXISTS(
+										SELECT 1
+										FROM user_blocks ub
+										WHERE (ub.blocker_id = $2 AND ub.blocked_id = p.user_id) OR (ub.blocker_id = p.user_id AND ub.blocked_id = $2))
+									AND NOT EXISTS(
+										SELECT 1
+										FROM user_mutes m
+										WHERE m.muter_id = $2 AND m.muted_id = p.user_id))
+								 SELECT id, score
+								 FROM ranked
+								 WHERE $3::float8 IS NULL OR (score, id) < ($3::float8, $4::uuid)
+								 ORDER BY score DESC, id DESC
+								 LIMIT $5`
+	QueryCreateCategory = `INSERT INTO categories (id, parent_id, slug, name, description, created_at, updated_at)
+						   VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	QueryUpdateCategory = `UPDATE categories
+						   SET parent_id = $2, slug = $3, name = $4, description = $5, updated_at = $6
+						   WHERE id = $1`
+	QueryDeleteCategory = `DELETE FROM categories
+						   WHERE id = $1`
+)
+
+type categoryRepository struct {
+	DB *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) categories.CategoryRepository {
+	return &categoryRepository{
+		DB: db,
+	}
+}
+
+func (r categoryRepository) GetAll(ctx context.Context) ([]*categories.Category, error) {
+	return r.queryCategories(ctx, QueryGetAllCategories)
+}
+
+func (r categoryRepository) GetById(ctx context.Context, id uuid.UUID) (*categories.Category, error) {
+	return r.queryCategory(ctx, QueryGetCategoryById, id)
+}
+
+func (r categoryRepository) GetBySlug(ctx context.Context, slug string) (*categories.Category, error) {
+	return r.queryCategory(ctx, QueryGetCategoryBySlug, slug)
+}
+
+func (r categoryRepository) GetPath(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	return r.queryCategories(ctx, QueryGetCategoryPath, id)
+}
+
+func (r categoryRepository) GetChildren(ctx context.Context, id uuid.UUID) ([]*categories.Category, error) {
+	return r.queryCategories(ctx, QueryGetCategoryChildren, id)
+}
+
+func (r categoryRepository) GetHeight(ctx context.Context, id uuid.UUID) (int, error) {
+	var height int
+
+	err := r.DB.QueryRowContext(ctx, QueryGetCategoryHeight, id).Scan(&height)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return height, nil
+}
+
+func (r categoryRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	return r.queryExists(ctx, QueryExistCategoryBySlug, slug)
+}
+
+func (r categoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.queryExists(ctx, QueryHasCategoryChildren, id)
+}
+
+func (r categoryRepository) GetTags(ctx context.Context, id uuid.UUID) ([]pins.Tag, error) {
+	var (
+		tags      []pins.Tag
+		tagId     uuid.UUID
+		name      string
+		createdAt time.Time
+		deletedAt *time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetCategoryTags, id)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&tagId, &name, &createdAt, &deletedAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		tags = append(tags, *pins.NewTagFromDB(tagId, name, createdAt, deletedAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return tags, nil
+}
+
+func (r categoryRepository) ExistsTag(ctx context.Context, id, tagId uuid.UUID) (bool, error) {
+	return r.queryExists(ctx, QueryExistCategoryTag, id, tagId)
+}
+
+func (r categoryRepository) AddTag(ctx context.Context, id, tagId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateCategoryTag, id, tagId)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r categoryRepository) RemoveTag(ctx context.Context, id, tagId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteCategoryTag, id, tagId)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r categoryRepository) GetRankedPins(ctx context.Context, id, viewerId uuid.UUID, after *categories.Cursor, limit int) ([]categories.RankedPin, error) {
+	var (
+		ranked               []categories.RankedPin
+		pinId                uuid.UUID
+		score                float64
+		afterScore, afterPin any
+	)
+
+	if after != nil {
+		afterScore, afterPin = after.Score(), after.Id()
+	}
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetCategoryRankedPins, id, viewerId, afterScore, afterPin, limit,
+		categories.HotPeriod.Seconds(), trends.SaveWeight, trends.CommentWeight, trends.LikeWeight)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&pinId, &score); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		ranked = append(ranked, categories.NewRankedPin(pinId, score))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return ranked, nil
+}
+
+func (r categoryRepository) Create(ctx context.Context, c *categories.Category) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateCategory, c.Id(), c.ParentId(), c.Slug(), c.Name(), c.Description(), c.CreatedAt(), c.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r categoryRepository) Update(ctx context.Context, c *categories.Category) error {
+	_, err := r.DB.ExecContext(ctx, QueryUpdateCategory, c.Id(), c.ParentId(), c.Slug(), c.Name(), c.Description(), c.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r categoryRepository) Delete(ctx context.Context, c *categories.Category) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteCategory, c.Id())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+// queryCategory reads a single category, a missing row being
+// ErrNotFoundCategory.
+func (r categoryRepository) queryCategory(ctx context.Context, query string, args ...any) (*categories.Category, error) {
+	var (
+		id                   uuid.UUID
+		parentId             *uuid.UUID
+		slug, name           string
+		description          *string
+		createdAt, updatedAt time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, query, args...).Scan(&id, &parentId, &slug, &name, &description, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, categories.ErrNotFoundCategory
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return categories.NewCategoryFromDB(id, parentId, slug, name, description, createdAt, updatedAt), nil
+}
+
+func (r categoryRepository) queryCategories(ctx context.Context, query string, args ...any) ([]*categories.Category, error) {
+	var (
+		categoriesList       []*categories.Category
+		id                   uuid.UUID
+		parentId             *uuid.UUID
+		slug, name           string
+		description          *string
+		createdAt, updatedAt time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &parentId, &slug, &name, &description, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		categoriesList = append(categoriesList, categories.NewCategoryFromDB(id, parentId, slug, name, description, createdAt, updatedAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return categoriesList, nil
+}
+
+func (r categoryRepository) queryExists(ctx context.Context, query string, args ...any) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, query, args...).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
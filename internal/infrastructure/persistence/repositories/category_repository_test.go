@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var categoryColumns = []string{"id", "parent_id", "slug", "name", "description", "created_at", "updated_at"}
+
+func TestCategoryRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	homeId, kitchenId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllCategories)).
+		WillReturnRows(sqlmock.NewRows(categoryColumns).
+			AddRow(homeId, nil, "home", "Home", nil, now, now).
+			AddRow(kitchenId, homeId, "kitchen", "Kitchen", "Cooking spaces", now, now))
+
+	list, err := repo.GetAll(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Nil(t, list[0].ParentId())
+	assert.Equal(t, "home", list[0].Slug())
+	assert.Equal(t, &homeId, list[1].ParentId())
+	assert.Equal(t, "Cooking spaces", *list[1].Description())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_GetBySlug_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCategoryBySlug)).
+		WithArgs("cabinets").
+		WillReturnError(sql.ErrNoRows)
+
+	category, err := repo.GetBySlug(ctx, "cabinets")
+
+	assert.Nil(t, category)
+	assert.ErrorIs(t, err, categories.ErrNotFoundCategory)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_GetHeight(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCategoryHeight)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"height"}).AddRow(3))
+
+	height, err := repo.GetHeight(ctx, id)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, height)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_GetRankedPins(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	id, viewerId, first, second := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	after := categories.NewCursor(categories.NewRankedPin(uuid.New(), 2900.5))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCategoryRankedPins)).
+		WithArgs(id, viewerId, 2900.5, after.Id(), 10, categories.HotPeriod.Seconds(), trends.SaveWeight, trends.CommentWeight, trends.LikeWeight).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "score"}).
+			AddRow(first, 2900.4).
+			AddRow(second, 2899.9))
+
+	ranked, err := repo.GetRankedPins(ctx, id, viewerId, after, 10)
+
+	require.NoError(t, err)
+	require.Len(t, ranked, 2)
+	assert.Equal(t, first, ranked[0].PinId())
+	assert.Equal(t, 2900.4, ranked[0].Score())
+	assert.Equal(t, second, ranked[1].PinId())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_GetRankedPins_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	id, viewerId := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCategoryRankedPins)).
+		WithArgs(id, viewerId, nil, nil, 10, categories.HotPeriod.Seconds(), trends.SaveWeight, trends.CommentWeight, trends.LikeWeight).
+		WillReturnError(errors.New("db down"))
+
+	ranked, err := repo.GetRankedPins(ctx, id, viewerId, nil, 10)
+
+	assert.Nil(t, ranked)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_CreateAndTags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCategoryRepository(db)
+	category := categories.NewCategory(nil, "home", "Home", nil)
+	tagId := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateCategory)).
+		WithArgs(category.Id(), category.ParentId(), "home", "Home", category.Description(), category.CreatedAt(), category.UpdatedAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateCategoryTag)).
+		WithArgs(category.Id(), tagId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCategoryTags)).
+		WithArgs(category.Id()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at", "deleted_at"}).AddRow(tagId, "kitchen", time.Now(), nil))
+
+	require.NoError(t, repo.Create(ctx, category))
+	require.NoError(t, repo.AddTag(ctx, category.Id(), tagId))
+	tags, err := repo.GetTags(ctx, category.Id())
+
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "kitchen", tags[0].Name())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetListCommentsByPinId = `SELECT id, user_id, content, created_at, updated_at, deleted_at
+								   FROM comments
+								   WHERE pin_id = $1 AND deleted_at IS NULL
+								   AND NOT EXISTS(
+									SELECT 1
+									FROM user_blocks b
+									WHERE (b.blocker_id = $2 AND b.blocked_id = comments.user_id) OR (b.blocker_id = comments.user_id AND b.blocked_id = $2))
+								   ORDER BY created_at`
+	QueryGetCommentById = `SELECT id, pin_id, user_id, content, created_at, updated_at, deleted_at
+						   FROM comments
+						   WHERE id = $1`
+	QueryCreateComment = `INSERT INTO comments (id, pin_id, user_id, content, created_at, updated_at)
+						  VALUES ($1, $2, $3, $4, $5, $6)
+						  RETURNING id, pin_id, user_id, content, created_at, updated_at, deleted_at`
+	QueryUpdateComment = `UPDATE comments
+						  SET content = $2, updated_at = $3
+						  WHERE id = $1 AND deleted_at IS NULL`
+	QueryDeleteComment = `UPDATE comments
+						  SET deleted_at = $2
+						  WHERE id = $1 AND deleted_at IS NULL`
+)
+
+type commentRepository struct {
+	DB *sql.DB
+}
+
+func NewCommentRepository(db *sql.DB) comments.CommentRepository {
+	return &commentRepository{
+		DB: db,
+	}
+}
+
+func (r commentRepository) GetListByPinId(ctx context.Context, pinId, viewerId uuid.UUID) ([]*comments.Comment, error) {
+	var (
+		commentsList         []*comments.Comment
+		id, userId           uuid.UUID
+		content              string
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListCommentsByPinId, pinId, viewerId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&id, &userId, &content, &createdAt, &updatedAt, &deletedAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		comment := comments.NewCommentFromDB(id, pinId, userId, content, createdAt, updatedAt, deletedAt)
+		commentsList = append(commentsList, comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return commentsList, nil
+}
+
+func (r commentRepository) GetById(ctx context.Context, id uuid.UUID) (*comments.Comment, error) {
+	var (
+		commentId, pinId, userId uuid.UUID
+		content                  string
+		createdAt, updatedAt     time.Time
+		deletedAt                *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetCommentById, id).Scan(&commentId, &pinId, &userId, &content, &createdAt, &updatedAt, &deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return comments.NewCommentFromDB(commentId, pinId, userId, content, createdAt, updatedAt, deletedAt), nil
+}
+
+func (r commentRepository) Create(ctx context.Context, c *comments.Comment) (*comments.Comment, error) {
+	var (
+		id, pinId, userId    uuid.UUID
+		content              string
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryCreateComment,
+		c.Id(), c.PinId(), c.UserId(), c.Content(), c.CreatedAt(), c.UpdatedAt(),
+	).Scan(
+		&id, &pinId, &userId, &content, &createdAt, &updatedAt, &deletedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return comments.NewCommentFromDB(id, pinId, userId, content, createdAt, updatedAt, deletedAt), nil
+}
+
+func (r commentRepository) Update(ctx context.Context, c *comments.Comment) error {
+	_, err := r.DB.ExecContext(ctx, QueryUpdateComment, c.Id(), c.Content(), c.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r commentRepository) Delete(ctx context.Context, c *comments.Comment) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteComment, c.Id(), c.DeletedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetListConversationsByUserId = `SELECT c.id, c.creator_id, c.title, c.is_group, c.last_message_at, c.created_at, c.updated_at
+										 FROM conversations c
+										 JOIN conversation_members cm ON cm.conversation_id = c.id
+										 WHERE cm.user_id = $1
+										 ORDER BY COALESCE(c.last_message_at, c.created_at) DESC`
+	QueryGetConversationById = `SELECT id, creator_id, title, is_group, last_message_at, created_at, updated_at
+								FROM conversations
+								WHERE id = $1`
+	QueryGetDirectConversation = `SELECT c.id, c.creator_id, c.title, c.is_group, c.last_message_at, c.created_at, c.updated_at
+								  FROM conversations c
+								  JOIN conversation_members a ON a.conversation_id = c.id AND a.user_id = $1
+								  JOIN conversation_members b ON b.conversation_id = c.id AND b.user_id = $2
+								  WHERE c.is_group = FALSE
+								  LIMIT 1`
+	QueryGetMembersByConversationIds = `SELECT conversation_id, user_id, last_read_at, joined_at
+										FROM conversation_members
+										WHERE conversation_id = ANY($1)
+										ORDER BY joined_at`
+	QueryCreateConversation = `INSERT INTO conversations (id, creator_id, title, is_group, created_at, updated_at)
+							   VALUES ($1, $2, $3, $4, $5, $6)`
+	QueryCreateConversationMember = `INSERT INTO conversation_members (conversation_id, user_id, joined_at)
+									 VALUES ($1, $2, $3)`
+	QueryMarkConversationRead = `UPDATE conversation_members
+								 SET last_read_at = GREATEST(COALESCE(last_read_at, $3), $3)
+								 WHERE conversation_id = $1 AND user_id = $2`
+)
+
+type conversationRepository struct {
+	DB *sql.DB
+}
+
+func NewConversationRepository(db *sql.DB) conversations.ConversationRepository {
+	return &conversationRepository{
+		DB: db,
+	}
+}
+
+func (r conversationRepository) GetListByUserId(ctx context.Context, userId uuid.UUID) ([]*conversations.Conversation, error) {
+	var (
+		conversationsList    []*conversations.Conversation
+		ids                  []uuid.UUID
+		id, creatorId        uuid.UUID
+		title                *string
+		isGroup              bool
+		lastMessageAt        *time.Time
+		createdAt, updatedAt time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListConversationsByUserId, userId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&id, &creatorId, &title, &isGroup, &lastMessageAt, &createdAt, &updatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		conversationsList = append(conversationsList, conversations.NewConversationFromDB(id, creatorId, title, isGroup, nil, lastMessageAt, createdAt, updatedAt))
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	if len(ids) == 0 {
+		return conversationsList, nil
+	}
+
+	members, err := r.membersByConversationIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range conversationsList {
+		conversationsList[i] = conversations.NewConversationFromDB(c.Id(), c.CreatorId(), c.Title(), c.IsGroup(), members[c.Id()], c.LastMessageAt(), c.CreatedAt(), c.UpdatedAt())
+	}
+
+	return conversationsList, nil
+}
+
+func (r conversationRepository) GetById(ctx context.Context, id uuid.UUID) (*conversations.Conversation, error) {
+	conversation, err := r.queryConversation(ctx, QueryGetConversationById, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, conversations.ErrNotFoundConversation
+	}
+	return conversation, err
+}
+
+func (r conversationRepository) GetDirect(ctx context.Context, userId, otherId uuid.UUID) (*conversations.Conversation, error) {
+	conversation, err := r.queryConversation(ctx, QueryGetDirectConversation, userId, otherId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return conversation, err
+}
+
+func (r conversationRepository) Create(ctx context.Context, c *conversations.Conversation) (*conversations.Conversation, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, QueryCreateConversation, c.Id(), c.CreatorId(), c.Title(), c.IsGroup(), c.CreatedAt(), c.UpdatedAt())
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	for _, member := range c.Members() {
+		if _, err = tx.ExecContext(ctx, QueryCreateConversationMember, c.Id(), member.UserId(), member.JoinedAt()); err != nil {
+			return nil, fmt.Errorf(got, ErrQuery, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return c, nil
+}
+
+func (r conversationRepository) MarkRead(ctx context.Context, conversationId, userId uuid.UUID, readAt time.Time) error {
+	_, err := r.DB.ExecContext(ctx, QueryMarkConversationRead, conversationId, userId, readAt)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+// queryConversation loads a single conversation with its members. A missing
+// row comes back as sql.ErrNoRows so callers can decide what it means.
+func (r conversationRepository) queryConversation(ctx context.Context, query string, args ...any) (*conversations.Conversation, error) {
+	var (
+		id, creatorId        uuid.UUID
+		title                *string
+		isGroup              bool
+		lastMessageAt        *time.Time
+		createdAt, updatedAt time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, query, args...).Scan(&id, &creatorId, &title, &isGroup, &lastMessageAt, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	members, err := r.membersByConversationIds(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return conversations.NewConversationFromDB(id, creatorId, title, isGroup, members[id], lastMessageAt, createdAt, updatedAt), nil
+}
+
+func (r conversationRepository) membersByConversationIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*conversations.Member, error) {
+	var (
+		conversationId, userId uuid.UUID
+		lastReadAt             *time.Time
+		joinedAt               time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetMembersByConversationIds, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	members := make(map[uuid.UUID][]*conversations.Member)
+	for rows.Next() {
+		if err = rows.Scan(&conversationId, &userId, &lastReadAt, &joinedAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		members[conversationId] = append(members[conversationId], conversations.NewMemberFromDB(userId, lastReadAt, joinedAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var conversationColumns = []string{"id", "creator_id", "title", "is_group", "last_message_at", "created_at", "updated_at"}
+
+func TestConversationRepository_GetListByUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewConversationRepository(db)
+	userId, otherId := uuid.New(), uuid.New()
+	directId, groupId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListConversationsByUserId)).WithArgs(userId).WillReturnRows(
+		sqlmock.NewRows(conversationColumns).
+			AddRow(directId, userId, nil, false, now, now, now).
+			AddRow(groupId, otherId, "Trip", true, nil, now, now),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetMembersByConversationIds)).WithArgs(pq.Array([]uuid.UUID{directId, groupId})).WillReturnRows(
+		sqlmock.NewRows([]string{"conversation_id", "user_id", "last_read_at", "joined_at"}).
+			AddRow(directId, userId, now, now).
+			AddRow(directId, otherId, nil, now).
+			AddRow(groupId, otherId, nil, now).
+			AddRow(groupId, userId, nil, now).
+			AddRow(groupId, uuid.New(), nil, now),
+	)
+
+	conversationsList, err := repo.GetListByUserId(ctx, userId)
+
+	require.NoError(t, err)
+	require.Len(t, conversationsList, 2)
+	assert.Equal(t, directId, conversationsList[0].Id())
+	assert.False(t, conversationsList[0].IsGroup())
+	assert.Len(t, conversationsList[0].Members(), 2)
+	assert.True(t, conversationsList[0].IsMember(otherId))
+	require.NotNil(t, conversationsList[1].Title())
+	assert.Equal(t, "Trip", *conversationsList[1].Title())
+	assert.Len(t, conversationsList[1].Members(), 3)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationRepository_GetById_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewConversationRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetConversationById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	conversation, err := repo.GetById(ctx, id)
+
+	assert.Nil(t, conversation)
+	assert.ErrorIs(t, err, conversations.ErrNotFoundConversation)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationRepository_GetDirect_None(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewConversationRepository(db)
+	userId, otherId := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDirectConversation)).WithArgs(userId, otherId).WillReturnError(sql.ErrNoRows)
+
+	conversation, err := repo.GetDirect(ctx, userId, otherId)
+
+	assert.NoError(t, err)
+	assert.Nil(t, conversation)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewConversationRepository(db)
+	c := conversations.NewConversation(uuid.New(), []uuid.UUID{uuid.New()}, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateConversation)).
+		WithArgs(c.Id(), c.CreatorId(), c.Title(), c.IsGroup(), c.CreatedAt(), c.UpdatedAt()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, member := range c.Members() {
+		mock.ExpectExec(regexp.QuoteMeta(QueryCreateConversationMember)).
+			WithArgs(c.Id(), member.UserId(), member.JoinedAt()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	created, err := repo.Create(ctx, c)
+
+	require.NoError(t, err)
+	assert.Equal(t, c, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationRepository_Create_MemberError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewConversationRepository(db)
+	c := conversations.NewConversation(uuid.New(), []uuid.UUID{uuid.New()}, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateConversation)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateConversationMember)).WillReturnError(ErrDatabase)
+	mock.ExpectRollback()
+
+	created, err := repo.Create(ctx, c)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_CountUnreadByUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewMessageRepository(db)
+	userId, first, second := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountUnreadMessagesByUserId)).WithArgs(userId).WillReturnRows(
+		sqlmock.NewRows([]string{"conversation_id", "count"}).AddRow(first, 3).AddRow(second, 1),
+	)
+
+	unread, err := repo.CountUnreadByUserId(ctx, userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{first: 3, second: 1}, unread)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewMessageRepository(db)
+	body := "look at this"
+	pinId := uuid.New()
+	m := conversations.NewMessage(uuid.New(), uuid.New(), &body, &pinId, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateMessage)).
+		WithArgs(m.Id(), m.ConversationId(), m.SenderId(), m.Body(), m.PinId(), m.BoardId(), m.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchConversation)).
+		WithArgs(m.ConversationId(), m.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkConversationRead)).
+		WithArgs(m.ConversationId(), m.SenderId(), m.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	created, err := repo.Create(ctx, m)
+
+	require.NoError(t, err)
+	assert.Equal(t, m, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
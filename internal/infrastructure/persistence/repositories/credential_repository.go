@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetCredentialById = `SELECT id, user_id, public_key, sign_count, name, created_at, last_used_at
+							  FROM webauthn_credentials
+							  WHERE id = $1`
+	QueryGetCredentialsByUser = `SELECT id, user_id, public_key, sign_count, name, created_at, last_used_at
+								 FROM webauthn_credentials
+								 WHERE user_id = $1
+								 ORDER BY created_at`
+	QueryCreateCredential = `INSERT INTO webauthn_credentials (id, user_id, public_key, sign_count, name, created_at)
+							 VALUES ($1, $2, $3, $4, $5, $6)
+							 ON CONFLICT (id) DO NOTHING`
+	QueryUseCredential = `UPDATE webauthn_credentials
+						  SET sign_count = $2, last_used_at = $3
+						  WHERE id = $1 AND sign_count = $4`
+	QueryDeleteCredential = `DELETE FROM webauthn_credentials WHERE user_id = $1 AND id = $2`
+)
+
+type credentialRepository struct {
+	DB *sql.DB
+}
+
+func NewCredentialRepository(db *sql.DB) passkeys.CredentialRepository {
+	return &credentialRepository{
+		DB: db,
+	}
+}
+
+func (r credentialRepository) GetById(ctx context.Context, id []byte) (*passkeys.Credential, error) {
+	credential, err := scanCredential(r.DB.QueryRowContext(ctx, QueryGetCredentialById, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, passkeys.ErrNotFoundCredential
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return credential, nil
+}
+
+func (r credentialRepository) GetByUser(ctx context.Context, userId uuid.UUID) ([]*passkeys.Credential, error) {
+	var credentials []*passkeys.Credential
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetCredentialsByUser, userId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		credential, err := scanCredential(rows)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		credentials = append(credentials, credential)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return credentials, nil
+}
+
+func (r credentialRepository) Create(ctx context.Context, c *passkeys.Credential) error {
+	result, err := r.DB.ExecContext(ctx, QueryCreateCredential, c.Id(), c.UserId(), c.PublicKey(), int64(c.SignCount()), c.Name(), c.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return passkeys.ErrExistsCredential
+	}
+
+	return nil
+}
+
+func (r credentialRepository) Use(ctx context.Context, c *passkeys.Credential, previous uint32) (bool, error) {
+	result, err := r.DB.ExecContext(ctx, QueryUseCredential, c.Id(), int64(c.SignCount()), c.LastUsedAt(), int64(previous))
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r credentialRepository) Delete(ctx context.Context, userId uuid.UUID, id []byte) error {
+	result, err := r.DB.ExecContext(ctx, QueryDeleteCredential, userId, id)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return passkeys.ErrNotFoundCredential
+	}
+
+	return nil
+}
+
+type credentialScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCredential(row credentialScanner) (*passkeys.Credential, error) {
+	var (
+		id, publicKey []byte
+		userId        uuid.UUID
+		signCount     int64
+		name          string
+		createdAt     time.Time
+		lastUsedAt    *time.Time
+	)
+
+	if err := row.Scan(&id, &userId, &publicKey, &signCount, &name, &createdAt, &lastUsedAt); err != nil {
+		return nil, err
+	}
+
+	return passkeys.NewCredentialFromDB(id, userId, publicKey, uint32(signCount), name, createdAt, lastUsedAt), nil
+}
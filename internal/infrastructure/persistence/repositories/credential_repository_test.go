@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var credentialColumns = []string{"id", "user_id", "public_key", "sign_count", "name", "created_at", "last_used_at"}
+
+func TestCredentialRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCredentialRepository(db)
+	id, userId := []byte{1, 2, 3}, uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCredentialById)).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(credentialColumns).AddRow(id, userId, []byte{9}, int64(4), "Phone", now, now),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCredentialById)).WithArgs(id).WillReturnRows(sqlmock.NewRows(credentialColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCredentialById)).WithArgs(id).WillReturnError(ErrDatabase)
+
+	credential, err := repo.GetById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, credential.Id())
+	assert.Equal(t, userId, credential.UserId())
+	assert.Equal(t, []byte{9}, credential.PublicKey())
+	assert.Equal(t, uint32(4), credential.SignCount())
+	assert.Equal(t, "Phone", credential.Name())
+	assert.Equal(t, now, *credential.LastUsedAt())
+
+	_, err = repo.GetById(ctx, id)
+	assert.ErrorIs(t, err, passkeys.ErrNotFoundCredential)
+
+	_, err = repo.GetById(ctx, id)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCredentialRepository_GetByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCredentialRepository(db)
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCredentialsByUser)).WithArgs(userId).WillReturnRows(
+		sqlmock.NewRows(credentialColumns).
+			AddRow([]byte{1}, userId, []byte{9}, int64(0), "Phone", now, nil).
+			AddRow([]byte{2}, userId, []byte{8}, int64(7), "Laptop", now, now),
+	)
+
+	credentials, err := repo.GetByUser(ctx, userId)
+
+	require.NoError(t, err)
+	require.Len(t, credentials, 2)
+	assert.Equal(t, "Phone", credentials[0].Name())
+	assert.Nil(t, credentials[0].LastUsedAt())
+	assert.Equal(t, uint32(7), credentials[1].SignCount())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCredentialRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCredentialRepository(db)
+	credential := passkeys.NewCredentialFromDB([]byte{1}, uuid.New(), []byte{9}, 3, "Phone", time.Now(), nil)
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(regexp.QuoteMeta(QueryCreateCredential)).
+			WithArgs(credential.Id(), credential.UserId(), credential.PublicKey(), int64(3), "Phone", credential.CreatedAt()).
+			WillReturnResult(sqlmock.NewResult(0, result))
+	}
+
+	require.NoError(t, repo.Create(ctx, credential))
+	assert.ErrorIs(t, repo.Create(ctx, credential), passkeys.ErrExistsCredential)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCredentialRepository_Use(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCredentialRepository(db)
+	now := time.Now()
+	credential := passkeys.NewCredentialFromDB([]byte{1}, uuid.New(), []byte{9}, 3, "Phone", now, nil)
+	require.NoError(t, credential.Use(4, now))
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(regexp.QuoteMeta(QueryUseCredential)).
+			WithArgs(credential.Id(), int64(4), credential.LastUsedAt(), int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, result))
+	}
+
+	used, err := repo.Use(ctx, credential, 3)
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = repo.Use(ctx, credential, 3)
+	require.NoError(t, err)
+	assert.False(t, used)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCredentialRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCredentialRepository(db)
+	userId, id := uuid.New(), []byte{1}
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCredential)).WithArgs(userId, id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCredential)).WithArgs(userId, id).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCredential)).WithArgs(userId, id).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Delete(ctx, userId, id))
+	assert.ErrorIs(t, repo.Delete(ctx, userId, id), passkeys.ErrNotFoundCredential)
+	assert.ErrorIs(t, repo.Delete(ctx, userId, id), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
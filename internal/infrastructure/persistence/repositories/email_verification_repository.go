@@ -3,45 +3,67 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/google/uuid"
 	"time"
 )
 
+const (
+	QueryCreateEmailVerification = `INSERT INTO email_verifications (id, user_id, token_hash, created_at, expires_at)
+									VALUES ($1, $2, $3, $4, $5)`
+	QueryGetEmailVerificationByHash = `SELECT id, user_id, token_hash, created_at, expires_at, verified_at
+									   FROM email_verifications
+									   WHERE token_hash = $1`
+	QueryMarkEmailVerified = `UPDATE email_verifications
+							  SET verified_at = $2
+							  WHERE id = $1`
+	QueryCountEmailVerificationsSince = `SELECT COUNT(*)
+										 FROM email_verifications
+										 WHERE user_id = $1 AND created_at >= $2`
+)
+
 type emailVerificationRepo struct {
 	db *sql.DB
 }
 
 func (e *emailVerificationRepo) Save(ctx context.Context, ev *email.EmailVerification) error {
-	query := `
-        INSERT INTO email_verifications (id, user_id, token, created_at, expires_at)
-        VALUES ($1, $2, $3, $4, $5)
-    `
-	_, err := e.db.ExecContext(ctx, query, ev.Id, ev.UserId, ev.Token, ev.CreatedAt, ev.ExpiresAt)
-	return err
+	_, err := e.db.ExecContext(ctx, QueryCreateEmailVerification, ev.Id, ev.UserId, ev.TokenHash, ev.CreatedAt, ev.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
 }
 
-func (e *emailVerificationRepo) FindByToken(ctx context.Context, token string) (*email.EmailVerification, error) {
-	query := `
-        SELECT id, user_id, token, created_at, expires_at, verified_at
-        FROM email_verifications
-        WHERE token = $1
-    `
+func (e *emailVerificationRepo) FindByHash(ctx context.Context, hash string) (*email.EmailVerification, error) {
 	ev := &email.EmailVerification{}
-	row := e.db.QueryRowContext(ctx, query, token)
-	err := row.Scan(&ev.Id, &ev.UserId, &ev.Token, &ev.CreatedAt, &ev.ExpiresAt, &ev.VerifiedAt)
-	if err != nil {
-		return nil, err
+	err := e.db.QueryRowContext(ctx, QueryGetEmailVerificationByHash, hash).Scan(&ev.Id, &ev.UserId, &ev.TokenHash, &ev.CreatedAt, &ev.ExpiresAt, &ev.VerifiedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, email.ErrNotFoundEmailVerification
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
+
 	return ev, nil
 }
 
 func (e *emailVerificationRepo) MarkVerified(ctx context.Context, ev *email.EmailVerification) error {
-	query := `
-        UPDATE email_verifications
-        SET verified_at = $1
-        WHERE id = $2
-    `
-	_, err := e.db.ExecContext(ctx, query, time.Now(), ev.Id)
-	return err
+	if _, err := e.db.ExecContext(ctx, QueryMarkEmailVerified, ev.Id, ev.VerifiedAt); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (e *emailVerificationRepo) CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error) {
+	var count int
+	if err := e.db.QueryRowContext(ctx, QueryCountEmailVerificationsSince, userId, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return count, nil
 }
 
 func NewEmailVerificationRepo(db *sql.DB) email.EmailVerificationRepository {
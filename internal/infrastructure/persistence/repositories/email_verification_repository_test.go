@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestEmailVerificationRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEmailVerificationRepo(db)
+	ev, _, err := email.NewEmailVerification(uuid.New())
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateEmailVerification)).
+		WithArgs(ev.Id, ev.UserId, ev.TokenHash, ev.CreatedAt, ev.ExpiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Save(ctx, ev))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailVerificationRepository_FindByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEmailVerificationRepo(db)
+	id, userId, now := uuid.New(), uuid.New(), time.Now()
+	columns := []string{"id", "user_id", "token_hash", "created_at", "expires_at", "verified_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetEmailVerificationByHash)).WithArgs("hash").WillReturnRows(
+		sqlmock.NewRows(columns).AddRow(id, userId, "hash", now, now.Add(time.Hour), nil),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetEmailVerificationByHash)).WithArgs("other").WillReturnRows(sqlmock.NewRows(columns))
+
+	ev, err := repo.FindByHash(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, id, ev.Id)
+	assert.Equal(t, userId, ev.UserId)
+	assert.False(t, ev.IsVerified())
+
+	ev, err = repo.FindByHash(ctx, "other")
+	assert.ErrorIs(t, err, email.ErrNotFoundEmailVerification)
+	assert.Nil(t, ev)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailVerificationRepository_MarkVerified(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEmailVerificationRepo(db)
+	ev := &email.EmailVerification{Id: uuid.New()}
+	ev.MarkVerified()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkEmailVerified)).WithArgs(ev.Id, ev.VerifiedAt).WillReturnError(ErrDatabase)
+
+	assert.ErrorIs(t, repo.MarkVerified(ctx, ev), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailVerificationRepository_CountSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEmailVerificationRepo(db)
+	userId, since := uuid.New(), time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountEmailVerificationsSince)).WithArgs(userId, since).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(3),
+	)
+
+	count, err := repo.CountSince(ctx, userId, since)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryCreateEvent = `INSERT INTO events (id, user_id, session_id, pin_id, kind, occurred_at, received_at)
+						VALUES ($1, $2, $3, $4, $5, $6, $7)
+						ON CONFLICT (session_id, pin_id, kind) DO NOTHING`
+	QueryGetVisibleEventPins = `SELECT p.id
+								FROM pins p
+								JOIN boards b ON b.id = p.board_id
+								WHERE p.id = ANY($2) AND p.deleted_at IS NULL AND b.deleted_at IS NULL
+								AND (p.user_id = $1 OR (p.visibility AND b.visibility AND NOT EXISTS(
+									SELECT 1
+									FROM user_blocks ub
+									WHERE (ub.blocker_id = $1 AND ub.blocked_id = p.user_id) OR (ub.blocker_id = p.user_id AND ub.blocked_id = $1))))`
+	// QueryRollupPinStats recounts the events of each pin per hour from $1
+	// up to $2. Events of pins that no longer exist are left out.
+	QueryRollupPinStats = `INSERT INTO pin_stats_hourly (pin_id, hour, impressions, closeups, outbound_clicks, saves, shares)
+						   SELECT e.pin_id, DATE_TRUNC('hour', e.occurred_at),
+								COUNT(*) FILTER (WHERE e.kind = 'impression'),
+								COUNT(*) FILTER (WHERE e.kind = 'closeup'),
+								COUNT(*) FILTER (WHERE e.kind = 'outbound_click'),
+								COUNT(*) FILTER (WHERE e.kind = 'save'),
+								COUNT(*) FILTER (WHERE e.kind = 'share')
+						   FROM events e
+						   JOIN pins p ON p.id = e.pin_id
+						   WHERE e.occurred_at >= $1 AND e.occurred_at < $2
+						   GROUP BY e.pin_id, DATE_TRUNC('hour', e.occurred_at)
+						   ON CONFLICT (pin_id, hour) DO UPDATE
+						   SET impressions = EXCLUDED.impressions, closeups = EXCLUDED.closeups,
+							   outbound_clicks = EXCLUDED.outbound_clicks, saves = EXCLUDED.saves, shares = EXCLUDED.shares`
+	// QueryRollupBoardStats sums the hourly stats of the pins on each board
+	// from $1 up to $2.
+	QueryRollupBoardStats = `INSERT INTO board_stats_hourly (board_id, hour, impressions, closeups, outbound_clicks, saves, shares)
+							 SELECT p.board_id, s.hour, SUM(s.impressions), SUM(s.closeups), SUM(s.outbound_clicks), SUM(s.saves), SUM(s.shares)
+							 FROM pin_stats_hourly s
+							 JOIN pins p ON p.id = s.pin_id
+							 WHERE s.hour >= $1 AND s.hour < $2
+							 GROUP BY p.board_id, s.hour
+							 ON CONFLICT (board_id, hour) DO UPDATE
+							 SET impressions = EXCLUDED.impressions, closeups = EXCLUDED.closeups,
+								 outbound_clicks = EXCLUDED.outbound_clicks, saves = EXCLUDED.saves, shares = EXCLUDED.shares`
+)
+
+type eventRepository struct {
+	DB *sql.DB
+}
+
+func NewEventRepository(db *sql.DB) events.EventRepository {
+	return &eventRepository{
+		DB: db,
+	}
+}
+
+func (r eventRepository) CreateBatch(ctx context.Context, list []*events.Event) (int64, error) {
+	var created int64
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	for _, e := range list {
+		result, err := tx.ExecContext(ctx, QueryCreateEvent,
+			e.Id(), e.UserId(), e.SessionId(), e.PinId(), string(e.Kind()), e.OccurredAt(), e.ReceivedAt(),
+		)
+		if err != nil {
+			return 0, fmt.Errorf(got, ErrQuery, err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf(got, ErrQuery, err)
+		}
+		created += affected
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return created, nil
+}
+
+func (r eventRepository) GetListVisiblePins(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	var (
+		ids []uuid.UUID
+		id  uuid.UUID
+	)
+
+	if len(pinIds) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetVisibleEventPins, userId, pq.Array(pinIds))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return ids, nil
+}
+
+func (r eventRepository) Rollup(ctx context.Context, from, to time.Time) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, QueryRollupPinStats, from, to); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryRollupBoardStats, from, to); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
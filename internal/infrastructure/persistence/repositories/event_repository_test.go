@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestEventRepository_CreateBatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	now := time.Now()
+	userId, sessionId, pinId := uuid.New(), uuid.New(), uuid.New()
+	impression, err := events.NewEvent(userId, sessionId, pinId, events.ImpressionKind, now, now)
+	require.NoError(t, err)
+	closeup, err := events.NewEvent(userId, sessionId, pinId, events.CloseupKind, now, now)
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateEvent)).
+		WithArgs(impression.Id(), userId, sessionId, pinId, "impression", now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateEvent)).
+		WithArgs(closeup.Id(), userId, sessionId, pinId, "closeup", now, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	created, err := repo.CreateBatch(ctx, []*events.Event{impression, closeup})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_CreateBatch_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	now := time.Now()
+	e, err := events.NewEvent(uuid.New(), uuid.New(), uuid.New(), events.ShareKind, now, now)
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateEvent)).WillReturnError(ErrDatabase)
+	mock.ExpectRollback()
+
+	created, err := repo.CreateBatch(ctx, []*events.Event{e})
+
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.Zero(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_GetListVisiblePins(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	userId, pinIds := uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetVisibleEventPins)).WithArgs(userId, pq.Array(pinIds)).WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).AddRow(pinIds[0]),
+	)
+
+	visible, err := repo.GetListVisiblePins(ctx, userId, pinIds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{pinIds[0]}, visible)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_GetListVisiblePins_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	pinIds := []uuid.UUID{uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetVisibleEventPins)).WillReturnError(ErrDatabase)
+
+	visible, err := repo.GetListVisiblePins(ctx, uuid.New(), pinIds)
+
+	assert.Nil(t, visible)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_Rollup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	to := time.Now()
+	from := to.Add(-25 * time.Hour).Truncate(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryRollupPinStats)).WithArgs(from, to).WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRollupBoardStats)).WithArgs(from, to).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	err = repo.Rollup(ctx, from, to)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_Rollup_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewEventRepository(db)
+	to := time.Now()
+	from := to.Add(-time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryRollupPinStats)).WithArgs(from, to).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRollupBoardStats)).WithArgs(from, to).WillReturnError(ErrDatabase)
+	mock.ExpectRollback()
+
+	err = repo.Rollup(ctx, from, to)
+
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetFactorByUser = `SELECT user_id, secret, last_counter, created_at, confirmed_at
+							FROM totp_factors
+							WHERE user_id = $1`
+	QuerySaveFactor = `INSERT INTO totp_factors (user_id, secret, last_counter, created_at)
+					   VALUES ($1, $2, $3, $4)
+					   ON CONFLICT (user_id) DO UPDATE
+					   SET secret = EXCLUDED.secret, last_counter = EXCLUDED.last_counter, created_at = EXCLUDED.created_at
+					   WHERE totp_factors.confirmed_at IS NULL`
+	QueryConfirmFactor = `UPDATE totp_factors
+						  SET confirmed_at = $2, last_counter = $3
+						  WHERE user_id = $1 AND confirmed_at IS NULL`
+	QueryAcceptFactorCode = `UPDATE totp_factors
+							 SET last_counter = $2
+							 WHERE user_id = $1 AND last_counter < $2`
+	QueryDeleteFactor        = `DELETE FROM totp_factors WHERE user_id = $1`
+	QueryDeleteRecoveryCodes = `DELETE FROM recovery_codes WHERE user_id = $1`
+	QueryCreateRecoveryCodes = `INSERT INTO recovery_codes (user_id, code_hash)
+								SELECT $1, UNNEST($2::CHAR(64)[])`
+	QueryUseRecoveryCode = `UPDATE recovery_codes
+							SET used_at = $3
+							WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+)
+
+type factorRepository struct {
+	DB *sql.DB
+}
+
+func NewFactorRepository(db *sql.DB) factors.FactorRepository {
+	return &factorRepository{
+		DB: db,
+	}
+}
+
+func (r factorRepository) GetByUser(ctx context.Context, userId uuid.UUID) (*factors.Factor, error) {
+	var (
+		id          uuid.UUID
+		secret      string
+		lastCounter int64
+		createdAt   time.Time
+		confirmedAt *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetFactorByUser, userId).Scan(&id, &secret, &lastCounter, &createdAt, &confirmedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, factors.ErrNotFoundFactor
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return factors.NewFactorFromDB(id, secret, lastCounter, createdAt, confirmedAt), nil
+}
+
+func (r factorRepository) Save(ctx context.Context, f *factors.Factor) error {
+	result, err := r.DB.ExecContext(ctx, QuerySaveFactor, f.UserId(), f.Secret(), f.LastCounter(), f.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return factors.ErrConfirmedFactor
+	}
+
+	return nil
+}
+
+func (r factorRepository) Confirm(ctx context.Context, f *factors.Factor, hashes []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, QueryConfirmFactor, f.UserId(), f.ConfirmedAt(), f.LastCounter())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return factors.ErrConfirmedFactor
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryDeleteRecoveryCodes, f.UserId()); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryCreateRecoveryCodes, f.UserId(), pq.Array(hashes)); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r factorRepository) Accept(ctx context.Context, userId uuid.UUID, counter int64) (bool, error) {
+	result, err := r.DB.ExecContext(ctx, QueryAcceptFactorCode, userId, counter)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r factorRepository) UseRecoveryCode(ctx context.Context, userId uuid.UUID, hash string, at time.Time) (bool, error) {
+	result, err := r.DB.ExecContext(ctx, QueryUseRecoveryCode, userId, hash, at)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r factorRepository) Delete(ctx context.Context, userId uuid.UUID) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, QueryDeleteRecoveryCodes, userId); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryDeleteFactor, userId); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package repositories
+
+import (
+	"database/sql/driver"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestFactorRepository_GetByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetFactorByUser)).WithArgs(userId).WillReturnRows(
+		sqlmock.NewRows([]string{"user_id", "secret", "last_counter", "created_at", "confirmed_at"}).
+			AddRow(userId, "SECRET", int64(42), now, now),
+	)
+
+	factor, err := repo.GetByUser(ctx, userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, userId, factor.UserId())
+	assert.Equal(t, "SECRET", factor.Secret())
+	assert.Equal(t, int64(42), factor.LastCounter())
+	assert.True(t, factor.IsConfirmed())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_GetByUser_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	userId := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetFactorByUser)).WithArgs(userId).WillReturnRows(
+		sqlmock.NewRows([]string{"user_id", "secret", "last_counter", "created_at", "confirmed_at"}),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetFactorByUser)).WithArgs(userId).WillReturnError(ErrDatabase)
+
+	_, err = repo.GetByUser(ctx, userId)
+	assert.ErrorIs(t, err, factors.ErrNotFoundFactor)
+
+	_, err = repo.GetByUser(ctx, userId)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	factor, err := factors.NewFactor(uuid.New())
+	require.NoError(t, err)
+
+	args := []driver.Value{factor.UserId(), factor.Secret(), factor.LastCounter(), factor.CreatedAt()}
+	mock.ExpectExec(regexp.QuoteMeta(QuerySaveFactor)).WithArgs(args...).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QuerySaveFactor)).WithArgs(args...).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QuerySaveFactor)).WithArgs(args...).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Save(ctx, factor))
+	assert.ErrorIs(t, repo.Save(ctx, factor), factors.ErrConfirmedFactor)
+	assert.ErrorIs(t, repo.Save(ctx, factor), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_Confirm(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	now := time.Now()
+	factor := factors.NewFactorFromDB(uuid.New(), "SECRET", 7, now, &now)
+	hashes := []string{"a", "b"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryConfirmFactor)).WithArgs(factor.UserId(), factor.ConfirmedAt(), factor.LastCounter()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteRecoveryCodes)).WithArgs(factor.UserId()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateRecoveryCodes)).WithArgs(factor.UserId(), pq.Array(hashes)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.Confirm(ctx, factor, hashes))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_Confirm_Confirmed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	now := time.Now()
+	factor := factors.NewFactorFromDB(uuid.New(), "SECRET", 7, now, &now)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryConfirmFactor)).WithArgs(factor.UserId(), factor.ConfirmedAt(), factor.LastCounter()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.Confirm(ctx, factor, []string{"a"}), factors.ErrConfirmedFactor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_Accept(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	userId := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryAcceptFactorCode)).WithArgs(userId, int64(9)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryAcceptFactorCode)).WithArgs(userId, int64(9)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryAcceptFactorCode)).WithArgs(userId, int64(9)).WillReturnError(ErrDatabase)
+
+	accepted, err := repo.Accept(ctx, userId, 9)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+
+	accepted, err = repo.Accept(ctx, userId, 9)
+	require.NoError(t, err)
+	assert.False(t, accepted)
+
+	_, err = repo.Accept(ctx, userId, 9)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_UseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryUseRecoveryCode)).WithArgs(userId, "hash", now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryUseRecoveryCode)).WithArgs(userId, "hash", now).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	used, err := repo.UseRecoveryCode(ctx, userId, "hash", now)
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = repo.UseRecoveryCode(ctx, userId, "hash", now)
+	require.NoError(t, err)
+	assert.False(t, used)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFactorRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewFactorRepository(db)
+	userId := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteRecoveryCodes)).WithArgs(userId).WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteFactor)).WithArgs(userId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.Delete(ctx, userId))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,266 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetFeedRecipients = `WITH author AS (
+								SELECT p.id, p.user_id, p.board_id, (SELECT COUNT(*) FROM follows c WHERE c.followee_id = p.user_id) < $2 AS fan_out
+								FROM pins p
+								WHERE p.id = $1 AND p.deleted_at IS NULL AND p.visibility)
+							  SELECT r.user_id
+							  FROM author p
+							  JOIN LATERAL (
+								SELECT f.follower_id AS user_id
+								FROM follows f
+								WHERE f.followee_id = p.user_id AND p.fan_out
+								UNION
+								SELECT bf.user_id
+								FROM board_follows bf
+								WHERE bf.board_id = p.board_id
+								UNION
+								SELECT tf.user_id
+								FROM pins_tags pt
+								JOIN tag_follows tf ON tf.tag_id = pt.tag_id
+								WHERE pt.pin_id = p.id) r ON TRUE
+							  WHERE r.user_id <> p.user_id
+							  AND NOT EXISTS(
+								SELECT 1
+								FROM user_blocks b
+								WHERE (b.blocker_id = r.user_id AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = r.user_id))
+							  AND NOT EXISTS(
+								SELECT 1
+								FROM user_mutes m
+								WHERE m.muter_id = r.user_id AND m.muted_id = p.user_id)`
+	QueryGetFeed = `SELECT p.id, p.created_at
+					FROM pins p
+					WHERE p.deleted_at IS NULL AND p.visibility AND p.user_id <> $1
+					AND ($2::timestamp IS NULL OR (p.created_at, p.id) < ($2, $3))
+					AND (EXISTS(SELECT 1 FROM follows f WHERE f.follower_id = $1 AND f.followee_id = p.user_id)
+						OR EXISTS(SELECT 1 FROM board_follows bf WHERE bf.user_id = $1 AND bf.board_id = p.board_id)
+						OR EXISTS(SELECT 1 FROM pins_tags pt JOIN tag_follows tf ON tf.tag_id = pt.tag_id WHERE pt.pin_id = p.id AND tf.user_id = $1))
+					ORDER BY p.created_at DESC, p.id DESC
+					LIMIT $4`
+	QueryGetFeedFromPopular = `WITH popular AS (
+								SELECT f.followee_id
+								FROM follows f
+								JOIN follows c ON c.followee_id = f.followee_id
+								WHERE f.follower_id = $1
+								GROUP BY f.followee_id
+								HAVING COUNT(*) >= $2)
+							   SELECT p.id, p.created_at
+							   FROM popular
+							   JOIN pins p ON p.user_id = popular.followee_id
+							   WHERE p.deleted_at IS NULL AND p.visibility
+							   AND ($3::timestamp IS NULL OR (p.created_at, p.id) < ($3, $4))
+							   ORDER BY p.created_at DESC, p.id DESC
+							   LIMIT $5`
+	QueryGetFeedByUser = `SELECT id, created_at
+						  FROM pins
+						  WHERE user_id = $1 AND deleted_at IS NULL AND visibility
+						  ORDER BY created_at DESC, id DESC
+						  LIMIT $2`
+	QueryGetFeedByBoard = `SELECT id, created_at
+						   FROM pins
+						   WHERE board_id = $1 AND deleted_at IS NULL AND visibility
+						   ORDER BY created_at DESC, id DESC
+						   LIMIT $2`
+	QueryGetFeedByTag = `SELECT p.id, p.created_at
+						 FROM pins p
+						 JOIN pins_tags pt ON pt.pin_id = p.id
+						 WHERE pt.tag_id = $1 AND p.deleted_at IS NULL AND p.visibility
+						 ORDER BY p.created_at DESC, p.id DESC
+						 LIMIT $2`
+	QueryGetFeedOrphanedByUser = `SELECT p.id
+								  FROM pins p
+								  WHERE p.user_id = $2
+								  AND NOT EXISTS(SELECT 1 FROM follows f WHERE f.follower_id = $1 AND f.followee_id = p.user_id)
+								  AND NOT EXISTS(SELECT 1 FROM board_follows bf WHERE bf.user_id = $1 AND bf.board_id = p.board_id)
+								  AND NOT EXISTS(SELECT 1 FROM pins_tags pt JOIN tag_follows tf ON tf.tag_id = pt.tag_id WHERE pt.pin_id = p.id AND tf.user_id = $1)
+								  ORDER BY p.created_at DESC, p.id DESC
+								  LIMIT $3`
+	QueryGetFeedOrphanedByBoard = `SELECT p.id
+								   FROM pins p
+								   WHERE p.board_id = $2
+								   AND NOT EXISTS(SELECT 1 FROM follows f WHERE f.follower_id = $1 AND f.followee_id = p.user_id)
+								   AND NOT EXISTS(SELECT 1 FROM board_follows bf WHERE bf.user_id = $1 AND bf.board_id = p.board_id)
+								   AND NOT EXISTS(SELECT 1 FROM pins_tags pt JOIN tag_follows tf ON tf.tag_id = pt.tag_id WHERE pt.pin_id = p.id AND tf.user_id = $1)
+								   ORDER BY p.created_at DESC, p.id DESC
+								   LIMIT $3`
+	QueryGetFeedOrphanedByTag = `SELECT p.id
+								 FROM pins p
+								 JOIN pins_tags t ON t.pin_id = p.id
+								 WHERE t.tag_id = $2
+								 AND NOT EXISTS(SELECT 1 FROM follows f WHERE f.follower_id = $1 AND f.followee_id = p.user_id)
+								 AND NOT EXISTS(SELECT 1 FROM board_follows bf WHERE bf.user_id = $1 AND bf.board_id = p.board_id)
+								 AND NOT EXISTS(SELECT 1 FROM pins_tags pt JOIN tag_follows tf ON tf.tag_id = pt.tag_id WHERE pt.pin_id = p.id AND tf.user_id = $1)
+								 ORDER BY p.created_at DESC, p.id DESC
+								 LIMIT $3`
+	QueryGetFeedVisible = `SELECT p.id
+						   FROM pins p
+						   WHERE p.id = ANY($2) AND p.deleted_at IS NULL AND p.visibility
+						   AND NOT EXISTS(
+							SELECT 1
+							FROM user_blocks b
+							WHERE (b.blocker_id = $1 AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = $1))
+						   AND NOT EXISTS(
+							SELECT 1
+							FROM user_mutes m
+							WHERE m.muter_id = $1 AND m.muted_id = p.user_id)
+						   AND (EXISTS(SELECT 1 FROM follows f WHERE f.follower_id = $1 AND f.followee_id = p.user_id)
+							OR EXISTS(SELECT 1 FROM board_follows bf WHERE bf.user_id = $1 AND bf.board_id = p.board_id)
+							OR EXISTS(SELECT 1 FROM pins_tags pt JOIN tag_follows tf ON tf.tag_id = pt.tag_id WHERE pt.pin_id = p.id AND tf.user_id = $1))`
+	QueryIsPopularUser = `SELECT COUNT(*) >= $2
+						  FROM follows
+						  WHERE followee_id = $1`
+)
+
+type feedRepository struct {
+	DB *sql.DB
+}
+
+func NewFeedRepository(db *sql.DB) feeds.FeedRepository {
+	return &feedRepository{
+		DB: db,
+	}
+}
+
+func (r feedRepository) GetRecipients(ctx context.Context, pinId uuid.UUID, popularAt int) ([]uuid.UUID, error) {
+	return r.queryIds(ctx, QueryGetFeedRecipients, pinId, popularAt)
+}
+
+func (r feedRepository) GetList(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	createdAt, pinId := cursorArgs(cursor)
+	return r.queryEntries(ctx, QueryGetFeed, userId, createdAt, pinId, limit)
+}
+
+func (r feedRepository) GetListFromPopular(ctx context.Context, userId uuid.UUID, popularAt int, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	createdAt, pinId := cursorArgs(cursor)
+	return r.queryEntries(ctx, QueryGetFeedFromPopular, userId, popularAt, createdAt, pinId, limit)
+}
+
+func (r feedRepository) GetListBySource(ctx context.Context, source feeds.Source, limit int) ([]feeds.Entry, error) {
+	switch source.Kind() {
+	case feeds.UserSource:
+		return r.queryEntries(ctx, QueryGetFeedByUser, source.Id(), limit)
+	case feeds.BoardSource:
+		return r.queryEntries(ctx, QueryGetFeedByBoard, source.Id(), limit)
+	case feeds.TagSource:
+		return r.queryEntries(ctx, QueryGetFeedByTag, source.Id(), limit)
+	default:
+		return nil, nil
+	}
+}
+
+func (r feedRepository) GetListOrphaned(ctx context.Context, userId uuid.UUID, source feeds.Source, limit int) ([]uuid.UUID, error) {
+	switch source.Kind() {
+	case feeds.UserSource:
+		return r.queryIds(ctx, QueryGetFeedOrphanedByUser, userId, source.Id(), limit)
+	case feeds.BoardSource:
+		return r.queryIds(ctx, QueryGetFeedOrphanedByBoard, userId, source.Id(), limit)
+	case feeds.TagSource:
+		return r.queryIds(ctx, QueryGetFeedOrphanedByTag, userId, source.Id(), limit)
+	default:
+		return nil, nil
+	}
+}
+
+func (r feedRepository) GetListVisible(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	if len(pinIds) == 0 {
+		return nil, nil
+	}
+
+	return r.queryIds(ctx, QueryGetFeedVisible, userId, pq.Array(pinIds))
+}
+
+func (r feedRepository) IsPopular(ctx context.Context, userId uuid.UUID, popularAt int) (bool, error) {
+	var popular bool
+
+	err := r.DB.QueryRowContext(ctx, QueryIsPopularUser, userId, popularAt).Scan(&popular)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return popular, nil
+}
+
+func (r feedRepository) queryEntries(ctx context.Context, query string, args ...any) ([]feeds.Entry, error) {
+	var (
+		entries   []feeds.Entry
+		pinId     uuid.UUID
+		createdAt time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&pinId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		entries = append(entries, feeds.NewEntry(pinId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return entries, nil
+}
+
+func (r feedRepository) queryIds(ctx context.Context, query string, args ...any) ([]uuid.UUID, error) {
+	var (
+		ids []uuid.UUID
+		id  uuid.UUID
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return ids, nil
+}
+
+// cursorArgs turns a cursor into the keyset arguments of the feed queries. A
+// nil cursor gives a NULL timestamp, which reads from the top.
+func cursorArgs(cursor *feeds.Cursor) (*time.Time, uuid.UUID) {
+	if cursor == nil {
+		return nil, uuid.Nil
+	}
+
+	createdAt := cursor.Last().CreatedAt()
+	return &createdAt, cursor.Last().PinId()
+}
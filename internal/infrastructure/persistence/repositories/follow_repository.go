@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetFollowers = `SELECT follower_id, created_at
+						 FROM follows
+						 WHERE followee_id = $1
+						 ORDER BY created_at DESC`
+	QueryGetFollowing = `SELECT followee_id, created_at
+						 FROM follows
+						 WHERE follower_id = $1
+						 ORDER BY created_at DESC`
+	QueryExistFollow = `SELECT EXISTS(
+							SELECT 1
+							FROM follows
+							WHERE follower_id = $1 AND followee_id = $2)`
+	QueryCreateFollow = `INSERT INTO follows (follower_id, followee_id, created_at)
+						 SELECT $1, $2, $3
+						 WHERE NOT EXISTS(
+							SELECT 1
+							FROM user_blocks
+							WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1))
+						 ON CONFLICT DO NOTHING`
+	QueryDeleteFollow = `DELETE FROM follows
+						 WHERE follower_id = $1 AND followee_id = $2`
+)
+
+type followRepository struct {
+	DB *sql.DB
+}
+
+func NewFollowRepository(db *sql.DB) follows.FollowRepository {
+	return &followRepository{
+		DB: db,
+	}
+}
+
+func (r followRepository) GetFollowers(ctx context.Context, userId uuid.UUID) ([]*follows.Follow, error) {
+	var (
+		followsList []*follows.Follow
+		followerId  uuid.UUID
+		createdAt   time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetFollowers, userId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&followerId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		followsList = append(followsList, follows.NewFollowFromDB(followerId, userId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return followsList, nil
+}
+
+func (r followRepository) GetFollowing(ctx context.Context, userId uuid.UUID) ([]*follows.Follow, error) {
+	var (
+		followsList []*follows.Follow
+		followeeId  uuid.UUID
+		createdAt   time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetFollowing, userId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&followeeId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		followsList = append(followsList, follows.NewFollowFromDB(userId, followeeId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return followsList, nil
+}
+
+func (r followRepository) Exists(ctx context.Context, followerId, followeeId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistFollow, followerId, followeeId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r followRepository) Create(ctx context.Context, f *follows.Follow) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateFollow, f.FollowerId(), f.FolloweeId(), f.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r followRepository) Delete(ctx context.Context, f *follows.Follow) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteFollow, f.FollowerId(), f.FolloweeId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
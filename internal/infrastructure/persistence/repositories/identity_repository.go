@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetIdentityBySubject = `SELECT user_id, email, created_at
+								 FROM user_identities
+								 WHERE provider = $1 AND subject = $2`
+	QueryCreateIdentity = `INSERT INTO user_identities (provider, subject, user_id, email, created_at)
+						   VALUES ($1, $2, $3, $4, $5)
+						   ON CONFLICT (provider, subject) DO NOTHING`
+)
+
+type identityRepository struct {
+	DB *sql.DB
+}
+
+func NewIdentityRepository(db *sql.DB) identities.IdentityRepository {
+	return &identityRepository{
+		DB: db,
+	}
+}
+
+func (r identityRepository) GetBySubject(ctx context.Context, provider, subject string) (*identities.Identity, error) {
+	var (
+		userId    uuid.UUID
+		email     string
+		createdAt time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetIdentityBySubject, provider, subject).Scan(&userId, &email, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, identities.ErrNotFoundIdentity
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return identities.NewIdentityFromDB(provider, subject, userId, email, createdAt), nil
+}
+
+func (r identityRepository) Create(ctx context.Context, i *identities.Identity) error {
+	result, err := r.DB.ExecContext(ctx, QueryCreateIdentity, i.Provider(), i.Subject(), i.UserId(), i.Email(), i.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return identities.ErrExistsIdentity
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestIdentityRepository_GetBySubject(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdentityRepository(db)
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetIdentityBySubject)).WithArgs("google", "1234").WillReturnRows(
+		sqlmock.NewRows([]string{"user_id", "email", "created_at"}).AddRow(userId, "john@doe.com", now),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetIdentityBySubject)).WithArgs("google", "1234").WillReturnRows(
+		sqlmock.NewRows([]string{"user_id", "email", "created_at"}),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetIdentityBySubject)).WithArgs("google", "1234").WillReturnError(ErrDatabase)
+
+	identity, err := repo.GetBySubject(ctx, "google", "1234")
+	require.NoError(t, err)
+	assert.Equal(t, "google", identity.Provider())
+	assert.Equal(t, "1234", identity.Subject())
+	assert.Equal(t, userId, identity.UserId())
+	assert.Equal(t, "john@doe.com", identity.Email())
+	assert.Equal(t, now, identity.CreatedAt())
+
+	_, err = repo.GetBySubject(ctx, "google", "1234")
+	assert.ErrorIs(t, err, identities.ErrNotFoundIdentity)
+
+	_, err = repo.GetBySubject(ctx, "google", "1234")
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdentityRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewIdentityRepository(db)
+	identity, err := identities.NewIdentity("google", "1234", uuid.New(), "john@doe.com")
+	require.NoError(t, err)
+
+	for _, result := range []int64{1, 0} {
+		mock.ExpectExec(regexp.QuoteMeta(QueryCreateIdentity)).
+			WithArgs("google", "1234", identity.UserId(), "john@doe.com", identity.CreatedAt()).
+			WillReturnResult(sqlmock.NewResult(0, result))
+	}
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateIdentity)).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Create(ctx, identity))
+	assert.ErrorIs(t, repo.Create(ctx, identity), identities.ErrExistsIdentity)
+	assert.ErrorIs(t, repo.Create(ctx, identity), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
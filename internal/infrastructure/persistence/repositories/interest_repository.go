@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetInterestProfile = `SELECT language, country
+							   FROM users
+							   WHERE id = $1 AND deleted_at IS NULL`
+	QueryGetInterests = `SELECT ui.tag_id, t.name, ui.signal, ui.weight, p.title
+						 FROM user_interests ui
+						 JOIN tags t ON t.id = ui.tag_id
+						 LEFT JOIN pins p ON p.id = ui.pin_id AND p.deleted_at IS NULL
+						 WHERE ui.user_id = $1 AND t.deleted_at IS NULL
+						 ORDER BY ui.weight DESC, ui.updated_at DESC
+						 LIMIT $2`
+	QueryGetInterestCandidates = `SELECT p.id, p.created_at, u.language, u.country, pt.tag_id
+								  FROM pins p
+								  JOIN users u ON u.id = p.user_id
+								  LEFT JOIN pins_tags pt ON pt.pin_id = p.id
+								  WHERE p.id = ANY($1)`
+	QueryAddInterestPin = `INSERT INTO user_interests (user_id, tag_id, signal, weight, pin_id, updated_at)
+						   SELECT $1, pt.tag_id, $2, 1, pt.pin_id, $4
+						   FROM pins_tags pt
+						   WHERE pt.pin_id = $3
+						   ON CONFLICT (user_id, tag_id, signal) DO UPDATE
+						   SET weight = user_interests.weight + 1, pin_id = EXCLUDED.pin_id, updated_at = EXCLUDED.updated_at`
+	// QueryRemoveInterestPin drops the interests the pin was the last point of
+	// and takes 1 from the others, leaving the deleted rows alone.
+	QueryRemoveInterestPin = `WITH removed AS (
+								DELETE FROM user_interests ui
+								USING pins_tags pt
+								WHERE pt.pin_id = $3 AND ui.tag_id = pt.tag_id AND ui.user_id = $1 AND ui.signal = $2 AND ui.weight <= 1
+								RETURNING ui.tag_id)
+							  UPDATE user_interests ui
+							  SET weight = ui.weight - 1, pin_id = NULLIF(ui.pin_id, $3), updated_at = $4
+							  FROM pins_tags pt
+							  WHERE pt.pin_id = $3 AND ui.tag_id = pt.tag_id AND ui.user_id = $1 AND ui.signal = $2
+							  AND ui.tag_id NOT IN (SELECT tag_id FROM removed)`
+	QueryAddInterestTag = `INSERT INTO user_interests (user_id, tag_id, signal, weight, pin_id, updated_at)
+						   VALUES ($1, $2, 'follow', 1, NULL, $3)
+						   ON CONFLICT (user_id, tag_id, signal) DO NOTHING`
+	QueryRemoveInterestTag = `DELETE FROM user_interests
+							  WHERE user_id = $1 AND tag_id = $2 AND signal = 'follow'`
+)
+
+type interestRepository struct {
+	DB *sql.DB
+}
+
+func NewInterestRepository(db *sql.DB) interests.InterestRepository {
+	return &interestRepository{
+		DB: db,
+	}
+}
+
+func (r interestRepository) GetProfile(ctx context.Context, userId uuid.UUID) (*interests.Profile, error) {
+	var (
+		list              []interests.Interest
+		language, country string
+		tagId             uuid.UUID
+		tag, signal       string
+		weight            float64
+		pinTitle          *string
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetInterestProfile, userId).Scan(&language, &country)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, users.ErrNotFoundUser
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetInterests, userId, interests.MaxInterests)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&tagId, &tag, &signal, &weight, &pinTitle); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		list = append(list, interests.NewInterest(tagId, tag, interests.Signal(signal), weight, pinTitle))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return interests.NewProfile(userId, shared.Language(language), shared.Country(country), list), nil
+}
+
+// GetCandidates reads a row per pin and tag and folds them into one candidate
+// per pin.
+func (r interestRepository) GetCandidates(ctx context.Context, pinIds []uuid.UUID) ([]interests.Candidate, error) {
+	var (
+		order             []uuid.UUID
+		pinId             uuid.UUID
+		tagId             *uuid.UUID
+		language, country string
+		createdAt         time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetInterestCandidates, pq.Array(pinIds))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	type pinInfo struct {
+		tagIds            []uuid.UUID
+		language, country string
+		createdAt         time.Time
+	}
+	byPin := make(map[uuid.UUID]*pinInfo)
+
+	for rows.Next() {
+		if err = rows.Scan(&pinId, &createdAt, &language, &country, &tagId); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		info, ok := byPin[pinId]
+		if !ok {
+			info = &pinInfo{language: language, country: country, createdAt: createdAt}
+			byPin[pinId] = info
+			order = append(order, pinId)
+		}
+		if tagId != nil {
+			info.tagIds = append(info.tagIds, *tagId)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	candidates := make([]interests.Candidate, len(order))
+	for i, id := range order {
+		info := byPin[id]
+		candidates[i] = interests.NewCandidate(id, info.tagIds, shared.Language(info.language), shared.Country(info.country), info.createdAt)
+	}
+
+	return candidates, nil
+}
+
+func (r interestRepository) AddPin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryAddInterestPin, userId, string(signal), pinId, time.Now())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r interestRepository) RemovePin(ctx context.Context, userId uuid.UUID, signal interests.Signal, pinId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryRemoveInterestPin, userId, string(signal), pinId, time.Now())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r interestRepository) AddTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryAddInterestTag, userId, tagId, time.Now())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r interestRepository) RemoveTag(ctx context.Context, userId, tagId uuid.UUID) error {
+	_, err := r.DB.ExecContext(ctx, QueryRemoveInterestTag, userId, tagId)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestInterestRepository_GetProfile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInterestRepository(db)
+	userId, kitchenId, gardenId := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInterestProfile)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"language", "country"}).AddRow("ES", "BO"))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInterests)).
+		WithArgs(userId, interests.MaxInterests).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_id", "name", "signal", "weight", "title"}).
+			AddRow(kitchenId, "kitchen", "save", 3.0, "Walnut cabinets").
+			AddRow(kitchenId, "kitchen", "follow", 1.0, nil).
+			AddRow(gardenId, "garden", "like", 1.0, nil))
+
+	profile, err := repo.GetProfile(ctx, userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.Spanish, profile.Language())
+	assert.Equal(t, shared.Country("BO"), profile.Country())
+	require.Len(t, profile.Interests(kitchenId), 2)
+	assert.Equal(t, interests.SaveSignal, profile.Interests(kitchenId)[0].Signal())
+	assert.Equal(t, "Walnut cabinets", *profile.Interests(kitchenId)[0].PinTitle())
+	assert.Nil(t, profile.Interests(kitchenId)[1].PinTitle())
+	require.Len(t, profile.Interests(gardenId), 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInterestRepository_GetProfile_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInterestRepository(db)
+	userId := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInterestProfile)).
+		WithArgs(userId).
+		WillReturnError(sql.ErrNoRows)
+
+	profile, err := repo.GetProfile(ctx, userId)
+
+	assert.Nil(t, profile)
+	assert.ErrorIs(t, err, users.ErrNotFoundUser)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInterestRepository_GetCandidates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInterestRepository(db)
+	firstId, secondId, kitchenId, woodId, now := uuid.New(), uuid.New(), uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInterestCandidates)).
+		WithArgs(pq.Array([]uuid.UUID{firstId, secondId})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "language", "country", "tag_id"}).
+			AddRow(firstId, now, "EN", "US", kitchenId).
+			AddRow(secondId, now, "ES", "ES", nil).
+			AddRow(firstId, now, "EN", "US", woodId))
+
+	candidates, err := repo.GetCandidates(ctx, []uuid.UUID{firstId, secondId})
+
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+	assert.Equal(t, firstId, candidates[0].PinId())
+	assert.Equal(t, []uuid.UUID{kitchenId, woodId}, candidates[0].TagIds())
+	assert.Equal(t, shared.English, candidates[0].Language())
+	assert.Equal(t, secondId, candidates[1].PinId())
+	assert.Empty(t, candidates[1].TagIds())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInterestRepository_Writes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInterestRepository(db)
+	userId, pinId, tagId := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryAddInterestPin)).
+		WithArgs(userId, "save", pinId, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRemoveInterestPin)).
+		WithArgs(userId, "like", pinId, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryAddInterestTag)).
+		WithArgs(userId, tagId, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRemoveInterestTag)).
+		WithArgs(userId, tagId).
+		WillReturnError(errors.New("db down"))
+
+	require.NoError(t, repo.AddPin(ctx, userId, interests.SaveSignal, pinId))
+	require.NoError(t, repo.RemovePin(ctx, userId, interests.LikeSignal, pinId))
+	require.NoError(t, repo.AddTag(ctx, userId, tagId))
+	assert.ErrorIs(t, repo.RemoveTag(ctx, userId, tagId), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+const (
+	QueryExistLike = `SELECT EXISTS(
+						SELECT 1
+						FROM pin_likes
+						WHERE pin_id = $1 AND user_id = $2)`
+	QueryCreateLike = `INSERT INTO pin_likes (pin_id, user_id, created_at)
+					   VALUES ($1, $2, $3)`
+	QueryDeleteLike = `DELETE FROM pin_likes
+					   WHERE pin_id = $1 AND user_id = $2`
+)
+
+type likeRepository struct {
+	DB *sql.DB
+}
+
+func NewLikeRepository(db *sql.DB) pins.LikeRepository {
+	return &likeRepository{
+		DB: db,
+	}
+}
+
+func (r likeRepository) Exists(ctx context.Context, pinId, userId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistLike, pinId, userId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r likeRepository) Create(ctx context.Context, l *pins.Like) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateLike, l.PinId(), l.UserId(), l.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r likeRepository) Delete(ctx context.Context, l *pins.Like) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteLike, l.PinId(), l.UserId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
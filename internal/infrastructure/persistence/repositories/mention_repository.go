@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetListMentionsBySource = `SELECT id, author_id, mentioned_user_id, "offset", length, created_at
+									FROM mentions
+									WHERE source_type = $1 AND source_id = $2
+									ORDER BY "offset"`
+	QueryDeleteMentionsBySource = `DELETE FROM mentions
+								   WHERE source_type = $1 AND source_id = $2`
+	QueryCreateMention = `INSERT INTO mentions (id, source_type, source_id, author_id, mentioned_user_id, "offset", length, created_at)
+						  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+)
+
+type mentionRepository struct {
+	DB *sql.DB
+}
+
+func NewMentionRepository(db *sql.DB) mentions.MentionRepository {
+	return &mentionRepository{
+		DB: db,
+	}
+}
+
+func (r mentionRepository) GetListBySource(ctx context.Context, sourceType mentions.SourceType, sourceId uuid.UUID) ([]*mentions.Mention, error) {
+	var (
+		mentionsList                  []*mentions.Mention
+		id, authorId, mentionedUserId uuid.UUID
+		offset, length                int
+		createdAt                     time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListMentionsBySource, string(sourceType), sourceId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&id, &authorId, &mentionedUserId, &offset, &length, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		mention, err := mentions.NewMentionFromDB(id, string(sourceType), sourceId, authorId, mentionedUserId, offset, length, createdAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrConcatenating, err)
+		}
+		mentionsList = append(mentionsList, mention)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return mentionsList, nil
+}
+
+func (r mentionRepository) Replace(ctx context.Context, sourceType mentions.SourceType, sourceId uuid.UUID, mentionsList []*mentions.Mention) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, QueryDeleteMentionsBySource, string(sourceType), sourceId); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	for _, m := range mentionsList {
+		_, err = tx.ExecContext(ctx, QueryCreateMention,
+			m.Id(), string(m.SourceType()), m.SourceId(), m.AuthorId(), m.MentionedUserId(), m.Offset(), m.Length(), m.CreatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf(got, ErrQuery, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
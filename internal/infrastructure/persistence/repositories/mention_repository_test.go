@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mention"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestMentionRepository_GetListBySource(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewMentionRepository(db)
+	sourceId, authorId, mentionedId := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListMentionsBySource)).WithArgs("comment", sourceId).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "author_id", "mentioned_user_id", "offset", "length", "created_at"}).
+			AddRow(uuid.New(), authorId, mentionedId, 3, 9, now),
+	)
+
+	mentionsList, err := repo.GetListBySource(ctx, mentions.CommentSource, sourceId)
+
+	require.NoError(t, err)
+	require.Len(t, mentionsList, 1)
+	assert.Equal(t, mentions.CommentSource, mentionsList[0].SourceType())
+	assert.Equal(t, sourceId, mentionsList[0].SourceId())
+	assert.Equal(t, mentionedId, mentionsList[0].MentionedUserId())
+	assert.Equal(t, 3, mentionsList[0].Offset())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMentionRepository_Replace(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewMentionRepository(db)
+	sourceId, authorId := uuid.New(), uuid.New()
+	m, err := mentions.NewMention(mentions.PinSource, sourceId, authorId, uuid.New(), 0, 5)
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteMentionsBySource)).WithArgs("pin", sourceId).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateMention)).
+		WithArgs(m.Id(), "pin", sourceId, authorId, m.MentionedUserId(), 0, 5, m.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Replace(ctx, mentions.PinSource, sourceId, []*mentions.Mention{m})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMentionRepository_Replace_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewMentionRepository(db)
+	sourceId := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteMentionsBySource)).WithArgs("pin", sourceId).WillReturnError(ErrDatabase)
+	mock.ExpectRollback()
+
+	err = repo.Replace(ctx, mentions.PinSource, sourceId, nil)
+
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetListMessagesByConversationId = `SELECT id, sender_id, body, pin_id, board_id, created_at
+											FROM messages
+											WHERE conversation_id = $1 AND ($2::timestamp IS NULL OR (created_at, id) < ($2, $3))
+											ORDER BY created_at DESC, id DESC
+											LIMIT $4`
+	QueryCountUnreadMessagesByUserId = `SELECT m.conversation_id, COUNT(*)
+										FROM messages m
+										JOIN conversation_members cm ON cm.conversation_id = m.conversation_id AND cm.user_id = $1
+										WHERE m.sender_id <> $1 AND (cm.last_read_at IS NULL OR m.created_at > cm.last_read_at)
+										GROUP BY m.conversation_id`
+	QueryCreateMessage = `INSERT INTO messages (id, conversation_id, sender_id, body, pin_id, board_id, created_at)
+						  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	QueryTouchConversation = `UPDATE conversations
+							  SET last_message_at = $2, updated_at = $2
+							  WHERE id = $1`
+)
+
+type messageRepository struct {
+	DB *sql.DB
+}
+
+func NewMessageRepository(db *sql.DB) conversations.MessageRepository {
+	return &messageRepository{
+		DB: db,
+	}
+}
+
+func (r messageRepository) GetListByConversationId(ctx context.Context, conversationId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*conversations.Message, error) {
+	var (
+		messagesList   []*conversations.Message
+		id, senderId   uuid.UUID
+		body           *string
+		pinId, boardId *uuid.UUID
+		createdAt      time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListMessagesByConversationId, conversationId, before, beforeId, limit)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &senderId, &body, &pinId, &boardId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		messagesList = append(messagesList, conversations.NewMessageFromDB(id, conversationId, senderId, body, pinId, boardId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return messagesList, nil
+}
+
+func (r messageRepository) CountUnreadByUserId(ctx context.Context, userId uuid.UUID) (map[uuid.UUID]int, error) {
+	var (
+		conversationId uuid.UUID
+		count          int
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryCountUnreadMessagesByUserId, userId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	unread := make(map[uuid.UUID]int)
+	for rows.Next() {
+		if err = rows.Scan(&conversationId, &count); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		unread[conversationId] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return unread, nil
+}
+
+func (r messageRepository) Create(ctx context.Context, m *conversations.Message) (*conversations.Message, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, QueryCreateMessage, m.Id(), m.ConversationId(), m.SenderId(), m.Body(), m.PinId(), m.BoardId(), m.CreatedAt())
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryTouchConversation, m.ConversationId(), m.CreatedAt()); err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	// Sending a message means the sender has read everything up to it.
+	if _, err = tx.ExecContext(ctx, QueryMarkConversationRead, m.ConversationId(), m.SenderId(), m.CreatedAt()); err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return m, nil
+}
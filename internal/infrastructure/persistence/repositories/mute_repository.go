@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetListMutesByMuterId = `SELECT muted_id, created_at
+								  FROM user_mutes
+								  WHERE muter_id = $1
+								  ORDER BY created_at DESC`
+	QueryExistMute = `SELECT EXISTS(
+						SELECT 1
+						FROM user_mutes
+						WHERE muter_id = $1 AND muted_id = $2)`
+	QueryCreateMute = `INSERT INTO user_mutes (muter_id, muted_id, created_at)
+					   VALUES ($1, $2, $3)
+					   ON CONFLICT DO NOTHING`
+	QueryDeleteMute = `DELETE FROM user_mutes
+					   WHERE muter_id = $1 AND muted_id = $2`
+)
+
+type muteRepository struct {
+	DB *sql.DB
+}
+
+func NewMuteRepository(db *sql.DB) mutes.MuteRepository {
+	return &muteRepository{
+		DB: db,
+	}
+}
+
+func (r muteRepository) GetListByMuterId(ctx context.Context, muterId uuid.UUID) ([]*mutes.Mute, error) {
+	var (
+		mutesList []*mutes.Mute
+		mutedId   uuid.UUID
+		createdAt time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListMutesByMuterId, muterId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&mutedId, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		mutesList = append(mutesList, mutes.NewMuteFromDB(muterId, mutedId, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return mutesList, nil
+}
+
+func (r muteRepository) Exists(ctx context.Context, muterId, mutedId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistMute, muterId, mutedId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r muteRepository) Create(ctx context.Context, m *mutes.Mute) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateMute, m.MuterId(), m.MutedId(), m.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r muteRepository) Delete(ctx context.Context, m *mutes.Mute) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteMute, m.MuterId(), m.MutedId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetListNotificationsByRecipient = `SELECT id, actor_id, kind, pin_id, comment_id, read_at, created_at
+											FROM notifications
+											WHERE recipient_id = $1 AND ($2::timestamp IS NULL OR (created_at, id) < ($2, $3))
+											ORDER BY created_at DESC, id DESC
+											LIMIT $4`
+	QueryGetListNotificationsSince = `SELECT id, actor_id, kind, pin_id, comment_id, read_at, created_at
+									  FROM notifications
+									  WHERE recipient_id = $1 AND (created_at, id) > (
+										SELECT created_at, id
+										FROM notifications
+										WHERE id = $2 AND recipient_id = $1)
+									  ORDER BY created_at ASC, id ASC
+									  LIMIT $3`
+	QueryGetNotificationById = `SELECT id, recipient_id, actor_id, kind, pin_id, comment_id, read_at, created_at
+								FROM notifications
+								WHERE id = $1`
+	QueryCountUnreadNotifications = `SELECT COUNT(*)
+									 FROM notifications
+									 WHERE recipient_id = $1 AND read_at IS NULL`
+	QueryCreateNotification = `INSERT INTO notifications (id, recipient_id, actor_id, kind, pin_id, comment_id, created_at)
+							   VALUES ($1, $2, $3, $4, $5, $6, $7)
+							   RETURNING id, recipient_id, actor_id, kind, pin_id, comment_id, read_at, created_at`
+	QueryMarkNotificationRead = `UPDATE notifications
+								 SET read_at = $2
+								 WHERE id = $1 AND read_at IS NULL`
+	QueryMarkAllNotificationsRead = `UPDATE notifications
+									 SET read_at = $2
+									 WHERE recipient_id = $1 AND read_at IS NULL`
+	QueryPruneNotifications = `DELETE FROM notifications
+							   WHERE created_at < $1
+							   OR id IN (
+									SELECT id
+									FROM (
+										SELECT id, ROW_NUMBER() OVER (PARTITION BY recipient_id ORDER BY created_at DESC) AS position
+										FROM notifications
+									) ranked
+									WHERE position > $2)`
+)
+
+type notificationRepository struct {
+	DB *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) notifications.NotificationRepository {
+	return &notificationRepository{
+		DB: db,
+	}
+}
+
+func (r notificationRepository) GetListByRecipient(ctx context.Context, recipientId uuid.UUID, before *time.Time, beforeId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	return r.queryNotifications(ctx, recipientId, QueryGetListNotificationsByRecipient, recipientId, before, beforeId, limit)
+}
+
+func (r notificationRepository) GetListSince(ctx context.Context, recipientId, sinceId uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	return r.queryNotifications(ctx, recipientId, QueryGetListNotificationsSince, recipientId, sinceId, limit)
+}
+
+func (r notificationRepository) queryNotifications(ctx context.Context, recipientId uuid.UUID, query string, args ...any) ([]*notifications.Notification, error) {
+	var (
+		notificationsList []*notifications.Notification
+		id, actorId       uuid.UUID
+		kind              string
+		pinId, commentId  *uuid.UUID
+		readAt            *time.Time
+		createdAt         time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&id, &actorId, &kind, &pinId, &commentId, &readAt, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		n, err := notifications.NewNotificationFromDB(id, recipientId, actorId, kind, pinId, commentId, readAt, createdAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrConcatenating, err)
+		}
+		notificationsList = append(notificationsList, n)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return notificationsList, nil
+}
+
+func (r notificationRepository) GetById(ctx context.Context, id uuid.UUID) (*notifications.Notification, error) {
+	var (
+		notificationId, recipientId, actorId uuid.UUID
+		kind                                 string
+		pinId, commentId                     *uuid.UUID
+		readAt                               *time.Time
+		createdAt                            time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetNotificationById, id).Scan(
+		&notificationId, &recipientId, &actorId, &kind, &pinId, &commentId, &readAt, &createdAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	n, err := notifications.NewNotificationFromDB(notificationId, recipientId, actorId, kind, pinId, commentId, readAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenating, err)
+	}
+
+	return n, nil
+}
+
+func (r notificationRepository) CountUnread(ctx context.Context, recipientId uuid.UUID) (int, error) {
+	var count int
+
+	err := r.DB.QueryRowContext(ctx, QueryCountUnreadNotifications, recipientId).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return count, nil
+}
+
+func (r notificationRepository) Create(ctx context.Context, n *notifications.Notification) (*notifications.Notification, error) {
+	var (
+		id, recipientId, actorId uuid.UUID
+		kind                     string
+		pinId, commentId         *uuid.UUID
+		readAt                   *time.Time
+		createdAt                time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryCreateNotification,
+		n.Id(), n.RecipientId(), n.ActorId(), string(n.Kind()), n.PinId(), n.CommentId(), n.CreatedAt(),
+	).Scan(
+		&id, &recipientId, &actorId, &kind, &pinId, &commentId, &readAt, &createdAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	notification, err := notifications.NewNotificationFromDB(id, recipientId, actorId, kind, pinId, commentId, readAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenating, err)
+	}
+
+	return notification, nil
+}
+
+func (r notificationRepository) MarkRead(ctx context.Context, n *notifications.Notification) error {
+	_, err := r.DB.ExecContext(ctx, QueryMarkNotificationRead, n.Id(), n.ReadAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r notificationRepository) MarkAllRead(ctx context.Context, recipientId uuid.UUID, readAt time.Time) (int64, error) {
+	result, err := r.DB.ExecContext(ctx, QueryMarkAllNotificationsRead, recipientId, readAt)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected, nil
+}
+
+func (r notificationRepository) Prune(ctx context.Context, olderThan time.Time, keepPerRecipient int) (int64, error) {
+	result, err := r.DB.ExecContext(ctx, QueryPruneNotifications, olderThan, keepPerRecipient)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected, nil
+}
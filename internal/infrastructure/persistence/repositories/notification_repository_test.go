@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNotificationRepository_GetListByRecipient(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	recipientId, actorId, pinId, beforeId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	before := time.Now()
+	readAt := before.Add(-time.Minute)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListNotificationsByRecipient)).WithArgs(recipientId, before, beforeId, 20).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "actor_id", "kind", "pin_id", "comment_id", "read_at", "created_at"}).
+			AddRow(uuid.New(), actorId, "save", pinId, nil, nil, before.Add(-time.Second)).
+			AddRow(uuid.New(), actorId, "follow", nil, nil, readAt, before.Add(-time.Hour)),
+	)
+
+	notificationsList, err := repo.GetListByRecipient(ctx, recipientId, &before, beforeId, 20)
+
+	require.NoError(t, err)
+	require.Len(t, notificationsList, 2)
+	assert.Equal(t, notifications.SaveKind, notificationsList[0].Kind())
+	assert.Equal(t, recipientId, notificationsList[0].RecipientId())
+	require.NotNil(t, notificationsList[0].PinId())
+	assert.Equal(t, pinId, *notificationsList[0].PinId())
+	assert.Nil(t, notificationsList[0].CommentId())
+	assert.False(t, notificationsList[0].IsRead())
+	assert.Equal(t, notifications.FollowKind, notificationsList[1].Kind())
+	assert.Nil(t, notificationsList[1].PinId())
+	assert.True(t, notificationsList[1].IsRead())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_GetListByRecipient_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	recipientId := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListNotificationsByRecipient)).WithArgs(recipientId, nil, uuid.Nil, 20).WillReturnError(ErrDatabase)
+
+	notificationsList, err := repo.GetListByRecipient(ctx, recipientId, nil, uuid.Nil, 20)
+
+	assert.Nil(t, notificationsList)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_CountUnread(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	recipientId := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountUnreadNotifications)).WithArgs(recipientId).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(7),
+	)
+
+	count, err := repo.CountUnread(ctx, recipientId)
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	pinId, commentId := uuid.New(), uuid.New()
+	n := notifications.NewNotification(uuid.New(), uuid.New(), notifications.CommentKind, &pinId, &commentId)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateNotification)).
+		WithArgs(n.Id(), n.RecipientId(), n.ActorId(), "comment", pinId, commentId, n.CreatedAt()).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "recipient_id", "actor_id", "kind", "pin_id", "comment_id", "read_at", "created_at"}).
+				AddRow(n.Id(), n.RecipientId(), n.ActorId(), "comment", pinId, commentId, nil, n.CreatedAt()),
+		)
+
+	created, err := repo.Create(ctx, n)
+
+	require.NoError(t, err)
+	assert.Equal(t, n.Id(), created.Id())
+	assert.Equal(t, notifications.CommentKind, created.Kind())
+	assert.Equal(t, commentId, *created.CommentId())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_MarkAllRead(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	recipientId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkAllNotificationsRead)).WithArgs(recipientId, now).WillReturnResult(sqlmock.NewResult(0, 4))
+
+	updated, err := repo.MarkAllRead(ctx, recipientId, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_Prune(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	olderThan := time.Now().AddDate(0, 0, -90)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryPruneNotifications)).WithArgs(olderThan, 500).WillReturnResult(sqlmock.NewResult(0, 12))
+
+	deleted, err := repo.Prune(ctx, olderThan, 500)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_Prune_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	olderThan := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryPruneNotifications)).WithArgs(olderThan, 500).WillReturnError(ErrDatabase)
+
+	deleted, err := repo.Prune(ctx, olderThan, 500)
+
+	assert.Zero(t, deleted)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_GetListSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	recipientId, sinceId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListNotificationsSince)).WithArgs(recipientId, sinceId, 100).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "actor_id", "kind", "pin_id", "comment_id", "read_at", "created_at"}).
+			AddRow(uuid.New(), uuid.New(), "follow", nil, nil, nil, now),
+	)
+
+	notificationsList, err := repo.GetListSince(ctx, recipientId, sinceId, 100)
+
+	require.NoError(t, err)
+	require.Len(t, notificationsList, 1)
+	assert.Equal(t, recipientId, notificationsList[0].RecipientId())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
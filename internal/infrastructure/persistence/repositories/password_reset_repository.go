@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryCreatePasswordReset = `INSERT INTO password_resets (id, user_id, token_hash, created_at, expires_at)
+								VALUES ($1, $2, $3, $4, $5)`
+	QueryGetPasswordResetByHash = `SELECT id, user_id, token_hash, created_at, expires_at, used_at
+								   FROM password_resets
+								   WHERE token_hash = $1`
+	QueryMarkPasswordResetUsed = `UPDATE password_resets
+								  SET used_at = $2
+								  WHERE id = $1 AND used_at IS NULL`
+	QueryCountPasswordResetsSince = `SELECT COUNT(*)
+									 FROM password_resets
+									 WHERE user_id = $1 AND created_at >= $2`
+)
+
+type passwordResetRepository struct {
+	DB *sql.DB
+}
+
+func NewPasswordResetRepository(db *sql.DB) email.PasswordResetRepository {
+	return &passwordResetRepository{
+		DB: db,
+	}
+}
+
+func (r passwordResetRepository) Save(ctx context.Context, pr *email.PasswordReset) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreatePasswordReset, pr.Id, pr.UserId, pr.TokenHash, pr.CreatedAt, pr.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r passwordResetRepository) FindByHash(ctx context.Context, hash string) (*email.PasswordReset, error) {
+	pr := &email.PasswordReset{}
+	err := r.DB.QueryRowContext(ctx, QueryGetPasswordResetByHash, hash).Scan(&pr.Id, &pr.UserId, &pr.TokenHash, &pr.CreatedAt, &pr.ExpiresAt, &pr.UsedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, email.ErrNotFoundPasswordReset
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return pr, nil
+}
+
+func (r passwordResetRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	result, err := r.DB.ExecContext(ctx, QueryMarkPasswordResetUsed, id, at)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r passwordResetRepository) CountSince(ctx context.Context, userId uuid.UUID, since time.Time) (int, error) {
+	var count int
+	if err := r.DB.QueryRowContext(ctx, QueryCountPasswordResetsSince, userId, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return count, nil
+}
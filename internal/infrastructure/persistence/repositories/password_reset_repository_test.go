@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestPasswordResetRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPasswordResetRepository(db)
+	reset, _, err := email.NewPasswordReset(uuid.New(), time.Hour)
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePasswordReset)).
+		WithArgs(reset.Id, reset.UserId, reset.TokenHash, reset.CreatedAt, reset.ExpiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePasswordReset)).
+		WithArgs(reset.Id, reset.UserId, reset.TokenHash, reset.CreatedAt, reset.ExpiresAt).
+		WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Save(ctx, reset))
+	assert.ErrorIs(t, repo.Save(ctx, reset), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordResetRepository_FindByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPasswordResetRepository(db)
+	id, userId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPasswordResetByHash)).WithArgs("hash").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_id", "token_hash", "created_at", "expires_at", "used_at"}).
+			AddRow(id, userId, "hash", now, now.Add(time.Hour), nil),
+	)
+
+	reset, err := repo.FindByHash(ctx, "hash")
+
+	require.NoError(t, err)
+	assert.Equal(t, id, reset.Id)
+	assert.Equal(t, userId, reset.UserId)
+	assert.False(t, reset.IsUsed())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordResetRepository_FindByHash_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPasswordResetRepository(db)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPasswordResetByHash)).WithArgs("hash").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_id", "token_hash", "created_at", "expires_at", "used_at"}),
+	)
+
+	reset, err := repo.FindByHash(ctx, "hash")
+
+	assert.ErrorIs(t, err, email.ErrNotFoundPasswordReset)
+	assert.Nil(t, reset)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordResetRepository_MarkUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPasswordResetRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkPasswordResetUsed)).WithArgs(id, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkPasswordResetUsed)).WithArgs(id, now).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	marked, err := repo.MarkUsed(ctx, id, now)
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	marked, err = repo.MarkUsed(ctx, id, now)
+	require.NoError(t, err)
+	assert.False(t, marked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPasswordResetRepository_CountSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPasswordResetRepository(db)
+	userId, since := uuid.New(), time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountPasswordResetsSince)).WithArgs(userId, since).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(2),
+	)
+
+	count, err := repo.CountSince(ctx, userId, since)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,314 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetAllPins = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+					   FROM pins`
+	QueryGetListPins = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+						FROM pins
+						WHERE deleted_at IS NULL`
+	QueryGetListPinsByUserId = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+								FROM pins
+								WHERE user_id = $1 AND deleted_at IS NULL`
+	QueryGetListPinsByName = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+							  FROM pins
+							  WHERE title ILIKE '%' || $1 || '%' AND deleted_at IS NULL`
+	QueryGetListPinsByTag = `SELECT p.id, p.user_id, p.board_id, p.title, p.description, p.image, p.save_count, p.like_count, p.comment_count, p.visibility, p.created_at, p.updated_at, p.deleted_at
+							 FROM pins p
+							 JOIN pins_tags pt ON pt.pin_id = p.id
+							 JOIN tags t ON t.id = pt.tag_id
+							 WHERE t.name = $1 AND p.deleted_at IS NULL
+							 AND NOT EXISTS(
+								SELECT 1
+								FROM user_blocks b
+								WHERE (b.blocker_id = $2 AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = $2))`
+	QueryGetListPinsByIds = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+							 FROM pins
+							 WHERE id = ANY($1) AND deleted_at IS NULL`
+	QueryGetPinById = `SELECT id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at
+					   FROM pins
+					   WHERE id = $1`
+	QueryGetTagsByPinIds = `SELECT pt.pin_id, t.id, t.name, t.created_at, t.deleted_at
+							FROM pins_tags pt
+							JOIN tags t ON t.id = pt.tag_id
+							WHERE pt.pin_id = ANY($1)
+							ORDER BY t.name`
+	QueryExistPinById = `SELECT EXISTS(
+							SELECT 1
+							FROM pins
+							WHERE id = $1 AND deleted_at IS NULL)`
+	QueryCreatePin = `INSERT INTO pins (id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at)
+					  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+					  RETURNING id, user_id, board_id, title, description, image, save_count, like_count, comment_count, visibility, created_at, updated_at, deleted_at`
+	QueryUpdatePin = `UPDATE pins
+					  SET title = $2, description = $3, image = $4, save_count = $5, like_count = $6, comment_count = $7, visibility = $8, updated_at = $9
+					  WHERE id = $1 AND deleted_at IS NULL`
+	QueryDeletePin = `UPDATE pins
+					  SET deleted_at = $2
+					  WHERE id = $1 AND deleted_at IS NULL`
+	QueryDeletePinTags = `DELETE FROM pins_tags
+						  WHERE pin_id = $1`
+	QueryCreatePinTag = `INSERT INTO pins_tags (pin_id, tag_id)
+						 VALUES ($1, $2)
+						 ON CONFLICT DO NOTHING`
+)
+
+type pinRepository struct {
+	DB *sql.DB
+}
+
+func NewPinRepository(db *sql.DB) pins.PinRepository {
+	return &pinRepository{
+		DB: db,
+	}
+}
+
+func (r pinRepository) GetAll(ctx context.Context) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetAllPins)
+}
+
+func (r pinRepository) GetList(ctx context.Context) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetListPins)
+}
+
+func (r pinRepository) GetListByUserId(ctx context.Context, id uuid.UUID) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetListPinsByUserId, id)
+}
+
+func (r pinRepository) GetListByName(ctx context.Context, name string) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetListPinsByName, name)
+}
+
+func (r pinRepository) GetListByTag(ctx context.Context, tag string, viewerId uuid.UUID) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetListPinsByTag, tag, viewerId)
+}
+
+func (r pinRepository) GetListByIds(ctx context.Context, ids []uuid.UUID) ([]*pins.Pin, error) {
+	return r.queryPins(ctx, QueryGetListPinsByIds, pq.Array(ids))
+}
+
+func (r pinRepository) GetById(ctx context.Context, id uuid.UUID) (*pins.Pin, error) {
+	var (
+		pinId, userId, boardId             uuid.UUID
+		title                              string
+		description, image                 *string
+		saveCount, likeCount, commentCount int
+		visibility                         bool
+		createdAt, updatedAt               time.Time
+		deletedAt                          *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetPinById, id).Scan(
+		&pinId, &userId, &boardId, &title, &description, &image, &saveCount, &likeCount, &commentCount, &visibility, &createdAt, &updatedAt, &deletedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	tags, err := r.tagsByPinIds(ctx, []uuid.UUID{pinId})
+	if err != nil {
+		return nil, err
+	}
+
+	pin := pins.NewPinFromDB(pinId, userId, boardId, title, description, image, saveCount, likeCount, commentCount, visibility, tags[pinId], createdAt, updatedAt, deletedAt)
+
+	return pin, nil
+}
+
+func (r pinRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistPinById, id).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r pinRepository) Create(ctx context.Context, p *pins.Pin) (*pins.Pin, error) {
+	var (
+		pinId, userId, boardId             uuid.UUID
+		title                              string
+		description, image                 *string
+		saveCount, likeCount, commentCount int
+		visibility                         bool
+		createdAt, updatedAt               time.Time
+		deletedAt                          *time.Time
+	)
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, QueryCreatePin,
+		p.Id(), p.UserId(), p.BoardId(), p.Title(), p.Description(), p.Image(), p.SaveCount(), p.LikeCount(), p.CommentCount(), p.Visibility(), p.CreatedAt(), p.UpdatedAt(),
+	).Scan(
+		&pinId, &userId, &boardId, &title, &description, &image, &saveCount, &likeCount, &commentCount, &visibility, &createdAt, &updatedAt, &deletedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = linkTags(ctx, tx, pinId, p.Tags()); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	pin := pins.NewPinFromDB(pinId, userId, boardId, title, description, image, saveCount, likeCount, commentCount, visibility, p.Tags(), createdAt, updatedAt, deletedAt)
+
+	return pin, nil
+}
+
+func (r pinRepository) Update(ctx context.Context, p *pins.Pin) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, QueryUpdatePin,
+		p.Id(), p.Title(), p.Description(), p.Image(), p.SaveCount(), p.LikeCount(), p.CommentCount(), p.Visibility(), p.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, QueryDeletePinTags, p.Id()); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	if err = linkTags(ctx, tx, p.Id(), p.Tags()); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r pinRepository) Delete(ctx context.Context, p *pins.Pin) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeletePin, p.Id(), p.DeletedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r pinRepository) queryPins(ctx context.Context, query string, args ...any) ([]*pins.Pin, error) {
+	var (
+		pinsList                           []*pins.Pin
+		ids                                []uuid.UUID
+		pinId, userId, boardId             uuid.UUID
+		title                              string
+		description, image                 *string
+		saveCount, likeCount, commentCount int
+		visibility                         bool
+		createdAt, updatedAt               time.Time
+		deletedAt                          *time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&pinId, &userId, &boardId, &title, &description, &image, &saveCount, &likeCount, &commentCount, &visibility, &createdAt, &updatedAt, &deletedAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		pin := pins.NewPinFromDB(pinId, userId, boardId, title, description, image, saveCount, likeCount, commentCount, visibility, nil, createdAt, updatedAt, deletedAt)
+		pinsList = append(pinsList, pin)
+		ids = append(ids, pinId)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	if len(ids) == 0 {
+		return pinsList, nil
+	}
+
+	tags, err := r.tagsByPinIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range pinsList {
+		pinsList[i] = pins.NewPinFromDB(p.Id(), p.UserId(), p.BoardId(), p.Title(), p.Description(), p.Image(), p.SaveCount(), p.LikeCount(), p.CommentCount(), p.Visibility(), tags[p.Id()], p.CreatedAt(), p.UpdatedAt(), p.DeletedAt())
+	}
+
+	return pinsList, nil
+}
+
+func (r pinRepository) tagsByPinIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]pins.Tag, error) {
+	var (
+		pinId, tagId uuid.UUID
+		name         string
+		createdAt    time.Time
+		deletedAt    *time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetTagsByPinIds, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	tags := make(map[uuid.UUID][]pins.Tag)
+	for rows.Next() {
+		err = rows.Scan(&pinId, &tagId, &name, &createdAt, &deletedAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		tags[pinId] = append(tags[pinId], *pins.NewTagFromDB(tagId, name, createdAt, deletedAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return tags, nil
+}
+
+func linkTags(ctx context.Context, tx *sql.Tx, pinId uuid.UUID, tags []pins.Tag) error {
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx, QueryCreatePinTag, pinId, tag.Id()); err != nil {
+			return fmt.Errorf(got, ErrQuery, err)
+		}
+	}
+
+	return nil
+}
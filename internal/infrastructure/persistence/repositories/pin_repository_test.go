@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	pinColumns = []string{"id", "user_id", "board_id", "title", "description", "image", "save_count", "like_count", "comment_count", "visibility", "created_at", "updated_at", "deleted_at"}
+	tagColumns = []string{"pin_id", "id", "name", "created_at", "deleted_at"}
+)
+
+func TestNewPinRepository(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+
+	pr, ok := repo.(*pinRepository)
+
+	require.True(t, ok)
+	assert.Equal(t, db, pr.DB)
+}
+
+func TestPinRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	now := time.Now()
+	id, userId, boardId, tagId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	description := "Made with #oak"
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPinById)).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(pinColumns).AddRow(id, userId, boardId, "Kitchen", description, nil, 1, 2, 3, true, now, now, nil),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetTagsByPinIds)).WithArgs(sqlmock.AnyArg()).WillReturnRows(
+		sqlmock.NewRows(tagColumns).AddRow(id, tagId, "oak", now, nil),
+	)
+
+	pin, err := repo.GetById(ctx, id)
+
+	require.NoError(t, err)
+	require.NotNil(t, pin)
+	assert.Equal(t, id, pin.Id())
+	assert.Equal(t, description, *pin.Description())
+	assert.Nil(t, pin.Image())
+	assert.Equal(t, 3, pin.CommentCount())
+	require.Len(t, pin.Tags(), 1)
+	assert.Equal(t, tagId, pin.Tags()[0].Id())
+	assert.Equal(t, "oak", pin.Tags()[0].Name())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinRepository_GetById_QueryError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPinById)).WithArgs(id).WillReturnError(ErrDatabase)
+
+	pin, err := repo.GetById(ctx, id)
+
+	assert.Nil(t, pin)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.ErrorIs(t, err, ErrDatabase)
+}
+
+func TestPinRepository_GetListByTag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	now := time.Now()
+	first, second, tagId, viewerId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListPinsByTag)).WithArgs("oak", viewerId).WillReturnRows(
+		sqlmock.NewRows(pinColumns).
+			AddRow(first, uuid.New(), uuid.New(), "First", nil, nil, 0, 0, 0, true, now, now, nil).
+			AddRow(second, uuid.New(), uuid.New(), "Second", nil, nil, 0, 0, 0, true, now, now, nil),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetTagsByPinIds)).WithArgs(sqlmock.AnyArg()).WillReturnRows(
+		sqlmock.NewRows(tagColumns).AddRow(first, tagId, "oak", now, nil).AddRow(second, tagId, "oak", now, nil),
+	)
+
+	pinsList, err := repo.GetListByTag(ctx, "oak", viewerId)
+
+	require.NoError(t, err)
+	require.Len(t, pinsList, 2)
+	assert.Len(t, pinsList[0].Tags(), 1)
+	assert.Len(t, pinsList[1].Tags(), 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	description := "#oak and #kitchen"
+	tags := []pins.Tag{*pins.NewTag("oak"), *pins.NewTag("kitchen")}
+	p := pins.NewPin(uuid.New(), uuid.New(), "Kitchen", &description, tags)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePin)).
+		WithArgs(p.Id(), p.UserId(), p.BoardId(), p.Title(), p.Description(), p.Image(), 0, 0, 0, true, p.CreatedAt(), p.UpdatedAt()).
+		WillReturnRows(sqlmock.NewRows(pinColumns).AddRow(p.Id(), p.UserId(), p.BoardId(), p.Title(), description, nil, 0, 0, 0, true, p.CreatedAt(), p.UpdatedAt(), nil))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePinTag)).WithArgs(p.Id(), tags[0].Id()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePinTag)).WithArgs(p.Id(), tags[1].Id()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	pin, err := repo.Create(ctx, p)
+
+	require.NoError(t, err)
+	assert.Equal(t, p.Id(), pin.Id())
+	assert.Len(t, pin.Tags(), 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinRepository_Create_TagError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	tags := []pins.Tag{*pins.NewTag("oak")}
+	p := pins.NewPin(uuid.New(), uuid.New(), "Kitchen", nil, tags)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePin)).
+		WillReturnRows(sqlmock.NewRows(pinColumns).AddRow(p.Id(), p.UserId(), p.BoardId(), p.Title(), nil, nil, 0, 0, 0, true, p.CreatedAt(), p.UpdatedAt(), nil))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePinTag)).WithArgs(p.Id(), tags[0].Id()).WillReturnError(ErrDatabase)
+	mock.ExpectRollback()
+
+	pin, err := repo.Create(ctx, p)
+
+	assert.Nil(t, pin)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPinRepository(db)
+	tags := []pins.Tag{*pins.NewTag("oak")}
+	p := pins.NewPin(uuid.New(), uuid.New(), "Kitchen", nil, tags)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(QueryUpdatePin)).
+		WithArgs(p.Id(), p.Title(), p.Description(), p.Image(), 0, 0, 0, true, p.UpdatedAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeletePinTags)).WithArgs(p.Id()).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreatePinTag)).WithArgs(p.Id(), tags[0].Id()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.Update(ctx, p)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetRefreshTokenByHash = `SELECT id, user_id, family_id, token_hash, expires_at, created_at, used_at, revoked_at
+								  FROM refresh_tokens
+								  WHERE token_hash = $1`
+	QueryCreateRefreshToken = `INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at)
+							   VALUES ($1, $2, $3, $4, $5, $6)`
+	QueryMarkRefreshTokenUsed = `UPDATE refresh_tokens
+								 SET used_at = $2
+								 WHERE id = $1 AND used_at IS NULL`
+	QueryRevokeRefreshTokenFamily = `UPDATE refresh_tokens
+									 SET revoked_at = $2
+									 WHERE family_id = $1 AND revoked_at IS NULL`
+	QueryRevokeRefreshTokensByUser = `UPDATE refresh_tokens
+									  SET revoked_at = $2
+									  WHERE user_id = $1 AND revoked_at IS NULL`
+)
+
+type refreshTokenRepository struct {
+	DB *sql.DB
+}
+
+func NewRefreshTokenRepository(db *sql.DB) tokens.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		DB: db,
+	}
+}
+
+func (r refreshTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.RefreshToken, error) {
+	var (
+		id, userId, familyId uuid.UUID
+		tokenHash            string
+		expiresAt, createdAt time.Time
+		usedAt, revokedAt    *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetRefreshTokenByHash, hash).Scan(&id, &userId, &familyId, &tokenHash, &expiresAt, &createdAt, &usedAt, &revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, tokens.ErrNotFoundRefreshToken
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return tokens.NewRefreshTokenFromDB(id, userId, familyId, tokenHash, expiresAt, createdAt, usedAt, revokedAt), nil
+}
+
+func (r refreshTokenRepository) Create(ctx context.Context, t *tokens.RefreshToken) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateRefreshToken, t.Id(), t.UserId(), t.FamilyId(), t.Hash(), t.ExpiresAt(), t.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r refreshTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	result, err := r.DB.ExecContext(ctx, QueryMarkRefreshTokenUsed, id, at)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected == 1, nil
+}
+
+func (r refreshTokenRepository) RevokeFamily(ctx context.Context, familyId uuid.UUID, at time.Time) error {
+	if _, err := r.DB.ExecContext(ctx, QueryRevokeRefreshTokenFamily, familyId, at); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r refreshTokenRepository) RevokeUser(ctx context.Context, userId uuid.UUID, at time.Time) error {
+	if _, err := r.DB.ExecContext(ctx, QueryRevokeRefreshTokensByUser, userId, at); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
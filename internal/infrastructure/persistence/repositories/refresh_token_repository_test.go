@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRefreshTokenRepository_GetByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRefreshTokenRepository(db)
+	id, userId, familyId := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetRefreshTokenByHash)).WithArgs("hash").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_id", "family_id", "token_hash", "expires_at", "created_at", "used_at", "revoked_at"}).
+			AddRow(id, userId, familyId, "hash", now.Add(time.Hour), now, now, nil),
+	)
+
+	token, err := repo.GetByHash(ctx, "hash")
+
+	require.NoError(t, err)
+	assert.Equal(t, id, token.Id())
+	assert.Equal(t, familyId, token.FamilyId())
+	require.NotNil(t, token.UsedAt())
+	assert.Nil(t, token.RevokedAt())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepository_GetByHash_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRefreshTokenRepository(db)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetRefreshTokenByHash)).WithArgs("hash").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_id", "family_id", "token_hash", "expires_at", "created_at", "used_at", "revoked_at"}),
+	)
+
+	token, err := repo.GetByHash(ctx, "hash")
+
+	assert.ErrorIs(t, err, tokens.ErrNotFoundRefreshToken)
+	assert.Nil(t, token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRefreshTokenRepository(db)
+	token, _, err := tokens.NewRefreshToken(uuid.New(), uuid.Nil, time.Hour)
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateRefreshToken)).
+		WithArgs(token.Id(), token.UserId(), token.FamilyId(), token.Hash(), token.ExpiresAt(), token.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Create(ctx, token)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepository_MarkUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRefreshTokenRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkRefreshTokenUsed)).WithArgs(id, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkRefreshTokenUsed)).WithArgs(id, now).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	marked, err := repo.MarkUsed(ctx, id, now)
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	marked, err = repo.MarkUsed(ctx, id, now)
+	require.NoError(t, err)
+	assert.False(t, marked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepository_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRefreshTokenRepository(db)
+	familyId, userId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeRefreshTokenFamily)).WithArgs(familyId, now).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeRefreshTokensByUser)).WithArgs(userId, now).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.RevokeFamily(ctx, familyId, now))
+	assert.ErrorIs(t, repo.RevokeUser(ctx, userId, now), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
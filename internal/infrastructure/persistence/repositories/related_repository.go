@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/recommendation"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	QueryGetRelatedCandidates = `WITH src AS (
+									SELECT p.id, p.board_id, b.name
+									FROM pins p
+									JOIN boards b ON b.id = p.board_id
+									WHERE p.id = $1),
+								 src_boards AS (
+									SELECT board_id FROM src
+									UNION
+									SELECT board_id FROM pin_saves WHERE pin_id = $1),
+								 tagged AS (
+									SELECT pt.pin_id, COUNT(*) AS shared
+									FROM pins_tags st
+									JOIN pins_tags pt ON pt.tag_id = st.tag_id AND pt.pin_id <> st.pin_id
+									WHERE st.pin_id = $1
+									GROUP BY pt.pin_id
+									ORDER BY shared DESC
+									LIMIT $2),
+								 saved AS (
+									SELECT bp.pin_id, COUNT(DISTINCT bp.board_id) AS boards
+									FROM (
+										SELECT id AS pin_id, board_id FROM pins WHERE board_id IN (SELECT board_id FROM src_boards)
+										UNION
+										SELECT pin_id, board_id FROM pin_saves WHERE board_id IN (SELECT board_id FROM src_boards)) bp
+									WHERE bp.pin_id <> $1
+									GROUP BY bp.pin_id
+									ORDER BY boards DESC
+									LIMIT $2),
+								 named AS (
+									SELECT p.id AS pin_id
+									FROM src
+									JOIN boards b ON b.name % src.name AND b.id <> src.board_id
+									JOIN pins p ON p.board_id = b.id
+									ORDER BY similarity(b.name, src.name) DESC, p.created_at DESC
+									LIMIT $2)
+								 SELECT p.id, COALESCE(t.shared, 0), COALESCE(s.boards, 0), similarity(b.name, src.name), p.created_at
+								 FROM (SELECT pin_id FROM tagged UNION SELECT pin_id FROM saved UNION SELECT pin_id FROM named) c
+								 JOIN pins p ON p.id = c.pin_id
+								 JOIN boards b ON b.id = p.board_id
+								 CROSS JOIN src
+								 LEFT JOIN tagged t ON t.pin_id = p.id
+								 LEFT JOIN saved s ON s.pin_id = p.id
+								 WHERE p.id <> $1 AND p.deleted_at IS NULL AND p.visibility AND b.deleted_at IS NULL AND b.visibility`
+	QueryGetActivePins = `SELECT p.id
+						  FROM pins p
+						  LEFT JOIN (
+							SELECT pin_id, MAX(created_at) AS saved_at
+							FROM pin_saves
+							WHERE created_at >= $1
+							GROUP BY pin_id) s ON s.pin_id = p.id
+						  WHERE p.deleted_at IS NULL AND p.visibility AND (p.updated_at >= $1 OR s.saved_at IS NOT NULL)
+						  ORDER BY GREATEST(p.updated_at, COALESCE(s.saved_at, p.updated_at)) DESC
+						  LIMIT $2`
+	QueryGetRelatedVisible = `SELECT p.id
+							  FROM pins p
+							  JOIN boards b ON b.id = p.board_id
+							  WHERE p.id = ANY($2) AND p.user_id <> $1 AND p.deleted_at IS NULL AND p.visibility
+							  AND b.deleted_at IS NULL AND b.visibility
+							  AND NOT EXISTS(
+								SELECT 1
+								FROM user_blocks ub
+								WHERE (ub.blocker_id = $1 AND ub.blocked_id = p.user_id) OR (ub.blocker_id = p.user_id AND ub.blocked_id = $1))`
+)
+
+type relatedRepository struct {
+	DB *sql.DB
+}
+
+func NewRelatedRepository(db *sql.DB) recommendations.RelatedRepository {
+	return &relatedRepository{
+		DB: db,
+	}
+}
+
+func (r relatedRepository) GetCandidates(ctx context.Context, pinId uuid.UUID, limit int) ([]recommendations.Candidate, error) {
+	var (
+		candidates        []recommendations.Candidate
+		id                uuid.UUID
+		sharedTags, saves int
+		similarity        float64
+		createdAt         time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetRelatedCandidates, pinId, limit)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &sharedTags, &saves, &similarity, &createdAt); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		candidates = append(candidates, recommendations.NewCandidate(id, sharedTags, saves, similarity, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return candidates, nil
+}
+
+func (r relatedRepository) GetListActive(ctx context.Context, since time.Time, limit int) ([]uuid.UUID, error) {
+	return r.queryIds(ctx, QueryGetActivePins, since, limit)
+}
+
+func (r relatedRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	if len(pinIds) == 0 {
+		return nil, nil
+	}
+
+	return r.queryIds(ctx, QueryGetRelatedVisible, viewerId, pq.Array(pinIds))
+}
+
+func (r relatedRepository) queryIds(ctx context.Context, query string, args ...any) ([]uuid.UUID, error) {
+	var (
+		ids []uuid.UUID
+		id  uuid.UUID
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return ids, nil
+}
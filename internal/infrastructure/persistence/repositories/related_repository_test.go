@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRelatedRepository_GetCandidates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRelatedRepository(db)
+	pinId, candidateId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetRelatedCandidates)).WithArgs(pinId, 50).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "shared", "boards", "similarity", "created_at"}).AddRow(candidateId, 2, 1, 0.5, now),
+	)
+
+	candidates, err := repo.GetCandidates(ctx, pinId, 50)
+
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, candidateId, candidates[0].PinId())
+	assert.Equal(t, 2, candidates[0].SharedTags())
+	assert.Equal(t, 1, candidates[0].CoSaves())
+	assert.Equal(t, 0.5, candidates[0].BoardSimilarity())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelatedRepository_GetListVisible(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRelatedRepository(db)
+	viewerId, pinIds := uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetRelatedVisible)).WithArgs(viewerId, pq.Array(pinIds)).WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).AddRow(pinIds[1]),
+	)
+
+	visible, err := repo.GetListVisible(ctx, viewerId, pinIds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{pinIds[1]}, visible)
+
+	visible, err = repo.GetListVisible(ctx, viewerId, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, visible)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
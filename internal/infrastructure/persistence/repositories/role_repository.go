@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetUserRole = `SELECT role
+						FROM users
+						WHERE id = $1`
+	QueryChangeUserRole = `UPDATE users
+						   SET role = $2, updated_at = $3
+						   WHERE id = $1`
+)
+
+type roleRepository struct {
+	DB *sql.DB
+}
+
+func NewRoleRepository(db *sql.DB) users.RoleRepository {
+	return &roleRepository{
+		DB: db,
+	}
+}
+
+func (r *roleRepository) GetRole(ctx context.Context, id uuid.UUID) (users.Role, error) {
+	var role string
+
+	err := r.DB.QueryRowContext(ctx, QueryGetUserRole, id).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", users.ErrNotFoundUser
+	} else if err != nil {
+		return "", fmt.Errorf(got, ErrQuery, err)
+	}
+
+	parsed, err := users.ParseRole(role)
+	if err != nil {
+		return "", fmt.Errorf(got, ErrScan, err)
+	}
+
+	return parsed, nil
+}
+
+func (r *roleRepository) ChangeRole(ctx context.Context, id uuid.UUID, role users.Role) error {
+	result, err := r.DB.ExecContext(ctx, QueryChangeUserRole, id, role.String(), time.Now())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	} else if affected == 0 {
+		return users.ErrNotFoundUser
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestRoleRepository_GetRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRoleRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("moderator"))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("root"))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserRole)).WithArgs(id).WillReturnError(ErrDatabase)
+
+	role, err := repo.GetRole(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, users.RoleModerator, role)
+
+	_, err = repo.GetRole(ctx, id)
+	assert.ErrorIs(t, err, users.ErrNotFoundUser)
+
+	_, err = repo.GetRole(ctx, id)
+	assert.ErrorIs(t, err, ErrScan)
+	assert.ErrorIs(t, err, users.ErrNotARole)
+
+	_, err = repo.GetRole(ctx, id)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRoleRepository_ChangeRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRoleRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryChangeUserRole)).WithArgs(id, "admin", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryChangeUserRole)).WithArgs(id, "admin", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryChangeUserRole)).WithArgs(id, "admin", sqlmock.AnyArg()).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.ChangeRole(ctx, id, users.RoleAdmin))
+	assert.ErrorIs(t, repo.ChangeRole(ctx, id, users.RoleAdmin), users.ErrNotFoundUser)
+	assert.ErrorIs(t, repo.ChangeRole(ctx, id, users.RoleAdmin), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
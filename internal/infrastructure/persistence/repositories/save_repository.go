@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+)
+
+const (
+	QueryExistSave = `SELECT EXISTS(
+						SELECT 1
+						FROM pin_saves
+						WHERE pin_id = $1 AND board_id = $2)`
+	QueryCreateSave = `INSERT INTO pin_saves (pin_id, user_id, board_id, created_at)
+					   VALUES ($1, $2, $3, $4)`
+	QueryDeleteSave = `DELETE FROM pin_saves
+					   WHERE pin_id = $1 AND board_id = $2`
+)
+
+type saveRepository struct {
+	DB *sql.DB
+}
+
+func NewSaveRepository(db *sql.DB) pins.SaveRepository {
+	return &saveRepository{
+		DB: db,
+	}
+}
+
+func (r saveRepository) Exists(ctx context.Context, pinId, boardId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistSave, pinId, boardId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r saveRepository) Create(ctx context.Context, s *pins.Save) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateSave, s.PinId(), s.UserId(), s.BoardId(), s.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r saveRepository) Delete(ctx context.Context, s *pins.Save) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteSave, s.PinId(), s.BoardId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
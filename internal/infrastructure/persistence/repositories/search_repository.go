@@ -0,0 +1,272 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"html"
+	"strings"
+	"time"
+)
+
+// querySearchMatched matches $1, stemmed in language $2 or the viewer's ($4)
+// when null, and word by word so exact words in any language match too. Only
+// the kinds in $3 are searched. A language also keeps only content whose
+// creator writes in it. Filters: tag $5, board $6, creator country $7,
+// created-at from $8 to $9 and image $10; tag, board and image only apply to
+// pins, so boards and users drop out when any is set.
+const querySearchMatched = `WITH q AS (
+								SELECT websearch_to_tsquery(search_config(COALESCE($2::char(2), (SELECT language FROM users WHERE id = $4))), $1) ||
+									websearch_to_tsquery('simple', $1) AS query),
+							 matched AS (
+								SELECT 'pin' AS kind, p.id, p.title, concat_ws(' ', p.title, p.description) AS document,
+									search_config(u.language) AS config, ts_rank_cd(p.search_vector, q.query)::float8 AS rank,
+									p.board_id, u.country, u.language, p.created_at, p.image IS NOT NULL AS has_image
+								FROM pins p
+								CROSS JOIN q
+								JOIN boards b ON b.id = p.board_id
+								JOIN users u ON u.id = p.user_id
+								WHERE 'pin' = ANY($3) AND p.search_vector @@ q.query
+								AND p.deleted_at IS NULL AND b.deleted_at IS NULL AND ((p.visibility AND b.visibility) OR p.user_id = $4)
+								AND ($2::char(2) IS NULL OR u.language = $2) AND ($7::char(2) IS NULL OR u.country = $7)
+								AND ($8::timestamp IS NULL OR p.created_at >= $8) AND ($9::timestamp IS NULL OR p.created_at < $9)
+								AND ($6::uuid IS NULL OR p.board_id = $6) AND ($10::bool IS NULL OR (p.image IS NOT NULL) = $10)
+								AND ($5::text IS NULL OR EXISTS(
+									SELECT 1
+									FROM pins_tags pt
+									JOIN tags t ON t.id = pt.tag_id
+									WHERE pt.pin_id = p.id AND t.name = $5))
+								AND NOT EXISTS(
+									SELECT 1
+									FROM user_blocks ub
+									WHERE (ub.blocker_id = $4 AND ub.blocked_id = p.user_id) OR (ub.blocker_id = p.user_id AND ub.blocked_id = $4))
+								UNION ALL
+								SELECT 'board', b.id, b.name, concat_ws(' ', b.name, b.description),
+									search_config(u.language), ts_rank_cd(b.search_vector, q.query)::float8,
+									NULL::uuid, u.country, u.language, b.created_at, NULL::bool
+								FROM boards b
+								CROSS JOIN q
+								JOIN users u ON u.id = b.user_id
+								WHERE 'board' = ANY($3) AND b.search_vector @@ q.query
+								AND b.deleted_at IS NULL AND (b.visibility OR b.user_id = $4)
+								AND ($2::char(2) IS NULL OR u.language = $2) AND ($7::char(2) IS NULL OR u.country = $7)
+								AND ($8::timestamp IS NULL OR b.created_at >= $8) AND ($9::timestamp IS NULL OR b.created_at < $9)
+								AND $5::text IS NULL AND $6::uuid IS NULL AND $10::bool IS NULL
+								AND NOT EXISTS(
+									SELECT 1
+									FROM user_blocks ub
+									WHERE (ub.blocker_id = $4 AND ub.blocked_id = b.user_id) OR (ub.blocker_id = b.user_id AND ub.blocked_id = $4))
+								UNION ALL
+								SELECT 'user', u.id, u.user_name, concat_ws(' ', u.user_name, u.first_name, u.last_name),
+									'simple'::regconfig, ts_rank_cd(u.search_vector, q.query)::float8,
+									NULL::uuid, u.country, u.language, u.created_at, NULL::bool
+								FROM users u
+								CROSS JOIN q
+								WHERE 'user' = ANY($3) AND u.search_vector @@ q.query AND u.deleted_at IS NULL
+								AND ($2::char(2) IS NULL OR u.language = $2) AND ($7::char(2) IS NULL OR u.country = $7)
+								AND ($8::timestamp IS NULL OR u.created_at >= $8) AND ($9::timestamp IS NULL OR u.created_at < $9)
+								AND $5::text IS NULL AND $6::uuid IS NULL AND $10::bool IS NULL
+								AND NOT EXISTS(
+									SELECT 1
+									FROM user_blocks ub
+									WHERE (ub.blocker_id = $4 AND ub.blocked_id = u.id) OR (ub.blocker_id = u.id AND ub.blocked_id = $4)))`
+
+// The snippets are highlighted with two private-use characters, dropped from
+// the text beforehand, instead of markup: pins carry whatever their users
+// wrote, so the text is escaped as HTML before the selectors turn into <mark>
+// tags. See snippetHTML.
+const (
+	highlightStart = "\uE000"
+	highlightStop  = "\uE001"
+)
+
+var highlighter = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+const (
+	// QuerySearch ranks the matches after the cursor ($11 rank, $12 id) and
+	// cuts them to $13 before the snippets, the costly part, are highlighted.
+	QuerySearch = querySearchMatched + `,
+					 page AS (
+						SELECT kind, id, title, document, config, rank
+						FROM matched
+						WHERE $11::float8 IS NULL OR (rank, id) < ($11::float8, $12::uuid)
+						ORDER BY rank DESC, id DESC
+						LIMIT $13)
+					 SELECT page.kind, page.id, page.title,
+						ts_headline(page.config, translate(page.document, '` + highlightStart + highlightStop + `', ''), q.query,
+							'StartSel=` + highlightStart + `, StopSel=` + highlightStop + `, MaxWords=35, MinWords=15, MaxFragments=2'),
+						page.rank
+					 FROM page
+					 CROSS JOIN q
+					 ORDER BY page.rank DESC, page.id DESC`
+	// QuerySearchFacets counts the matches by facet value, keeping the $11 most
+	// frequent tags and boards. Created-at buckets count back from $12.
+	QuerySearchFacets = querySearchMatched + `
+					 (SELECT 'tag' AS facet, t.name AS value, '' AS label, COUNT(*) AS count
+					  FROM matched m
+					  JOIN pins_tags pt ON pt.pin_id = m.id
+					  JOIN tags t ON t.id = pt.tag_id
+					  WHERE m.kind = 'pin' AND t.deleted_at IS NULL
+					  GROUP BY t.name
+					  ORDER BY count DESC, value
+					  LIMIT $11)
+					 UNION ALL
+					 (SELECT 'board', b.id::text, b.name, COUNT(*) AS count
+					  FROM matched m
+					  JOIN boards b ON b.id = m.board_id
+					  WHERE m.kind = 'pin'
+					  GROUP BY b.id, b.name
+					  ORDER BY count DESC, b.name
+					  LIMIT $11)
+					 UNION ALL
+					 (SELECT 'country', country, '', COUNT(*) AS count
+					  FROM matched
+					  GROUP BY country
+					  ORDER BY count DESC, country)
+					 UNION ALL
+					 (SELECT 'language', language, '', COUNT(*) AS count
+					  FROM matched
+					  GROUP BY language
+					  ORDER BY count DESC, language)
+					 UNION ALL
+					 (SELECT 'created_at', r.bucket, '', COUNT(m.id)
+					  FROM (VALUES ('day', INTERVAL '1 day'), ('week', INTERVAL '7 days'), ('month', INTERVAL '1 month'), ('year', INTERVAL '1 year')) r(bucket, span)
+					  LEFT JOIN matched m ON m.created_at >= $12::timestamp - r.span
+					  GROUP BY r.bucket, r.span
+					  ORDER BY r.span)
+					 UNION ALL
+					 (SELECT 'has_image', has_image::text, '', COUNT(*) AS count
+					  FROM matched
+					  WHERE kind = 'pin'
+					  GROUP BY has_image
+					  ORDER BY has_image DESC)`
+)
+
+type searchRepository struct {
+	DB *sql.DB
+}
+
+func NewSearchRepository(db *sql.DB) searches.SearchRepository {
+	return &searchRepository{
+		DB: db,
+	}
+}
+
+func (r searchRepository) Search(ctx context.Context, query *searches.Query) ([]searches.Result, error) {
+	var (
+		results            []searches.Result
+		kind, title, text  string
+		id                 uuid.UUID
+		rank               float64
+		afterRank, afterId any
+	)
+
+	if after := query.After(); after != nil {
+		afterRank, afterId = after.Rank(), after.Id()
+	}
+
+	args := append(searchArgs(query), afterRank, afterId, query.Limit())
+	rows, err := r.DB.QueryContext(ctx, QuerySearch, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&kind, &id, &title, &text, &rank); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		results = append(results, searches.NewResult(searches.Kind(kind), id, title, snippetHTML(text), rank))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return results, nil
+}
+
+func (r searchRepository) GetFacets(ctx context.Context, query *searches.Query, now time.Time) ([]searches.Bucket, error) {
+	var (
+		buckets             []searches.Bucket
+		facet, value, label string
+		count               int
+	)
+
+	args := append(searchArgs(query), searches.MaxBuckets, now)
+	rows, err := r.DB.QueryContext(ctx, QuerySearchFacets, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&facet, &value, &label, &count); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		buckets = append(buckets, searches.NewBucket(searches.Facet(facet), value, label, count))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return buckets, nil
+}
+
+// searchArgs are the arguments of querySearchMatched, $1 to $10. Missing
+// filters are passed as nulls. The created-at bounds go in UTC: they are cast
+// to timestamp, which would otherwise drop the offset they were sent with.
+func searchArgs(query *searches.Query) []any {
+	var language, tag, boardId, country, createdFrom, createdTo, hasImage any
+
+	if query.Language() != nil {
+		language = string(*query.Language())
+	}
+
+	filters := query.Filters()
+	if filters.Tag() != nil {
+		tag = *filters.Tag()
+	}
+	if filters.BoardId() != nil {
+		boardId = *filters.BoardId()
+	}
+	if filters.Country() != nil {
+		country = string(*filters.Country())
+	}
+	if filters.CreatedFrom() != nil {
+		createdFrom = filters.CreatedFrom().UTC()
+	}
+	if filters.CreatedTo() != nil {
+		createdTo = filters.CreatedTo().UTC()
+	}
+	if filters.HasImage() != nil {
+		hasImage = *filters.HasImage()
+	}
+
+	kinds := make([]string, len(query.Kinds()))
+	for i, k := range query.Kinds() {
+		kinds[i] = string(k)
+	}
+
+	return []any{query.Text(), language, pq.Array(kinds), query.ViewerId(), tag, boardId, country, createdFrom, createdTo, hasImage}
+}
+
+// snippetHTML escapes a highlighted snippet as HTML and wraps the matched words
+// in <mark> tags, the only markup it may carry.
+func snippetHTML(snippet string) string {
+	return highlighter.Replace(html.EscapeString(snippet))
+}
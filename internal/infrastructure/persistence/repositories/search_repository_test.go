@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSearchRepository_Search(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	viewerId, pinId, userId := uuid.New(), uuid.New(), uuid.New()
+	query, err := searches.NewQuery("kitchen", nil, nil, searches.Filters{}, viewerId, nil, 10)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySearch)).
+		WithArgs("kitchen", nil, pq.Array([]string{"pin", "board", "user"}), viewerId, nil, nil, nil, nil, nil, nil, nil, nil, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "id", "title", "snippet", "rank"}).
+			AddRow("pin", pinId, "Kitchen", highlightStart+"Kitchen"+highlightStop+" ideas", 0.5).
+			AddRow("user", userId, "kitchenlover", highlightStart+"kitchenlover"+highlightStop, 0.1))
+
+	results, err := repo.Search(ctx, query)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, searches.PinKind, results[0].Kind())
+	assert.Equal(t, pinId, results[0].Id())
+	assert.Equal(t, "<mark>Kitchen</mark> ideas", results[0].Snippet())
+	assert.Equal(t, 0.5, results[0].Rank())
+	assert.Equal(t, searches.UserKind, results[1].Kind())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_Search_Markup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	viewerId, pinId := uuid.New(), uuid.New()
+	query, err := searches.NewQuery("kitchen", nil, nil, searches.Filters{}, viewerId, nil, 10)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySearch)).
+		WithArgs("kitchen", nil, pq.Array([]string{"pin", "board", "user"}), viewerId, nil, nil, nil, nil, nil, nil, nil, nil, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "id", "title", "snippet", "rank"}).
+			AddRow("pin", pinId, "Kitchen", `<img src=x onerror="alert(1)"> `+highlightStart+"kitchen"+highlightStop+` <script>alert('x')</script> <mark>&`, 0.5))
+
+	results, err := repo.Search(ctx, query)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>kitchen</mark> &lt;script&gt;alert(&#39;x&#39;)&lt;/script&gt; &lt;mark&gt;&amp;`, results[0].Snippet())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_Search_Cursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	viewerId, language := uuid.New(), shared.Spanish
+	after := searches.NewCursor(searches.NewResult(searches.BoardKind, uuid.New(), "Cocina", "", 0.3))
+	query, err := searches.NewQuery("cocina", []searches.Kind{searches.BoardKind}, &language, searches.Filters{}, viewerId, after, 10)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySearch)).
+		WithArgs("cocina", "ES", pq.Array([]string{"board"}), viewerId, nil, nil, nil, nil, nil, nil, 0.3, after.Id(), 10).
+		WillReturnError(errors.New("db down"))
+
+	results, err := repo.Search(ctx, query)
+
+	assert.Nil(t, results)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_GetFacets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	viewerId, boardId, country, hasImage := uuid.New(), uuid.New(), shared.Mexico, true
+	tag := "#Recipes"
+	now := time.Date(2025, 11, 13, 12, 0, 0, 0, time.UTC)
+	from := now.AddDate(0, -1, 0).In(time.FixedZone("UTC-6", -6*60*60))
+	filters, err := searches.NewFilters(&tag, nil, &country, &from, nil, &hasImage)
+	require.NoError(t, err)
+	query, err := searches.NewQuery("tacos", []searches.Kind{searches.PinKind}, nil, filters, viewerId, nil, 10)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySearchFacets)).
+		WithArgs("tacos", nil, pq.Array([]string{"pin"}), viewerId, "recipes", nil, "MX", from.UTC(), nil, true, searches.MaxBuckets, now).
+		WillReturnRows(sqlmock.NewRows([]string{"facet", "value", "label", "count"}).
+			AddRow("tag", "recipes", "", 12).
+			AddRow("board", boardId.String(), "Dinner", 7).
+			AddRow("created_at", "week", "", 3))
+
+	buckets, err := repo.GetFacets(ctx, query, now)
+
+	require.NoError(t, err)
+	require.Len(t, buckets, 3)
+	assert.Equal(t, searches.TagFacet, buckets[0].Facet())
+	assert.Equal(t, 12, buckets[0].Count())
+	assert.Equal(t, searches.BoardFacet, buckets[1].Facet())
+	assert.Equal(t, boardId.String(), buckets[1].Value())
+	assert.Equal(t, "Dinner", buckets[1].Label())
+	assert.Equal(t, searches.WeekBucket, buckets[2].Value())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_GetFacets_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	viewerId := uuid.New()
+	now := time.Now()
+	query, err := searches.NewQuery("tacos", nil, nil, searches.Filters{}, viewerId, nil, 10)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySearchFacets)).
+		WithArgs("tacos", nil, pq.Array([]string{"pin", "board", "user"}), viewerId, nil, nil, nil, nil, nil, nil, searches.MaxBuckets, now).
+		WillReturnError(errors.New("db down"))
+
+	buckets, err := repo.GetFacets(ctx, query, now)
+
+	assert.Nil(t, buckets)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
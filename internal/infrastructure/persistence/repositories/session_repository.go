@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetSessionById = `SELECT id, user_id, device, user_agent, ip, created_at, last_seen_at
+						   FROM sessions
+						   WHERE id = $1`
+	QueryGetActiveSessionsByUser = `SELECT s.id, s.user_id, s.device, s.user_agent, s.ip, s.created_at, s.last_seen_at
+									FROM sessions s
+									WHERE s.user_id = $1 AND EXISTS (SELECT 1
+																	 FROM refresh_tokens t
+																	 WHERE t.family_id = s.id AND t.used_at IS NULL AND t.revoked_at IS NULL AND t.expires_at > $2)
+									ORDER BY s.last_seen_at DESC`
+	QueryCreateSession = `INSERT INTO sessions (id, user_id, device, user_agent, ip, created_at, last_seen_at)
+						  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	QueryTouchSession = `UPDATE sessions
+						 SET ip = $2, last_seen_at = $3
+						 WHERE id = $1`
+)
+
+type sessionRepository struct {
+	DB *sql.DB
+}
+
+func NewSessionRepository(db *sql.DB) sessions.SessionRepository {
+	return &sessionRepository{
+		DB: db,
+	}
+}
+
+func (r sessionRepository) GetById(ctx context.Context, id uuid.UUID) (*sessions.Session, error) {
+	session, err := scanSession(r.DB.QueryRowContext(ctx, QueryGetSessionById, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, sessions.ErrNotFoundSession
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return session, nil
+}
+
+func (r sessionRepository) GetActiveByUser(ctx context.Context, userId uuid.UUID, now time.Time) ([]*sessions.Session, error) {
+	var list []*sessions.Session
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetActiveSessionsByUser, userId, now)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		list = append(list, session)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return list, nil
+}
+
+func (r sessionRepository) Create(ctx context.Context, s *sessions.Session) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateSession, s.Id(), s.UserId(), s.Device(), s.UserAgent(), s.IP(), s.CreatedAt(), s.LastSeenAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r sessionRepository) Touch(ctx context.Context, id uuid.UUID, ip string, at time.Time) error {
+	if _, err := r.DB.ExecContext(ctx, QueryTouchSession, id, ip, at); err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+type sessionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row sessionScanner) (*sessions.Session, error) {
+	var (
+		id, userId            uuid.UUID
+		device, userAgent, ip string
+		createdAt, lastSeenAt time.Time
+	)
+
+	if err := row.Scan(&id, &userId, &device, &userAgent, &ip, &createdAt, &lastSeenAt); err != nil {
+		return nil, err
+	}
+
+	return sessions.NewSessionFromDB(id, userId, device, userAgent, ip, createdAt, lastSeenAt), nil
+}
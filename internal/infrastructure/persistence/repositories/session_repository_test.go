@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var sessionColumns = []string{"id", "user_id", "device", "user_agent", "ip", "created_at", "last_seen_at"}
+
+func TestSessionRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSessionRepository(db)
+	id, userId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSessionById)).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(sessionColumns).AddRow(id, userId, "Firefox on Linux", "Mozilla/5.0", "203.0.113.7", now, now),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSessionById)).WithArgs(id).WillReturnRows(sqlmock.NewRows(sessionColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSessionById)).WithArgs(id).WillReturnError(ErrDatabase)
+
+	session, err := repo.GetById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, session.Id())
+	assert.Equal(t, userId, session.UserId())
+	assert.Equal(t, "Firefox on Linux", session.Device())
+	assert.Equal(t, "Mozilla/5.0", session.UserAgent())
+	assert.Equal(t, "203.0.113.7", session.IP())
+	assert.Equal(t, now, session.LastSeenAt())
+
+	_, err = repo.GetById(ctx, id)
+	assert.ErrorIs(t, err, sessions.ErrNotFoundSession)
+
+	_, err = repo.GetById(ctx, id)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_GetActiveByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSessionRepository(db)
+	userId := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetActiveSessionsByUser)).WithArgs(userId, now).WillReturnRows(
+		sqlmock.NewRows(sessionColumns).
+			AddRow(uuid.New(), userId, "Chrome on Android", "", "198.51.100.1", now, now).
+			AddRow(uuid.New(), userId, "Safari on iPhone", "", "198.51.100.2", now, now.Add(-time.Hour)),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetActiveSessionsByUser)).WithArgs(userId, now).WillReturnError(ErrDatabase)
+
+	list, err := repo.GetActiveByUser(ctx, userId, now)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "Chrome on Android", list[0].Device())
+	assert.Equal(t, "198.51.100.2", list[1].IP())
+
+	_, err = repo.GetActiveByUser(ctx, userId, now)
+	assert.ErrorIs(t, err, ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSessionRepository(db)
+	session, err := sessions.NewSession(uuid.New(), "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0", "203.0.113.7")
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateSession)).
+		WithArgs(session.Id(), session.UserId(), "Firefox on Linux", session.UserAgent(), "203.0.113.7", session.CreatedAt(), session.LastSeenAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateSession)).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Create(ctx, session))
+	assert.ErrorIs(t, repo.Create(ctx, session), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_Touch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSessionRepository(db)
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchSession)).WithArgs(id, "203.0.113.7", now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchSession)).WithArgs(id, "203.0.113.7", now).WillReturnError(ErrDatabase)
+
+	require.NoError(t, repo.Touch(ctx, id, "203.0.113.7", now))
+	assert.ErrorIs(t, repo.Touch(ctx, id, "203.0.113.7", now), ErrQuery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
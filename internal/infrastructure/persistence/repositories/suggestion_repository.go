@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+	"github.com/google/uuid"
+)
+
+// Users and tags are ranked by their follower_count and pin_count, kept up to
+// date by triggers, so every prefix match is ranked without counting per row.
+// A common prefix may use the popularity indexes instead of the prefix ones.
+const (
+	QueryAutocompleteUsers = `SELECT u.id, u.user_name, u.first_name || ' ' || u.last_name, u.follower_count
+							  FROM users u
+							  WHERE (LOWER(u.user_name) LIKE $1 OR LOWER(u.first_name || ' ' || u.last_name) LIKE $1 OR LOWER(u.last_name) LIKE $1)
+							  AND u.deleted_at IS NULL
+							  AND NOT EXISTS(
+								SELECT 1
+								FROM user_blocks ub
+								WHERE (ub.blocker_id = $2 AND ub.blocked_id = u.id) OR (ub.blocker_id = u.id AND ub.blocked_id = $2))
+							  ORDER BY u.follower_count DESC, LENGTH(u.user_name), u.user_name
+							  LIMIT $3`
+	QueryAutocompleteTags = `SELECT id, name, '', pin_count
+							 FROM tags
+							 WHERE name LIKE $1 AND deleted_at IS NULL
+							 ORDER BY pin_count DESC, LENGTH(name), name
+							 LIMIT $2`
+	QueryAutocompleteBoards = `SELECT id, name, '', COALESCE(pin_count, 0)
+							   FROM boards
+							   WHERE user_id = $2 AND LOWER(name) LIKE $1 AND deleted_at IS NULL
+							   ORDER BY COALESCE(pin_count, 0) DESC, updated_at DESC
+							   LIMIT $3`
+)
+
+type suggestionRepository struct {
+	DB *sql.DB
+}
+
+func NewSuggestionRepository(db *sql.DB) suggestions.SuggestionRepository {
+	return &suggestionRepository{
+		DB: db,
+	}
+}
+
+func (r suggestionRepository) GetUsers(ctx context.Context, prefix suggestions.Prefix, viewerId uuid.UUID, limit int) ([]suggestions.Suggestion, error) {
+	return r.querySuggestions(ctx, suggestions.UserKind, QueryAutocompleteUsers, prefix.Pattern(), viewerId, limit)
+}
+
+func (r suggestionRepository) GetTags(ctx context.Context, prefix suggestions.Prefix, limit int) ([]suggestions.Suggestion, error) {
+	return r.querySuggestions(ctx, suggestions.TagKind, QueryAutocompleteTags, prefix.Pattern(), limit)
+}
+
+func (r suggestionRepository) GetBoards(ctx context.Context, prefix suggestions.Prefix, ownerId uuid.UUID, limit int) ([]suggestions.Suggestion, error) {
+	return r.querySuggestions(ctx, suggestions.BoardKind, QueryAutocompleteBoards, prefix.Pattern(), ownerId, limit)
+}
+
+func (r suggestionRepository) querySuggestions(ctx context.Context, kind suggestions.Kind, query string, args ...any) ([]suggestions.Suggestion, error) {
+	var (
+		list        []suggestions.Suggestion
+		id          uuid.UUID
+		text, label string
+		popularity  int
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id, &text, &label, &popularity); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		list = append(list, suggestions.NewSuggestion(kind, id, text, label, popularity))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return list, nil
+}
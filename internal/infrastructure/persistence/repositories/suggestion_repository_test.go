@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestSuggestionRepository_GetUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSuggestionRepository(db)
+	viewerId, userId := uuid.New(), uuid.New()
+	prefix, err := suggestions.NewPrefix("@car_")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryAutocompleteUsers)).WithArgs(`car\_%`, viewerId, 5).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "user_name", "name", "followers"}).AddRow(userId, "car_los", "Carlos Clavijo", 7),
+	)
+
+	list, err := repo.GetUsers(ctx, prefix, viewerId, 5)
+
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, suggestions.UserKind, list[0].Kind())
+	assert.Equal(t, userId, list[0].Id())
+	assert.Equal(t, "car_los", list[0].Text())
+	assert.Equal(t, "Carlos Clavijo", list[0].Label())
+	assert.Equal(t, 7, list[0].Popularity())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggestionRepository_GetTags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSuggestionRepository(db)
+	tagId := uuid.New()
+	prefix, err := suggestions.NewPrefix("#kit")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryAutocompleteTags)).WithArgs("kit%", 5).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "label", "pins"}).
+			AddRow(tagId, "kitchen", "", 40).
+			AddRow(uuid.New(), "kit", "", 3),
+	)
+
+	list, err := repo.GetTags(ctx, prefix, 5)
+
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, suggestions.TagKind, list[0].Kind())
+	assert.Equal(t, "kitchen", list[0].Text())
+	assert.Equal(t, 40, list[0].Popularity())
+	assert.Equal(t, "kit", list[1].Text())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggestionRepository_GetBoards(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSuggestionRepository(db)
+	ownerId, boardId := uuid.New(), uuid.New()
+	prefix, err := suggestions.NewPrefix("Kit")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryAutocompleteBoards)).WithArgs("kit%", ownerId, 5).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name", "label", "pin_count"}).AddRow(boardId, "Kitchen", "", 3),
+	)
+
+	list, err := repo.GetBoards(ctx, prefix, ownerId, 5)
+
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, suggestions.BoardKind, list[0].Kind())
+	assert.Equal(t, boardId, list[0].Id())
+	assert.Equal(t, 3, list[0].Popularity())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/google/uuid"
+)
+
+const (
+	QueryExistTagFollow = `SELECT EXISTS(
+							SELECT 1
+							FROM tag_follows
+							WHERE user_id = $1 AND tag_id = $2)`
+	QueryCreateTagFollow = `INSERT INTO tag_follows (user_id, tag_id, created_at)
+							VALUES ($1, $2, $3)
+							ON CONFLICT DO NOTHING`
+	QueryDeleteTagFollow = `DELETE FROM tag_follows
+							WHERE user_id = $1 AND tag_id = $2`
+)
+
+type tagFollowRepository struct {
+	DB *sql.DB
+}
+
+func NewTagFollowRepository(db *sql.DB) follows.TagFollowRepository {
+	return &tagFollowRepository{
+		DB: db,
+	}
+}
+
+func (r tagFollowRepository) Exists(ctx context.Context, userId, tagId uuid.UUID) (bool, error) {
+	var exist bool
+
+	err := r.DB.QueryRowContext(ctx, QueryExistTagFollow, userId, tagId).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return exist, nil
+}
+
+func (r tagFollowRepository) Create(ctx context.Context, f *follows.TagFollow) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateTagFollow, f.UserId(), f.TagId(), f.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r tagFollowRepository) Delete(ctx context.Context, f *follows.TagFollow) error {
+	_, err := r.DB.ExecContext(ctx, QueryDeleteTagFollow, f.UserId(), f.TagId())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	QueryGetTagByName = `SELECT id, name, created_at, deleted_at
+						 FROM tags
+						 WHERE name = $1`
+	QueryGetListTagsByPinId = `SELECT t.id, t.name, t.created_at, t.deleted_at
+							   FROM tags t
+							   JOIN pins_tags pt ON pt.tag_id = t.id
+							   WHERE pt.pin_id = $1
+							   ORDER BY t.name`
+	QueryGetOrCreateTag = `INSERT INTO tags (id, name, created_at)
+						   VALUES ($1, $2, $3)
+						   ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+						   RETURNING id, name, created_at, deleted_at`
+)
+
+type tagRepository struct {
+	DB *sql.DB
+}
+
+func NewTagRepository(db *sql.DB) pins.TagRepository {
+	return &tagRepository{
+		DB: db,
+	}
+}
+
+func (r tagRepository) GetByName(ctx context.Context, name string) (*pins.Tag, error) {
+	var (
+		id        uuid.UUID
+		tagName   string
+		createdAt time.Time
+		deletedAt *time.Time
+	)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetTagByName, name).Scan(&id, &tagName, &createdAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pins.ErrNotFoundTag
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return pins.NewTagFromDB(id, tagName, createdAt, deletedAt), nil
+}
+
+func (r tagRepository) GetListByPinId(ctx context.Context, pinId uuid.UUID) ([]pins.Tag, error) {
+	var (
+		tags      []pins.Tag
+		id        uuid.UUID
+		name      string
+		createdAt time.Time
+		deletedAt *time.Time
+	)
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetListTagsByPinId, pinId)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		err = rows.Scan(&id, &name, &createdAt, &deletedAt)
+		if err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+
+		tags = append(tags, *pins.NewTagFromDB(id, name, createdAt, deletedAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return tags, nil
+}
+
+func (r tagRepository) GetOrCreate(ctx context.Context, name string) (*pins.Tag, error) {
+	var (
+		id        uuid.UUID
+		tagName   string
+		createdAt time.Time
+		deletedAt *time.Time
+	)
+
+	tag := pins.NewTag(name)
+
+	err := r.DB.QueryRowContext(ctx, QueryGetOrCreateTag, tag.Id(), tag.Name(), tag.CreatedAt()).Scan(&id, &tagName, &createdAt, &deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return pins.NewTagFromDB(id, tagName, createdAt, deletedAt), nil
+}
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+// queryTrendEvents gathers the engagement on public pins since $1, each worth
+// its weight decayed at rate $3 per hour up to $2. Owners engaging with their
+// own pins do not count.
+const queryTrendEvents = `WITH events AS (
+								SELECT pin_id, user_id, created_at, $5::float8 AS weight FROM pin_saves WHERE created_at >= $1
+								UNION ALL
+								SELECT pin_id, user_id, created_at, $6::float8 FROM comments WHERE created_at >= $1 AND deleted_at IS NULL
+								UNION ALL
+								SELECT pin_id, user_id, created_at, $7::float8 FROM pin_likes WHERE created_at >= $1
+								UNION ALL
+								SELECT pin_id, user_id, created_at, $8::float8 FROM pin_views WHERE created_at >= $1),
+							 engaged AS (
+								SELECT e.pin_id, u.country, u.language,
+									e.weight * EXP(-$3::float8 * GREATEST(EXTRACT(EPOCH FROM ($2::timestamp - e.created_at)), 0) / 3600) AS score
+								FROM events e
+								JOIN users u ON u.id = e.user_id
+								JOIN pins p ON p.id = e.pin_id
+								JOIN boards b ON b.id = p.board_id
+								WHERE e.user_id <> p.user_id AND p.deleted_at IS NULL AND p.visibility
+								AND b.deleted_at IS NULL AND b.visibility),`
+
+// queryTrendRanked keeps the best $4 members of each segment. Users always
+// have a country and a language, so a null one marks a segment not split by it.
+const queryTrendRanked = `ranked AS (
+								SELECT member, country, language, SUM(score) AS score,
+									ROW_NUMBER() OVER (PARTITION BY country, language ORDER BY SUM(score) DESC, member) AS position
+								FROM scored
+								GROUP BY GROUPING SETS ((member), (member, country), (member, language), (member, country, language)))
+							 SELECT member, country, language, score
+							 FROM ranked
+							 WHERE position <= $4
+							 ORDER BY country NULLS FIRST, language NULLS FIRST, position`
+
+const (
+	QueryGetPinTrends = queryTrendEvents + `
+							 scored AS (
+								SELECT pin_id::text AS member, country, language, score
+								FROM engaged),
+							 ` + queryTrendRanked
+	QueryGetTagTrends = queryTrendEvents + `
+							 scored AS (
+								SELECT t.name AS member, e.country, e.language, e.score
+								FROM engaged e
+								JOIN pins_tags pt ON pt.pin_id = e.pin_id
+								JOIN tags t ON t.id = pt.tag_id
+								WHERE t.deleted_at IS NULL),
+							 ` + queryTrendRanked
+	QueryGetTrendVisible = `SELECT p.id
+							FROM pins p
+							JOIN boards b ON b.id = p.board_id
+							WHERE p.id = ANY($2) AND p.deleted_at IS NULL AND p.visibility
+							AND b.deleted_at IS NULL AND b.visibility
+							AND NOT EXISTS(
+								SELECT 1
+								FROM user_blocks ub
+								WHERE (ub.blocker_id = $1 AND ub.blocked_id = p.user_id) OR (ub.blocker_id = p.user_id AND ub.blocked_id = $1))
+							AND NOT EXISTS(
+								SELECT 1
+								FROM user_mutes m
+								WHERE m.muter_id = $1 AND m.muted_id = p.user_id)`
+)
+
+type trendRepository struct {
+	DB *sql.DB
+}
+
+func NewTrendRepository(db *sql.DB) trends.TrendRepository {
+	return &trendRepository{
+		DB: db,
+	}
+}
+
+func (r trendRepository) GetPinScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	return r.queryScores(ctx, QueryGetPinTrends, since, now, rate, limit)
+}
+
+func (r trendRepository) GetTagScores(ctx context.Context, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	return r.queryScores(ctx, QueryGetTagTrends, since, now, rate, limit)
+}
+
+func (r trendRepository) GetListVisible(ctx context.Context, viewerId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	var (
+		ids []uuid.UUID
+		id  uuid.UUID
+	)
+
+	if len(pinIds) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.DB.QueryContext(ctx, QueryGetTrendVisible, viewerId, pq.Array(pinIds))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return ids, nil
+}
+
+func (r trendRepository) queryScores(ctx context.Context, query string, since, now time.Time, rate float64, limit int) ([]trends.Score, error) {
+	var (
+		scores            []trends.Score
+		member            string
+		country, language sql.NullString
+		value             float64
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, since, now, rate, limit, trends.SaveWeight, trends.CommentWeight, trends.LikeWeight, trends.ViewWeight)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err = rows.Scan(&member, &country, &language, &value); err != nil {
+			return nil, fmt.Errorf(got, ErrScan, err)
+		}
+		scores = append(scores, trends.NewScore(trendSegment(country, language), member, value))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrIterationRows, err)
+	}
+
+	return scores, nil
+}
+
+func trendSegment(country, language sql.NullString) trends.Segment {
+	var (
+		c *shared.Country
+		l *shared.Language
+	)
+
+	if country.Valid {
+		value := shared.Country(country.String)
+		c = &value
+	}
+
+	if language.Valid {
+		value := shared.Language(language.String)
+		l = &value
+	}
+
+	return trends.NewSegment(c, l)
+}
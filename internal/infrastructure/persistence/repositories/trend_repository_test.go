@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestTrendRepository_GetPinScores(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTrendRepository(db)
+	pinId, now := uuid.New(), time.Now()
+	since := now.Add(-time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPinTrends)).
+		WithArgs(since, now, 0.5, 10, trends.SaveWeight, trends.CommentWeight, trends.LikeWeight, trends.ViewWeight).
+		WillReturnRows(sqlmock.NewRows([]string{"member", "country", "language", "score"}).
+			AddRow(pinId.String(), nil, nil, 3.0).
+			AddRow(pinId.String(), "US", nil, 2.0).
+			AddRow(pinId.String(), "US", "EN", 1.0))
+
+	scores, err := repo.GetPinScores(ctx, since, now, 0.5, 10)
+
+	require.NoError(t, err)
+	require.Len(t, scores, 3)
+	assert.Equal(t, trends.Global, scores[0].Segment())
+	assert.Equal(t, pinId.String(), scores[0].Member())
+	assert.Equal(t, 3.0, scores[0].Value())
+	assert.Equal(t, trends.Segment("country:US"), scores[1].Segment())
+	assert.Equal(t, trends.Segment("country:US:language:EN"), scores[2].Segment())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrendRepository_GetTagScores(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTrendRepository(db)
+	now := time.Now()
+	since := now.Add(-time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetTagTrends)).
+		WithArgs(since, now, 0.5, 10, trends.SaveWeight, trends.CommentWeight, trends.LikeWeight, trends.ViewWeight).
+		WillReturnRows(sqlmock.NewRows([]string{"member", "country", "language", "score"}).AddRow("kitchen", nil, "ES", 4.0))
+
+	scores, err := repo.GetTagScores(ctx, since, now, 0.5, 10)
+
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	assert.Equal(t, trends.Segment("language:ES"), scores[0].Segment())
+	assert.Equal(t, "kitchen", scores[0].Member())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrendRepository_GetListVisible(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTrendRepository(db)
+	viewerId, pinIds := uuid.New(), []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetTrendVisible)).WithArgs(viewerId, pq.Array(pinIds)).WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).AddRow(pinIds[0]),
+	)
+
+	visible, err := repo.GetListVisible(ctx, viewerId, pinIds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{pinIds[0]}, visible)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -12,29 +12,33 @@ import (
 
 const (
 	got              = "%w: got %w"
-	QueryGetAllUsers = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetAllUsers = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 						FROM users`
-	QueryGetListUsers = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetListUsers = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 						FROM users
 						WHERE deleted_at IS NULL`
-	QueryGetUserById = `SELECT first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUserById = `SELECT first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 						FROM users
 						WHERE id = $1`
-	QueryGetUserByUsername = `SELECT id, first_name, last_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUserByUsername = `SELECT id, first_name, last_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 						  	  FROM users 
 						  	  WHERE user_name = $1`
-	QueryGetUserByEmail = `SELECT id, first_name, last_name, user_name, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUserByEmail = `SELECT id, first_name, last_name, user_name, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 						   FROM users 
 						   WHERE email = $1`
-	QueryGetUsersByCountry = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUsersByCountry = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 							 	  FROM users
 							 	  WHERE country = $1`
-	QueryGetUsersByLanguage = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUsersByLanguage = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 							  	   FROM users
 							  	   WHERE language = $1`
-	QueryGetUsersLikeUsername = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at
+	QueryGetUsersLikeUsername = `SELECT id, first_name, last_name, user_name, email, password, gender, birth_date, country, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at
 							  	   FROM users
-							  	   WHERE language ILIKE '%' || $1 || '%' AND deleted_at IS NULL`
+							  	   WHERE user_name ILIKE '%' || $1 || '%' AND deleted_at IS NULL
+							  	   AND NOT EXISTS(
+							  	   	SELECT 1
+							  	   	FROM user_blocks b
+							  	   	WHERE (b.blocker_id = $2 AND b.blocked_id = users.id) OR (b.blocker_id = users.id AND b.blocked_id = $2))`
 	QueryExistUserById = `SELECT EXISTS(
 							SELECT 1 
 							FROM users
@@ -55,9 +59,9 @@ const (
 				  		  	)`
 	QueryCreateUser = `INSERT INTO users(id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, visibility, last_login_at, created_at, updated_at)
 					   VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
-					   RETURNING id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at`
+					   RETURNING id, first_name, last_name, user_name, email, password, gender, birth_date, country, language, phone, information, profile_pic, web_site, visibility, last_login_at, created_at, updated_at, deleted_at, verified_at`
 	QueryUpdateUser = `UPDATE users
-					   SET first_name = $2, last_name = $3, user_name = $4, email = $5, password = $6, gender = $7, birth_date = $8, country = $9, language = $10, phone = $11, information = $12, profile_pic = $13, web_site = $14, visibility = $15, last_login_at = $16, updated_at = $17
+					   SET first_name = $2, last_name = $3, user_name = $4, email = $5, password = $6, gender = $7, birth_date = $8, country = $9, language = $10, phone = $11, information = $12, profile_pic = $13, web_site = $14, visibility = $15, last_login_at = $16, updated_at = $17, verified_at = $18
 					   WHERE id = $1`
 	QueryDeleteUser = `UPDATE users
 					   SET deleted_at = $2
@@ -89,7 +93,7 @@ func (r *userRepository) GetAll(ctx context.Context) ([]*users.User, error) {
 		birth, lastLoginAt, createdAt, updatedAt                                  time.Time
 		phone, information, profilePic, webSite                                   *string
 		visibility                                                                bool
-		deletedAt                                                                 *time.Time
+		deletedAt, verifiedAt                                                     *time.Time
 	)
 
 	rows, err := r.DB.QueryContext(ctx, QueryGetAllUsers)
@@ -103,12 +107,12 @@ func (r *userRepository) GetAll(ctx context.Context) ([]*users.User, error) {
 		}
 	}(rows)
 	for rows.Next() {
-		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
+		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrScan, err)
 		}
 
-		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrConcatenating, err)
 		}
@@ -131,7 +135,7 @@ func (r *userRepository) GetList(ctx context.Context) ([]*users.User, error) {
 		birth, lastLoginAt, createdAt, updatedAt                                  time.Time
 		phone, information, profilePic, webSite                                   *string
 		visibility                                                                bool
-		deletedAt                                                                 *time.Time
+		deletedAt, verifiedAt                                                     *time.Time
 	)
 
 	rows, err := r.DB.QueryContext(ctx, QueryGetListUsers)
@@ -146,13 +150,13 @@ func (r *userRepository) GetList(ctx context.Context) ([]*users.User, error) {
 	}(rows)
 	for rows.Next() {
 		err = rows.Scan(
-			&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
+			&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrScan, err)
 		}
 
-		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrConcatenating, err)
 		}
@@ -173,17 +177,17 @@ func (r *userRepository) GetById(ctx context.Context, id uuid.UUID) (*users.User
 		birth, lastLoginAt, createdAt, updatedAt                                  time.Time
 		phone, information, profilePic, webSite                                   *string
 		visibility                                                                bool
-		deletedAt                                                                 *time.Time
+		deletedAt, verifiedAt                                                     *time.Time
 	)
 
 	err := r.DB.QueryRowContext(ctx, QueryGetUserById, id).Scan(
-		&firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
+		&firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
 
-	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrConcatenating, err)
 	}
@@ -198,17 +202,17 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*u
 		birth, lastLoginAt, createdAt, updatedAt                        time.Time
 		phone, information, profilePic, webSite                         *string
 		visibility                                                      bool
-		deletedAt                                                       *time.Time
+		deletedAt, verifiedAt                                           *time.Time
 	)
 
 	err := r.DB.QueryRowContext(ctx, QueryGetUserByUsername, username).Scan(
-		&id, &firstName, &lastName, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
+		&id, &firstName, &lastName, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
 
-	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrConcatenating, err)
 	}
@@ -223,17 +227,17 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*users.U
 		birth, lastLoginAt, createdAt, updatedAt                           time.Time
 		phone, information, profilePic, webSite                            *string
 		visibility                                                         bool
-		deletedAt                                                          *time.Time
+		deletedAt, verifiedAt                                              *time.Time
 	)
 
 	err := r.DB.QueryRowContext(ctx, QueryGetUserByEmail, email).Scan(
-		&id, &firstName, &lastName, &username, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
+		&id, &firstName, &lastName, &username, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
 
-	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrConcatenating, err)
 	}
@@ -249,7 +253,7 @@ func (r *userRepository) GetListByCountry(ctx context.Context, country string) (
 		birth, lastLoginAt, createdAt, updatedAt                         time.Time
 		phone, information, profilePic, webSite                          *string
 		visibility                                                       bool
-		deletedAt                                                        *time.Time
+		deletedAt, verifiedAt                                            *time.Time
 	)
 
 	rows, err := r.DB.QueryContext(ctx, QueryGetUsersByCountry, country)
@@ -263,12 +267,12 @@ func (r *userRepository) GetListByCountry(ctx context.Context, country string) (
 		}
 	}(rows)
 	for rows.Next() {
-		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
+		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrScan, err)
 		}
 
-		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrConcatenating, err)
 		}
@@ -291,7 +295,7 @@ func (r *userRepository) GetListByLanguage(ctx context.Context, language string)
 		birth, lastLoginAt, createdAt, updatedAt                        time.Time
 		phone, information, profilePic, webSite                         *string
 		visibility                                                      bool
-		deletedAt                                                       *time.Time
+		deletedAt, verifiedAt                                           *time.Time
 	)
 
 	rows, err := r.DB.QueryContext(ctx, QueryGetUsersByLanguage, language)
@@ -305,12 +309,12 @@ func (r *userRepository) GetListByLanguage(ctx context.Context, language string)
 		}
 	}(rows)
 	for rows.Next() {
-		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
+		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrScan, err)
 		}
 
-		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrConcatenating, err)
 		}
@@ -325,7 +329,7 @@ func (r *userRepository) GetListByLanguage(ctx context.Context, language string)
 	return usersList, nil
 }
 
-func (r *userRepository) GetListLikeUsername(ctx context.Context, name string) ([]*users.User, error) {
+func (r *userRepository) GetListLikeUsername(ctx context.Context, name string, viewerId uuid.UUID) ([]*users.User, error) {
 	var (
 		usersList                                                                 []*users.User
 		id                                                                        uuid.UUID
@@ -333,10 +337,10 @@ func (r *userRepository) GetListLikeUsername(ctx context.Context, name string) (
 		birth, lastLoginAt, createdAt, updatedAt                                  time.Time
 		phone, information, profilePic, webSite                                   *string
 		visibility                                                                bool
-		deletedAt                                                                 *time.Time
+		deletedAt, verifiedAt                                                     *time.Time
 	)
 
-	rows, err := r.DB.QueryContext(ctx, QueryGetUsersLikeUsername, name)
+	rows, err := r.DB.QueryContext(ctx, QueryGetUsersLikeUsername, name, viewerId)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
@@ -347,12 +351,12 @@ func (r *userRepository) GetListLikeUsername(ctx context.Context, name string) (
 		}
 	}(rows)
 	for rows.Next() {
-		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
+		err = rows.Scan(&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrScan, err)
 		}
 
-		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+		usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 		if err != nil {
 			return nil, fmt.Errorf(got, ErrConcatenating, err)
 		}
@@ -407,7 +411,7 @@ func (r *userRepository) Create(ctx context.Context, u *users.User) (*users.User
 		birth, lastLoginAt, createdAt, updatedAt                                  time.Time
 		phone, information, profilePic, webSite                                   *string
 		visibility                                                                bool
-		deletedAt                                                                 *time.Time
+		deletedAt, verifiedAt                                                     *time.Time
 	)
 
 	if u.Phone() != nil {
@@ -418,14 +422,14 @@ func (r *userRepository) Create(ctx context.Context, u *users.User) (*users.User
 	err := r.DB.QueryRowContext(ctx, QueryCreateUser,
 		u.Id(), u.FirstName(), u.LastName(), u.Username().String(), u.Email().String(), u.Password().String(), u.Gender(), u.Birth().Time(), u.Country(), u.Language(), phone, u.Visibility(), u.LastLoginAt(), u.CreatedAt(), u.UpdatedAt(),
 	).Scan(
-		&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
+		&id, &firstName, &lastName, &username, &email, &password, &gender, &birth, &country, &language, &phone, &information, &profilePic, &webSite, &visibility, &lastLoginAt, &createdAt, &updatedAt, &deletedAt, &verifiedAt,
 	)
 
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrQuery, err)
 	}
 
-	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt)
+	usr, err := users.NewUserFromDB(id, firstName, lastName, username, email, password, gender, birth, country, language, phone, information, profilePic, webSite, visibility, lastLoginAt, createdAt, updatedAt, deletedAt, verifiedAt)
 	if err != nil {
 		return nil, fmt.Errorf(got, ErrConcatenating, err)
 	}
@@ -454,7 +458,7 @@ func (r *userRepository) Update(ctx context.Context, u *users.User) error {
 	}
 
 	_, err := r.DB.ExecContext(ctx, QueryUpdateUser,
-		u.Id(), u.FirstName(), u.LastName(), u.Username().String(), u.Email().String(), u.Password().String(), u.Gender(), u.Birth().Time(), u.Country(), u.Language(), phone, information, profilePic, webSite, u.Visibility(), u.LastLoginAt(), u.UpdatedAt(),
+		u.Id(), u.FirstName(), u.LastName(), u.Username().String(), u.Email().String(), u.Password().String(), u.Gender(), u.Birth().Time(), u.Country(), u.Language(), phone, information, profilePic, webSite, u.Visibility(), u.LastLoginAt(), u.UpdatedAt(), u.VerifiedAt(),
 	)
 
 	if err != nil {
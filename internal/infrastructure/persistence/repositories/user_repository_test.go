@@ -16,7 +16,7 @@ import (
 
 var (
 	ctx         = context.Background()
-	columns     = []string{"id", "first_name", "last_name", "username", "email", "password", "gender", "birth", "country", "language", "phone", "information", "profile_pic", "web_site", "visibility", "last_login_at", "created_at", "updated_at", "deleted_at"}
+	columns     = []string{"id", "first_name", "last_name", "username", "email", "password", "gender", "birth", "country", "language", "phone", "information", "profile_pic", "web_site", "visibility", "last_login_at", "created_at", "updated_at", "deleted_at", "verified_at"}
 	ErrDatabase = errors.New("database is down")
 )
 
@@ -73,7 +73,7 @@ func TestUserRepository_GetAll(t *testing.T) {
 
 		rows.AddRow(
 			tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender().String(), tc.Birth().Time(), tc.Country().String(),
-			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 		)
 	}
 
@@ -108,7 +108,7 @@ func TestUserRepository_GetAll_QueryError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -127,7 +127,7 @@ func TestUserRepository_GetAll_ScanError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrScanUser)
+	assert.ErrorIs(t, err, ErrScan)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -136,7 +136,7 @@ func TestUserRepository_GetAll_NewUserError(t *testing.T) {
 	defer db.Close()
 
 	repo := NewUserRepository(db)
-	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(QueryGetAllUsers).WillReturnRows(rows)
 
@@ -145,7 +145,7 @@ func TestUserRepository_GetAll_NewUserError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -178,7 +178,7 @@ func TestUserRepository_GetList(t *testing.T) {
 
 		rows.AddRow(
 			tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender().String(), tc.Birth().Time(), tc.Country().String(),
-			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 		)
 	}
 
@@ -213,7 +213,7 @@ func TestUserRepository_GetList_QueryError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -231,7 +231,7 @@ func TestUserRepository_GetList_ScanError(t *testing.T) {
 
 	require.Nil(t, usersList)
 	require.Error(t, err)
-	assert.ErrorIs(t, err, ErrScanUser)
+	assert.ErrorIs(t, err, ErrScan)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -240,7 +240,7 @@ func TestUserRepository_GetList_NewUserError(t *testing.T) {
 	defer db.Close()
 
 	repo := NewUserRepository(db)
-	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(QueryGetListUsers).WillReturnRows(rows)
 
@@ -248,7 +248,7 @@ func TestUserRepository_GetList_NewUserError(t *testing.T) {
 
 	require.Nil(t, usersList)
 	require.Error(t, err)
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -282,7 +282,7 @@ func TestUserRepository_GetById(t *testing.T) {
 
 	rows := sqlmock.NewRows(cols).AddRow(
 		tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserById)).WithArgs(tc.Id()).WillReturnRows(rows)
@@ -308,7 +308,7 @@ func TestUserRepository_GetById_QueryError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -322,7 +322,7 @@ func TestUserRepository_GetById_NewUserError(t *testing.T) {
 	cols := append([]string(nil), columns...)
 	cols = cols[1:]
 
-	rows := sqlmock.NewRows(cols).AddRow("", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(cols).AddRow("", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserById)).WithArgs(id).WillReturnRows(rows)
 
@@ -331,7 +331,7 @@ func TestUserRepository_GetById_NewUserError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -365,7 +365,7 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 
 	rows := sqlmock.NewRows(cols).AddRow(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserByUsername)).WithArgs(tc.Username().String()).WillReturnRows(rows)
@@ -391,7 +391,7 @@ func TestUserRepository_GetByUsername_QueryError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -405,7 +405,7 @@ func TestUserRepository_GetByUsername_NewUserError(t *testing.T) {
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:3], cols[4:]...)
 
-	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserByUsername)).WithArgs(username).WillReturnRows(rows)
 
@@ -414,7 +414,7 @@ func TestUserRepository_GetByUsername_NewUserError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -448,7 +448,7 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 
 	rows := sqlmock.NewRows(cols).AddRow(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+		tc.Language(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserByEmail)).WithArgs(tc.Email().String()).WillReturnRows(rows)
@@ -474,7 +474,7 @@ func TestUserRepository_GetByEmail_QueryError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -488,7 +488,7 @@ func TestUserRepository_GetByEmail_NewUserError(t *testing.T) {
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:4], cols[5:]...)
 
-	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUserByEmail)).WithArgs(email).WillReturnRows(rows)
 
@@ -497,7 +497,7 @@ func TestUserRepository_GetByEmail_NewUserError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -536,7 +536,7 @@ func TestUserRepository_GetListByCountry(t *testing.T) {
 
 		rows.AddRow(
 			tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender().String(), tc.Birth().Time(),
-			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+			tc.Language().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 		)
 	}
 
@@ -573,7 +573,7 @@ func TestUserRepository_GetListByCountry_QueryError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -595,7 +595,7 @@ func TestUserRepository_GetListByCountry_ScanError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrScanUser)
+	assert.ErrorIs(t, err, ErrScan)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -607,7 +607,7 @@ func TestUserRepository_GetListByCountry_NewUserError(t *testing.T) {
 	country := "Bolivia"
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:9], cols[10:]...)
-	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUsersByCountry)).WithArgs(country).WillReturnRows(rows)
 
@@ -616,7 +616,7 @@ func TestUserRepository_GetListByCountry_NewUserError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -655,7 +655,7 @@ func TestUserRepository_GetListByLanguage(t *testing.T) {
 
 		rows.AddRow(
 			tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender().String(), tc.Birth().Time(),
-			tc.Country().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+			tc.Country().String(), phone, information, profilePic, website, tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 		)
 	}
 
@@ -692,7 +692,7 @@ func TestUserRepository_GetListByLanguage_QueryError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -714,7 +714,7 @@ func TestUserRepository_GetListByLanguage_ScanError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrScanUser)
+	assert.ErrorIs(t, err, ErrScan)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -726,7 +726,7 @@ func TestUserRepository_GetListByLanguage_NewUserError(t *testing.T) {
 	language := "Spanish"
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:10], cols[11:]...)
-	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(cols).AddRow(uuid.New(), "", "", "", "", "", "", time.Now(), "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryGetUsersByLanguage)).WithArgs(language).WillReturnRows(rows)
 
@@ -735,7 +735,7 @@ func TestUserRepository_GetListByLanguage_NewUserError(t *testing.T) {
 	require.Nil(t, usersList)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -772,7 +772,7 @@ func TestUserRepository_ExistsById_QueryError(t *testing.T) {
 	require.False(t, exists)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -810,7 +810,7 @@ func TestUserRepository_ExistsByUserName_QueryError(t *testing.T) {
 	require.False(t, exists)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -848,7 +848,7 @@ func TestUserRepository_ExistsByEmail_QueryError(t *testing.T) {
 	require.False(t, exists)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -862,7 +862,7 @@ func TestUserRepository_Create(t *testing.T) {
 
 	rows := sqlmock.NewRows(columns).AddRow(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(), tc.Language(),
-		tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
+		tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(), tc.VerifiedAt(),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateUser)).WithArgs(
@@ -895,7 +895,7 @@ func TestUserRepository_Create_QueryError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrQueryUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.ErrorIs(t, err, ErrDatabase)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -907,7 +907,7 @@ func TestUserRepository_Create_NewUserError(t *testing.T) {
 	repo := NewUserRepository(db)
 	tc := userCases()[0]
 
-	rows := sqlmock.NewRows(columns).AddRow(uuid.Nil, "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
+	rows := sqlmock.NewRows(columns).AddRow(uuid.Nil, "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateUser)).WithArgs(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(),
@@ -920,7 +920,7 @@ func TestUserRepository_Create_NewUserError(t *testing.T) {
 	require.Nil(t, usr)
 	require.Error(t, err)
 
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrConcatenating)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -931,22 +931,14 @@ func TestUserRepository_Update(t *testing.T) {
 	repo := NewUserRepository(db)
 	tc := userCases()[0]
 
-	rows := sqlmock.NewRows(columns).AddRow(
-		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(), tc.Language(),
-		tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
-	)
-
-	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateUser)).WithArgs(
+	mock.ExpectExec(regexp.QuoteMeta(QueryUpdateUser)).WithArgs(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.UpdatedAt(),
-	).WillReturnRows(rows)
+		tc.Language(), tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.UpdatedAt(), tc.VerifiedAt(),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
 
-	usr, err := repo.Update(context.Background(), tc.User)
+	err = repo.Update(context.Background(), tc.User)
 
-	require.NotNil(t, usr)
 	require.NoError(t, err)
-
-	testCases(t, tc, usr)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -957,41 +949,17 @@ func TestUserRepository_Update_QueryError(t *testing.T) {
 	repo := NewUserRepository(db)
 	tc := userCases()[0]
 
-	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateUser)).WithArgs(
+	mock.ExpectExec(regexp.QuoteMeta(QueryUpdateUser)).WithArgs(
 		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.UpdatedAt(),
+		tc.Language(), tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.UpdatedAt(), tc.VerifiedAt(),
 	).WillReturnError(ErrDatabase)
 
-	usr, err := repo.Update(context.Background(), tc.User)
+	err = repo.Update(context.Background(), tc.User)
 
-	require.Nil(t, usr)
 	require.Error(t, err)
 
 	assert.ErrorIs(t, err, ErrDatabase)
-	assert.ErrorIs(t, err, ErrQueryUser)
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
-func TestUserRepository_Update_NewUserError(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	defer db.Close()
-
-	repo := NewUserRepository(db)
-	tc := userCases()[0]
-
-	rows := sqlmock.NewRows(columns).AddRow(uuid.Nil, "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
-
-	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateUser)).WithArgs(
-		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(),
-		tc.Language(), tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.UpdatedAt(),
-	).WillReturnRows(rows)
-
-	usr, err := repo.Update(context.Background(), tc.User)
-
-	require.Nil(t, usr)
-	require.Error(t, err)
-
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -1002,19 +970,11 @@ func TestUserRepository_Delete(t *testing.T) {
 	repo := NewUserRepository(db)
 	tc := userCases()[0]
 
-	rows := sqlmock.NewRows(columns).AddRow(
-		tc.Id(), tc.FirstName(), tc.LastName(), tc.Username().String(), tc.Email().String(), tc.Password().String(), tc.Gender(), tc.Birth().Time(), tc.Country(), tc.Language(),
-		tc.Phone().String(), tc.Information(), tc.ProfilePic(), tc.WebSite().String(), tc.Visibility(), tc.LastLoginAt(), tc.CreatedAt(), tc.UpdatedAt(), tc.DeletedAt(),
-	)
-
-	mock.ExpectQuery(regexp.QuoteMeta(QueryDeleteUser)).WithArgs(tc.Id(), tc.DeletedAt()).WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteUser)).WithArgs(tc.Id(), tc.DeletedAt()).WillReturnResult(sqlmock.NewResult(0, 1))
 
-	usr, err := repo.Delete(context.Background(), tc.User)
+	err = repo.Delete(context.Background(), tc.User)
 
-	require.NotNil(t, usr)
 	require.NoError(t, err)
-
-	testCases(t, tc, usr)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -1025,35 +985,14 @@ func TestUserRepository_Delete_QueryError(t *testing.T) {
 	repo := NewUserRepository(db)
 	tc := userCases()[0]
 
-	mock.ExpectQuery(regexp.QuoteMeta(QueryDeleteUser)).WithArgs(tc.Id(), tc.DeletedAt()).WillReturnError(ErrDatabase)
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteUser)).WithArgs(tc.Id(), tc.DeletedAt()).WillReturnError(ErrDatabase)
 
-	usr, err := repo.Delete(context.Background(), tc.User)
+	err = repo.Delete(context.Background(), tc.User)
 
-	require.Nil(t, usr)
 	require.Error(t, err)
 
 	assert.ErrorIs(t, err, ErrDatabase)
-	assert.ErrorIs(t, err, ErrQueryUser)
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
-func TestUserRepository_Delete_NewUserError(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	defer db.Close()
-
-	repo := NewUserRepository(db)
-	tc := userCases()[0]
-
-	rows := sqlmock.NewRows(columns).AddRow(uuid.Nil, "", "", "", "", "", "", time.Now(), "", "", nil, nil, nil, nil, false, time.Now(), time.Now(), time.Now(), nil)
-
-	mock.ExpectQuery(regexp.QuoteMeta(QueryDeleteUser)).WithArgs(tc.Id(), tc.DeletedAt()).WillReturnRows(rows)
-
-	usr, err := repo.Delete(context.Background(), tc.User)
-
-	require.Nil(t, usr)
-	require.Error(t, err)
-
-	assert.ErrorIs(t, err, ErrConcatenatingUser)
+	assert.ErrorIs(t, err, ErrQuery)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"time"
+)
+
+const (
+	QueryCreateView = `INSERT INTO pin_views (pin_id, user_id, created_at)
+					   VALUES ($1, $2, $3)`
+	QueryDeleteViewsBefore = `DELETE FROM pin_views
+							  WHERE created_at < $1`
+)
+
+type viewRepository struct {
+	DB *sql.DB
+}
+
+func NewViewRepository(db *sql.DB) pins.ViewRepository {
+	return &viewRepository{
+		DB: db,
+	}
+}
+
+func (r viewRepository) Create(ctx context.Context, v *pins.View) error {
+	_, err := r.DB.ExecContext(ctx, QueryCreateView, v.PinId(), v.UserId(), v.CreatedAt())
+	if err != nil {
+		return fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return nil
+}
+
+func (r viewRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.DB.ExecContext(ctx, QueryDeleteViewsBefore, before)
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(got, ErrQuery, err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// AnalyticsSettings keeps reports cached for CacheTTL. Rollups run hourly, so
+// a report is never much staler than that.
+type AnalyticsSettings struct {
+	CacheTTL time.Duration
+}
+
+// AnalyticsCache keeps reports in Redis as the JSON the API returns.
+type AnalyticsCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+func NewAnalyticsCache(rdb *redis.Client, ttl time.Duration) *AnalyticsCache {
+	return &AnalyticsCache{
+		rdb: rdb,
+		ttl: ttl,
+	}
+}
+
+func (c *AnalyticsCache) Get(ctx context.Context, key string) (*analytics.Report, bool, error) {
+	val, err := c.rdb.Get(ctx, analyticsKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	var reportDto dto.ReportDTO
+	if err = json.Unmarshal(val, &reportDto); err != nil {
+		return nil, false, err
+	}
+
+	report, err := mappers.MapToReport(&reportDto)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return report, true, nil
+}
+
+func (c *AnalyticsCache) Set(ctx context.Context, key string, report *analytics.Report) error {
+	val, err := json.Marshal(mappers.MapToReportDTO(report))
+	if err != nil {
+		return err
+	}
+
+	return c.rdb.Set(ctx, analyticsKey(key), val, c.ttl).Err()
+}
+
+func analyticsKey(key string) string {
+	return "analytics:" + key
+}
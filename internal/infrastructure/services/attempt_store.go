@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// AttemptStore counts failed logins in Redis. The failures, the wait between
+// attempts and the lock of a key each live in their own key and expire on
+// their own.
+type AttemptStore struct {
+	rdb *redis.Client
+}
+
+// UnlockStore keeps the unlock links emailed to locked accounts in Redis,
+// which drops them when they expire.
+type UnlockStore struct {
+	rdb *redis.Client
+}
+
+func NewAttemptStore(rdb *redis.Client) *AttemptStore {
+	return &AttemptStore{
+		rdb: rdb,
+	}
+}
+
+func NewUnlockStore(rdb *redis.Client) *UnlockStore {
+	return &UnlockStore{
+		rdb: rdb,
+	}
+}
+
+func (s *AttemptStore) Get(ctx context.Context, key string) (int, time.Duration, error) {
+	pipe := s.rdb.Pipeline()
+	fails := pipe.Get(ctx, attemptFailsKey(key))
+	lock := pipe.PTTL(ctx, attemptLockKey(key))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, 0, err
+	}
+
+	failures, _ := fails.Int()
+	return failures, max(lock.Val(), 0), nil
+}
+
+func (s *AttemptStore) Fail(ctx context.Context, key string, window time.Duration) (int, error) {
+	pipe := s.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, attemptFailsKey(key))
+	pipe.PExpire(ctx, attemptFailsKey(key), window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (s *AttemptStore) Wait(ctx context.Context, key string, delay time.Duration) (time.Duration, error) {
+	ok, err := s.rdb.SetNX(ctx, attemptWaitKey(key), 1, delay).Result()
+	if err != nil {
+		return 0, err
+	} else if ok {
+		return 0, nil
+	}
+
+	ttl, err := s.rdb.PTTL(ctx, attemptWaitKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// The wait may have expired right after SETNX; the next attempt goes on.
+	return max(ttl, time.Millisecond), nil
+}
+
+func (s *AttemptStore) Lock(ctx context.Context, key string, d time.Duration) (bool, error) {
+	pipe := s.rdb.TxPipeline()
+	lock := pipe.SetNX(ctx, attemptLockKey(key), 1, d)
+	pipe.Del(ctx, attemptFailsKey(key))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return lock.Val(), nil
+}
+
+func (s *AttemptStore) Reset(ctx context.Context, key string) error {
+	return s.rdb.Del(ctx, attemptFailsKey(key), attemptWaitKey(key), attemptLockKey(key)).Err()
+}
+
+func (s *UnlockStore) Save(ctx context.Context, unlock *attempts.Unlock) error {
+	ttl := time.Until(unlock.ExpiresAt())
+	if ttl <= 0 {
+		return attempts.ErrInvalidUnlock
+	}
+	return s.rdb.Set(ctx, unlockKey(unlock.Hash()), unlock.Email(), ttl).Err()
+}
+
+func (s *UnlockStore) Take(ctx context.Context, hash string) (*attempts.Unlock, error) {
+	pipe := s.rdb.TxPipeline()
+	ttl := pipe.PTTL(ctx, unlockKey(hash))
+	get := pipe.GetDel(ctx, unlockKey(hash))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, attempts.ErrInvalidUnlock
+	} else if err != nil {
+		return nil, err
+	} else if ttl.Val() <= 0 || get.Val() == "" {
+		return nil, attempts.ErrInvalidUnlock
+	}
+
+	return attempts.NewUnlockFromStore(hash, get.Val(), time.Now().Add(ttl.Val())), nil
+}
+
+func attemptFailsKey(key string) string {
+	return "login:fails:" + key
+}
+
+func attemptWaitKey(key string) string {
+	return "login:wait:" + key
+}
+
+func attemptLockKey(key string) string {
+	return "login:lock:" + key
+}
+
+func unlockKey(hash string) string {
+	return "login:unlock:" + hash
+}
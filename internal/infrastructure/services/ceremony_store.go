@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// PasskeySettings describe this service as the relying party of passkeys.
+// RPId is the domain passkeys are bound to, Origins the pages allowed to run
+// ceremonies, and a ceremony expires after ChallengeTTL.
+type PasskeySettings struct {
+	RPId         string
+	RPName       string
+	Origins      []string
+	ChallengeTTL time.Duration
+}
+
+// CeremonyStore keeps the passkey ceremonies waiting for an authenticator in
+// Redis, which drops them when they expire. Logins are stored without a user.
+type CeremonyStore struct {
+	rdb *redis.Client
+}
+
+func NewCeremonyStore(rdb *redis.Client) *CeremonyStore {
+	return &CeremonyStore{
+		rdb: rdb,
+	}
+}
+
+func (s *CeremonyStore) Save(ctx context.Context, ceremony *passkeys.Ceremony) error {
+	ttl := time.Until(ceremony.ExpiresAt())
+	if ttl <= 0 {
+		return passkeys.ErrInvalidCeremony
+	}
+
+	var userId string
+	if ceremony.UserId() != uuid.Nil {
+		userId = ceremony.UserId().String()
+	}
+
+	return s.rdb.Set(ctx, ceremonyKey(ceremony.Kind(), ceremony.Challenge()), userId, ttl).Err()
+}
+
+func (s *CeremonyStore) Take(ctx context.Context, kind, challenge string) (*passkeys.Ceremony, error) {
+	pipe := s.rdb.TxPipeline()
+	ttl := pipe.PTTL(ctx, ceremonyKey(kind, challenge))
+	get := pipe.GetDel(ctx, ceremonyKey(kind, challenge))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, passkeys.ErrInvalidCeremony
+	} else if err != nil {
+		return nil, err
+	} else if ttl.Val() <= 0 {
+		return nil, passkeys.ErrInvalidCeremony
+	}
+
+	userId := uuid.Nil
+	if get.Val() != "" {
+		parsed, err := uuid.Parse(get.Val())
+		if err != nil {
+			return nil, passkeys.ErrInvalidCeremony
+		}
+		userId = parsed
+	}
+
+	return passkeys.NewCeremonyFromStore(kind, challenge, userId, time.Now().Add(ttl.Val())), nil
+}
+
+func ceremonyKey(kind, challenge string) string {
+	return "webauthn:" + kind + ":" + challenge
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// MFASettings tunes two-factor authentication. Issuer names the account in
+// authenticator apps, and a login waiting for a code expires after
+// ChallengeTTL.
+type MFASettings struct {
+	Issuer       string
+	ChallengeTTL time.Duration
+}
+
+// ChallengeStore keeps the challenges of logins waiting for a code in Redis,
+// which drops them when they expire. Wrong codes are counted next to each one
+// and expire with it.
+type ChallengeStore struct {
+	rdb *redis.Client
+}
+
+func NewChallengeStore(rdb *redis.Client) *ChallengeStore {
+	return &ChallengeStore{
+		rdb: rdb,
+	}
+}
+
+func (s *ChallengeStore) Save(ctx context.Context, challenge *factors.Challenge) error {
+	ttl := time.Until(challenge.ExpiresAt())
+	if ttl <= 0 {
+		return factors.ErrInvalidChallenge
+	}
+	return s.rdb.Set(ctx, challengeKey(challenge.Hash()), challenge.UserId().String(), ttl).Err()
+}
+
+func (s *ChallengeStore) Get(ctx context.Context, hash string) (*factors.Challenge, error) {
+	pipe := s.rdb.Pipeline()
+	get := pipe.Get(ctx, challengeKey(hash))
+	ttl := pipe.PTTL(ctx, challengeKey(hash))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, factors.ErrInvalidChallenge
+	} else if err != nil {
+		return nil, err
+	}
+
+	userId, err := uuid.Parse(get.Val())
+	if err != nil || ttl.Val() <= 0 {
+		return nil, factors.ErrInvalidChallenge
+	}
+
+	return factors.NewChallengeFromStore(hash, userId, time.Now().Add(ttl.Val())), nil
+}
+
+func (s *ChallengeStore) Fail(ctx context.Context, hash string) (int, error) {
+	ttl, err := s.rdb.PTTL(ctx, challengeKey(hash)).Result()
+	if err != nil {
+		return 0, err
+	} else if ttl <= 0 {
+		return factors.MaxChallengeAttempts, nil
+	}
+
+	pipe := s.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, challengeFailsKey(hash))
+	pipe.PExpire(ctx, challengeFailsKey(hash), ttl)
+	if _, err = pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (s *ChallengeStore) Delete(ctx context.Context, hash string) (bool, error) {
+	deleted, err := s.rdb.Del(ctx, challengeKey(hash)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	s.rdb.Del(ctx, challengeFailsKey(hash))
+	return deleted == 1, nil
+}
+
+func challengeKey(hash string) string {
+	return "mfa:challenge:" + hash
+}
+
+func challengeFailsKey(hash string) string {
+	return "mfa:challenge:" + hash + ":fails"
+}
@@ -8,6 +8,20 @@ import (
 	"net/url"
 )
 
+const (
+	VerificationPolicyAllow       = "allow"
+	VerificationPolicyBlockLogin  = "block_login"
+	VerificationPolicyBlockWrites = "block_writes"
+)
+
+// VerificationSettings sets what users who have not verified their email may
+// do: everything, nothing past login, or only reads. RedirectUrl is where the
+// verification link lands once it worked.
+type VerificationSettings struct {
+	Policy      string
+	RedirectUrl string
+}
+
 type EmailService struct {
 	Host     string
 	Port     string
@@ -43,4 +57,50 @@ func (e *EmailService) SendVerificationEmail(toEmail, token string) error {
 	return nil
 }
 
+// SendPasswordResetEmail links to the page where the app asks for the new
+// password, which it then posts along with the token to /auth/password/reset.
+func (e *EmailService) SendPasswordResetEmail(toEmail, token string) error {
+	link := fmt.Sprintf("%s/reset-password?token=%s", e.AppUrl, url.QueryEscape(token))
+
+	em := email.NewEmail()
+	em.From = fmt.Sprintf("%s <%s>", "Pinterest-Clone", e.Username)
+	em.To = []string{toEmail}
+	em.Subject = "Reset your password"
+	em.HTML = []byte(fmt.Sprintf("<p>Click <a href='%s'>here</a> to choose a new password. If you did not ask for it, you can ignore this email.</p>", link))
+
+	auth := smtp.PlainAuth("", e.Username, e.Password, e.Host)
+	addr := fmt.Sprintf("%s:%s", e.Host, e.Port)
+
+	go func() {
+		if err := em.Send(addr, auth); err != nil {
+			fmt.Printf("failed to send password reset email to %s: %v\n", toEmail, err)
+		}
+	}()
+
+	return nil
+}
+
+// SendUnlockEmail links to the page where the app posts the token to
+// /users/unlock, lifting the lock that too many failed logins put on the account.
+func (e *EmailService) SendUnlockEmail(toEmail, token string) error {
+	link := fmt.Sprintf("%s/unlock-account?token=%s", e.AppUrl, url.QueryEscape(token))
+
+	em := email.NewEmail()
+	em.From = fmt.Sprintf("%s <%s>", "Pinterest-Clone", e.Username)
+	em.To = []string{toEmail}
+	em.Subject = "Your account was locked"
+	em.HTML = []byte(fmt.Sprintf("<p>Your account was locked after too many failed logins. If it was you, click <a href='%s'>here</a> to unlock it. If it was not, consider changing your password.</p>", link))
+
+	auth := smtp.PlainAuth("", e.Username, e.Password, e.Host)
+	addr := fmt.Sprintf("%s:%s", e.Host, e.Port)
+
+	go func() {
+		if err := em.Send(addr, auth); err != nil {
+			fmt.Printf("failed to send unlock email to %s: %v\n", toEmail, err)
+		}
+	}()
+
+	return nil
+}
+
 var _ em.Sender = (*EmailService)(nil)
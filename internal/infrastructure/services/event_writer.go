@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"sync"
+	"time"
+)
+
+type EventSettings struct {
+	BufferSize     int
+	BatchSize      int
+	FlushInterval  time.Duration
+	RollupInterval time.Duration
+}
+
+// EventWriter holds ingested events in memory and writes them to the
+// repository in batches of batchSize, whenever a batch fills up or every
+// interval given to Run. A batch the repository rejects is logged and
+// dropped, so a database outage costs events rather than requests.
+type EventWriter struct {
+	repository events.EventRepository
+	logger     application.Logger
+	capacity   int
+	batchSize  int
+	mu         sync.Mutex
+	buffer     []*events.Event
+	full       chan struct{}
+}
+
+func NewEventWriter(repository events.EventRepository, logger application.Logger, capacity, batchSize int) *EventWriter {
+	return &EventWriter{
+		repository: repository,
+		logger:     logger,
+		capacity:   capacity,
+		batchSize:  batchSize,
+		buffer:     make([]*events.Event, 0, capacity),
+		full:       make(chan struct{}, 1),
+	}
+}
+
+func (w *EventWriter) Write(ctx context.Context, list []*events.Event) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buffer)+len(list) > w.capacity {
+		return events.ErrFullBuffer
+	}
+
+	w.buffer = append(w.buffer, list...)
+	if len(w.buffer) >= w.batchSize {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Run flushes the buffer until ctx is done, then writes what is left.
+func (w *EventWriter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.flush(context.Background())
+			return
+		case <-ticker.C:
+			w.flush(ctx)
+		case <-w.full:
+			w.flush(ctx)
+		}
+	}
+}
+
+func (w *EventWriter) flush(ctx context.Context) {
+	for {
+		batch := w.take()
+		if len(batch) == 0 {
+			return
+		}
+
+		if _, err := w.repository.CreateBatch(ctx, batch); err != nil {
+			w.logger.Error("Could not write %d events: %v", len(batch), err)
+		}
+	}
+}
+
+func (w *EventWriter) take() []*events.Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n := min(len(w.buffer), w.batchSize)
+	batch := make([]*events.Event, n)
+	copy(batch, w.buffer[:n])
+	w.buffer = append(w.buffer[:0], w.buffer[n:]...)
+
+	return batch
+}
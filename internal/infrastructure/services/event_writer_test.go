@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeEventRepository records the batches it is given, failing the ones
+// listed in fail by their position.
+type fakeEventRepository struct {
+	mu      sync.Mutex
+	batches [][]*events.Event
+	fail    map[int]bool
+	written chan struct{}
+}
+
+func newFakeEventRepository() *fakeEventRepository {
+	return &fakeEventRepository{
+		fail:    map[int]bool{},
+		written: make(chan struct{}, 16),
+	}
+}
+
+func (r *fakeEventRepository) CreateBatch(ctx context.Context, list []*events.Event) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer func() { r.written <- struct{}{} }()
+
+	position := len(r.batches)
+	r.batches = append(r.batches, list)
+	if r.fail[position] {
+		return 0, errors.New("database error")
+	}
+	return int64(len(list)), nil
+}
+
+func (r *fakeEventRepository) GetListVisiblePins(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) ([]uuid.UUID, error) {
+	return pinIds, nil
+}
+
+func (r *fakeEventRepository) Rollup(ctx context.Context, from, to time.Time) error {
+	return nil
+}
+
+func (r *fakeEventRepository) sizes() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sizes := make([]int, len(r.batches))
+	for i, batch := range r.batches {
+		sizes[i] = len(batch)
+	}
+	return sizes
+}
+
+func (r *fakeEventRepository) waitBatches(t *testing.T, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-r.written:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected %d batches, got %v", n, r.sizes())
+		}
+	}
+}
+
+type recordingLogger struct {
+	mu     sync.Mutex
+	errors []string
+}
+
+func (l *recordingLogger) Debug(msg string, args ...any) {}
+func (l *recordingLogger) Info(msg string, args ...any)  {}
+func (l *recordingLogger) Warn(msg string, args ...any)  {}
+
+func (l *recordingLogger) Error(msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, msg)
+}
+
+func newTestEvents(t *testing.T, n int) []*events.Event {
+	t.Helper()
+
+	list := make([]*events.Event, n)
+	for i := range list {
+		now := time.Now()
+		event, err := events.NewEvent(uuid.New(), uuid.New(), uuid.New(), events.ImpressionKind, now, now)
+		require.NoError(t, err)
+		list[i] = event
+	}
+	return list
+}
+
+func TestEventWriter_Write_FullBuffer(t *testing.T) {
+	writer := NewEventWriter(newFakeEventRepository(), &recordingLogger{}, 3, 10)
+
+	require.NoError(t, writer.Write(context.Background(), newTestEvents(t, 2)))
+	err := writer.Write(context.Background(), newTestEvents(t, 2))
+
+	assert.ErrorIs(t, err, events.ErrFullBuffer)
+	require.NoError(t, writer.Write(context.Background(), newTestEvents(t, 1)))
+}
+
+func TestEventWriter_Run(t *testing.T) {
+	cases := []struct {
+		name     string
+		batch    int
+		interval time.Duration
+		written  int
+		sizes    []int
+	}{
+		{"FlushOnSize", 2, time.Hour, 5, []int{2, 2, 1}},
+		{"FlushOnInterval", 10, 10 * time.Millisecond, 3, []int{3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repository := newFakeEventRepository()
+			writer := NewEventWriter(repository, &recordingLogger{}, 100, tc.batch)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go writer.Run(ctx, tc.interval)
+
+			require.NoError(t, writer.Write(ctx, newTestEvents(t, tc.written)))
+			repository.waitBatches(t, len(tc.sizes))
+
+			assert.Equal(t, tc.sizes, repository.sizes())
+		})
+	}
+}
+
+func TestEventWriter_Run_FlushOnStop(t *testing.T) {
+	repository := newFakeEventRepository()
+	writer := NewEventWriter(repository, &recordingLogger{}, 100, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		writer.Run(ctx, time.Hour)
+		close(done)
+	}()
+
+	require.NoError(t, writer.Write(ctx, newTestEvents(t, 1)))
+	cancel()
+	<-done
+
+	assert.Equal(t, []int{1}, repository.sizes())
+}
+
+func TestEventWriter_Run_DropsFailedBatch(t *testing.T) {
+	repository := newFakeEventRepository()
+	repository.fail[0] = true
+	logger := &recordingLogger{}
+	writer := NewEventWriter(repository, logger, 100, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		writer.Run(ctx, time.Hour)
+		close(done)
+	}()
+
+	require.NoError(t, writer.Write(ctx, newTestEvents(t, 4)))
+	repository.waitBatches(t, 2)
+	cancel()
+	<-done
+
+	assert.Equal(t, []int{2, 2}, repository.sizes())
+	assert.Len(t, logger.errors, 1)
+	require.NoError(t, writer.Write(context.Background(), newTestEvents(t, 100)))
+}
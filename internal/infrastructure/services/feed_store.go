@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// FeedSettings tunes the home feed. Pins of authors with at least
+// PopularFollowers followers are read when the feed is requested instead of
+// being copied to every follower. Stored feeds keep their newest MaxSize pins
+// and expire after TTL without writes. RankerName picks the interests.Ranker
+// that orders each page.
+type FeedSettings struct {
+	PopularFollowers int
+	MaxSize          int
+	TTL              time.Duration
+	RankerName       string
+}
+
+// Ranker returns the ranker named in the settings, falling back to the
+// default one when the name is unknown.
+func (s *FeedSettings) Ranker() interests.Ranker {
+	ranker, err := interests.NewRanker(s.RankerName)
+	if err != nil {
+		ranker, _ = interests.NewRanker("")
+	}
+	return ranker
+}
+
+// FeedStore keeps home feeds in Redis sorted sets, one per user, scored by the
+// creation time of each pin in microseconds. A feed holds its newest maxSize
+// pins and expires after ttl without writes.
+type FeedStore struct {
+	rdb     *redis.Client
+	maxSize int
+	ttl     time.Duration
+}
+
+func NewFeedStore(rdb *redis.Client, maxSize int, ttl time.Duration) *FeedStore {
+	return &FeedStore{
+		rdb:     rdb,
+		maxSize: maxSize,
+		ttl:     ttl,
+	}
+}
+
+func (s *FeedStore) Exists(ctx context.Context, userId uuid.UUID) (bool, error) {
+	val, err := s.rdb.Exists(ctx, feedKey(userId)).Result()
+	if err != nil {
+		return false, err
+	}
+	return val == 1, nil
+}
+
+// Page walks the sorted set from the cursor's score down. Members sharing the
+// cursor's score are ordered by pin id, like feeds.Entry, so the ones at or
+// above the cursor are skipped and the walk goes on until the page is full.
+func (s *FeedStore) Page(ctx context.Context, userId uuid.UUID, cursor *feeds.Cursor, limit int) ([]feeds.Entry, error) {
+	var entries []feeds.Entry
+
+	upper := "+inf"
+	if cursor != nil {
+		upper = strconv.FormatInt(cursor.Last().CreatedAt().UnixMicro(), 10)
+	}
+
+	batch := int64(limit + 1)
+	for offset := int64(0); len(entries) < limit; offset += batch {
+		members, err := s.rdb.ZRevRangeByScoreWithScores(ctx, feedKey(userId), &redis.ZRangeBy{
+			Max:    upper,
+			Min:    "-inf",
+			Offset: offset,
+			Count:  batch,
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			pinId, err := uuid.Parse(member.Member.(string))
+			if err != nil {
+				continue
+			}
+
+			entry := feeds.NewEntry(pinId, time.UnixMicro(int64(member.Score)).UTC())
+			if cursor.Includes(entry) && len(entries) < limit {
+				entries = append(entries, entry)
+			}
+		}
+
+		if int64(len(members)) < batch {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *FeedStore) Add(ctx context.Context, userIds []uuid.UUID, entries []feeds.Entry) error {
+	if len(userIds) == 0 || len(entries) == 0 {
+		return nil
+	}
+
+	pipe := s.rdb.Pipeline()
+	exists := make([]*redis.IntCmd, len(userIds))
+	for i, userId := range userIds {
+		exists[i] = pipe.Exists(ctx, feedKey(userId))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	pipe = s.rdb.Pipeline()
+	for i, userId := range userIds {
+		if exists[i].Val() == 1 {
+			s.write(ctx, pipe, userId, entries)
+		}
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *FeedStore) Replace(ctx context.Context, userId uuid.UUID, entries []feeds.Entry) error {
+	pipe := s.rdb.TxPipeline()
+	pipe.Del(ctx, feedKey(userId))
+	if len(entries) > 0 {
+		s.write(ctx, pipe, userId, entries)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *FeedStore) Remove(ctx context.Context, userId uuid.UUID, pinIds []uuid.UUID) error {
+	if len(pinIds) == 0 {
+		return nil
+	}
+
+	members := make([]any, len(pinIds))
+	for i, pinId := range pinIds {
+		members[i] = pinId.String()
+	}
+
+	return s.rdb.ZRem(ctx, feedKey(userId), members...).Err()
+}
+
+// write queues the entries into the user's feed, trims it to maxSize and
+// pushes its expiry back.
+func (s *FeedStore) write(ctx context.Context, pipe redis.Pipeliner, userId uuid.UUID, entries []feeds.Entry) {
+	members := make([]redis.Z, len(entries))
+	for i, entry := range entries {
+		members[i] = redis.Z{
+			Score:  float64(entry.CreatedAt().UnixMicro()),
+			Member: entry.PinId().String(),
+		}
+	}
+
+	key := feedKey(userId)
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByRank(ctx, key, 0, int64(-s.maxSize-1))
+	pipe.Expire(ctx, key, s.ttl)
+}
+
+func feedKey(userId uuid.UUID) string {
+	return "feed:" + userId.String()
+}
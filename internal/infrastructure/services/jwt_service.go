@@ -1,40 +1,279 @@
 package services
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"math/big"
+	"sort"
 	"time"
 )
 
+const (
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+	HS256 = "HS256"
+
+	// MinRSABits is the smallest RSA key tokens may be signed with.
+	MinRSABits = 2048
+)
+
+var (
+	ErrUnknownKeyJWT     = errors.New("token is signed with an unknown key")
+	ErrAlgorithmJWT      = errors.New("token algorithm does not match its key")
+	ErrNoSigningKeyJWT   = errors.New("signing key must be one of the keys and hold a private key")
+	ErrUnsupportedKeyJWT = errors.New("key must be an RSA key of at least 2048 bits or an Ed25519 key")
+	ErrNoSecretJWT       = errors.New("a secret is required when no keys are configured")
+	ErrClaimsJWT         = errors.New("token claims are invalid")
+)
+
+// AuthSettings sets how long tokens last. Access tokens are short-lived;
+// clients keep their session going by trading the refresh token they got with
+// it at /auth/refresh. ResetTTL is how long a password reset link works.
+type AuthSettings struct {
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	ResetTTL   time.Duration
+}
+
+// JWTSettings chooses how tokens are signed. With Keys, tokens are signed by
+// the key named SigningKeyId and verified by any of them, so a new key can
+// take over while tokens signed by the previous one are still accepted. The
+// HS256 Secret is only used when there are no keys.
+type JWTSettings struct {
+	Secret       string
+	Issuer       string
+	Audience     string
+	SigningKeyId string
+	Keys         []*JWTKey
+}
+
+// JWTKey signs and verifies tokens. A key kept only to verify the tokens it
+// signed before a rotation needs no private part.
+type JWTKey struct {
+	id        string
+	algorithm string
+	private   crypto.Signer
+	public    crypto.PublicKey
+}
+
+// NewJWTKey wraps an RSA or Ed25519 key, public or private.
+func NewJWTKey(id string, key any) (*JWTKey, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < MinRSABits {
+			return nil, ErrUnsupportedKeyJWT
+		}
+		return &JWTKey{id: id, algorithm: RS256, private: k, public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < MinRSABits {
+			return nil, ErrUnsupportedKeyJWT
+		}
+		return &JWTKey{id: id, algorithm: RS256, public: k}, nil
+	case ed25519.PrivateKey:
+		return &JWTKey{id: id, algorithm: EdDSA, private: k, public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &JWTKey{id: id, algorithm: EdDSA, public: k}, nil
+	default:
+		return nil, ErrUnsupportedKeyJWT
+	}
+}
+
+// ParseJWTKey reads a PEM encoded PKCS#8 or PKCS#1 private key, or a PKIX
+// public key.
+func ParseJWTKey(id, data string) (*JWTKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("key %s is not PEM encoded", id)
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("key %s has unsupported PEM type %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", id, err)
+	}
+
+	return NewJWTKey(id, key)
+}
+
+func (k *JWTKey) Id() string {
+	return k.id
+}
+
+func (k *JWTKey) Algorithm() string {
+	return k.algorithm
+}
+
+func (k *JWTKey) method() jwt.SigningMethod {
+	if k.algorithm == EdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// Claims are the claims of the access tokens. The subject is the user id,
+// repeated as user_id for clients reading it from before, and SessionId (sid)
+// is the session the token belongs to, so a session is revoked with every
+// token of it. The id (jti) is unique to each token. Role is the role of the
+// user when the token was signed.
+type Claims struct {
+	UserId    string `json:"user_id"`
+	SessionId string `json:"sid,omitempty"`
+	Role      string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Session is the session the token belongs to. Tokens signed before sid was
+// added carry it as their id.
+func (c *Claims) Session() string {
+	if c.SessionId != "" {
+		return c.SessionId
+	}
+	return c.ID
+}
+
 type JWTService struct {
 	secretKey string
+	issuer    string
+	audience  string
 	ttl       time.Duration
+	signing   *JWTKey
+	keys      map[string]*JWTKey
+	methods   []string
 }
 
-func NewJWTService(secret string, ttl time.Duration) *JWTService {
-	return &JWTService{secretKey: secret, ttl: ttl}
+func NewJWTService(settings JWTSettings, ttl time.Duration) (*JWTService, error) {
+	j := &JWTService{
+		secretKey: settings.Secret,
+		issuer:    settings.Issuer,
+		audience:  settings.Audience,
+		ttl:       ttl,
+		keys:      make(map[string]*JWTKey, len(settings.Keys)),
+	}
+
+	if len(settings.Keys) == 0 {
+		if settings.Secret == "" {
+			return nil, ErrNoSecretJWT
+		}
+		j.methods = []string{HS256}
+		return j, nil
+	}
+
+	algorithms := make(map[string]bool)
+	for _, key := range settings.Keys {
+		j.keys[key.id] = key
+		if !algorithms[key.algorithm] {
+			algorithms[key.algorithm] = true
+			j.methods = append(j.methods, key.algorithm)
+		}
+	}
+
+	signing, ok := j.keys[settings.SigningKeyId]
+	if !ok || signing.private == nil {
+		return nil, ErrNoSigningKeyJWT
+	}
+	j.signing = signing
+
+	return j, nil
+}
+
+func (j *JWTService) TTL() time.Duration {
+	return j.ttl
 }
 
-func (j *JWTService) Generate(userID string) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(j.ttl).Unix(),
+func (j *JWTService) Generate(userID, sessionID, role string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserId:    userID,
+		SessionId: sessionID,
+		Role:      role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    j.issuer,
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
+		},
+	}
+	if j.audience != "" {
+		claims.Audience = jwt.ClaimStrings{j.audience}
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	if j.signing == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(j.secretKey))
+	}
+
+	token := jwt.NewWithClaims(j.signing.method(), claims)
+	token.Header["kid"] = j.signing.id
+	return token.SignedString(j.signing.private)
+}
+
+// Verify checks the signature, algorithm, issuer, audience and lifetime of a
+// token and returns its claims. Only the algorithms of the configured keys
+// are accepted, and each key only for its own algorithm.
+func (j *JWTService) Verify(tokenStr string) (*Claims, error) {
+	options := []jwt.ParserOption{jwt.WithValidMethods(j.methods), jwt.WithExpirationRequired(), jwt.WithIssuedAt()}
+	if j.issuer != "" {
+		options = append(options, jwt.WithIssuer(j.issuer))
+	}
+	if j.audience != "" {
+		options = append(options, jwt.WithAudience(j.audience))
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, j.key, options...)
+	if err != nil {
+		return nil, err
+	} else if !token.Valid || claims.Subject == "" {
+		return nil, ErrClaimsJWT
+	}
+
+	return claims, nil
 }
 
 func (j *JWTService) Validate(tokenStr string) (string, error) {
-	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
-		return []byte(j.secretKey), nil
-	})
-	if err != nil || !token.Valid {
+	claims, err := j.Verify(tokenStr)
+	if err != nil {
 		return "", err
 	}
 
-	claims := token.Claims.(jwt.MapClaims)
-	return claims["user_id"].(string), nil
+	return claims.Subject, nil
+}
+
+func (j *JWTService) key(t *jwt.Token) (any, error) {
+	if len(j.keys) == 0 {
+		return []byte(j.secretKey), nil
+	}
+
+	kid, _ := t.Header["kid"].(string)
+	key, ok := j.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKeyJWT
+	} else if key.algorithm != t.Method.Alg() {
+		return nil, ErrAlgorithmJWT
+	}
+
+	return key.public, nil
 }
 
 func (j *JWTService) ParseToken(tokenStr string) (map[string]any, error) {
@@ -49,3 +288,46 @@ func (j *JWTService) ParseToken(tokenStr string) (map[string]any, error) {
 
 	return nil, fmt.Errorf("invalid claims")
 }
+
+// JWK is a public key as RFC 7517 describes it.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS lists the public keys tokens are verified with, ordered by id. It is
+// empty when tokens are signed with the HS256 secret, which is never shared.
+func (j *JWTService) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(j.keys))}
+	for _, key := range j.keys {
+		jwk := JWK{Kid: key.id, Alg: key.algorithm, Use: "sig"}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	sort.Slice(set.Keys, func(a, b int) bool {
+		return set.Keys[a].Kid < set.Keys[b].Kid
+	})
+
+	return set
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testRSAKey = sync.OnceValue(func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+})
+
+func newTestJWTKey(t *testing.T, id string, key any) *JWTKey {
+	t.Helper()
+
+	jwtKey, err := NewJWTKey(id, key)
+	require.NoError(t, err)
+	return jwtKey
+}
+
+func newTestEdKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestJWTService(t *testing.T, settings JWTSettings) *JWTService {
+	t.Helper()
+
+	service, err := NewJWTService(settings, time.Minute)
+	require.NoError(t, err)
+	return service
+}
+
+func TestJWTService_RoundTrip(t *testing.T) {
+	cases := []struct {
+		name      string
+		settings  JWTSettings
+		algorithm string
+	}{
+		{"HS256", JWTSettings{Secret: "secret"}, HS256},
+		{"RS256", JWTSettings{SigningKeyId: "rsa", Keys: []*JWTKey{newTestJWTKey(t, "rsa", testRSAKey())}}, RS256},
+		{"EdDSA", JWTSettings{SigningKeyId: "ed", Keys: []*JWTKey{newTestJWTKey(t, "ed", newTestEdKey(t))}}, EdDSA},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.settings.Issuer, tc.settings.Audience = "pinterest", "pinterest-web"
+			service := newTestJWTService(t, tc.settings)
+
+			token, err := service.Generate("user", "session", "admin")
+			require.NoError(t, err)
+			claims, err := service.Verify(token)
+
+			require.NoError(t, err)
+			assert.Equal(t, "user", claims.Subject)
+			assert.Equal(t, "user", claims.UserId)
+			assert.Equal(t, "session", claims.Session())
+			assert.Equal(t, "admin", claims.Role)
+			assert.Equal(t, "pinterest", claims.Issuer)
+			assert.Equal(t, jwt.ClaimStrings{"pinterest-web"}, claims.Audience)
+
+			parsed, err := service.ParseToken(token)
+			require.NoError(t, err)
+			header, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.algorithm, header.Method.Alg())
+			assert.Equal(t, "session", parsed["sid"])
+		})
+	}
+}
+
+func TestJWTService_UniqueTokenId(t *testing.T) {
+	service := newTestJWTService(t, JWTSettings{Secret: "secret"})
+
+	first, err := service.Generate("user", "session", "user")
+	require.NoError(t, err)
+	second, err := service.Generate("user", "session", "user")
+	require.NoError(t, err)
+
+	a, err := service.Verify(first)
+	require.NoError(t, err)
+	b, err := service.Verify(second)
+	require.NoError(t, err)
+
+	assert.Equal(t, a.Session(), b.Session())
+	assert.NotEmpty(t, a.ID)
+	assert.NotEqual(t, a.ID, b.ID)
+	assert.NotEqual(t, a.ID, a.SessionId)
+}
+
+func TestClaims_Session(t *testing.T) {
+	legacy := &Claims{RegisteredClaims: jwt.RegisteredClaims{ID: "session"}}
+	assert.Equal(t, "session", legacy.Session())
+
+	current := &Claims{SessionId: "session", RegisteredClaims: jwt.RegisteredClaims{ID: "token"}}
+	assert.Equal(t, "session", current.Session())
+}
+
+func TestJWTService_Rotation(t *testing.T) {
+	old := newTestJWTKey(t, "2025-01", testRSAKey())
+	before := newTestJWTService(t, JWTSettings{SigningKeyId: "2025-01", Keys: []*JWTKey{old}})
+	token, err := before.Generate("user", "session", "user")
+	require.NoError(t, err)
+
+	retired := newTestJWTKey(t, "2025-01", &testRSAKey().PublicKey)
+	after := newTestJWTService(t, JWTSettings{SigningKeyId: "2025-06", Keys: []*JWTKey{retired, newTestJWTKey(t, "2025-06", newTestEdKey(t))}})
+
+	claims, err := after.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user", claims.Subject)
+
+	fresh, err := after.Generate("user", "session", "user")
+	require.NoError(t, err)
+	header, _, err := jwt.NewParser().ParseUnverified(fresh, jwt.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06", header.Header["kid"])
+	assert.Equal(t, EdDSA, header.Method.Alg())
+}
+
+func TestJWTService_Verify_Errors(t *testing.T) {
+	rsaKey := newTestJWTKey(t, "rsa", testRSAKey())
+	edKey := newTestEdKey(t)
+	verifier := newTestJWTService(t, JWTSettings{Issuer: "pinterest", Audience: "pinterest-web", SigningKeyId: "rsa", Keys: []*JWTKey{rsaKey, newTestJWTKey(t, "ed", edKey.Public())}})
+
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	claims := func(issuer, audience string) jwt.MapClaims {
+		now := time.Now()
+		return jwt.MapClaims{"sub": "user", "iss": issuer, "aud": audience, "iat": now.Unix(), "exp": now.Add(time.Minute).Unix()}
+	}
+	other := newTestJWTKey(t, "other", newTestEdKey(t))
+
+	cases := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"UnknownKid", sign(jwt.SigningMethodEdDSA, "other", other.private, claims("pinterest", "pinterest-web")), ErrUnknownKeyJWT},
+		{"MissingKid", sign(jwt.SigningMethodEdDSA, "", edKey, claims("pinterest", "pinterest-web")), ErrUnknownKeyJWT},
+		{"EdDSAWithRSAKid", sign(jwt.SigningMethodEdDSA, "rsa", edKey, claims("pinterest", "pinterest-web")), ErrAlgorithmJWT},
+		{"RS256WithEdKid", sign(jwt.SigningMethodRS256, "ed", testRSAKey(), claims("pinterest", "pinterest-web")), ErrAlgorithmJWT},
+		{"HS256WithRSAKid", sign(jwt.SigningMethodHS256, "rsa", []byte("secret"), claims("pinterest", "pinterest-web")), jwt.ErrTokenSignatureInvalid},
+		{"WrongIssuer", sign(jwt.SigningMethodRS256, "rsa", testRSAKey(), claims("evil", "pinterest-web")), jwt.ErrTokenInvalidIssuer},
+		{"WrongAudience", sign(jwt.SigningMethodRS256, "rsa", testRSAKey(), claims("pinterest", "evil")), jwt.ErrTokenInvalidAudience},
+		{"NotSigned", sign(jwt.SigningMethodNone, "rsa", jwt.UnsafeAllowNoneSignatureType, claims("pinterest", "pinterest-web")), jwt.ErrTokenSignatureInvalid},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tc.token)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, claims)
+		})
+	}
+}
+
+func TestNewJWTKey_Errors(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		key  any
+	}{
+		{"SmallRSAPrivate", small},
+		{"SmallRSAPublic", &small.PublicKey},
+		{"Secret", []byte("secret")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := NewJWTKey("key", tc.key)
+
+			assert.ErrorIs(t, err, ErrUnsupportedKeyJWT)
+			assert.Nil(t, key)
+		})
+	}
+}
+
+func TestNewJWTService_Errors(t *testing.T) {
+	verifyOnly := newTestJWTKey(t, "rsa", &testRSAKey().PublicKey)
+
+	_, err := NewJWTService(JWTSettings{}, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSecretJWT)
+
+	_, err = NewJWTService(JWTSettings{SigningKeyId: "missing", Keys: []*JWTKey{verifyOnly}}, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSigningKeyJWT)
+
+	_, err = NewJWTService(JWTSettings{SigningKeyId: "rsa", Keys: []*JWTKey{verifyOnly}}, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSigningKeyJWT)
+}
+
+func TestJWTService_JWKS(t *testing.T) {
+	edKey := newTestEdKey(t)
+	service := newTestJWTService(t, JWTSettings{SigningKeyId: "b-ed", Keys: []*JWTKey{newTestJWTKey(t, "b-ed", edKey), newTestJWTKey(t, "a-rsa", testRSAKey())}})
+
+	set := service.JWKS()
+
+	require.Len(t, set.Keys, 2)
+	rsaJWK, edJWK := set.Keys[0], set.Keys[1]
+
+	assert.Equal(t, JWK{Kty: "RSA", Kid: "a-rsa", Alg: RS256, Use: "sig", N: rsaJWK.N, E: "AQAB"}, rsaJWK)
+	n, err := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	require.NoError(t, err)
+	assert.Zero(t, new(big.Int).SetBytes(n).Cmp(testRSAKey().N))
+
+	assert.Equal(t, JWK{Kty: "OKP", Kid: "b-ed", Alg: EdDSA, Use: "sig", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edKey.Public().(ed25519.PublicKey))}, edJWK)
+
+	assert.Empty(t, newTestJWTService(t, JWTSettings{Secret: "secret"}).JWKS().Keys)
+}
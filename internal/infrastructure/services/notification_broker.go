@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamSettings lists the Origins of the pages allowed to open notification
+// WebSockets.
+type StreamSettings struct {
+	Origins []string
+}
+
+// NotificationBroker fans notification events out through Redis pub/sub, one
+// channel per recipient, so a user connected to any instance receives them.
+type NotificationBroker struct {
+	rdb *redis.Client
+}
+
+func NewNotificationBroker(rdb *redis.Client) *NotificationBroker {
+	return &NotificationBroker{
+		rdb: rdb,
+	}
+}
+
+func (b *NotificationBroker) PublishNotification(ctx context.Context, n *notifications.Notification) error {
+	notificationDto := mappers.MapToNotificationDTO(n)
+	event, err := mappers.MapToNotificationEventDTO(mappers.MapToNotificationResponse(notificationDto, n.ReadAt(), n.CreatedAt()))
+	if err != nil {
+		return err
+	}
+
+	return b.publish(ctx, n.RecipientId(), event)
+}
+
+func (b *NotificationBroker) PublishUnreadCount(ctx context.Context, recipientId uuid.UUID, count int) error {
+	event, err := mappers.MapToUnreadCountEventDTO(count)
+	if err != nil {
+		return err
+	}
+
+	return b.publish(ctx, recipientId, event)
+}
+
+// Subscribe listens to the recipient's channel until ctx is done. The
+// subscription is confirmed before it returns, so nothing published afterwards
+// is missed. The returned channel is closed when ctx is done or the
+// connection to Redis drops.
+func (b *NotificationBroker) Subscribe(ctx context.Context, recipientId uuid.UUID) (<-chan *dto.NotificationEventDTO, error) {
+	pubsub := b.rdb.Subscribe(ctx, notificationChannel(recipientId))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+
+	events := make(chan *dto.NotificationEventDTO)
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var event dto.NotificationEventDTO
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					continue
+				}
+
+				select {
+				case events <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (b *NotificationBroker) publish(ctx context.Context, recipientId uuid.UUID, event *dto.NotificationEventDTO) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return b.rdb.Publish(ctx, notificationChannel(recipientId), payload).Err()
+}
+
+func notificationChannel(recipientId uuid.UUID) string {
+	return "notifications:" + recipientId.String()
+}
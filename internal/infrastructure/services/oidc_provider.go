@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/golang-jwt/jwt/v5"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxOIDCResponse bounds what is read from a provider.
+	maxOIDCResponse = 1 << 20
+	// jwksRefreshInterval is how often an unknown key id may make the keys of
+	// a provider be fetched again, so rotated keys are picked up.
+	jwksRefreshInterval = time.Minute
+)
+
+var (
+	ErrDiscoveryOIDC  = errors.New("identity provider configuration is invalid")
+	ErrUnknownKeyOIDC = errors.New("id token is signed with an unknown key")
+)
+
+// OIDCSettings lists the identity providers users may log in with. A login
+// sent to a provider expires after StateTTL, and an account with no user yet
+// has SignupTTL to complete its signup.
+type OIDCSettings struct {
+	Providers []OIDCProviderSettings
+	StateTTL  time.Duration
+	SignupTTL time.Duration
+}
+
+// OIDCProviderSettings is an OpenID Connect provider, found through the
+// discovery document of its issuer. Providers like Apple that post the
+// callback back want ResponseMode form_post; Apple also wants ClientSecret to
+// be the JWT it documents, signed beforehand.
+type OIDCProviderSettings struct {
+	Name         string
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	ResponseMode string
+}
+
+// OIDCProvider logs users in through the authorization code flow with PKCE.
+// Its discovery document is fetched on first use and its keys whenever a
+// token names one it does not know.
+type OIDCProvider struct {
+	settings OIDCProviderSettings
+	client   *http.Client
+
+	mu          sync.Mutex
+	discovery   *oidcDiscovery
+	keys        map[string]any
+	keysFetched time.Time
+}
+
+type oidcDiscovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	TokenAuthMethods      []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+}
+
+func NewOIDCProvider(settings OIDCProviderSettings, client *http.Client) *OIDCProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &OIDCProvider{
+		settings: settings,
+		client:   client,
+	}
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.settings.Name
+}
+
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, codeChallenge, nonce string) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := p.settings.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.settings.ClientId},
+		"redirect_uri":          {p.settings.RedirectURL},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	if p.settings.ResponseMode != "" {
+		query.Set("response_mode", p.settings.ResponseMode)
+	}
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, verifier string) (*identities.Claims, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.settings.RedirectURL},
+		"code_verifier": {verifier},
+	}
+
+	// client_secret_basic is the default of the spec, so it is used unless
+	// the provider only lists client_secret_post.
+	basic := len(discovery.TokenAuthMethods) == 0 || slices.Contains(discovery.TokenAuthMethods, "client_secret_basic")
+	if !basic || p.settings.ClientSecret == "" {
+		form.Set("client_id", p.settings.ClientId)
+		if p.settings.ClientSecret != "" {
+			form.Set("client_secret", p.settings.ClientSecret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if basic && p.settings.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.settings.ClientId), url.QueryEscape(p.settings.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var body struct {
+		IdToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+	if err = json.NewDecoder(io.LimitReader(res.Body, maxOIDCResponse)).Decode(&body); err != nil && res.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("%w: %v", identities.ErrIdToken, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", identities.ErrProviderDenied, body.Error)
+	} else if body.IdToken == "" {
+		return nil, identities.ErrIdToken
+	}
+
+	return p.verify(ctx, discovery, body.IdToken)
+}
+
+// verify checks the id token the way OpenID Connect Core 3.1.3.7 has it,
+// short of the nonce, which only the caller knows.
+func (p *OIDCProvider) verify(ctx context.Context, discovery *oidcDiscovery, idToken string) (*identities.Claims, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, discovery, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.settings.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", identities.ErrIdToken, err)
+	} else if claims.Subject == "" {
+		return nil, identities.ErrIdToken
+	}
+
+	// Apple sends email_verified as a string.
+	verified := claims.EmailVerified == true || claims.EmailVerified == "true"
+
+	return &identities.Claims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: verified,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Nonce:         claims.Nonce,
+	}, nil
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	discovery := &oidcDiscovery{}
+	if err := p.get(ctx, strings.TrimSuffix(p.settings.Issuer, "/")+"/.well-known/openid-configuration", discovery); err != nil {
+		return nil, err
+	}
+
+	if discovery.Issuer != p.settings.Issuer || discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, ErrDiscoveryOIDC
+	}
+
+	p.discovery = discovery
+	return discovery, nil
+}
+
+// key returns the key with the id, fetching the keys again when it is not
+// known and they were not fetched in the last jwksRefreshInterval. Tokens with
+// no key id are accepted when the provider has a single key.
+func (p *OIDCProvider) key(ctx context.Context, discovery *oidcDiscovery, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	} else if time.Since(p.keysFetched) < jwksRefreshInterval {
+		return nil, ErrUnknownKeyOIDC
+	}
+
+	var set JWKSet
+	if err := p.get(ctx, discovery.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	p.keys = keys
+	p.keysFetched = time.Now()
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKeyOIDC
+}
+
+func (p *OIDCProvider) lookup(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *OIDCProvider) get(ctx context.Context, target string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s answered %d", ErrDiscoveryOIDC, target, res.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, maxOIDCResponse)).Decode(v)
+}
+
+// publicKey reads the RSA, P-256 or Ed25519 key of a JWK.
+func (k JWK) publicKey() (any, error) {
+	decode := base64.RawURLEncoding.DecodeString
+
+	switch {
+	case k.Kty == "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrUnsupportedKeyJWT
+		}
+
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if key.N.BitLen() < MinRSABits {
+			return nil, ErrUnsupportedKeyJWT
+		}
+		return key, nil
+	case k.Kty == "EC" && k.Crv == "P-256":
+		x, err := decode(k.X)
+		if err != nil || len(x) != 32 {
+			return nil, ErrUnsupportedKeyJWT
+		}
+		y, err := decode(k.Y)
+		if err != nil || len(y) != 32 {
+			return nil, ErrUnsupportedKeyJWT
+		}
+
+		point := append(append([]byte{4}, x...), y...)
+		return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+	case k.Kty == "OKP" && k.Crv == "Ed25519":
+		x, err := decode(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKeyJWT
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnsupportedKeyJWT
+	}
+}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testClientId     = "pinterest"
+	testClientSecret = "s3cr3t"
+	testRedirectURL  = "https://pinterest.test/auth/oidc/fake/callback"
+)
+
+// fakeIdP is an OpenID Connect provider run locally. Its authorization
+// endpoint consents right away, sending the user back with a code for the
+// account in claims.
+type fakeIdP struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	key    *rsa.PrivateKey
+	kid    string
+	issuer string
+	claims jwt.MapClaims
+	grants map[string]fakeGrant
+}
+
+type fakeGrant struct {
+	challenge string
+	nonce     string
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	idp := &fakeIdP{
+		grants: map[string]fakeGrant{},
+		claims: jwt.MapClaims{
+			"sub":            "1234",
+			"email":          "john@doe.com",
+			"email_verified": true,
+			"given_name":     "John",
+			"family_name":    "Doe",
+		},
+	}
+	idp.rotate(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/authorize", idp.authorize)
+	mux.HandleFunc("/token", idp.token)
+	mux.HandleFunc("/jwks", idp.jwks)
+	idp.server = httptest.NewServer(mux)
+	idp.issuer = idp.server.URL
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *fakeIdP) rotate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.key = key
+	idp.kid = rand.Text()
+}
+
+func (idp *fakeIdP) settings() OIDCProviderSettings {
+	return OIDCProviderSettings{
+		Name:         "fake",
+		Issuer:       idp.server.URL,
+		ClientId:     testClientId,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+	}
+}
+
+func (idp *fakeIdP) discovery(w http.ResponseWriter, r *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                idp.issuer,
+		"authorization_endpoint":                idp.server.URL + "/authorize",
+		"token_endpoint":                        idp.server.URL + "/token",
+		"jwks_uri":                              idp.server.URL + "/jwks",
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic"},
+	})
+}
+
+func (idp *fakeIdP) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != testClientId || query.Get("redirect_uri") != testRedirectURL || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	idp.mu.Lock()
+	idp.grants[code] = fakeGrant{challenge: query.Get("code_challenge"), nonce: query.Get("nonce")}
+	idp.mu.Unlock()
+
+	http.Redirect(w, r, testRedirectURL+"?"+url.Values{"code": {code}, "state": {query.Get("state")}}.Encode(), http.StatusFound)
+}
+
+func (idp *fakeIdP) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != testClientId || secret != testClientSecret {
+		idp.fail(w, "invalid_client")
+		return
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	grant, ok := idp.grants[r.FormValue("code")]
+	delete(idp.grants, r.FormValue("code"))
+	sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+	if !ok || r.FormValue("grant_type") != "authorization_code" || r.FormValue("redirect_uri") != testRedirectURL ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		idp.fail(w, "invalid_grant")
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   idp.issuer,
+		"aud":   testClientId,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": grant.nonce,
+	}
+	for k, v := range idp.claims {
+		claims[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = idp.kid
+	idToken, err := token.SignedString(idp.key)
+	if err != nil {
+		idp.fail(w, "server_error")
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"access_token": rand.Text(), "token_type": "Bearer", "id_token": idToken})
+}
+
+func (idp *fakeIdP) jwks(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(JWKSet{Keys: []JWK{{
+		Kty: "RSA",
+		Kid: idp.kid,
+		Alg: RS256,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+	}}})
+}
+
+func (idp *fakeIdP) fail(w http.ResponseWriter, code string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// consent follows the authorization URL as a browser would, and returns the
+// code the provider sent back along with the state.
+func consent(t *testing.T, authURL string) (string, string) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	location, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func login(t *testing.T, provider *OIDCProvider) (*identities.Claims, *identities.Authorization, error) {
+	ctx := context.Background()
+
+	authorization, err := identities.NewAuthorization(provider.Name(), time.Minute)
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, authorization.State(), authorization.CodeChallenge(), authorization.Nonce())
+	require.NoError(t, err)
+
+	code, state := consent(t, authURL)
+	require.Equal(t, authorization.State(), state)
+
+	claims, err := provider.Exchange(ctx, code, authorization.Verifier())
+	return claims, authorization, err
+}
+
+func TestOIDCProvider_AuthCodeURL(t *testing.T) {
+	idp := newFakeIdP(t)
+	settings := idp.settings()
+	settings.ResponseMode = "form_post"
+	provider := NewOIDCProvider(settings, nil)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "challenge", "nonce")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, idp.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, testClientId, query.Get("client_id"))
+	assert.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "state", query.Get("state"))
+	assert.Equal(t, "nonce", query.Get("nonce"))
+	assert.Equal(t, "challenge", query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, "form_post", query.Get("response_mode"))
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	idp := newFakeIdP(t)
+	provider := NewOIDCProvider(idp.settings(), nil)
+
+	claims, authorization, err := login(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "1234", claims.Subject)
+	assert.Equal(t, "john@doe.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "John", claims.GivenName)
+	assert.Equal(t, "Doe", claims.FamilyName)
+	assert.Equal(t, authorization.Nonce(), claims.Nonce)
+}
+
+func TestOIDCProvider_Exchange_StringEmailVerified(t *testing.T) {
+	idp := newFakeIdP(t)
+	provider := NewOIDCProvider(idp.settings(), nil)
+
+	idp.claims["email_verified"] = "true"
+	claims, _, err := login(t, provider)
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+
+	idp.claims["email_verified"] = "false"
+	claims, _, err = login(t, provider)
+	require.NoError(t, err)
+	assert.False(t, claims.EmailVerified)
+}
+
+func TestOIDCProvider_Exchange_WrongVerifier(t *testing.T) {
+	ctx := context.Background()
+	idp := newFakeIdP(t)
+	provider := NewOIDCProvider(idp.settings(), nil)
+
+	authorization, err := identities.NewAuthorization(provider.Name(), time.Minute)
+	require.NoError(t, err)
+	authURL, err := provider.AuthCodeURL(ctx, authorization.State(), authorization.CodeChallenge(), authorization.Nonce())
+	require.NoError(t, err)
+	code, _ := consent(t, authURL)
+
+	claims, err := provider.Exchange(ctx, code, "another verifier")
+
+	assert.ErrorIs(t, err, identities.ErrProviderDenied)
+	assert.Nil(t, claims)
+
+	claims, err = provider.Exchange(ctx, code, authorization.Verifier())
+
+	assert.ErrorIs(t, err, identities.ErrProviderDenied, "codes are redeemed once")
+	assert.Nil(t, claims)
+}
+
+func TestOIDCProvider_Exchange_WrongClient(t *testing.T) {
+	idp := newFakeIdP(t)
+	settings := idp.settings()
+	settings.ClientSecret = "wrong"
+	provider := NewOIDCProvider(settings, nil)
+
+	claims, _, err := login(t, provider)
+
+	assert.ErrorIs(t, err, identities.ErrProviderDenied)
+	assert.Nil(t, claims)
+}
+
+func TestOIDCProvider_Exchange_InvalidIdToken(t *testing.T) {
+	cases := []struct {
+		name  string
+		claim string
+		value any
+	}{
+		{"other audience", "aud", "another-client"},
+		{"other issuer", "iss", "https://evil.test"},
+		{"expired", "exp", time.Now().Add(-time.Hour).Unix()},
+		{"no subject", "sub", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			idp := newFakeIdP(t)
+			provider := NewOIDCProvider(idp.settings(), nil)
+			idp.claims[tc.claim] = tc.value
+
+			claims, _, err := login(t, provider)
+
+			assert.ErrorIs(t, err, identities.ErrIdToken)
+			assert.Nil(t, claims)
+		})
+	}
+}
+
+func TestOIDCProvider_Exchange_RotatedKey(t *testing.T) {
+	idp := newFakeIdP(t)
+	provider := NewOIDCProvider(idp.settings(), nil)
+
+	_, _, err := login(t, provider)
+	require.NoError(t, err)
+
+	idp.rotate(t)
+	_, _, err = login(t, provider)
+	assert.ErrorIs(t, err, identities.ErrIdToken, "keys are not fetched again right away")
+
+	provider.keysFetched = time.Now().Add(-jwksRefreshInterval)
+	claims, _, err := login(t, provider)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", claims.Subject)
+}
+
+func TestOIDCProvider_Discovery_OtherIssuer(t *testing.T) {
+	idp := newFakeIdP(t)
+	idp.issuer = "https://evil.test"
+	provider := NewOIDCProvider(idp.settings(), nil)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "challenge", "nonce")
+
+	assert.ErrorIs(t, err, ErrDiscoveryOIDC)
+	assert.Empty(t, authURL)
+}
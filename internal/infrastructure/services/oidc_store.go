@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// AuthorizationStore keeps the logins sent to an identity provider in Redis,
+// which drops them when they expire.
+type AuthorizationStore struct {
+	rdb *redis.Client
+}
+
+// SignupStore keeps the provider accounts waiting to complete their signup in
+// Redis, which drops them when they expire.
+type SignupStore struct {
+	rdb *redis.Client
+}
+
+type storedAuthorization struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+type storedSignup struct {
+	Provider   string `json:"provider"`
+	Subject    string `json:"subject"`
+	Email      string `json:"email"`
+	GivenName  string `json:"given_name"`
+	FamilyName string `json:"family_name"`
+}
+
+func NewAuthorizationStore(rdb *redis.Client) *AuthorizationStore {
+	return &AuthorizationStore{
+		rdb: rdb,
+	}
+}
+
+func NewSignupStore(rdb *redis.Client) *SignupStore {
+	return &SignupStore{
+		rdb: rdb,
+	}
+}
+
+func (s *AuthorizationStore) Save(ctx context.Context, authorization *identities.Authorization) error {
+	ttl := time.Until(authorization.ExpiresAt())
+	if ttl <= 0 {
+		return identities.ErrInvalidAuthorization
+	}
+
+	value, err := json.Marshal(storedAuthorization{
+		Provider: authorization.Provider(),
+		Verifier: authorization.Verifier(),
+		Nonce:    authorization.Nonce(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.rdb.Set(ctx, authorizationKey(authorization.State()), value, ttl).Err()
+}
+
+func (s *AuthorizationStore) Take(ctx context.Context, state string) (*identities.Authorization, error) {
+	pipe := s.rdb.TxPipeline()
+	ttl := pipe.PTTL(ctx, authorizationKey(state))
+	get := pipe.GetDel(ctx, authorizationKey(state))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, identities.ErrInvalidAuthorization
+	} else if err != nil {
+		return nil, err
+	} else if ttl.Val() <= 0 {
+		return nil, identities.ErrInvalidAuthorization
+	}
+
+	var stored storedAuthorization
+	if err := json.Unmarshal([]byte(get.Val()), &stored); err != nil {
+		return nil, identities.ErrInvalidAuthorization
+	}
+
+	return identities.NewAuthorizationFromStore(state, stored.Provider, stored.Verifier, stored.Nonce, time.Now().Add(ttl.Val())), nil
+}
+
+func (s *SignupStore) Save(ctx context.Context, signup *identities.Signup) error {
+	ttl := time.Until(signup.ExpiresAt())
+	if ttl <= 0 {
+		return identities.ErrInvalidSignup
+	}
+
+	value, err := json.Marshal(storedSignup{
+		Provider:   signup.Provider(),
+		Subject:    signup.Subject(),
+		Email:      signup.Email(),
+		GivenName:  signup.GivenName(),
+		FamilyName: signup.FamilyName(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.rdb.Set(ctx, signupKey(signup.Hash()), value, ttl).Err()
+}
+
+func (s *SignupStore) Get(ctx context.Context, hash string) (*identities.Signup, error) {
+	pipe := s.rdb.Pipeline()
+	get := pipe.Get(ctx, signupKey(hash))
+	ttl := pipe.PTTL(ctx, signupKey(hash))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, identities.ErrInvalidSignup
+	} else if err != nil {
+		return nil, err
+	} else if ttl.Val() <= 0 {
+		return nil, identities.ErrInvalidSignup
+	}
+
+	var stored storedSignup
+	if err := json.Unmarshal([]byte(get.Val()), &stored); err != nil {
+		return nil, identities.ErrInvalidSignup
+	}
+
+	return identities.NewSignupFromStore(hash, stored.Provider, stored.Subject, stored.Email, stored.GivenName, stored.FamilyName, time.Now().Add(ttl.Val())), nil
+}
+
+func (s *SignupStore) Delete(ctx context.Context, hash string) (bool, error) {
+	deleted, err := s.rdb.Del(ctx, signupKey(hash)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return deleted == 1, nil
+}
+
+func authorizationKey(state string) string {
+	return "oidc:state:" + state
+}
+
+func signupKey(hash string) string {
+	return "oidc:signup:" + hash
+}
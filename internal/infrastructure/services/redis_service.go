@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"log"
 	"time"
@@ -27,12 +28,38 @@ func (r *TokenBlacklist) Add(token string, exp time.Time) error {
 	return r.rdb.Set(r.ctx, "blacklist:"+token, true, ttl).Err()
 }
 
-func (r *TokenBlacklist) IsBlacklisted(token string) (bool, error) {
-	val, err := r.rdb.Exists(r.ctx, "blacklist:"+token).Result()
+// RevokeSessions turns away the access tokens of the sessions for ttl, which
+// must be at least as long as access tokens live.
+func (r *TokenBlacklist) RevokeSessions(ids []uuid.UUID, ttl time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	pipe := r.rdb.Pipeline()
+	for _, id := range ids {
+		pipe.Set(r.ctx, revokedSessionKey(id.String()), true, ttl)
+	}
+	_, err := pipe.Exec(r.ctx)
+	return err
+}
+
+// IsRevoked tells whether the token was blacklisted or its session revoked,
+// checking both in a single round trip.
+func (r *TokenBlacklist) IsRevoked(token, sessionId string) (bool, error) {
+	keys := []string{"blacklist:" + token}
+	if sessionId != "" {
+		keys = append(keys, revokedSessionKey(sessionId))
+	}
+
+	val, err := r.rdb.Exists(r.ctx, keys...).Result()
 	if err != nil {
 		return false, err
 	}
-	return val == 1, nil
+	return val > 0, nil
+}
+
+func revokedSessionKey(id string) string {
+	return "revoked:session:" + id
 }
 
 func NewRedisClient() *redis.Client {
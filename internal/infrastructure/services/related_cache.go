@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"time"
+)
+
+// RelatedSettings tunes the related pins of each pin. Size ranked pins are
+// kept per pin for TTL. Every Interval a job refreshes the pins touched since
+// the previous run; the rest are ranked again when first requested.
+type RelatedSettings struct {
+	Size     int
+	TTL      time.Duration
+	Interval time.Duration
+}
+
+// RelatedCache keeps the ranked related pins of each pin in Redis as a comma
+// separated list, so an empty result is cached as well.
+type RelatedCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+func NewRelatedCache(rdb *redis.Client, ttl time.Duration) *RelatedCache {
+	return &RelatedCache{
+		rdb: rdb,
+		ttl: ttl,
+	}
+}
+
+func (c *RelatedCache) Get(ctx context.Context, pinId uuid.UUID) ([]uuid.UUID, bool, error) {
+	val, err := c.rdb.Get(ctx, relatedKey(pinId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	var ids []uuid.UUID
+	for _, part := range strings.Split(val, ",") {
+		if id, err := uuid.Parse(part); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, true, nil
+}
+
+func (c *RelatedCache) Set(ctx context.Context, pinId uuid.UUID, related []uuid.UUID) error {
+	parts := make([]string, len(related))
+	for i, id := range related {
+		parts[i] = id.String()
+	}
+
+	return c.rdb.Set(ctx, relatedKey(pinId), strings.Join(parts, ","), c.ttl).Err()
+}
+
+func relatedKey(pinId uuid.UUID) string {
+	return "related:" + pinId.String()
+}
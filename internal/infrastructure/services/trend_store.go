@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/trend"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// TrendSettings tunes trending pins and tags. Engagement of the last Window is
+// scored, each losing half its weight every HalfLife, and the best Size members
+// of every segment are kept. A job scores them again every Interval.
+type TrendSettings struct {
+	HalfLife time.Duration
+	Window   time.Duration
+	Interval time.Duration
+	Size     int
+}
+
+// TrendStore keeps trends in Redis sorted sets, one per kind and segment. A
+// segment missing from a refresh is left to expire after ttl, which should
+// span a few refreshes so a slow run never leaves the explore page empty.
+type TrendStore struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+func NewTrendStore(rdb *redis.Client, ttl time.Duration) *TrendStore {
+	return &TrendStore{
+		rdb: rdb,
+		ttl: ttl,
+	}
+}
+
+func (s *TrendStore) Replace(ctx context.Context, kind trends.Kind, scores []trends.Score) error {
+	segments := make(map[trends.Segment][]redis.Z)
+	for _, score := range scores {
+		segments[score.Segment()] = append(segments[score.Segment()], redis.Z{
+			Score:  score.Value(),
+			Member: score.Member(),
+		})
+	}
+
+	pipe := s.rdb.TxPipeline()
+	for segment, members := range segments {
+		key := trendKey(kind, segment)
+		pipe.Del(ctx, key)
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, s.ttl)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *TrendStore) Top(ctx context.Context, kind trends.Kind, segment trends.Segment, limit int) ([]trends.Score, error) {
+	members, err := s.rdb.ZRevRangeWithScores(ctx, trendKey(kind, segment), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]trends.Score, 0, len(members))
+	for _, member := range members {
+		scores = append(scores, trends.NewScore(segment, member.Member.(string), member.Score))
+	}
+
+	return scores, nil
+}
+
+func trendKey(kind trends.Kind, segment trends.Segment) string {
+	return "trends:" + string(kind) + ":" + string(segment)
+}
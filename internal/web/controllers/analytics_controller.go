@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/analytics"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/analytics"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"time"
+)
+
+type AnalyticsController struct {
+	queryHandler  *query.AnalyticsHandler
+	jwtService    *services.JWTService
+	blacklistRepo *services.TokenBlacklist
+}
+
+func NewAnalyticsController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, cache *services.AnalyticsCache) *AnalyticsController {
+	queryHandler := query.NewAnalyticsHandler(repositories.NewAnalyticsRepository(db), repositories.NewPinRepository(db), cache)
+	return &AnalyticsController{
+		queryHandler:  queryHandler,
+		jwtService:    jwt,
+		blacklistRepo: blacklistRepo,
+	}
+}
+
+// GetMyAnalytics godoc
+// @Summary      Get my analytics
+// @Description  Returns the impressions, closeups, outbound clicks, saves, shares and engagement rate of the authenticated user's pins over a range of UTC days, both included, with a daily series, the top pins and boards, and the countries and languages of the users who came across them. Without dates the last 30 days are returned; ranges span at most 366 days. Stats are rolled up hourly and cached for a few minutes
+// @Tags         analytics
+// @Produce      json
+// @Param        from  query     string  false  "First day, as YYYY-MM-DD or an RFC3339 timestamp"
+// @Param        to    query     string  false  "Last day, as YYYY-MM-DD or an RFC3339 timestamp"
+// @Success      200   {object}  helpers.GetReportResponse
+// @Failure      400   {object}  helpers.GetReportResponse  "Invalid range"
+// @Failure      500   {object}  helpers.GetReportResponse  "Server error"
+// @Router       /analytics/me [get]
+func (c *AnalyticsController) GetMyAnalytics(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetReportQuery{OwnerId: authUserId(r)}
+	if !parseRangeParams(w, r, &qry) {
+		return
+	}
+
+	c.writeReport(w, r, qry)
+}
+
+// GetMyPinAnalytics godoc
+// @Summary      Get analytics of one of my pins
+// @Description  Returns the impressions, closeups, outbound clicks, saves, shares and engagement rate of a pin of the authenticated user over a range of UTC days, both included, with a daily series and the countries and languages of the users who came across it. Without dates the last 30 days are returned
+// @Tags         analytics
+// @Produce      json
+// @Param        id    path      string  true   "Pin ID"
+// @Param        from  query     string  false  "First day, as YYYY-MM-DD or an RFC3339 timestamp"
+// @Param        to    query     string  false  "Last day, as YYYY-MM-DD or an RFC3339 timestamp"
+// @Success      200   {object}  helpers.GetReportResponse
+// @Failure      400   {object}  helpers.GetReportResponse  "Invalid id or range"
+// @Failure      403   {object}  helpers.GetReportResponse  "Not the owner of the pin"
+// @Failure      404   {object}  helpers.GetReportResponse  "Pin not found"
+// @Failure      500   {object}  helpers.GetReportResponse  "Server error"
+// @Router       /analytics/me/pins/{id} [get]
+func (c *AnalyticsController) GetMyPinAnalytics(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetReportQuery{OwnerId: authUserId(r), PinId: &id}
+	if !parseRangeParams(w, r, &qry) {
+		return
+	}
+
+	c.writeReport(w, r, qry)
+}
+
+func (c *AnalyticsController) writeReport(w http.ResponseWriter, r *http.Request, qry queries.GetReportQuery) {
+	report, err := c.queryHandler.HandleGetReport(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, analyticsErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_ANALYTICS_FAILED",
+				Message: "Could not fetch analytics",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.ReportDTO]{
+		Success: true,
+		Data:    report,
+	})
+}
+
+func (c *AnalyticsController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/me", c.GetMyAnalytics)
+		r.Get("/me/pins/{id}", c.GetMyPinAnalytics)
+	})
+}
+
+// parseRangeParams reads the optional from and to dates, writing a 400 and
+// returning false when one is malformed.
+func parseRangeParams(w http.ResponseWriter, r *http.Request, qry *queries.GetReportQuery) bool {
+	for _, param := range []struct {
+		name string
+		code string
+		dest **time.Time
+	}{
+		{"from", "INVALID_FROM", &qry.From},
+		{"to", "INVALID_TO", &qry.To},
+	} {
+		value := r.URL.Query().Get(param.name)
+		if value == "" {
+			continue
+		}
+
+		t, err := parseDateParam(value)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    param.code,
+					Message: param.name + " must be an RFC3339 timestamp or a YYYY-MM-DD date",
+					Err:     &errStr,
+				},
+			})
+			return false
+		}
+		*param.dest = &t
+	}
+
+	return true
+}
+
+func analyticsErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, pins.ErrNotFoundPin):
+		return http.StatusNotFound
+	case errors.Is(err, analytics.ErrNotOwnerPin):
+		return http.StatusForbidden
+	case errors.Is(err, analytics.ErrInvalidRange), errors.Is(err, analytics.ErrLongRange):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
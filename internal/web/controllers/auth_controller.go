@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/token/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/token"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// AuthController keeps sessions going past the short life of access tokens.
+// Its routes are authenticated by the refresh token in the body, not by a JWT.
+type AuthController struct {
+	commandHandler *command.TokenHandler
+	jwtService     *services.JWTService
+}
+
+func NewAuthController(db *sql.DB, jwt *services.JWTService, settings *services.AuthSettings) *AuthController {
+	return &AuthController{
+		commandHandler: newTokenHandler(db, jwt, settings),
+		jwtService:     jwt,
+	}
+}
+
+func newTokenHandler(db *sql.DB, jwt *services.JWTService, settings *services.AuthSettings) *command.TokenHandler {
+	return command.NewTokenHandler(repositories.NewRefreshTokenRepository(db), repositories.NewSessionRepository(db), repositories.NewRoleRepository(db), jwt, settings.RefreshTTL, services.NewZapAdapter())
+}
+
+// issueTokensCommand starts a session of the user on the device the request
+// came from.
+func issueTokensCommand(r *http.Request, userId uuid.UUID) commands.IssueTokensCommand {
+	return commands.IssueTokensCommand{
+		UserId:    userId,
+		UserAgent: r.UserAgent(),
+		IP:        helpers.ClientIP(r),
+	}
+}
+
+// RefreshTokens godoc
+// @Summary      Refresh tokens
+// @Description  Trades a refresh token for a new access token and a new refresh token. Each refresh token works once; presenting one again revokes every token of its session, which then has to log in again
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        token  body      commands.RefreshTokensCommand  true  "Refresh token"
+// @Success      200    {object}  helpers.TokenPairResponse
+// @Failure      400    {object}  helpers.TokenPairResponse  "Invalid request body"
+// @Failure      401    {object}  helpers.TokenPairResponse  "Invalid, expired, revoked or reused refresh token"
+// @Failure      500    {object}  helpers.TokenPairResponse  "Server error"
+// @Router       /auth/refresh [post]
+func (c *AuthController) RefreshTokens(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.RefreshTokensCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+	cmd.IP = helpers.ClientIP(r)
+
+	pair, err := c.commandHandler.HandleRefresh(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, authErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REFRESH_FAILED",
+				Message: "Could not refresh tokens",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.TokenPairDTO]{
+		Success: true,
+		Data:    pair,
+	})
+}
+
+// RevokeTokens godoc
+// @Summary      Revoke a refresh token
+// @Description  Revokes a refresh token and every other token of its session, so it cannot be refreshed anymore. Revoking an unknown token succeeds as well
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        token  body      commands.RevokeTokensCommand  true  "Refresh token"
+// @Success      200    {object}  helpers.LogoutSuccessResponse
+// @Failure      400    {object}  helpers.LogoutSuccessResponse  "Invalid request body"
+// @Failure      500    {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /auth/revoke [post]
+func (c *AuthController) RevokeTokens(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.RevokeTokensCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	if err := c.commandHandler.HandleRevoke(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, authErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REVOKE_FAILED",
+				Message: "Could not revoke tokens",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "tokens revoked",
+	})
+}
+
+// GetJWKS godoc
+// @Summary      Get the token keys
+// @Description  Lists the public keys access tokens are verified with, as a JSON Web Key Set. Tokens name the key that signed them in their kid header. Keys being rotated out stay listed until the tokens they signed expire
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  services.JWKSet
+// @Router       /.well-known/jwks.json [get]
+func (c *AuthController) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	helpers.WriteJSON(w, http.StatusOK, c.jwtService.JWKS())
+}
+
+func (c *AuthController) RegisterRoutes(r chi.Router) {
+	r.Post("/refresh", c.RefreshTokens)
+	r.Post("/revoke", c.RevokeTokens)
+}
+
+func authErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, tokens.ErrInvalidRefreshToken), errors.Is(err, tokens.ErrExpiredRefreshToken),
+		errors.Is(err, tokens.ErrRevokedRefreshToken), errors.Is(err, tokens.ErrReusedRefreshToken):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
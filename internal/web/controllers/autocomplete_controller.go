@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/suggestion"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/suggestions"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+type AutocompleteController struct {
+	queryHandler  *query.SuggestionHandler
+	jwtService    *services.JWTService
+	blacklistRepo *services.TokenBlacklist
+}
+
+func NewAutocompleteController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *AutocompleteController {
+	repository := repositories.NewSuggestionRepository(db)
+	return &AutocompleteController{
+		queryHandler:  query.NewSuggestionHandler(repository),
+		jwtService:    jwt,
+		blacklistRepo: blacklistRepo,
+	}
+}
+
+// Autocomplete godoc
+// @Summary      Autocomplete usernames, tags and boards
+// @Description  Suggests users by username or display name, tags and the authenticated user's own boards starting with what was typed, most popular first. A leading @ suggests only users and a leading # only tags. Responses may be cached by the client for a short while
+// @Tags         search
+// @Produce      json
+// @Param        q      query     string  true   "Text typed so far"
+// @Param        type   query     string  false  "Comma separated kinds to suggest: users, tags, boards (default all)"
+// @Param        limit  query     int     false  "Suggestions per kind (default 5, max 20)"
+// @Success      200    {object}  helpers.GetAutocompleteResponse
+// @Failure      400    {object}  helpers.GetAutocompleteResponse  "Invalid text, type or limit"
+// @Failure      500    {object}  helpers.GetAutocompleteResponse  "Server error"
+// @Router       /autocomplete/ [get]
+func (c *AutocompleteController) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimitParam(w, r)
+	if !ok {
+		return
+	}
+
+	qry := queries.AutocompleteQuery{
+		ViewerId: authUserId(r),
+		Text:     r.URL.Query().Get("q"),
+		Types:    parseListParam(r, "type"),
+		Limit:    limit,
+	}
+
+	result, err := c.queryHandler.HandleAutocomplete(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, autocompleteErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "AUTOCOMPLETE_FAILED",
+				Message: "Could not autocomplete",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	// Typing back and forth asks for the same prefixes again; let the client
+	// answer those itself.
+	w.Header().Set("Cache-Control", "private, max-age=30")
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.AutocompleteDTO]{
+		Success: true,
+		Data:    result,
+	})
+}
+
+func (c *AutocompleteController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.Autocomplete)
+	})
+}
+
+func autocompleteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, suggestions.ErrEmptyPrefix), errors.Is(err, suggestions.ErrLongPrefix), errors.Is(err, suggestions.ErrKindNotFound):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -8,21 +8,27 @@ import (
 	command "github.com/carlosclavijo/Pinterest-Services/internal/application/board/handlers"
 	boards "github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
 	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
 	"net/http"
 )
 
 type BoardController struct {
 	commandHandler command.BoardHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
 }
 
-func NewBoardController(db *sql.DB) *BoardController {
+func NewBoardController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *BoardController {
 	repository := repositories.NewBoardRepository(db)
 	factory := boards.NewBoardFactory()
 	commandHandler := command.NewBoardHandler(repository, factory)
 	return &BoardController{
 		commandHandler: *commandHandler,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
 	}
 }
 
@@ -38,6 +44,7 @@ func (c *BoardController) CreateBoard(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	cmd.UserId = authUserId(r)
 
 	board, err := c.commandHandler.HandleCreate(r.Context(), cmd)
 	if err != nil {
@@ -60,5 +67,9 @@ func (c *BoardController) CreateBoard(w http.ResponseWriter, r *http.Request) {
 }
 
 func (c *BoardController) RegisterRoutes(r chi.Router) {
-	r.Post("/create", c.CreateBoard)
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Post("/create", c.CreateBoard)
+	})
 }
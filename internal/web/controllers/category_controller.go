@@ -0,0 +1,373 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/category/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	pinDto "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/categories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+// CategoryController maintains the interest taxonomy. Every route requires the
+// admin role; users browse categories through the ExploreController.
+type CategoryController struct {
+	commandHandler *command.CategoryHandler
+	queryHandler   *query.CategoryHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewCategoryController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *CategoryController {
+	repository := repositories.NewCategoryRepository(db)
+	tagRepo := repositories.NewTagRepository(db)
+	pinRepo := repositories.NewPinRepository(db)
+	commandHandler := command.NewCategoryHandler(repository, tagRepo, categories.NewCategoryFactory(), services.NewZapAdapter())
+	queryHandler := query.NewCategoryHandler(repository, pinRepo)
+	return &CategoryController{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// GetCategories godoc
+// @Summary      Get the category tree
+// @Description  Returns the whole interest taxonomy, root categories first, each with its subcategories nested in name order. Admins only
+// @Tags         admin
+// @Produce      json
+// @Success      200  {object}  helpers.GetCategoryTreeResponse
+// @Failure      403  {string}  string  "Not an admin"
+// @Failure      500  {object}  helpers.GetCategoryTreeResponse  "Server error"
+// @Router       /admin/categories/ [get]
+func (c *CategoryController) GetCategories(w http.ResponseWriter, r *http.Request) {
+	tree, err := c.queryHandler.HandleGetTree(r.Context(), queries.GetCategoryTreeQuery{})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_CATEGORIES_FAILED",
+				Message: "Could not fetch categories",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(tree)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.CategoryNodeDTO]{
+		Success: true,
+		Data:    tree,
+		Length:  &length,
+	})
+}
+
+// CreateCategory godoc
+// @Summary      Create a category
+// @Description  Adds a category at the root or under parent_id, at most five levels deep. The slug defaults to one made from the name and must be unique. Admins only
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        category  body      commands.CreateCategoryCommand  true  "Category payload"
+// @Success      201       {object}  helpers.GetCategoryResponse
+// @Failure      400       {object}  helpers.GetCategoryResponse  "Invalid request body, name, slug or description, or too deep"
+// @Failure      403       {string}  string  "Not an admin"
+// @Failure      404       {object}  helpers.GetCategoryResponse  "Parent category not found"
+// @Failure      409       {object}  helpers.GetCategoryResponse  "Slug already taken"
+// @Failure      500       {object}  helpers.GetCategoryResponse  "Server error"
+// @Router       /admin/categories/ [post]
+func (c *CategoryController) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.CreateCategoryCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	category, err := c.commandHandler.HandleCreate(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CATEGORY_CREATION_FAILED",
+				Message: "Could not create category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.CategoryDTO]{
+		Success: true,
+		Data:    category,
+	})
+}
+
+// UpdateCategory godoc
+// @Summary      Update a category
+// @Description  Changes the fields given. parent_id moves the category, with its subcategories, under another one and root moves it to the root; it cannot go under itself or its own subcategories, nor past five levels. An empty description clears it. Admins only
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                          true  "Category ID (UUID)"
+// @Param        category  body      commands.UpdateCategoryCommand  true  "Fields to change"
+// @Success      200       {object}  helpers.GetCategoryResponse
+// @Failure      400       {object}  helpers.GetCategoryResponse  "Invalid id, request body, name, slug, description or move"
+// @Failure      403       {string}  string  "Not an admin"
+// @Failure      404       {object}  helpers.GetCategoryResponse  "Category or parent not found"
+// @Failure      409       {object}  helpers.GetCategoryResponse  "Slug already taken"
+// @Failure      500       {object}  helpers.GetCategoryResponse  "Server error"
+// @Router       /admin/categories/{id} [patch]
+func (c *CategoryController) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var cmd commands.UpdateCategoryCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.Id = id
+
+	category, err := c.commandHandler.HandleUpdate(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CATEGORY_UPDATE_FAILED",
+				Message: "Could not update category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.CategoryDTO]{
+		Success: true,
+		Data:    category,
+	})
+}
+
+// DeleteCategory godoc
+// @Summary      Delete a category
+// @Description  Deletes a category and its tag mappings. Categories with subcategories must be emptied first. The tags and pins stay. Admins only
+// @Tags         admin
+// @Produce      json
+// @Param        id   path  string  true  "Category ID (UUID)"
+// @Success      204  "Category deleted"
+// @Failure      400  {object}  helpers.GetCategoryResponse  "Invalid id"
+// @Failure      403  {string}  string  "Not an admin"
+// @Failure      404  {object}  helpers.GetCategoryResponse  "Category not found"
+// @Failure      409  {object}  helpers.GetCategoryResponse  "Category has subcategories"
+// @Failure      500  {object}  helpers.GetCategoryResponse  "Server error"
+// @Router       /admin/categories/{id} [delete]
+func (c *CategoryController) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.commandHandler.HandleDelete(r.Context(), commands.DeleteCategoryCommand{Id: id}); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CATEGORY_DELETION_FAILED",
+				Message: "Could not delete category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCategoryTags godoc
+// @Summary      Get the tags of a category
+// @Description  Returns the tags mapped directly into a category, by name. Admins only
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Category ID (UUID)"
+// @Success      200  {object}  helpers.GetListTagsResponse
+// @Failure      400  {object}  helpers.GetListTagsResponse  "Invalid id"
+// @Failure      403  {string}  string  "Not an admin"
+// @Failure      404  {object}  helpers.GetListTagsResponse  "Category not found"
+// @Failure      500  {object}  helpers.GetListTagsResponse  "Server error"
+// @Router       /admin/categories/{id}/tags [get]
+func (c *CategoryController) GetCategoryTags(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	tags, err := c.queryHandler.HandleGetTags(r.Context(), queries.GetCategoryTagsQuery{Id: id})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_CATEGORY_TAGS_FAILED",
+				Message: "Could not fetch category tags",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(tags)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*pinDto.TagDTO]{
+		Success: true,
+		Data:    tags,
+		Length:  &length,
+	})
+}
+
+// MapCategoryTag godoc
+// @Summary      Map a tag into a category
+// @Description  Maps a hashtag into a category, so pins with it show up when browsing the category and its ancestors. A tag may map into several categories. Admins only
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Category ID (UUID)"
+// @Param        tag  path      string  true  "Hashtag, with or without #"
+// @Success      200  {object}  helpers.GetTagResponse
+// @Failure      400  {object}  helpers.GetTagResponse  "Invalid id or hashtag"
+// @Failure      403  {string}  string  "Not an admin"
+// @Failure      404  {object}  helpers.GetTagResponse  "Category not found"
+// @Failure      409  {object}  helpers.GetTagResponse  "Tag already mapped"
+// @Failure      500  {object}  helpers.GetTagResponse  "Server error"
+// @Router       /admin/categories/{id}/tags/{tag} [put]
+func (c *CategoryController) MapCategoryTag(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.MapTagCommand{
+		CategoryId: id,
+		Tag:        chi.URLParam(r, "tag"),
+	}
+
+	tag, err := c.commandHandler.HandleMapTag(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MAP_CATEGORY_TAG_FAILED",
+				Message: "Could not map tag into category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*pinDto.TagDTO]{
+		Success: true,
+		Data:    tag,
+	})
+}
+
+// UnmapCategoryTag godoc
+// @Summary      Unmap a tag from a category
+// @Description  Removes a hashtag from a category. The tag and its pins stay. Admins only
+// @Tags         admin
+// @Produce      json
+// @Param        id   path  string  true  "Category ID (UUID)"
+// @Param        tag  path  string  true  "Hashtag, with or without #"
+// @Success      204  "Tag unmapped"
+// @Failure      400  {object}  helpers.GetTagResponse  "Invalid id or hashtag"
+// @Failure      403  {string}  string  "Not an admin"
+// @Failure      404  {object}  helpers.GetTagResponse  "Category not found or tag not mapped"
+// @Failure      500  {object}  helpers.GetTagResponse  "Server error"
+// @Router       /admin/categories/{id}/tags/{tag} [delete]
+func (c *CategoryController) UnmapCategoryTag(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnmapTagCommand{
+		CategoryId: id,
+		Tag:        chi.URLParam(r, "tag"),
+	}
+
+	if err := c.commandHandler.HandleUnmapTag(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, categoryErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNMAP_CATEGORY_TAG_FAILED",
+				Message: "Could not unmap tag from category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *CategoryController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+		r.Use(middleware.RequireRole(users.RoleAdmin))
+
+		r.Get("/", c.GetCategories)
+		r.Post("/", c.CreateCategory)
+		r.Patch("/{id}", c.UpdateCategory)
+		r.Delete("/{id}", c.DeleteCategory)
+		r.Get("/{id}/tags", c.GetCategoryTags)
+		r.Put("/{id}/tags/{tag}", c.MapCategoryTag)
+		r.Delete("/{id}/tags/{tag}", c.UnmapCategoryTag)
+	})
+}
+
+func categoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, categories.ErrNotFoundCategory), errors.Is(err, categories.ErrNotFoundParentCategory),
+		errors.Is(err, categories.ErrNotFoundTagCategory):
+		return http.StatusNotFound
+	case errors.Is(err, categories.ErrExistsSlugCategory), errors.Is(err, categories.ErrHasChildrenCategory),
+		errors.Is(err, categories.ErrExistsTagCategory):
+		return http.StatusConflict
+	case errors.Is(err, categories.ErrNilIdCategory), errors.Is(err, categories.ErrEmptyNameCategory),
+		errors.Is(err, categories.ErrLongNameCategory), errors.Is(err, categories.ErrLongDescriptionCategory),
+		errors.Is(err, categories.ErrInvalidSlug), errors.Is(err, categories.ErrCycleCategory),
+		errors.Is(err, categories.ErrDeepCategory), errors.Is(err, shared.ErrEmptyHashtag),
+		errors.Is(err, shared.ErrLongHashtag), errors.Is(err, shared.ErrInvalidHashtag):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,350 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/conversation"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/conversations"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type ConversationController struct {
+	commandHandler *command.ConversationHandler
+	queryHandler   *query.ConversationHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewConversationController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *ConversationController {
+	repository := repositories.NewConversationRepository(db)
+	messageRepo := repositories.NewMessageRepository(db)
+	blockRepo := repositories.NewBlockRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+	pinRepo := repositories.NewPinRepository(db)
+	boardRepo := repositories.NewBoardRepository(db)
+	commandHandler := command.NewConversationHandler(repository, messageRepo, blockRepo, userRepo, pinRepo, boardRepo, conversations.NewConversationFactory(), conversations.NewMessageFactory(), services.NewZapAdapter())
+	queryHandler := query.NewConversationHandler(repository, messageRepo)
+	return &ConversationController{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+const ErrFetchConversations = "Could not fetch conversations"
+
+// GetConversations godoc
+// @Summary      Get conversations
+// @Description  Returns the authenticated user's conversations, most recently active first, with how many messages each has unread
+// @Tags         conversations
+// @Produce      json
+// @Success      200  {object}  helpers.GetListConversationsResponse
+// @Failure      500  {object}  helpers.GetListConversationsResponse  "Server error"
+// @Router       /conversations/ [get]
+func (c *ConversationController) GetConversations(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetConversationsQuery{
+		UserId: authUserId(r),
+	}
+
+	conversationsList, err := c.queryHandler.HandleGetList(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_CONVERSATIONS_FAILED",
+				Message: ErrFetchConversations,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.ConversationDTO]{
+		Success: true,
+		Data:    conversationsList,
+	})
+}
+
+// CreateConversation godoc
+// @Summary      Start a conversation
+// @Description  Starts a conversation between the authenticated user and up to nine others. Starting a one to one conversation that already exists returns it. Groups may have a title
+// @Tags         conversations
+// @Accept       json
+// @Produce      json
+// @Param        conversation  body      commands.CreateConversationCommand  true  "Conversation payload"
+// @Success      201           {object}  helpers.GetConversationResponse
+// @Failure      400           {object}  helpers.GetConversationResponse  "Invalid request body"
+// @Failure      403           {object}  helpers.GetConversationResponse  "A member is blocked"
+// @Failure      404           {object}  helpers.GetConversationResponse  "User not found"
+// @Failure      500           {object}  helpers.GetConversationResponse  "Server error"
+// @Router       /conversations/ [post]
+func (c *ConversationController) CreateConversation(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.CreateConversationCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.UserId = authUserId(r)
+
+	conversation, err := c.commandHandler.HandleCreate(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, conversationErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CONVERSATION_CREATION_FAILED",
+				Message: "Could not start conversation",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.ConversationDTO]{
+		Success: true,
+		Data:    conversation,
+	})
+}
+
+// GetMessages godoc
+// @Summary      Get the messages of a conversation
+// @Description  Returns a page of messages, newest first. Pass next_before as before and next_before_id as before_id to get older messages
+// @Tags         conversations
+// @Produce      json
+// @Param        id         path      string  true   "Conversation ID (UUID)"
+// @Param        limit      query     int     false  "Page size (default 50, max 100)"
+// @Param        before     query     string  false  "Only messages sent before this RFC3339 timestamp"
+// @Param        before_id  query     string  false  "Id of the last message read, for the ones sent at before"
+// @Success      200        {object}  helpers.GetMessagesResponse
+// @Failure      400        {object}  helpers.GetMessagesResponse  "Invalid id, limit, before or before_id"
+// @Failure      404        {object}  helpers.GetMessagesResponse  "Conversation not found"
+// @Failure      500        {object}  helpers.GetMessagesResponse  "Server error"
+// @Router       /conversations/{id}/messages [get]
+func (c *ConversationController) GetMessages(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetMessagesQuery{
+		ConversationId: id,
+		UserId:         authUserId(r),
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_LIMIT",
+					Message: "limit must be a positive integer",
+				},
+			})
+			return
+		}
+		qry.Limit = n
+	}
+
+	if before := r.URL.Query().Get("before"); before != "" {
+		t, err := time.Parse(time.RFC3339Nano, before)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_BEFORE",
+					Message: "before must be an RFC3339 timestamp",
+					Err:     &errStr,
+				},
+			})
+			return
+		}
+		qry.Before = &t
+	}
+
+	if beforeId := r.URL.Query().Get("before_id"); beforeId != "" {
+		id, err := uuid.Parse(beforeId)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_BEFORE_ID",
+					Message: "before_id must be a UUID",
+					Err:     &errStr,
+				},
+			})
+			return
+		}
+		qry.BeforeId = id
+	}
+
+	page, err := c.queryHandler.HandleGetMessages(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, conversationErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_MESSAGES_FAILED",
+				Message: "Could not fetch messages",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.MessagesPageDTO]{
+		Success: true,
+		Data:    page,
+	})
+}
+
+// SendMessage godoc
+// @Summary      Send a message
+// @Description  Sends a message to a conversation of the authenticated user. A message carries text, a shared pin or board, or text with one of them. Members who block each other cannot message
+// @Tags         conversations
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                      true  "Conversation ID (UUID)"
+// @Param        message  body      commands.SendMessageCommand  true  "Message payload"
+// @Success      201      {object}  helpers.GetMessageResponse
+// @Failure      400      {object}  helpers.GetMessageResponse  "Invalid request body"
+// @Failure      403      {object}  helpers.GetMessageResponse  "A member is blocked"
+// @Failure      404      {object}  helpers.GetMessageResponse  "Conversation, pin or board not found"
+// @Failure      500      {object}  helpers.GetMessageResponse  "Server error"
+// @Router       /conversations/{id}/messages [post]
+func (c *ConversationController) SendMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var cmd commands.SendMessageCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.ConversationId = id
+	cmd.UserId = authUserId(r)
+
+	message, err := c.commandHandler.HandleSendMessage(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, conversationErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "SEND_MESSAGE_FAILED",
+				Message: "Could not send message",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.MessageDTO]{
+		Success: true,
+		Data:    message,
+	})
+}
+
+// MarkRead godoc
+// @Summary      Mark a conversation as read
+// @Description  Moves the authenticated user's read marker to now
+// @Tags         conversations
+// @Produce      json
+// @Param        id   path  string  true  "Conversation ID (UUID)"
+// @Success      204  "Marked as read"
+// @Failure      400  {object}  helpers.GetConversationResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetConversationResponse  "Conversation not found"
+// @Failure      500  {object}  helpers.GetConversationResponse  "Server error"
+// @Router       /conversations/{id}/read [patch]
+func (c *ConversationController) MarkRead(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.MarkConversationReadCommand{
+		ConversationId: id,
+		UserId:         authUserId(r),
+	}
+
+	if err := c.commandHandler.HandleMarkRead(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, conversationErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MARK_READ_FAILED",
+				Message: "Could not mark conversation as read",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *ConversationController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.GetConversations)
+		r.Post("/", c.CreateConversation)
+		r.Get("/{id}/messages", c.GetMessages)
+		r.Post("/{id}/messages", c.SendMessage)
+		r.Patch("/{id}/read", c.MarkRead)
+	})
+}
+
+func conversationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, conversations.ErrNotFoundConversation), errors.Is(err, users.ErrNotFoundUser),
+		errors.Is(err, pins.ErrNotFoundPin), errors.Is(err, boards.ErrNotFoundBoard):
+		return http.StatusNotFound
+	case errors.Is(err, blocks.ErrBlockedUser):
+		return http.StatusForbidden
+	case errors.Is(err, conversations.ErrNilCreatorIdConversation), errors.Is(err, conversations.ErrNilMemberIdConversation),
+		errors.Is(err, conversations.ErrFewMembersConversation), errors.Is(err, conversations.ErrManyMembersConversation),
+		errors.Is(err, conversations.ErrLongTitleConversation), errors.Is(err, conversations.ErrTitleDirectConversation),
+		errors.Is(err, conversations.ErrEmptyMessage), errors.Is(err, conversations.ErrLongBodyMessage),
+		errors.Is(err, conversations.ErrPinAndBoardMessage):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/event/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/event/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/event"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+type EventController struct {
+	commandHandler *command.EventHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewEventController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, writer events.Writer) *EventController {
+	commandHandler := command.NewEventHandler(repositories.NewEventRepository(db), writer, services.NewZapAdapter())
+	return &EventController{
+		commandHandler: commandHandler,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// TrackEvents godoc
+// @Summary      Track pin events
+// @Description  Records a batch of up to 100 impressions, closeups, outbound clicks, saves and shares seen by the authenticated session. Each pin and kind counts once per session, events of pins the user cannot see are dropped and events older than a day are rejected. Events without a time happened now. They are written shortly after and show up in the hourly pin and board stats
+// @Tags         events
+// @Accept       json
+// @Produce      json
+// @Param        events  body      commands.TrackEventsCommand  true  "Event batch"
+// @Success      202     {object}  helpers.TrackEventsResponse
+// @Failure      400     {object}  helpers.TrackEventsResponse  "Invalid request body or event"
+// @Failure      503     {object}  helpers.TrackEventsResponse  "Too many events, try again later"
+// @Failure      500     {object}  helpers.TrackEventsResponse  "Server error"
+// @Router       /events/ [post]
+func (c *EventController) TrackEvents(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.TrackEventsCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.UserId, cmd.SessionId = authUserId(r), authSessionId(r)
+
+	result, err := c.commandHandler.HandleTrack(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, eventErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "TRACK_EVENTS_FAILED",
+				Message: "Could not track events",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusAccepted, helpers.Response[*dto.TrackEventsDTO]{
+		Success: true,
+		Data:    result,
+	})
+}
+
+func (c *EventController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Post("/", c.TrackEvents)
+	})
+}
+
+func eventErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, events.ErrFullBuffer):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, events.ErrNilSessionIdEvent), errors.Is(err, events.ErrNilPinIdEvent),
+		errors.Is(err, events.ErrInvalidKindEvent), errors.Is(err, events.ErrFutureEvent),
+		errors.Is(err, events.ErrStaleEvent), errors.Is(err, events.ErrEmptyBatch),
+		errors.Is(err, events.ErrLargeBatch):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	categoryDto "github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+	categoryQueries "github.com/carlosclavijo/Pinterest-Services/internal/application/category/queries"
+	pinDto "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/trend/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/category"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	categoryQuery "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/categories"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/trends"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strconv"
+)
+
+type ExploreController struct {
+	queryHandler    *query.TrendHandler
+	categoryHandler *categoryQuery.CategoryHandler
+	jwtService      *services.JWTService
+	blacklistRepo   *services.TokenBlacklist
+}
+
+func NewExploreController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, store *services.TrendStore, settings *services.TrendSettings) *ExploreController {
+	repository := repositories.NewTrendRepository(db)
+	pinRepo := repositories.NewPinRepository(db)
+	queryHandler := query.NewTrendHandler(repository, store, pinRepo, settings.Size)
+	categoryHandler := categoryQuery.NewCategoryHandler(repositories.NewCategoryRepository(db), pinRepo)
+	return &ExploreController{
+		queryHandler:    queryHandler,
+		categoryHandler: categoryHandler,
+		jwtService:      jwt,
+		blacklistRepo:   blacklistRepo,
+	}
+}
+
+// GetTrendingPins godoc
+// @Summary      Get trending pins
+// @Description  Returns the pins with the most saves, comments, likes and views lately, newer engagement weighing more. Pass a country and/or a language, by code or name, to see what is hot among those users. Pins the authenticated user cannot see are left out
+// @Tags         explore
+// @Produce      json
+// @Param        country   query     string  false  "Country code or name"
+// @Param        language  query     string  false  "Language code or name"
+// @Param        limit     query     int     false  "Number of pins (default 25, max 50)"
+// @Success      200       {object}  helpers.GetListPinsDTO
+// @Failure      400       {object}  helpers.GetListPinsDTO  "Invalid country, language or limit"
+// @Failure      500       {object}  helpers.GetListPinsDTO  "Server error"
+// @Router       /explore/trending/pins [get]
+func (c *ExploreController) GetTrendingPins(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimitParam(w, r)
+	if !ok {
+		return
+	}
+
+	qry := queries.GetTrendingPinsQuery{
+		ViewerId: authUserId(r),
+		Country:  r.URL.Query().Get("country"),
+		Language: r.URL.Query().Get("language"),
+		Limit:    limit,
+	}
+
+	pinsList, err := c.queryHandler.HandleGetTrendingPins(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, exploreErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_TRENDING_PINS_FAILED",
+				Message: "Could not fetch trending pins",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(pinsList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*pinDto.PinDTO]{
+		Success: true,
+		Data:    pinsList,
+		Length:  &length,
+	})
+}
+
+// GetTrendingTags godoc
+// @Summary      Get trending tags
+// @Description  Returns the tags of the pins with the most saves, comments, likes and views lately, newer engagement weighing more. Pass a country and/or a language, by code or name, to see what is hot among those users
+// @Tags         explore
+// @Produce      json
+// @Param        country   query     string  false  "Country code or name"
+// @Param        language  query     string  false  "Language code or name"
+// @Param        limit     query     int     false  "Number of tags (default 25, max 50)"
+// @Success      200       {object}  helpers.GetTrendingTagsResponse
+// @Failure      400       {object}  helpers.GetTrendingTagsResponse  "Invalid country, language or limit"
+// @Failure      500       {object}  helpers.GetTrendingTagsResponse  "Server error"
+// @Router       /explore/trending/tags [get]
+func (c *ExploreController) GetTrendingTags(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimitParam(w, r)
+	if !ok {
+		return
+	}
+
+	qry := queries.GetTrendingTagsQuery{
+		Country:  r.URL.Query().Get("country"),
+		Language: r.URL.Query().Get("language"),
+		Limit:    limit,
+	}
+
+	tags, err := c.queryHandler.HandleGetTrendingTags(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, exploreErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_TRENDING_TAGS_FAILED",
+				Message: "Could not fetch trending tags",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(tags)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.TrendingTagDTO]{
+		Success: true,
+		Data:    tags,
+		Length:  &length,
+	})
+}
+
+// GetCategories godoc
+// @Summary      Get the category tree
+// @Description  Returns the interest taxonomy, root categories first, each with its subcategories nested in name order
+// @Tags         explore
+// @Produce      json
+// @Success      200  {object}  helpers.GetCategoryTreeResponse
+// @Failure      500  {object}  helpers.GetCategoryTreeResponse  "Server error"
+// @Router       /explore/categories [get]
+func (c *ExploreController) GetCategories(w http.ResponseWriter, r *http.Request) {
+	tree, err := c.categoryHandler.HandleGetTree(r.Context(), categoryQueries.GetCategoryTreeQuery{})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, exploreErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_CATEGORIES_FAILED",
+				Message: "Could not fetch categories",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(tree)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*categoryDto.CategoryNodeDTO]{
+		Success: true,
+		Data:    tree,
+		Length:  &length,
+	})
+}
+
+// GetCategory godoc
+// @Summary      Browse a category
+// @Description  Returns a category with its path from the root and its subcategories, and a page of the pins tagged with any tag mapped into it or its subcategories. Pins rank by saves, comments and likes, newer pins weighing more. Pins the authenticated user cannot see are left out. Pass next_cursor as cursor to get the next page
+// @Tags         explore
+// @Produce      json
+// @Param        slug    path      string  true   "Category slug"
+// @Param        limit   query     int     false  "Page size (default 25, max 50)"
+// @Param        cursor  query     string  false  "Opaque cursor from the previous page"
+// @Success      200     {object}  helpers.GetCategoryPageResponse
+// @Failure      400     {object}  helpers.GetCategoryPageResponse  "Invalid limit or cursor"
+// @Failure      404     {object}  helpers.GetCategoryPageResponse  "Category not found"
+// @Failure      500     {object}  helpers.GetCategoryPageResponse  "Server error"
+// @Router       /explore/categories/{slug} [get]
+func (c *ExploreController) GetCategory(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimitParam(w, r)
+	if !ok {
+		return
+	}
+
+	qry := categoryQueries.GetCategoryBySlugQuery{
+		ViewerId: authUserId(r),
+		Slug:     chi.URLParam(r, "slug"),
+		Cursor:   r.URL.Query().Get("cursor"),
+		Limit:    limit,
+	}
+
+	page, err := c.categoryHandler.HandleGetBySlug(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, exploreErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_CATEGORY_FAILED",
+				Message: "Could not fetch category",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*categoryDto.CategoryPageDTO]{
+		Success: true,
+		Data:    page,
+	})
+}
+
+func (c *ExploreController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/trending/pins", c.GetTrendingPins)
+		r.Get("/trending/tags", c.GetTrendingTags)
+		r.Get("/categories", c.GetCategories)
+		r.Get("/categories/{slug}", c.GetCategory)
+	})
+}
+
+// parseLimitParam reads the optional limit query parameter, leaving 0 for the
+// handler's default when it is missing.
+func parseLimitParam(w http.ResponseWriter, r *http.Request) (int, bool) {
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		return 0, true
+	}
+
+	n, err := strconv.Atoi(limit)
+	if err != nil || n <= 0 {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_LIMIT",
+				Message: "limit must be a positive integer",
+			},
+		})
+		return 0, false
+	}
+
+	return n, true
+}
+
+func exploreErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, categories.ErrNotFoundCategory):
+		return http.StatusNotFound
+	case errors.Is(err, shared.ErrNotACountry), errors.Is(err, shared.ErrNotALanguage), errors.Is(err, categories.ErrInvalidCursor):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/factor/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/handlers"
+	tokenDto "github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+	tokenCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/token/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/factor"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+// FactorController lets users turn TOTP two-factor authentication on and off,
+// and finishes the logins it holds back.
+type FactorController struct {
+	commandHandler *command.FactorHandler
+	tokenCommand   *tokenCommand.TokenHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewFactorController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, challenges *services.ChallengeStore, settings *services.MFASettings, auth *services.AuthSettings) *FactorController {
+	return &FactorController{
+		commandHandler: command.NewFactorHandler(repositories.NewFactorRepository(db), challenges, repositories.NewUserRepository(db), settings.Issuer, settings.ChallengeTTL, services.NewZapAdapter()),
+		tokenCommand:   newTokenHandler(db, jwt, auth),
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// Challenger is the handler the user controller asks for a challenge once the
+// password of a login checks out.
+func (c *FactorController) Challenger() *command.FactorHandler {
+	return c.commandHandler
+}
+
+// EnrollTOTP godoc
+// @Summary      Set up two-factor authentication
+// @Description  Returns a new TOTP secret and its otpauth URI to set an authenticator app up with, usually by scanning it as a QR code. Two-factor authentication is off until it is confirmed with a first code
+// @Tags         mfa
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Success      200  {object}  helpers.EnrollmentResponse
+// @Failure      409  {object}  helpers.EnrollmentResponse  "Two-factor authentication is already on"
+// @Failure      500  {object}  helpers.EnrollmentResponse  "Server error"
+// @Router       /mfa/totp [post]
+func (c *FactorController) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.EnrollFactorCommand{UserId: authUserId(r)}
+
+	enrollment, err := c.commandHandler.HandleEnroll(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, factorErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "ENROLL_FAILED",
+				Message: "Could not set up two-factor authentication",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.EnrollmentDTO]{
+		Success: true,
+		Data:    enrollment,
+	})
+}
+
+// ConfirmTOTP godoc
+// @Summary      Turn two-factor authentication on
+// @Description  Confirms the secret with the code the app shows and returns ten recovery codes, each of which logs in once in place of a code. They are not shown again
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Param        Authorization  header  string                         true  "Bearer token"
+// @Param        request        body    commands.ConfirmFactorCommand  true  "Code from the app"
+// @Success      200  {object}  helpers.RecoveryCodesResponse
+// @Failure      400  {object}  helpers.RecoveryCodesResponse  "Invalid request body or code"
+// @Failure      404  {object}  helpers.RecoveryCodesResponse  "Two-factor authentication was not set up"
+// @Failure      409  {object}  helpers.RecoveryCodesResponse  "Two-factor authentication is already on"
+// @Failure      500  {object}  helpers.RecoveryCodesResponse  "Server error"
+// @Router       /mfa/totp/confirm [post]
+func (c *FactorController) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.ConfirmFactorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+	cmd.UserId = authUserId(r)
+
+	codes, err := c.commandHandler.HandleConfirm(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, factorErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CONFIRM_FAILED",
+				Message: "Could not turn two-factor authentication on",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.RecoveryCodesDTO]{
+		Success: true,
+		Data:    codes,
+	})
+}
+
+// DisableTOTP godoc
+// @Summary      Turn two-factor authentication off
+// @Description  Turns two-factor authentication off and drops the recovery codes. It takes the password along with a code from the app or a recovery code
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Param        Authorization  header  string                         true  "Bearer token"
+// @Param        request        body    commands.DisableFactorCommand  true  "Password and code"
+// @Success      200  {object}  helpers.LogoutSuccessResponse
+// @Failure      400  {object}  helpers.LogoutSuccessResponse  "Invalid request body"
+// @Failure      401  {object}  helpers.LogoutSuccessResponse  "Wrong password or code"
+// @Failure      404  {object}  helpers.LogoutSuccessResponse  "Two-factor authentication was not set up"
+// @Failure      500  {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /mfa/totp/disable [post]
+func (c *FactorController) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.DisableFactorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+	cmd.UserId = authUserId(r)
+
+	if err := c.commandHandler.HandleDisable(r.Context(), cmd); err != nil {
+		status := factorErrorStatus(err)
+		if errors.Is(err, factors.ErrInvalidCodeFactor) {
+			status = http.StatusUnauthorized
+		}
+
+		errStr := err.Error()
+		helpers.WriteJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "DISABLE_FAILED",
+				Message: "Could not turn two-factor authentication off",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "two-factor authentication disabled",
+	})
+}
+
+// VerifyChallenge godoc
+// @Summary      Finish a two-factor login
+// @Description  Trades the challenge token a login returned, along with a code from the app or a recovery code, for an access token and a refresh token. A challenge is dropped after five wrong codes
+// @Tags         mfa
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.VerifyChallengeCommand  true  "Challenge token and code"
+// @Success      200      {object}  helpers.TokenPairResponse
+// @Failure      400      {object}  helpers.TokenPairResponse  "Invalid request body"
+// @Failure      401      {object}  helpers.TokenPairResponse  "Invalid or expired challenge, or wrong code"
+// @Failure      500      {object}  helpers.TokenPairResponse  "Server error"
+// @Router       /mfa/verify [post]
+func (c *FactorController) VerifyChallenge(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.VerifyChallengeCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	userId, err := c.commandHandler.HandleVerifyChallenge(r.Context(), cmd)
+	if err != nil {
+		status := factorErrorStatus(err)
+		if status != http.StatusInternalServerError {
+			status = http.StatusUnauthorized
+		}
+
+		errStr := err.Error()
+		helpers.WriteJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MFA_FAILED",
+				Message: "Could not verify the code",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	pair, err := c.tokenCommand.HandleIssue(r.Context(), issueTokensCommand(r, userId))
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "TOKEN_ERROR",
+				Message: "Could not generate token",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*tokenDto.TokenPairDTO]{
+		Success: true,
+		Data:    pair,
+	})
+}
+
+func (c *FactorController) RegisterRoutes(r chi.Router) {
+	r.Post("/verify", c.VerifyChallenge)
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Post("/totp", c.EnrollTOTP)
+		r.Post("/totp/confirm", c.ConfirmTOTP)
+		r.Post("/totp/disable", c.DisableTOTP)
+	})
+}
+
+func factorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, factors.ErrNotFoundFactor), errors.Is(err, users.ErrNotFoundUser):
+		return http.StatusNotFound
+	case errors.Is(err, factors.ErrConfirmedFactor):
+		return http.StatusConflict
+	case errors.Is(err, users.ErrInvalidCredentialsUser):
+		return http.StatusUnauthorized
+	case errors.Is(err, factors.ErrInvalidCodeFactor), errors.Is(err, factors.ErrInvalidChallenge):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
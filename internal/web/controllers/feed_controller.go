@@ -0,0 +1,317 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/feed/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/feed/queries"
+	interestCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/interest/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/feeds"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strconv"
+)
+
+type FeedController struct {
+	commandHandler *command.FeedHandler
+	queryHandler   *query.FeedHandler
+	tracker        *interestCommand.InterestHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewFeedController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, store *services.FeedStore, settings *services.FeedSettings) *FeedController {
+	repository := repositories.NewFeedRepository(db)
+	boardFollowRepo := repositories.NewBoardFollowRepository(db)
+	tagFollowRepo := repositories.NewTagFollowRepository(db)
+	boardRepo := repositories.NewBoardRepository(db)
+	tagRepo := repositories.NewTagRepository(db)
+	blockRepo := repositories.NewBlockRepository(db)
+	pinRepo := repositories.NewPinRepository(db)
+	interestRepo := repositories.NewInterestRepository(db)
+	tracker := interestCommand.NewInterestHandler(interestRepo, services.NewZapAdapter())
+	commandHandler := command.NewFeedHandler(repository, store, boardFollowRepo, tagFollowRepo, boardRepo, tagRepo, blockRepo, tracker, settings.PopularFollowers, settings.MaxSize, services.NewZapAdapter())
+	queryHandler := query.NewFeedHandler(repository, store, pinRepo, interestRepo, settings.Ranker(), settings.PopularFollowers, settings.MaxSize)
+	return &FeedController{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		tracker:        tracker,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// Distributor is the distributor the other controllers hand to their command
+// handlers so new pins and follows reach the stored feeds.
+func (c *FeedController) Distributor() feeds.Distributor {
+	return c.commandHandler
+}
+
+// Tracker is the tracker the other controllers hand to their command handlers
+// so saves and likes reach the interest profiles that rank the feed.
+func (c *FeedController) Tracker() interests.Tracker {
+	return c.tracker
+}
+
+// GetFeed godoc
+// @Summary      Get the home feed
+// @Description  Returns the newest pins of the users, boards and tags the authenticated user follows, without duplicates and leaving out blocked and muted users. Each page is ranked for the user, from the tags of the pins they saved and liked, the tags they follow and the language and country of their profile. Pass next_cursor as cursor to get the next page
+// @Tags         feed
+// @Produce      json
+// @Param        limit    query     int     false  "Page size (default 25, max 100)"
+// @Param        cursor   query     string  false  "Opaque cursor from the previous page"
+// @Param        explain  query     bool    false  "Add the ranking score and the reason behind it to each pin"
+// @Success      200      {object}  helpers.GetFeedPageResponse
+// @Failure      400      {object}  helpers.GetFeedPageResponse  "Invalid limit, cursor or explain"
+// @Failure      500      {object}  helpers.GetFeedPageResponse  "Server error"
+// @Router       /feed/ [get]
+func (c *FeedController) GetFeed(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetFeedQuery{
+		UserId: authUserId(r),
+		Cursor: r.URL.Query().Get("cursor"),
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_LIMIT",
+					Message: "limit must be a positive integer",
+				},
+			})
+			return
+		}
+		qry.Limit = n
+	}
+
+	if explain := r.URL.Query().Get("explain"); explain != "" {
+		b, err := strconv.ParseBool(explain)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_EXPLAIN",
+					Message: "explain must be true or false",
+					Err:     &errStr,
+				},
+			})
+			return
+		}
+		qry.Explain = b
+	}
+
+	feed, err := c.queryHandler.HandleGetFeed(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, feedErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_FEED_FAILED",
+				Message: "Could not fetch feed",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.FeedPageDTO]{
+		Success: true,
+		Data:    feed,
+	})
+}
+
+// FollowBoard godoc
+// @Summary      Follow a board
+// @Description  Adds the pins of another user's public board to the authenticated user's home feed
+// @Tags         feed
+// @Produce      json
+// @Param        id   path      string  true  "Board ID (UUID)"
+// @Success      201  {object}  helpers.GetBoardFollowResponse
+// @Failure      400  {object}  helpers.GetBoardFollowResponse  "Invalid id or own board"
+// @Failure      403  {object}  helpers.GetBoardFollowResponse  "Board owner is blocked"
+// @Failure      404  {object}  helpers.GetBoardFollowResponse  "Board not found"
+// @Failure      409  {object}  helpers.GetBoardFollowResponse  "Already following"
+// @Failure      500  {object}  helpers.GetBoardFollowResponse  "Server error"
+// @Router       /feed/boards/{id}/follow [post]
+func (c *FeedController) FollowBoard(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.FollowBoardCommand{
+		UserId:  authUserId(r),
+		BoardId: id,
+	}
+
+	follow, err := c.commandHandler.HandleFollowBoard(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, feedErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FOLLOW_BOARD_FAILED",
+				Message: "Could not follow board",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.BoardFollowDTO]{
+		Success: true,
+		Data:    follow,
+	})
+}
+
+// UnfollowBoard godoc
+// @Summary      Unfollow a board
+// @Description  Stops following a board. Its pins leave the home feed unless another followed user or tag still brings them in
+// @Tags         feed
+// @Produce      json
+// @Param        id   path      string  true  "Board ID (UUID)"
+// @Success      204  "Unfollowed"
+// @Failure      400  {object}  helpers.GetBoardFollowResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetBoardFollowResponse  "Not following this board"
+// @Failure      500  {object}  helpers.GetBoardFollowResponse  "Server error"
+// @Router       /feed/boards/{id}/follow [delete]
+func (c *FeedController) UnfollowBoard(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnfollowBoardCommand{
+		UserId:  authUserId(r),
+		BoardId: id,
+	}
+
+	if err := c.commandHandler.HandleUnfollowBoard(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, feedErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNFOLLOW_BOARD_FAILED",
+				Message: "Could not unfollow board",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FollowTag godoc
+// @Summary      Follow a tag
+// @Description  Adds pins tagged with a hashtag, with or without the leading #, to the authenticated user's home feed
+// @Tags         feed
+// @Produce      json
+// @Param        tag  path      string  true  "Tag name"
+// @Success      201  {object}  helpers.GetTagFollowResponse
+// @Failure      400  {object}  helpers.GetTagFollowResponse  "Invalid tag"
+// @Failure      409  {object}  helpers.GetTagFollowResponse  "Already following"
+// @Failure      500  {object}  helpers.GetTagFollowResponse  "Server error"
+// @Router       /feed/tags/{tag}/follow [post]
+func (c *FeedController) FollowTag(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.FollowTagCommand{
+		UserId: authUserId(r),
+		Tag:    chi.URLParam(r, "tag"),
+	}
+
+	follow, err := c.commandHandler.HandleFollowTag(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, feedErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FOLLOW_TAG_FAILED",
+				Message: "Could not follow tag",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.TagFollowDTO]{
+		Success: true,
+		Data:    follow,
+	})
+}
+
+// UnfollowTag godoc
+// @Summary      Unfollow a tag
+// @Description  Stops following a hashtag. Its pins leave the home feed unless another followed user or board still brings them in
+// @Tags         feed
+// @Produce      json
+// @Param        tag  path      string  true  "Tag name"
+// @Success      204  "Unfollowed"
+// @Failure      400  {object}  helpers.GetTagFollowResponse  "Invalid tag"
+// @Failure      404  {object}  helpers.GetTagFollowResponse  "Not following this tag"
+// @Failure      500  {object}  helpers.GetTagFollowResponse  "Server error"
+// @Router       /feed/tags/{tag}/follow [delete]
+func (c *FeedController) UnfollowTag(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.UnfollowTagCommand{
+		UserId: authUserId(r),
+		Tag:    chi.URLParam(r, "tag"),
+	}
+
+	if err := c.commandHandler.HandleUnfollowTag(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, feedErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNFOLLOW_TAG_FAILED",
+				Message: "Could not unfollow tag",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *FeedController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.GetFeed)
+		r.Post("/boards/{id}/follow", c.FollowBoard)
+		r.Delete("/boards/{id}/follow", c.UnfollowBoard)
+		r.Post("/tags/{tag}/follow", c.FollowTag)
+		r.Delete("/tags/{tag}/follow", c.UnfollowTag)
+	})
+}
+
+func feedErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, boards.ErrNotFoundBoard), errors.Is(err, follows.ErrNotFoundBoardFollow), errors.Is(err, follows.ErrNotFoundTagFollow):
+		return http.StatusNotFound
+	case errors.Is(err, blocks.ErrBlockedUser):
+		return http.StatusForbidden
+	case errors.Is(err, follows.ErrExistsBoardFollow), errors.Is(err, follows.ErrExistsTagFollow):
+		return http.StatusConflict
+	case errors.Is(err, feeds.ErrInvalidCursor), errors.Is(err, follows.ErrOwnBoardFollow),
+		errors.Is(err, follows.ErrNilBoardIdBoardFollow), errors.Is(err, shared.ErrEmptyHashtag),
+		errors.Is(err, shared.ErrLongHashtag), errors.Is(err, shared.ErrInvalidHashtag):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
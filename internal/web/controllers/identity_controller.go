@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	factorCommands "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	factorCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/identity/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/identity/handlers"
+	tokenCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/token/handlers"
+	userQueries "github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/identity"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	userQuery "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/users"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// IdentityController logs users in with their Google, Apple or other OpenID
+// Connect account, signing them up the first time.
+type IdentityController struct {
+	commandHandler *command.IdentityHandler
+	userQuery      *userQuery.UserHandler
+	tokenCommand   *tokenCommand.TokenHandler
+	challenger     *factorCommand.FactorHandler
+	verification   *services.VerificationSettings
+}
+
+func NewIdentityController(db *sql.DB, jwt *services.JWTService, authorizations *services.AuthorizationStore, signups *services.SignupStore, settings *services.OIDCSettings, challenger *factorCommand.FactorHandler, auth *services.AuthSettings, verification *services.VerificationSettings) *IdentityController {
+	providers := make([]identities.Provider, 0, len(settings.Providers))
+	for _, provider := range settings.Providers {
+		providers = append(providers, services.NewOIDCProvider(provider, nil))
+	}
+
+	userRepo := repositories.NewUserRepository(db)
+	factory := users.NewUserFactory()
+	return &IdentityController{
+		commandHandler: command.NewIdentityHandler(providers, repositories.NewIdentityRepository(db), authorizations, signups, userRepo, factory, settings.StateTTL, settings.SignupTTL, services.NewZapAdapter()),
+		userQuery:      userQuery.NewUserHandler(userRepo, factory),
+		tokenCommand:   newTokenHandler(db, jwt, auth),
+		challenger:     challenger,
+		verification:   verification,
+	}
+}
+
+// StartLogin godoc
+// @Summary      Start a login with an identity provider
+// @Description  Returns the page of the provider to send the user to. The provider sends them back to its callback once they consent, before the login expires
+// @Tags         auth
+// @Produce      json
+// @Param        provider  path      string  true  "Provider name, like google or apple"
+// @Success      200       {object}  helpers.AuthorizationResponse
+// @Failure      404       {object}  helpers.AuthorizationResponse  "Unknown provider"
+// @Failure      502       {object}  helpers.AuthorizationResponse  "Provider unavailable"
+// @Failure      500       {object}  helpers.AuthorizationResponse  "Server error"
+// @Router       /auth/oidc/{provider} [get]
+func (c *IdentityController) StartLogin(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.StartLoginCommand{Provider: chi.URLParam(r, "provider")}
+
+	authorization, err := c.commandHandler.HandleStartLogin(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, identityErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not start the login",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.AuthorizationDTO]{
+		Success: true,
+		Data:    authorization,
+	})
+}
+
+// Callback godoc
+// @Summary      Finish a login with an identity provider
+// @Description  Where the provider sends the user back, in the query or, for providers like Apple, as a form post. A provider account seen before, or whose verified email belongs to a user who verified it too, gets the same user data and tokens /users/login returns, or the two-factor challenge when the user turned it on. Any other account gets a signup token to complete at /auth/oidc/complete
+// @Tags         auth
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        provider  path      string  true   "Provider name, like google or apple"
+// @Param        state     query     string  true   "State of the login"
+// @Param        code      query     string  false  "Authorization code"
+// @Param        error     query     string  false  "Error the provider answered with"
+// @Success      200       {object}  helpers.LoginSuccessResponse
+// @Success      202       {object}  helpers.SignupResponse  "Signup to complete"
+// @Failure      400       {object}  helpers.GetUserResponse  "Invalid state or id token"
+// @Failure      401       {object}  helpers.GetUserResponse  "Login denied or email not verified by the provider"
+// @Failure      403       {object}  helpers.GetUserResponse  "Email not verified"
+// @Failure      404       {object}  helpers.GetUserResponse  "Unknown provider"
+// @Failure      409       {object}  helpers.GetUserResponse  "Email belongs to a user who has not verified it"
+// @Failure      502       {object}  helpers.GetUserResponse  "Provider unavailable"
+// @Failure      500       {object}  helpers.GetUserResponse  "Server error"
+// @Router       /auth/oidc/{provider}/callback [get]
+// @Router       /auth/oidc/{provider}/callback [post]
+func (c *IdentityController) Callback(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.CallbackCommand{
+		Provider: chi.URLParam(r, "provider"),
+		State:    r.FormValue("state"),
+		Code:     r.FormValue("code"),
+		Error:    r.FormValue("error"),
+	}
+
+	userId, signup, err := c.commandHandler.HandleCallback(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, identityErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not login user",
+				Err:     &errStr,
+			},
+		})
+		return
+	} else if signup != nil {
+		helpers.WriteJSON(w, http.StatusAccepted, helpers.Response[*dto.SignupDTO]{
+			Success: true,
+			Data:    signup,
+		})
+		return
+	}
+
+	c.login(w, r, userId, http.StatusOK)
+}
+
+// CompleteSignup godoc
+// @Summary      Complete a signup with an identity provider
+// @Description  Creates the user of a provider account with the fields the provider did not give, and returns the same user data and tokens /users/login returns. The email counts as verified. The names default to the ones the provider gave
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.CompleteSignupCommand  true  "Signup token and the missing fields"
+// @Success      201      {object}  helpers.LoginSuccessResponse
+// @Failure      400      {object}  helpers.GetUserResponse  "Invalid request body, signup token or field"
+// @Failure      409      {object}  helpers.GetUserResponse  "Username or email taken"
+// @Failure      500      {object}  helpers.GetUserResponse  "Server error"
+// @Router       /auth/oidc/complete [post]
+func (c *IdentityController) CompleteSignup(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.CompleteSignupCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	userId, err := c.commandHandler.HandleCompleteSignup(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, identityErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "SIGNUP_FAILED",
+				Message: "Could not complete the signup",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	c.login(w, r, userId, http.StatusCreated)
+}
+
+// login answers the way /users/login does for the user the provider
+// authenticated, with status once the user is logged in.
+func (c *IdentityController) login(w http.ResponseWriter, r *http.Request, userId uuid.UUID, status int) {
+	usr, err := c.userQuery.HandleGetById(r.Context(), userQueries.GetUserByIdQuery{Id: userId})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not login user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	if c.verification.Policy == services.VerificationPolicyBlockLogin && !usr.Verified {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "EMAIL_NOT_VERIFIED",
+				Message: "Verify your email before logging in",
+			},
+		})
+		return
+	}
+
+	challenge, err := c.challenger.HandleChallenge(r.Context(), factorCommands.StartChallengeCommand{UserId: usr.Id})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MFA_ERROR",
+				Message: "Could not start two-factor authentication",
+				Err:     &errStr,
+			},
+		})
+		return
+	} else if challenge != nil {
+		helpers.WriteJSON(w, http.StatusOK, helpers.Response[any]{
+			Success: true,
+			Data:    challenge,
+		})
+		return
+	}
+
+	pair, err := c.tokenCommand.HandleIssue(r.Context(), issueTokensCommand(r, usr.Id))
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "TOKEN_ERROR",
+				Message: "Could not generate token",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, status, helpers.Response[any]{
+		Success: true,
+		Data: map[string]any{
+			"user":          usr,
+			"token":         pair.AccessToken,
+			"expires_in":    pair.ExpiresIn,
+			"refresh_token": pair.RefreshToken,
+		},
+	})
+}
+
+func (c *IdentityController) RegisterRoutes(r chi.Router) {
+	r.Post("/complete", c.CompleteSignup)
+	r.Get("/{provider}", c.StartLogin)
+	r.Get("/{provider}/callback", c.Callback)
+	r.Post("/{provider}/callback", c.Callback)
+}
+
+func identityErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, identities.ErrUnknownProvider):
+		return http.StatusNotFound
+	case errors.Is(err, identities.ErrProviderDenied), errors.Is(err, identities.ErrUnverifiedEmail), errors.Is(err, users.ErrNotFoundUser):
+		return http.StatusUnauthorized
+	case errors.Is(err, users.ErrExistsUser), errors.Is(err, identities.ErrExistsIdentity), errors.Is(err, identities.ErrUnverifiedUserIdentity):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrDiscoveryOIDC):
+		return http.StatusBadGateway
+	case errors.Is(err, identities.ErrInvalidAuthorization),
+		errors.Is(err, identities.ErrInvalidSignup),
+		errors.Is(err, identities.ErrIdToken),
+		errors.Is(err, identities.ErrNonce),
+		errors.Is(err, services.ErrUnknownKeyOIDC),
+		errors.Is(err, shared.ErrEmptyUsername), errors.Is(err, shared.ErrLongUsername),
+		errors.Is(err, shared.ErrShortUsername), errors.Is(err, shared.ErrInvalidUsername),
+		errors.Is(err, shared.ErrEmptyEmail), errors.Is(err, shared.ErrInvalidEmail),
+		errors.Is(err, shared.ErrNotAGender), errors.Is(err, shared.ErrNotACountry), errors.Is(err, shared.ErrNotALanguage),
+		errors.Is(err, shared.ErrEmptyBirth), errors.Is(err, shared.ErrFutureDate), errors.Is(err, shared.ErrUnderTwelve),
+		errors.Is(err, users.ErrEmptyFirstNameUser), errors.Is(err, users.ErrEmptyLastNameUser),
+		errors.Is(err, users.ErrLongFirstNameUser), errors.Is(err, users.ErrLongLastNameUser),
+		errors.Is(err, users.ErrNonAlphaFirstNameUser), errors.Is(err, users.ErrNonAlphaLastNameUser):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,527 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/notification/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/mappers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/notifications"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var errOrigin = errors.New("origin is not allowed to stream notifications")
+
+// subscriber is what the streams listen to, the NotificationBroker outside
+// tests.
+type subscriber interface {
+	Subscribe(ctx context.Context, recipientId uuid.UUID) (<-chan *dto.NotificationEventDTO, error)
+}
+
+// NotificationController serves the inbox and streams it live. Streams are
+// open until the client leaves or Shutdown ends them, and WebSockets only
+// from the origins of settings.
+type NotificationController struct {
+	commandHandler *command.NotificationHandler
+	queryHandler   *query.NotificationHandler
+	broker         subscriber
+	origins        map[string]bool
+	streams        context.Context
+	stopStreams    context.CancelFunc
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewNotificationController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, broker *services.NotificationBroker, settings *services.StreamSettings) *NotificationController {
+	repository := repositories.NewNotificationRepository(db)
+	commandHandler := command.NewNotificationHandler(repository, notifications.NewNotificationFactory(), broker, services.NewZapAdapter())
+	queryHandler := query.NewNotificationHandler(repository)
+
+	origins := make(map[string]bool, len(settings.Origins))
+	for _, origin := range settings.Origins {
+		origins[origin] = true
+	}
+
+	streams, stopStreams := context.WithCancel(context.Background())
+	return &NotificationController{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		broker:         broker,
+		origins:        origins,
+		streams:        streams,
+		stopStreams:    stopStreams,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// Shutdown ends every open stream. The server waits on SSE streams as on any
+// request and never closes hijacked WebSockets, so it calls this when it
+// shuts down.
+func (c *NotificationController) Shutdown() {
+	c.stopStreams()
+}
+
+// streamContext is done when the request is or when Shutdown is called.
+func (c *NotificationController) streamContext(r *http.Request) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(r.Context())
+	stop := context.AfterFunc(c.streams, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// Notifier is the notifier the other controllers hand to their command
+// handlers so follows, saves, comments and mentions land in the inbox.
+func (c *NotificationController) Notifier() notifications.Notifier {
+	return c.commandHandler
+}
+
+const (
+	ErrFetchNotifications = "Could not fetch notifications"
+
+	// HeartbeatInterval keeps idle streams alive through proxies that close
+	// quiet connections, and lets the server notice clients that went away.
+	HeartbeatInterval = 25 * time.Second
+	// ReconnectDelay is the retry hint sent to EventSource clients.
+	ReconnectDelay = 3 * time.Second
+)
+
+// GetInbox godoc
+// @Summary      Get the notification inbox
+// @Description  Returns the authenticated user's notifications, newest first, with the unread count. Pass next_before as before and next_before_id as before_id to get the next page
+// @Tags         notifications
+// @Produce      json
+// @Param        limit      query     int     false  "Page size (default 20, max 100)"
+// @Param        before     query     string  false  "Only notifications created before this RFC3339 timestamp"
+// @Param        before_id  query     string  false  "Id of the last notification read, for the ones created at before"
+// @Success      200        {object}  helpers.GetInboxResponse
+// @Failure      400        {object}  helpers.GetInboxResponse  "Invalid limit, before or before_id"
+// @Failure      500        {object}  helpers.GetInboxResponse  "Server error"
+// @Router       /notifications/ [get]
+func (c *NotificationController) GetInbox(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetInboxQuery{
+		UserId: authUserId(r),
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_LIMIT",
+					Message: "limit must be a positive integer",
+				},
+			})
+			return
+		}
+		qry.Limit = n
+	}
+
+	if before := r.URL.Query().Get("before"); before != "" {
+		t, err := time.Parse(time.RFC3339Nano, before)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_BEFORE",
+					Message: "before must be an RFC3339 timestamp",
+					Err:     &errStr,
+				},
+			})
+			return
+		}
+		qry.Before = &t
+	}
+
+	if beforeId := r.URL.Query().Get("before_id"); beforeId != "" {
+		id, err := uuid.Parse(beforeId)
+		if err != nil {
+			errStr := err.Error()
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_BEFORE_ID",
+					Message: "before_id must be a UUID",
+					Err:     &errStr,
+				},
+			})
+			return
+		}
+		qry.BeforeId = id
+	}
+
+	inbox, err := c.queryHandler.HandleGetInbox(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_INBOX_FAILED",
+				Message: ErrFetchNotifications,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.InboxDTO]{
+		Success: true,
+		Data:    inbox,
+	})
+}
+
+// GetUnreadCount godoc
+// @Summary      Get the unread notification count
+// @Description  Returns how many of the authenticated user's notifications are unread
+// @Tags         notifications
+// @Produce      json
+// @Success      200  {object}  helpers.GetUnreadCountResponse
+// @Failure      500  {object}  helpers.GetUnreadCountResponse  "Server error"
+// @Router       /notifications/unread-count [get]
+func (c *NotificationController) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetUnreadCountQuery{
+		UserId: authUserId(r),
+	}
+
+	count, err := c.queryHandler.HandleGetUnreadCount(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_UNREAD_COUNT_FAILED",
+				Message: ErrFetchNotifications,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[int]{
+		Success: true,
+		Data:    count,
+	})
+}
+
+// MarkRead godoc
+// @Summary      Mark a notification as read
+// @Description  Marks one of the authenticated user's notifications as read
+// @Tags         notifications
+// @Produce      json
+// @Param        id   path      string  true  "Notification ID (UUID)"
+// @Success      200  {object}  helpers.GetNotificationResponse
+// @Failure      400  {object}  helpers.GetNotificationResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetNotificationResponse  "Notification not found"
+// @Failure      500  {object}  helpers.GetNotificationResponse  "Server error"
+// @Router       /notifications/{id}/read [patch]
+func (c *NotificationController) MarkRead(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.MarkNotificationReadCommand{
+		Id:     id,
+		UserId: authUserId(r),
+	}
+
+	notification, err := c.commandHandler.HandleMarkRead(r.Context(), cmd)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, notifications.ErrNotFoundNotification) {
+			status = http.StatusNotFound
+		}
+
+		errStr := err.Error()
+		helpers.WriteJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MARK_READ_FAILED",
+				Message: "Could not mark notification as read",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.NotificationResponse]{
+		Success: true,
+		Data:    notification,
+	})
+}
+
+// MarkAllRead godoc
+// @Summary      Mark every notification as read
+// @Description  Marks all of the authenticated user's unread notifications as read and returns how many changed
+// @Tags         notifications
+// @Produce      json
+// @Success      200  {object}  helpers.GetMarkAllReadResponse
+// @Failure      500  {object}  helpers.GetMarkAllReadResponse  "Server error"
+// @Router       /notifications/read-all [patch]
+func (c *NotificationController) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.MarkAllNotificationsReadCommand{
+		UserId: authUserId(r),
+	}
+
+	updated, err := c.commandHandler.HandleMarkAllRead(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MARK_ALL_READ_FAILED",
+				Message: "Could not mark notifications as read",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[int64]{
+		Success: true,
+		Data:    updated,
+	})
+}
+
+// Stream godoc
+// @Summary      Stream notifications (Server-Sent Events)
+// @Description  Pushes notification and unread_count events to the authenticated user. Notification events carry the notification id; on reconnect send it back as the Last-Event-ID header (or last_event_id query) to receive what was missed. The token may be passed as access_token for EventSource clients
+// @Tags         notifications
+// @Produce      text/event-stream
+// @Param        access_token   query     string  false  "JWT, for clients that cannot set headers"
+// @Param        last_event_id  query     string  false  "Last notification id received"
+// @Success      200            {string}  string  "Event stream"
+// @Failure      503            {string}  string  "Streaming unavailable"
+// @Router       /notifications/stream [get]
+func (c *NotificationController) Stream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	lastEventId := r.Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = r.URL.Query().Get("last_event_id")
+	}
+
+	ctx, cancel := c.streamContext(r)
+	defer cancel()
+
+	events, backlog, err := c.subscribe(ctx, authUserId(r), lastEventId)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusServiceUnavailable, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "STREAM_FAILED",
+				Message: "Could not open notification stream",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", ReconnectDelay.Milliseconds())
+	flusher.Flush()
+
+	send := func(event *dto.NotificationEventDTO) error {
+		if event.Type == dto.HeartbeatEvent {
+			_, err := fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+			return err
+		}
+
+		if event.Id != "" {
+			fmt.Fprintf(w, "id: %s\n", event.Id)
+		}
+		_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, event.Data)
+		flusher.Flush()
+		return err
+	}
+
+	pumpEvents(ctx, events, backlog, send)
+}
+
+// StreamWebSocket godoc
+// @Summary      Stream notifications (WebSocket)
+// @Description  Same events as /notifications/stream, sent as JSON messages {id, type, data}. Heartbeats are sent as {"type":"heartbeat"}. Only the pages of the allowed origins may connect
+// @Tags         notifications
+// @Param        access_token   query     string  false  "JWT, for clients that cannot set headers"
+// @Param        last_event_id  query     string  false  "Last notification id received"
+// @Success      101            {string}  string  "Switching protocols"
+// @Failure      403            {string}  string  "Origin not allowed"
+// @Router       /notifications/ws [get]
+func (c *NotificationController) StreamWebSocket(w http.ResponseWriter, r *http.Request) {
+	userId := authUserId(r)
+	lastEventId := r.URL.Query().Get("last_event_id")
+
+	server := websocket.Server{
+		// WebSockets are not held to CORS, so any page could open one with
+		// the token it got hold of.
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			if !c.origins[r.Header.Get("Origin")] {
+				return errOrigin
+			}
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+
+			ctx, cancel := c.streamContext(r)
+			defer cancel()
+
+			// The client never has to talk, but reading is how a closed
+			// connection is noticed.
+			go func() {
+				defer cancel()
+				var discard string
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+			}()
+
+			events, backlog, err := c.subscribe(ctx, userId, lastEventId)
+			if err != nil {
+				return
+			}
+
+			pumpEvents(ctx, events, backlog, func(event *dto.NotificationEventDTO) error {
+				return websocket.JSON.Send(ws, event)
+			})
+		},
+	}
+
+	server.ServeHTTP(w, r)
+}
+
+func (c *NotificationController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.QueryTokenMiddleware)
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/stream", c.Stream)
+		r.Get("/ws", c.StreamWebSocket)
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.GetInbox)
+		r.Get("/unread-count", c.GetUnreadCount)
+		r.Patch("/read-all", c.MarkAllRead)
+		r.Patch("/{id}/read", c.MarkRead)
+	})
+}
+
+// subscribe starts listening for the user's live events and then builds the
+// backlog a fresh connection starts with: whatever was missed since
+// lastEventId, followed by the current unread count. Subscribing first means
+// nothing created while the backlog is loaded can fall through the gap.
+func (c *NotificationController) subscribe(ctx context.Context, userId uuid.UUID, lastEventId string) (<-chan *dto.NotificationEventDTO, []*dto.NotificationEventDTO, error) {
+	events, err := c.broker.Subscribe(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var backlog []*dto.NotificationEventDTO
+
+	if sinceId, err := uuid.Parse(lastEventId); err == nil {
+		qry := queries.GetNotificationsSinceQuery{
+			UserId:      userId,
+			LastEventId: sinceId,
+		}
+
+		missed, err := c.queryHandler.HandleGetSince(ctx, qry)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, notification := range missed {
+			event, err := mappers.MapToNotificationEventDTO(notification)
+			if err != nil {
+				return nil, nil, err
+			}
+			backlog = append(backlog, event)
+		}
+	}
+
+	count, err := c.queryHandler.HandleGetUnreadCount(ctx, queries.GetUnreadCountQuery{UserId: userId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event, err := mappers.MapToUnreadCountEventDTO(count)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, append(backlog, event), nil
+}
+
+// pumpEvents sends the backlog and then every live event until ctx is done,
+// the subscription ends or a send fails. Live events already sent as part of
+// the backlog are skipped, and a heartbeat goes out whenever the stream has
+// been quiet for HeartbeatInterval.
+func pumpEvents(ctx context.Context, events <-chan *dto.NotificationEventDTO, backlog []*dto.NotificationEventDTO, send func(*dto.NotificationEventDTO) error) {
+	sent := make(map[string]bool)
+	for _, event := range backlog {
+		if err := send(event); err != nil {
+			return
+		}
+
+		if event.Id != "" {
+			sent[event.Id] = true
+		}
+	}
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if event.Id != "" && sent[event.Id] {
+				continue
+			}
+
+			if err := send(event); err != nil {
+				return
+			}
+			heartbeat.Reset(HeartbeatInterval)
+		case <-heartbeat.C:
+			if err := send(&dto.NotificationEventDTO{Type: dto.HeartbeatEvent}); err != nil {
+				return
+			}
+		}
+	}
+}
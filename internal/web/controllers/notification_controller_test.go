@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+const streamOrigin = "https://pins.example"
+
+type fakeSubscriber struct {
+	events chan *dto.NotificationEventDTO
+}
+
+func (s fakeSubscriber) Subscribe(ctx context.Context, recipientId uuid.UUID) (<-chan *dto.NotificationEventDTO, error) {
+	return s.events, nil
+}
+
+// newTestStreamServer serves handler of a controller whose streams listen to
+// events, as the user the unread count of 3 is expected for. done is closed
+// once handler returns.
+func newTestStreamServer(t *testing.T, handler func(c *NotificationController) http.HandlerFunc) (*NotificationController, chan *dto.NotificationEventDTO, *httptest.Server, <-chan struct{}) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	userId := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryCountUnreadNotifications)).WithArgs(userId).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	events := make(chan *dto.NotificationEventDTO)
+	c := NewNotificationController(db, nil, nil, nil, &services.StreamSettings{Origins: []string{streamOrigin}})
+	c.broker = fakeSubscriber{events: events}
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+		handler(c)(w, r.WithContext(context.WithValue(r.Context(), "user_id", userId.String())))
+	}))
+	t.Cleanup(server.Close)
+
+	return c, events, server, done
+}
+
+// readFrame reads an SSE frame, up to the blank line that ends it.
+func readFrame(t *testing.T, reader *bufio.Reader) string {
+	var frame strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		frame.WriteString(line)
+		if line == "\n" {
+			return frame.String()
+		}
+	}
+}
+
+func TestNotificationController_Stream(t *testing.T) {
+	cases := []struct {
+		name string
+		stop func(c *NotificationController, cancel context.CancelFunc)
+	}{
+		{name: "Client leaves", stop: func(c *NotificationController, cancel context.CancelFunc) { cancel() }},
+		{name: "Server shuts down", stop: func(c *NotificationController, cancel context.CancelFunc) { c.Shutdown() }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, events, server, done := newTestStreamServer(t, func(c *NotificationController) http.HandlerFunc { return c.Stream })
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			require.NoError(t, err)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+			reader := bufio.NewReader(resp.Body)
+			assert.Equal(t, "retry: 3000\n\n", readFrame(t, reader))
+			assert.Equal(t, "event: unread_count\ndata: {\"unread_count\":3}\n\n", readFrame(t, reader))
+
+			events <- &dto.NotificationEventDTO{Id: "n1", Type: dto.NotificationEvent, Data: json.RawMessage(`{"id":"n1"}`)}
+			assert.Equal(t, "id: n1\nevent: notification\ndata: {\"id\":\"n1\"}\n\n", readFrame(t, reader))
+
+			tc.stop(c, cancel)
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("Stream kept running after it was stopped")
+			}
+		})
+	}
+}
+
+func TestNotificationController_StreamWebSocket(t *testing.T) {
+	c, _, server, done := newTestStreamServer(t, func(c *NotificationController) http.HandlerFunc { return c.StreamWebSocket })
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	ws, err := websocket.Dial(url, "", streamOrigin)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	var event dto.NotificationEventDTO
+	require.NoError(t, websocket.JSON.Receive(ws, &event))
+	assert.Equal(t, dto.UnreadCountEvent, event.Type)
+	assert.JSONEq(t, `{"unread_count":3}`, string(event.Data))
+
+	c.Shutdown()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("StreamWebSocket kept running after the server shut down")
+	}
+}
+
+func TestNotificationController_StreamWebSocket_Origin(t *testing.T) {
+	_, _, server, _ := newTestStreamServer(t, func(c *NotificationController) http.HandlerFunc { return c.StreamWebSocket })
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	_, err := websocket.Dial(url, "", "https://evil.example")
+
+	var dialErr *websocket.DialError
+	require.ErrorAs(t, err, &dialErr)
+	assert.Equal(t, websocket.ErrBadStatus, dialErr.Err)
+}
+
+func TestPumpEvents(t *testing.T) {
+	events := make(chan *dto.NotificationEventDTO, 3)
+	backlog := []*dto.NotificationEventDTO{
+		{Id: "a", Type: dto.NotificationEvent},
+		{Type: dto.UnreadCountEvent},
+	}
+
+	events <- &dto.NotificationEventDTO{Id: "a", Type: dto.NotificationEvent}
+	events <- &dto.NotificationEventDTO{Id: "b", Type: dto.NotificationEvent}
+	close(events)
+
+	var sent []string
+	pumpEvents(context.Background(), events, backlog, func(event *dto.NotificationEventDTO) error {
+		sent = append(sent, event.Type+":"+event.Id)
+		return nil
+	})
+
+	assert.Equal(t, []string{"notification:a", "unread_count:", "notification:b"}, sent)
+}
+
+func TestPumpEvents_StopsOnSendError(t *testing.T) {
+	events := make(chan *dto.NotificationEventDTO)
+
+	done := make(chan struct{})
+	go func() {
+		pumpEvents(context.Background(), events, []*dto.NotificationEventDTO{{Type: dto.UnreadCountEvent}}, func(event *dto.NotificationEventDTO) error {
+			return errors.New("client gone")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("pumpEvents kept running after a failed send")
+	}
+}
+
+func TestPumpEvents_StopsOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *dto.NotificationEventDTO)
+
+	done := make(chan struct{})
+	go func() {
+		pumpEvents(ctx, events, nil, func(event *dto.NotificationEventDTO) error { return nil })
+		close(done)
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("pumpEvents kept running after the context was cancelled")
+	}
+}
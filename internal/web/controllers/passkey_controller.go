@@ -0,0 +1,359 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/queries"
+	tokenCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/token/handlers"
+	userQueries "github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/passkey"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/passkeys"
+	userQuery "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/users"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+// PasskeyController lets users add passkeys to their account and log in with
+// them in place of a password.
+type PasskeyController struct {
+	commandHandler *command.PasskeyHandler
+	queryHandler   *query.PasskeyHandler
+	userQuery      *userQuery.UserHandler
+	tokenCommand   *tokenCommand.TokenHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+	verification   *services.VerificationSettings
+}
+
+func NewPasskeyController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, ceremonies *services.CeremonyStore, settings *services.PasskeySettings, auth *services.AuthSettings, verification *services.VerificationSettings) *PasskeyController {
+	repository := repositories.NewCredentialRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+	rp := passkeys.NewRelyingParty(settings.RPId, settings.RPName, settings.Origins)
+	return &PasskeyController{
+		commandHandler: command.NewPasskeyHandler(repository, ceremonies, userRepo, rp, settings.ChallengeTTL, services.NewZapAdapter()),
+		queryHandler:   query.NewPasskeyHandler(repository),
+		userQuery:      userQuery.NewUserHandler(userRepo, users.NewUserFactory()),
+		tokenCommand:   newTokenHandler(db, jwt, auth),
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+		verification:   verification,
+	}
+}
+
+// BeginRegistration godoc
+// @Summary      Start adding a passkey
+// @Description  Returns the options to call navigator.credentials.create with, its buffers base64url encoded. The challenge is answered once, before it expires, at /passkeys/register/finish
+// @Tags         passkeys
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Success      200  {object}  helpers.CreationOptionsResponse
+// @Failure      404  {object}  helpers.CreationOptionsResponse  "User not found"
+// @Failure      500  {object}  helpers.CreationOptionsResponse  "Server error"
+// @Router       /passkeys/register/begin [post]
+func (c *PasskeyController) BeginRegistration(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.BeginRegistrationCommand{UserId: authUserId(r)}
+
+	options, err := c.commandHandler.HandleBeginRegistration(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, passkeyErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REGISTRATION_FAILED",
+				Message: "Could not start adding a passkey",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.CreationOptionsDTO]{
+		Success: true,
+		Data:    options,
+	})
+}
+
+// FinishRegistration godoc
+// @Summary      Add a passkey
+// @Description  Keeps the passkey navigator.credentials.create returned, serialized with toJSON(). Only attestation of format none is accepted, and the authenticator must have verified the user
+// @Tags         passkeys
+// @Accept       json
+// @Produce      json
+// @Param        Authorization  header  string                              true  "Bearer token"
+// @Param        request        body    commands.FinishRegistrationCommand  true  "Credential created and an optional name"
+// @Success      201  {object}  helpers.PasskeyResponse
+// @Failure      400  {object}  helpers.PasskeyResponse  "Invalid request body, challenge or credential"
+// @Failure      409  {object}  helpers.PasskeyResponse  "Passkey already registered"
+// @Failure      500  {object}  helpers.PasskeyResponse  "Server error"
+// @Router       /passkeys/register/finish [post]
+func (c *PasskeyController) FinishRegistration(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.FinishRegistrationCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+	cmd.UserId = authUserId(r)
+
+	passkey, err := c.commandHandler.HandleFinishRegistration(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, passkeyErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REGISTRATION_FAILED",
+				Message: "Could not add the passkey",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.PasskeyDTO]{
+		Success: true,
+		Data:    passkey,
+	})
+}
+
+// GetPasskeys godoc
+// @Summary      List passkeys
+// @Description  Returns the passkeys of the authenticated user, oldest first
+// @Tags         passkeys
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Success      200  {object}  helpers.PasskeysResponse
+// @Failure      500  {object}  helpers.PasskeysResponse  "Server error"
+// @Router       /passkeys [get]
+func (c *PasskeyController) GetPasskeys(w http.ResponseWriter, r *http.Request) {
+	passkeyDtos, err := c.queryHandler.HandleGetByUser(r.Context(), queries.GetPasskeysQuery{UserId: authUserId(r)})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FETCH_FAILED",
+				Message: "Could not fetch passkeys",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.PasskeyDTO]{
+		Success: true,
+		Data:    passkeyDtos,
+	})
+}
+
+// DeletePasskey godoc
+// @Summary      Remove a passkey
+// @Description  Removes a passkey of the authenticated user, which can no longer log in
+// @Tags         passkeys
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Param        id             path    string  true  "Passkey id, base64url encoded"
+// @Success      200  {object}  helpers.LogoutSuccessResponse
+// @Failure      400  {object}  helpers.LogoutSuccessResponse  "Invalid passkey id"
+// @Failure      404  {object}  helpers.LogoutSuccessResponse  "Passkey not found"
+// @Failure      500  {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /passkeys/{id} [delete]
+func (c *PasskeyController) DeletePasskey(w http.ResponseWriter, r *http.Request) {
+	cmd := commands.DeletePasskeyCommand{UserId: authUserId(r), Id: chi.URLParam(r, "id")}
+
+	if err := c.commandHandler.HandleDelete(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, passkeyErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "DELETE_FAILED",
+				Message: "Could not remove the passkey",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "passkey removed",
+	})
+}
+
+// BeginLogin godoc
+// @Summary      Start a passkey login
+// @Description  Returns the options to call navigator.credentials.get with, its challenge base64url encoded. The challenge is answered once, before it expires, at /passkeys/login/finish
+// @Tags         passkeys
+// @Produce      json
+// @Success      200  {object}  helpers.RequestOptionsResponse
+// @Failure      500  {object}  helpers.RequestOptionsResponse  "Server error"
+// @Router       /passkeys/login/begin [post]
+func (c *PasskeyController) BeginLogin(w http.ResponseWriter, r *http.Request) {
+	options, err := c.commandHandler.HandleBeginLogin(r.Context())
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not start a passkey login",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.RequestOptionsDTO]{
+		Success: true,
+		Data:    options,
+	})
+}
+
+// FinishLogin godoc
+// @Summary      Log in with a passkey
+// @Description  Trades the assertion navigator.credentials.get returned, serialized with toJSON(), for the same user data and tokens /users/login returns. A passkey verifies the user itself, so no two-factor code is asked for
+// @Tags         passkeys
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.FinishLoginCommand  true  "Assertion of the passkey"
+// @Success      200      {object}  helpers.LoginSuccessResponse
+// @Failure      400      {object}  helpers.GetUserResponse  "Invalid request body"
+// @Failure      401      {object}  helpers.GetUserResponse  "Invalid challenge, unknown passkey or wrong signature"
+// @Failure      403      {object}  helpers.GetUserResponse  "Email not verified"
+// @Failure      500      {object}  helpers.GetUserResponse  "Server error"
+// @Router       /passkeys/login/finish [post]
+func (c *PasskeyController) FinishLogin(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.FinishLoginCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	userId, err := c.commandHandler.HandleFinishLogin(r.Context(), cmd)
+	if err != nil {
+		status := passkeyErrorStatus(err)
+		if status != http.StatusInternalServerError {
+			status = http.StatusUnauthorized
+		}
+
+		errStr := err.Error()
+		helpers.WriteJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not login user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	usr, err := c.userQuery.HandleGetById(r.Context(), userQueries.GetUserByIdQuery{Id: userId})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not login user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	if c.verification.Policy == services.VerificationPolicyBlockLogin && !usr.Verified {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "EMAIL_NOT_VERIFIED",
+				Message: "Verify your email before logging in",
+			},
+		})
+		return
+	}
+
+	pair, err := c.tokenCommand.HandleIssue(r.Context(), issueTokensCommand(r, userId))
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "TOKEN_ERROR",
+				Message: "Could not generate token",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+		Data: map[string]any{
+			"user":          usr,
+			"token":         pair.AccessToken,
+			"expires_in":    pair.ExpiresIn,
+			"refresh_token": pair.RefreshToken,
+		},
+	})
+}
+
+func (c *PasskeyController) RegisterRoutes(r chi.Router) {
+	r.Post("/login/begin", c.BeginLogin)
+	r.Post("/login/finish", c.FinishLogin)
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.GetPasskeys)
+		r.Post("/register/begin", c.BeginRegistration)
+		r.Post("/register/finish", c.FinishRegistration)
+		r.Delete("/{id}", c.DeletePasskey)
+	})
+}
+
+func passkeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, passkeys.ErrNotFoundCredential), errors.Is(err, users.ErrNotFoundUser):
+		return http.StatusNotFound
+	case errors.Is(err, passkeys.ErrExistsCredential):
+		return http.StatusConflict
+	case errors.Is(err, passkeys.ErrInvalidCeremony),
+		errors.Is(err, passkeys.ErrInvalidClientData),
+		errors.Is(err, passkeys.ErrCeremonyType),
+		errors.Is(err, passkeys.ErrOrigin),
+		errors.Is(err, passkeys.ErrRelyingParty),
+		errors.Is(err, passkeys.ErrUserNotVerified),
+		errors.Is(err, passkeys.ErrUnsupportedAttestation),
+		errors.Is(err, passkeys.ErrNoCredential),
+		errors.Is(err, passkeys.ErrMalformedCBOR),
+		errors.Is(err, passkeys.ErrUnsupportedKey),
+		errors.Is(err, passkeys.ErrSignature),
+		errors.Is(err, passkeys.ErrInvalidIdCredential),
+		errors.Is(err, passkeys.ErrUserHandleCredential),
+		errors.Is(err, passkeys.ErrClonedCredential),
+		errors.Is(err, passkeys.ErrLongNameCredential):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
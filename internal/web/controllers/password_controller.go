@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/password/commands"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/password/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/email"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+// PasswordController lets users who forgot their password set a new one.
+// Its routes are authenticated by the emailed token, not by a JWT.
+type PasswordController struct {
+	commandHandler *command.PasswordHandler
+}
+
+func NewPasswordController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, emService *services.EmailService, settings *services.AuthSettings) *PasswordController {
+	return &PasswordController{
+		commandHandler: command.NewPasswordHandler(repositories.NewUserRepository(db), repositories.NewPasswordResetRepository(db), repositories.NewRefreshTokenRepository(db), repositories.NewSessionRepository(db), blacklistRepo, emService, settings.ResetTTL, jwt.TTL(), services.NewZapAdapter()),
+	}
+}
+
+// ForgotPassword godoc
+// @Summary      Request a password reset
+// @Description  Emails a link to reset the password to the account of the address. Links are sent at most once a minute and a few times a day per account. It answers the same whether there is such an account or a link was sent or not
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.RequestPasswordResetCommand  true  "Email of the account"
+// @Success      200      {object}  helpers.LogoutSuccessResponse
+// @Failure      400      {object}  helpers.LogoutSuccessResponse  "Invalid request body or email"
+// @Router       /auth/password/forgot [post]
+func (c *PasswordController) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.RequestPasswordResetCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	if _, err := shared.NewEmail(cmd.Email); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_EMAIL",
+				Message: "Invalid email",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	// Failures past this point are logged by the handler and not reported,
+	// as only existing accounts get that far.
+	_ = c.commandHandler.HandleRequest(r.Context(), cmd)
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "if the email belongs to an account, a reset link was sent to it",
+	})
+}
+
+// ResetPassword godoc
+// @Summary      Reset the password
+// @Description  Sets a new password with the token of an emailed reset link. Each link works once and for a limited time. Every session of the user is logged out
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.ResetPasswordCommand  true  "Reset token and new password"
+// @Success      200      {object}  helpers.LogoutSuccessResponse
+// @Failure      400      {object}  helpers.LogoutSuccessResponse  "Invalid request body, token or password"
+// @Failure      500      {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /auth/password/reset [post]
+func (c *PasswordController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.ResetPasswordCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	if err := c.commandHandler.HandleReset(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, passwordErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "RESET_FAILED",
+				Message: "Could not reset password",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "password reset",
+	})
+}
+
+func (c *PasswordController) RegisterRoutes(r chi.Router) {
+	r.Post("/forgot", c.ForgotPassword)
+	r.Post("/reset", c.ResetPassword)
+}
+
+func passwordErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, email.ErrInvalidPasswordReset), errors.Is(err, email.ErrExpiredPasswordReset), errors.Is(err, email.ErrUsedPasswordReset),
+		errors.Is(err, shared.ErrEmptyPassword), errors.Is(err, shared.ErrLongPassword), errors.Is(err, shared.ErrShortPassword), errors.Is(err, shared.ErrSoftPassword):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,599 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/pin/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/board"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/comment"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/interest"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/pin"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/pins"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+)
+
+type PinController struct {
+	commandHandler *command.PinHandler
+	queryHandler   *query.PinHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewPinController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, notifier notifications.Notifier, distributor feeds.Distributor, tracker interests.Tracker, relatedCache *services.RelatedCache, related *services.RelatedSettings) *PinController {
+	repository := repositories.NewPinRepository(db)
+	tagRepo := repositories.NewTagRepository(db)
+	commentRepo := repositories.NewCommentRepository(db)
+	mentionRepo := repositories.NewMentionRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+	boardRepo := repositories.NewBoardRepository(db)
+	saveRepo := repositories.NewSaveRepository(db)
+	likeRepo := repositories.NewLikeRepository(db)
+	viewRepo := repositories.NewViewRepository(db)
+	blockRepo := repositories.NewBlockRepository(db)
+	relatedRepo := repositories.NewRelatedRepository(db)
+	commandHandler := command.NewPinHandler(repository, tagRepo, commentRepo, mentionRepo, userRepo, boardRepo, saveRepo, likeRepo, viewRepo, blockRepo, notifier, distributor, tracker, pins.NewPinFactory(), comments.NewCommentFactory(), services.NewZapAdapter())
+	queryHandler := query.NewPinHandler(repository, commentRepo, mentionRepo, blockRepo, relatedRepo, relatedCache, related.Size)
+	return &PinController{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+const ErrFetchPins = "Could not fetch pins"
+
+// CreatePin godoc
+// @Summary      Create a pin
+// @Description  Creates a pin for the authenticated user. @mentions in the description are linked to users and #hashtags become tags
+// @Tags         pins
+// @Accept       json
+// @Produce      json
+// @Param        pin  body      commands.CreatePinCommand  true  "Pin payload"
+// @Success      201  {object}  helpers.GetPinResponse
+// @Failure      400  {object}  helpers.GetPinResponse  "Invalid request body"
+// @Failure      403  {object}  helpers.GetPinResponse  "Board belongs to another user"
+// @Failure      404  {object}  helpers.GetPinResponse  "Board not found"
+// @Failure      500  {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/create [post]
+func (c *PinController) CreatePin(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.CreatePinCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.UserId = authUserId(r)
+
+	pin, err := c.commandHandler.HandleCreate(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "PIN_CREATION_FAILED",
+				Message: "Could not create pin",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// UpdatePin godoc
+// @Summary      Update a pin
+// @Description  Updates a pin owned by the authenticated user and re-resolves its mentions and hashtags
+// @Tags         pins
+// @Accept       json
+// @Produce      json
+// @Param        pin  body      commands.UpdatePinCommand  true  "Pin update payload"
+// @Success      200  {object}  helpers.GetPinResponse
+// @Failure      400  {object}  helpers.GetPinResponse  "Invalid request body"
+// @Failure      403  {object}  helpers.GetPinResponse  "Forbidden: cannot update another user's pin"
+// @Failure      404  {object}  helpers.GetPinResponse  "Pin not found"
+// @Failure      500  {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/ [put]
+func (c *PinController) UpdatePin(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.UpdatePinCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.UserId = authUserId(r)
+
+	pin, err := c.commandHandler.HandleUpdate(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UPDATE_FAILED",
+				Message: "Could not update pin",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// SavePin godoc
+// @Summary      Save a pin to a board
+// @Description  Saves a pin to one of the authenticated user's boards and notifies the pin owner
+// @Tags         pins
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                  true  "Pin ID (UUID)"
+// @Param        save  body      commands.SavePinCommand  true  "Board to save the pin to"
+// @Success      200   {object}  helpers.GetPinResponse
+// @Failure      400   {object}  helpers.GetPinResponse  "Invalid request body"
+// @Failure      403   {object}  helpers.GetPinResponse  "Board belongs to another user or blocked by the pin owner"
+// @Failure      404   {object}  helpers.GetPinResponse  "Pin or board not found"
+// @Failure      409   {object}  helpers.GetPinResponse  "Pin already saved to this board"
+// @Failure      500   {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/{id}/save [post]
+func (c *PinController) SavePin(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var cmd commands.SavePinCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.PinId = id
+	cmd.UserId = authUserId(r)
+
+	pin, err := c.commandHandler.HandleSave(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "SAVE_FAILED",
+				Message: "Could not save pin",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// LikePin godoc
+// @Summary      Like a pin
+// @Description  Marks a pin as liked by the authenticated user and increases its like count
+// @Tags         pins
+// @Produce      json
+// @Param        id   path      string  true  "Pin ID (UUID)"
+// @Success      200  {object}  helpers.GetPinResponse
+// @Failure      400  {object}  helpers.GetPinResponse  "Invalid id"
+// @Failure      403  {object}  helpers.GetPinResponse  "Blocked by the pin owner"
+// @Failure      404  {object}  helpers.GetPinResponse  "Pin not found"
+// @Failure      409  {object}  helpers.GetPinResponse  "Pin already liked"
+// @Failure      500  {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/{id}/like [post]
+func (c *PinController) LikePin(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.LikePinCommand{
+		PinId:  id,
+		UserId: authUserId(r),
+	}
+
+	pin, err := c.commandHandler.HandleLike(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LIKE_FAILED",
+				Message: "Could not like pin",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// UnlikePin godoc
+// @Summary      Unlike a pin
+// @Description  Removes the authenticated user's like from a pin and decreases its like count
+// @Tags         pins
+// @Produce      json
+// @Param        id   path      string  true  "Pin ID (UUID)"
+// @Success      200  {object}  helpers.GetPinResponse
+// @Failure      400  {object}  helpers.GetPinResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetPinResponse  "Pin not liked"
+// @Failure      500  {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/{id}/like [delete]
+func (c *PinController) UnlikePin(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnlikePinCommand{
+		PinId:  id,
+		UserId: authUserId(r),
+	}
+
+	pin, err := c.commandHandler.HandleUnlike(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNLIKE_FAILED",
+				Message: "Could not unlike pin",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// GetPinById godoc
+// @Summary      Get pin by ID
+// @Description  Returns a single pin with the offsets of the mentions and hashtags in its description
+// @Tags         pins
+// @Produce      json
+// @Param        id   path      string  true  "Pin ID (UUID)"
+// @Success      200  {object}  helpers.GetPinResponse
+// @Failure      400  {object}  helpers.GetPinResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetPinResponse  "Pin not found"
+// @Failure      500  {object}  helpers.GetPinResponse  "Server error"
+// @Router       /pins/id/{id} [get]
+func (c *PinController) GetPinById(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetPinByIdQuery{
+		Id:       id,
+		ViewerId: authUserId(r),
+	}
+
+	pin, err := c.queryHandler.HandleGetById(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_BY_ID_FAILED",
+				Message: ErrFetchPins,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	c.commandHandler.HandleView(r.Context(), commands.ViewPinCommand{
+		PinId:  id,
+		UserId: qry.ViewerId,
+	})
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.PinResponse]{
+		Success: true,
+		Data:    pin,
+	})
+}
+
+// GetPinsByTag godoc
+// @Summary      Get pins by tag
+// @Description  Returns the active pins linked to a tag, with or without the leading #
+// @Tags         pins
+// @Produce      json
+// @Param        tag  path      string  true  "Tag name"
+// @Success      200  {object}  helpers.GetListPinsDTO
+// @Failure      400  {object}  helpers.GetListPinsDTO  "Invalid tag"
+// @Failure      500  {object}  helpers.GetListPinsDTO  "Server error"
+// @Router       /pins/tag/{tag} [get]
+func (c *PinController) GetPinsByTag(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetListPinsByTagQuery{
+		Tag:      chi.URLParam(r, "tag"),
+		ViewerId: authUserId(r),
+	}
+
+	pinsList, err := c.queryHandler.HandleGetListByTag(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_BY_TAG_FAILED",
+				Message: ErrFetchPins,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(pinsList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.PinDTO]{
+		Success: true,
+		Data:    pinsList,
+		Length:  &length,
+	})
+}
+
+// GetRelatedPins godoc
+// @Summary      Get related pins
+// @Description  Returns the pins most like this one, ranked by shared tags, boards that saved both pins and similar board names. The authenticated user's own pins and pins they cannot see are left out
+// @Tags         pins
+// @Produce      json
+// @Param        id     path      string  true   "Pin ID (UUID)"
+// @Param        limit  query     int     false  "Number of pins (default 25, max 50)"
+// @Success      200    {object}  helpers.GetListPinsDTO
+// @Failure      400    {object}  helpers.GetListPinsDTO  "Invalid id or limit"
+// @Failure      404    {object}  helpers.GetListPinsDTO  "Pin not found"
+// @Failure      500    {object}  helpers.GetListPinsDTO  "Server error"
+// @Router       /pins/{id}/related [get]
+func (c *PinController) GetRelatedPins(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetRelatedPinsQuery{
+		Id:       id,
+		ViewerId: authUserId(r),
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_LIMIT",
+					Message: "limit must be a positive integer",
+				},
+			})
+			return
+		}
+		qry.Limit = n
+	}
+
+	pinsList, err := c.queryHandler.HandleGetRelated(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_RELATED_FAILED",
+				Message: ErrFetchPins,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(pinsList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.PinDTO]{
+		Success: true,
+		Data:    pinsList,
+		Length:  &length,
+	})
+}
+
+// CreateComment godoc
+// @Summary      Comment on a pin
+// @Description  Adds a comment from the authenticated user. @mentions are linked to users and #hashtags to the tags the pin already has; comments never change the tags of the pin
+// @Tags         pins
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                        true  "Pin ID (UUID)"
+// @Param        comment  body      commands.CreateCommentCommand  true  "Comment payload"
+// @Success      201      {object}  helpers.GetCommentResponse
+// @Failure      400      {object}  helpers.GetCommentResponse  "Invalid request body"
+// @Failure      403      {object}  helpers.GetCommentResponse  "Blocked by the pin owner"
+// @Failure      404      {object}  helpers.GetCommentResponse  "Pin not found"
+// @Failure      500      {object}  helpers.GetCommentResponse  "Server error"
+// @Router       /pins/{id}/comments [post]
+func (c *PinController) CreateComment(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var cmd commands.CreateCommentCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	cmd.PinId = id
+	cmd.UserId = authUserId(r)
+
+	comment, err := c.commandHandler.HandleCreateComment(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "COMMENT_CREATION_FAILED",
+				Message: "Could not create comment",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.CommentResponse]{
+		Success: true,
+		Data:    comment,
+	})
+}
+
+// GetComments godoc
+// @Summary      Get the comments of a pin
+// @Description  Returns the active comments of a pin, oldest first, with the offsets of their mentions and hashtags
+// @Tags         pins
+// @Produce      json
+// @Param        id   path      string  true  "Pin ID (UUID)"
+// @Success      200  {object}  helpers.GetListCommentsResponse
+// @Failure      400  {object}  helpers.GetListCommentsResponse  "Invalid id"
+// @Failure      404  {object}  helpers.GetListCommentsResponse  "Pin not found"
+// @Failure      500  {object}  helpers.GetListCommentsResponse  "Server error"
+// @Router       /pins/{id}/comments [get]
+func (c *PinController) GetComments(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetListCommentsByPinIdQuery{
+		PinId:    id,
+		ViewerId: authUserId(r),
+	}
+
+	commentsList, err := c.queryHandler.HandleGetCommentsByPinId(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, pinErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_COMMENTS_FAILED",
+				Message: "Could not fetch comments",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(commentsList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.CommentResponse]{
+		Success: true,
+		Data:    commentsList,
+		Length:  &length,
+	})
+}
+
+func (c *PinController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Post("/create", c.CreatePin)
+		r.Put("/", c.UpdatePin)
+		r.Get("/id/{id}", c.GetPinById)
+		r.Get("/tag/{tag}", c.GetPinsByTag)
+		r.Post("/{id}/save", c.SavePin)
+		r.Post("/{id}/like", c.LikePin)
+		r.Delete("/{id}/like", c.UnlikePin)
+		r.Post("/{id}/comments", c.CreateComment)
+		r.Get("/{id}/comments", c.GetComments)
+		r.Get("/{id}/related", c.GetRelatedPins)
+	})
+}
+
+func authUserId(r *http.Request) uuid.UUID {
+	id, _ := uuid.Parse(r.Context().Value("user_id").(string))
+	return id
+}
+
+func parseIdParam(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "PARSING_UUID_FAILED",
+				Message: ErrParseId,
+				Err:     &errStr,
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func pinErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, pins.ErrNotFoundPin), errors.Is(err, boards.ErrNotFoundBoard), errors.Is(err, pins.ErrNotFoundLike):
+		return http.StatusNotFound
+	case errors.Is(err, pins.ErrForbiddenPin), errors.Is(err, pins.ErrForbiddenBoardPin), errors.Is(err, blocks.ErrBlockedUser):
+		return http.StatusForbidden
+	case errors.Is(err, pins.ErrExistsSave), errors.Is(err, pins.ErrExistsLike):
+		return http.StatusConflict
+	case errors.Is(err, pins.ErrIdNilPin), errors.Is(err, pins.ErrNilBoardIdPin), errors.Is(err, pins.ErrNilBoardIdSave), errors.Is(err, pins.ErrNilUserIdLike), errors.Is(err, pins.ErrEmptyTitlePin),
+		errors.Is(err, pins.ErrLongTitlePin), errors.Is(err, pins.ErrLongDescriptionPin), errors.Is(err, pins.ErrManyTagsPin),
+		errors.Is(err, shared.ErrEmptyHashtag), errors.Is(err, shared.ErrLongHashtag), errors.Is(err, shared.ErrInvalidHashtag),
+		errors.Is(err, comments.ErrEmptyContentComment), errors.Is(err, comments.ErrLongContentComment):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/search/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/search"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/searches"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SearchController struct {
+	queryHandler  *query.SearchHandler
+	jwtService    *services.JWTService
+	blacklistRepo *services.TokenBlacklist
+}
+
+func NewSearchController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *SearchController {
+	repository := repositories.NewSearchRepository(db)
+	return &SearchController{
+		queryHandler:  query.NewSearchHandler(repository),
+		jwtService:    jwt,
+		blacklistRepo: blacklistRepo,
+	}
+}
+
+// Search godoc
+// @Summary      Search pins, boards and users
+// @Description  Full-text search over pin titles, descriptions and tags, board names and descriptions, and usernames and names, best match first. Words are stemmed in the given language, or the authenticated user's, and also matched as typed. Supports "quoted phrases", OR and -excluded words. Snippets are escaped HTML with the matched words wrapped in <mark> tags. Pass next_cursor as cursor to get the next page. Filters combine with AND; tag, board and has_image only match pins. The first page also returns facet counts for the filtered results: top tags and boards, creator countries and languages, results created in the last day, week, month and year, and pins with and without an image
+// @Tags         search
+// @Produce      json
+// @Param        q             query     string  true   "Search text"
+// @Param        type          query     string  false  "Comma separated kinds to search: pins, boards, users (default all)"
+// @Param        language      query     string  false  "Language code or name used for stemming; also keeps only content whose creator writes in it"
+// @Param        tag           query     string  false  "Only pins with this tag"
+// @Param        board         query     string  false  "Only pins on this board"
+// @Param        country       query     string  false  "Creator country code or name"
+// @Param        created_from  query     string  false  "Created at or after, RFC3339 timestamp or YYYY-MM-DD date"
+// @Param        created_to    query     string  false  "Created before, RFC3339 timestamp or YYYY-MM-DD date"
+// @Param        has_image     query     bool    false  "Only pins with (true) or without (false) an image"
+// @Param        limit         query     int     false  "Page size (default 25, max 50)"
+// @Param        cursor        query     string  false  "Opaque cursor from the previous page"
+// @Success      200           {object}  helpers.GetSearchPageResponse
+// @Failure      400           {object}  helpers.GetSearchPageResponse  "Invalid query, type, language, filter, limit or cursor"
+// @Failure      500           {object}  helpers.GetSearchPageResponse  "Server error"
+// @Router       /search/ [get]
+func (c *SearchController) Search(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimitParam(w, r)
+	if !ok {
+		return
+	}
+
+	qry := queries.SearchQuery{
+		ViewerId: authUserId(r),
+		Text:     r.URL.Query().Get("q"),
+		Types:    parseListParam(r, "type"),
+		Language: r.URL.Query().Get("language"),
+		Tag:      r.URL.Query().Get("tag"),
+		Country:  r.URL.Query().Get("country"),
+		Cursor:   r.URL.Query().Get("cursor"),
+		Limit:    limit,
+	}
+
+	if !parseSearchFilters(w, r, &qry) {
+		return
+	}
+
+	page, err := c.queryHandler.HandleSearch(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, searchErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "SEARCH_FAILED",
+				Message: "Could not search",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.SearchPageDTO]{
+		Success: true,
+		Data:    page,
+	})
+}
+
+func (c *SearchController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.Search)
+	})
+}
+
+// parseListParam reads a query parameter given as comma separated values,
+// repeated, or both.
+func parseListParam(r *http.Request, param string) []string {
+	var values []string
+	for _, list := range r.URL.Query()[param] {
+		for _, value := range strings.Split(list, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// parseSearchFilters reads the board, created_from, created_to and has_image
+// filters into qry. On a malformed value it writes a bad request and returns
+// false.
+func parseSearchFilters(w http.ResponseWriter, r *http.Request, qry *queries.SearchQuery) bool {
+	invalid := func(code, message string, err error) bool {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: message,
+				Err:     &errStr,
+			},
+		})
+		return false
+	}
+
+	if board := r.URL.Query().Get("board"); board != "" {
+		id, err := uuid.Parse(board)
+		if err != nil {
+			return invalid("INVALID_BOARD", "board must be a board id", err)
+		}
+		qry.BoardId = &id
+	}
+
+	if from := r.URL.Query().Get("created_from"); from != "" {
+		t, err := parseDateParam(from)
+		if err != nil {
+			return invalid("INVALID_CREATED_FROM", "created_from must be an RFC3339 timestamp or a YYYY-MM-DD date", err)
+		}
+		qry.CreatedFrom = &t
+	}
+
+	if to := r.URL.Query().Get("created_to"); to != "" {
+		t, err := parseDateParam(to)
+		if err != nil {
+			return invalid("INVALID_CREATED_TO", "created_to must be an RFC3339 timestamp or a YYYY-MM-DD date", err)
+		}
+		qry.CreatedTo = &t
+	}
+
+	if image := r.URL.Query().Get("has_image"); image != "" {
+		b, err := strconv.ParseBool(image)
+		if err != nil {
+			return invalid("INVALID_HAS_IMAGE", "has_image must be true or false", err)
+		}
+		qry.HasImage = &b
+	}
+
+	return true
+}
+
+// parseDateParam accepts an RFC3339 timestamp or a plain date, read as
+// midnight UTC.
+func parseDateParam(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+func searchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, searches.ErrEmptyQuery), errors.Is(err, searches.ErrLongQuery), errors.Is(err, searches.ErrKindNotFound),
+		errors.Is(err, searches.ErrInvalidCursor), errors.Is(err, shared.ErrNotALanguage), errors.Is(err, shared.ErrNotACountry),
+		errors.Is(err, searches.ErrInvalidRangeFilters), errors.Is(err, shared.ErrEmptyHashtag), errors.Is(err, shared.ErrLongHashtag),
+		errors.Is(err, shared.ErrInvalidHashtag):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/commands"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/dto"
+	command "github.com/carlosclavijo/Pinterest-Services/internal/application/session/handlers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/application/session/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
+	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/sessions"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// SessionController shows users where they are logged in and lets them log
+// out of other devices.
+type SessionController struct {
+	commandHandler *command.SessionHandler
+	queryHandler   *query.SessionHandler
+	jwtService     *services.JWTService
+	blacklistRepo  *services.TokenBlacklist
+}
+
+func NewSessionController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist) *SessionController {
+	repository := repositories.NewSessionRepository(db)
+	return &SessionController{
+		commandHandler: command.NewSessionHandler(repository, repositories.NewRefreshTokenRepository(db), blacklistRepo, jwt.TTL(), services.NewZapAdapter()),
+		queryHandler:   query.NewSessionHandler(repository),
+		jwtService:     jwt,
+		blacklistRepo:  blacklistRepo,
+	}
+}
+
+// GetSessions godoc
+// @Summary      List sessions
+// @Description  Returns the sessions of the authenticated user that can still refresh, most recently seen first, marking the one of the request as current. Sessions are seen when they log in and each time they refresh
+// @Tags         auth
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Success      200  {object}  helpers.SessionsResponse
+// @Failure      500  {object}  helpers.SessionsResponse  "Server error"
+// @Router       /auth/sessions [get]
+func (c *SessionController) GetSessions(w http.ResponseWriter, r *http.Request) {
+	sessionDtos, err := c.queryHandler.HandleGetByUser(r.Context(), queries.GetSessionsQuery{UserId: authUserId(r), CurrentId: authSessionId(r)})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FETCH_FAILED",
+				Message: "Could not fetch sessions",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.SessionDTO]{
+		Success: true,
+		Data:    sessionDtos,
+	})
+}
+
+// RevokeSession godoc
+// @Summary      Revoke a session
+// @Description  Logs the authenticated user out of one of their sessions, which may be the current one. Its access tokens stop working at once and its refresh token can no longer refresh
+// @Tags         auth
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Param        id             path    string  true  "Session ID (UUID)"
+// @Success      200  {object}  helpers.LogoutSuccessResponse
+// @Failure      400  {object}  helpers.LogoutSuccessResponse  "Invalid id"
+// @Failure      404  {object}  helpers.LogoutSuccessResponse  "Session not found"
+// @Failure      500  {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /auth/sessions/{id} [delete]
+func (c *SessionController) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.commandHandler.HandleRevoke(r.Context(), commands.RevokeSessionCommand{UserId: authUserId(r), Id: id}); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, sessionErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REVOKE_FAILED",
+				Message: "Could not revoke the session",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "session revoked",
+	})
+}
+
+// RevokeOtherSessions godoc
+// @Summary      Revoke every other session
+// @Description  Logs the authenticated user out of every session but the current one, and returns how many were revoked
+// @Tags         auth
+// @Produce      json
+// @Param        Authorization  header  string  true  "Bearer token"
+// @Success      200  {object}  helpers.RevokedSessionsResponse
+// @Failure      500  {object}  helpers.RevokedSessionsResponse  "Server error"
+// @Router       /auth/sessions [delete]
+func (c *SessionController) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	revoked, err := c.commandHandler.HandleRevokeOthers(r.Context(), commands.RevokeOtherSessionsCommand{UserId: authUserId(r), CurrentId: authSessionId(r)})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "REVOKE_FAILED",
+				Message: "Could not revoke the other sessions",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[int]{
+		Success: true,
+		Data:    revoked,
+	})
+}
+
+func (c *SessionController) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+
+		r.Get("/", c.GetSessions)
+		r.Delete("/", c.RevokeOtherSessions)
+		r.Delete("/{id}", c.RevokeSession)
+	})
+}
+
+// authSessionId is the session of the access token, which JWTMiddleware puts
+// in the context.
+func authSessionId(r *http.Request) uuid.UUID {
+	id, _ := uuid.Parse(r.Context().Value("session_id").(string))
+	return id
+}
+
+func sessionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, sessions.ErrNotFoundSession):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -3,10 +3,24 @@ package controllers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	factorCommands "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/commands"
+	factorCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/handlers"
+	sessionCommands "github.com/carlosclavijo/Pinterest-Services/internal/application/session/commands"
+	sessionCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/session/handlers"
+	tokenCommands "github.com/carlosclavijo/Pinterest-Services/internal/application/token/commands"
+	tokenCommand "github.com/carlosclavijo/Pinterest-Services/internal/application/token/handlers"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
 	command "github.com/carlosclavijo/Pinterest-Services/internal/application/user/handlers"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/queries"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/block"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/feed"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/follow"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/mute"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/notification"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/session"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/shared"
 	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	query "github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/handlers/users"
@@ -17,7 +31,9 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"go/types"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -25,21 +41,42 @@ import (
 type UserController struct {
 	commandHandler *command.UserHandler
 	queryHandler   *query.UserHandler
+	followCommand  *command.FollowHandler
+	followQuery    *query.FollowHandler
+	blockCommand   *command.BlockHandler
+	blockQuery     *query.BlockHandler
+	tokenCommand   *tokenCommand.TokenHandler
+	roleCommand    *command.RoleHandler
+	sessionCommand *sessionCommand.SessionHandler
+	challenger     *factorCommand.FactorHandler
 	jwtService     *services.JWTService
 	blacklistRepo  *services.TokenBlacklist
+	verification   *services.VerificationSettings
 }
 
-func NewUserController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, emService *services.EmailService) *UserController {
+func NewUserController(db *sql.DB, jwt *services.JWTService, blacklistRepo *services.TokenBlacklist, emService *services.EmailService, notifier notifications.Notifier, distributor feeds.Distributor, challenger *factorCommand.FactorHandler, attemptStore *services.AttemptStore, unlockStore *services.UnlockStore, login *attempts.Policy, auth *services.AuthSettings, verification *services.VerificationSettings) *UserController {
 	repository := repositories.NewUserRepository(db)
 	factory := users.NewUserFactory()
 	emailRepo := repositories.NewEmailVerificationRepo(db)
-	commandHandler := command.NewUserHandler(repository, emailRepo, emService, factory)
+	commandHandler := command.NewUserHandler(repository, emailRepo, emService, factory, attemptStore, unlockStore, *login, services.NewZapAdapter())
 	queryHandler := query.NewUserHandler(repository, factory)
+	followRepo := repositories.NewFollowRepository(db)
+	blockRepo := repositories.NewBlockRepository(db)
+	muteRepo := repositories.NewMuteRepository(db)
 	return &UserController{
 		commandHandler: commandHandler,
 		queryHandler:   queryHandler,
+		followCommand:  command.NewFollowHandler(followRepo, repository, blockRepo, notifier, distributor, services.NewZapAdapter()),
+		followQuery:    query.NewFollowHandler(followRepo),
+		blockCommand:   command.NewBlockHandler(blockRepo, muteRepo, repository, services.NewZapAdapter()),
+		blockQuery:     query.NewBlockHandler(blockRepo, muteRepo),
+		tokenCommand:   newTokenHandler(db, jwt, auth),
+		roleCommand:    command.NewRoleHandler(repositories.NewRoleRepository(db), repositories.NewSessionRepository(db), repositories.NewRefreshTokenRepository(db), blacklistRepo, jwt.TTL(), services.NewZapAdapter()),
+		sessionCommand: sessionCommand.NewSessionHandler(repositories.NewSessionRepository(db), repositories.NewRefreshTokenRepository(db), blacklistRepo, jwt.TTL(), services.NewZapAdapter()),
+		challenger:     challenger,
 		jwtService:     jwt,
 		blacklistRepo:  blacklistRepo,
+		verification:   verification,
 	}
 }
 
@@ -51,12 +88,13 @@ const (
 
 // GetAllUsers godoc
 // @Summary      Get all users
-// @Description  Returns a list of all registered users
-// @Tags         users
+// @Description  Returns a list of all registered users, the deleted ones included. Admins only
+// @Tags         admin
 // @Produce      json
 // @Success      200  {object}  helpers.GetListUsersDTO
+// @Failure      403  {string}  string  "Not an admin"
 // @Failure      500  {object}  helpers.GetListUsersDTO "Server error"
-// @Router       /users/all [get]
+// @Router       /admin/users/ [get]
 func (c *UserController) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	qry := queries.GetAllUsersQuery{}
 	usersList, err := c.queryHandler.HandleGetAll(r.Context(), qry)
@@ -330,6 +368,7 @@ func (c *UserController) GetUsersLikeUsername(w http.ResponseWriter, r *http.Req
 
 	qry := queries.GetUsersLikeUsernameQuery{
 		Username: username,
+		ViewerId: authUserId(r),
 	}
 
 	usersList, err := c.queryHandler.HandleGetListLikeUsername(r.Context(), qry)
@@ -400,14 +439,15 @@ func (c *UserController) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 // LoginUser godoc
 // @Summary      Login a user
-// @Description  Authenticates a user and returns a JWT token
+// @Description  Authenticates a user and returns a short-lived JWT token along with a refresh token to get new ones at /auth/refresh. Users with two-factor authentication on get a challenge token instead, to trade for the tokens at /mfa/verify along with a code. A wrong email or password answer alike. After a few failures an account or an address has to wait between attempts, as Retry-After tells, and an account failing too often is locked until the link emailed to its owner is followed or the lock expires
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Param        credentials  body      commands.LoginUserCommand  true  "User login payload"
-// @Success      200          {object}  helpers.LoginSuccessResponse  "User data and token"
+// @Success      200          {object}  helpers.LoginSuccessResponse  "User data and token, or mfa_required and challenge_token when a code is needed"
 // @Failure      400          {object}  helpers.GetUserResponse  "Invalid request body"
 // @Failure      401          {object}  helpers.GetUserResponse  "Authentication failed"
+// @Failure      429          {object}  helpers.GetUserResponse  "Too many failed logins or account locked"
 // @Failure      500          {object}  helpers.GetUserResponse  "Server error"
 // @Router       /users/login [post]
 func (c *UserController) LoginUser(w http.ResponseWriter, r *http.Request) {
@@ -423,8 +463,23 @@ func (c *UserController) LoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	cmd.IP = helpers.ClientIP(r)
 	usr, err := c.commandHandler.HandleLogin(r.Context(), cmd)
-	if err != nil {
+
+	var retry *attempts.RetryError
+	if errors.As(err, &retry) {
+		errStr := err.Error()
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retry.After.Seconds()))))
+		helpers.WriteJSON(w, http.StatusTooManyRequests, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "TOO_MANY_ATTEMPTS",
+				Message: "Too many failed logins",
+				Err:     &errStr,
+			},
+		})
+		return
+	} else if err != nil {
 		errStr := err.Error()
 		helpers.WriteJSON(w, http.StatusUnauthorized, helpers.Response[any]{
 			Success: false,
@@ -437,7 +492,38 @@ func (c *UserController) LoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := c.jwtService.Generate(usr.Id.String())
+	if c.verification.Policy == services.VerificationPolicyBlockLogin && !usr.Verified {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "EMAIL_NOT_VERIFIED",
+				Message: "Verify your email before logging in",
+			},
+		})
+		return
+	}
+
+	challenge, err := c.challenger.HandleChallenge(r.Context(), factorCommands.StartChallengeCommand{UserId: usr.Id})
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MFA_ERROR",
+				Message: "Could not start two-factor authentication",
+				Err:     &errStr,
+			},
+		})
+		return
+	} else if challenge != nil {
+		helpers.WriteJSON(w, http.StatusOK, helpers.Response[any]{
+			Success: true,
+			Data:    challenge,
+		})
+		return
+	}
+
+	pair, err := c.tokenCommand.HandleIssue(r.Context(), issueTokensCommand(r, usr.Id))
 	if err != nil {
 		errStr := err.Error()
 		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
@@ -454,22 +540,24 @@ func (c *UserController) LoginUser(w http.ResponseWriter, r *http.Request) {
 	helpers.WriteJSON(w, http.StatusOK, helpers.Response[any]{
 		Success: true,
 		Data: map[string]any{
-			"user":  usr,
-			"token": token,
+			"user":          usr,
+			"token":         pair.AccessToken,
+			"expires_in":    pair.ExpiresIn,
+			"refresh_token": pair.RefreshToken,
 		},
 	})
 }
 
 // Logout godoc
 // @Summary      Logout user
-// @Description  Revokes the current JWT token
+// @Description  Logs the current session out, like revoking it at /auth/sessions/{id}: its refresh tokens and every access token of it stop working. Tokens of older logins with no session revoke the JWT token, and the refresh token sent as {"refresh_token": "..."} in the body
 // @Tags         users
 // @Produce      json
 // @Param        Authorization  header  string  true  "Bearer token"
 // @Success      200  {object}  helpers.LogoutSuccessResponse  "Logout successful"
 // @Failure      401  {object}  helpers.GetUserResponse  "Missing or invalid token"
 // @Failure      500  {object}  helpers.GetUserResponse  "Logout failed"
-// @Router       /users/logout [post]
+// @Router       /users/out [post]
 func (c *UserController) Logout(w http.ResponseWriter, r *http.Request) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
@@ -521,12 +609,30 @@ func (c *UserController) Logout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = c.sessionCommand.HandleRevoke(r.Context(), sessionCommands.RevokeSessionCommand{UserId: authUserId(r), Id: authSessionId(r)})
+	if err != nil && !errors.Is(err, sessions.ErrNotFoundSession) {
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOG_FAILED",
+				Message: "logout failed",
+			},
+		})
+		return
+	}
+
+	// Tokens of logins from before sessions have none to revoke, so the
+	// refresh token in the body is revoked on its own. Revoking logs its own
+	// errors and must not fail the logout.
+	var cmd tokenCommands.RevokeTokensCommand
+	if json.NewDecoder(r.Body).Decode(&cmd) == nil && cmd.RefreshToken != "" {
+		_ = c.tokenCommand.HandleRevoke(r.Context(), cmd)
+	}
+
 	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
 		Success: true,
 		Data:    "Successfully log out",
 	})
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("Logged out successfully"))
 }
 
 // UpdateUser godoc
@@ -589,7 +695,7 @@ func (c *UserController) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 // UploadProfilePic godoc
 // @Summary      Upload a user's profile picture
-// @Description  Uploads a profile picture for the given user
+// @Description  Uploads a profile picture for the given user. Users may only change their own; admins may change anyone's
 // @Tags         users
 // @Accept       multipart/form-data
 // @Produce      json
@@ -597,16 +703,21 @@ func (c *UserController) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Param        profile_pic  formData  file    true  "Profile picture file"
 // @Success      200          {object}  helpers.LogoutSuccessResponse  "Uploaded file info"
 // @Failure      400          {object}  helpers.GetUserDTO          "Bad request / missing file"
+// @Failure      403          {object}  helpers.GetUserDTO          "Forbidden: cannot change another user's picture"
 // @Failure      500          {object}  helpers.GetUserDTO          "Server error"
 // @Router       /users/profilepic/{id} [patch]
 func (c *UserController) UploadProfilePic(w http.ResponseWriter, r *http.Request) {
-	userID := chi.URLParam(r, "id")
-	if userID == "" {
-		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if !actsFor(r, id, users.RoleAdmin) {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "INVALID_ID",
-				Message: "User ID is required in the URL",
+				Code:    "FORBIDDEN",
+				Message: "Cannot change another user's profile picture",
 			},
 		})
 		return
@@ -652,7 +763,7 @@ func (c *UserController) UploadProfilePic(w http.ResponseWriter, r *http.Request
 	}
 
 	cmd := commands.UpdateProfilePicCommand{
-		UserID:     userID,
+		UserID:     id.String(),
 		ProfilePic: fileName,
 	}
 
@@ -675,25 +786,47 @@ func (c *UserController) UploadProfilePic(w http.ResponseWriter, r *http.Request
 
 // DeleteUser godoc
 // @Summary      Delete a user
-// @Description  Deletes a user by ID
+// @Description  Deletes a user by ID and logs them out of every session. Users may only delete themselves; moderators may delete plain users too, and admins anyone
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "User ID"
 // @Success      200  {object}  helpers.GetUserResponse  "Deleted user"
 // @Failure      400  {object}  helpers.GetUserResponse  "Invalid UUID"
+// @Failure      403  {object}  helpers.GetUserResponse  "Forbidden: cannot delete another user, or one of an equal or higher role"
+// @Failure      404  {object}  helpers.GetUserResponse  "User not found"
 // @Failure      500  {object}  helpers.GetUserResponse  "Server error"
 // @Router       /users/{id} [delete]
 func (c *UserController) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	idStr := chi.URLParam(r, "id")
-	id, err := uuid.Parse(idStr)
-	if err != nil {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if !actsFor(r, id, users.RoleModerator) {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FORBIDDEN",
+				Message: "Cannot delete another user",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ActOnUserCommand{
+		ActorId:   authUserId(r),
+		ActorRole: authRole(r).String(),
+		Id:        id,
+	}
+
+	if err := c.roleCommand.HandleActOn(r.Context(), cmd); err != nil {
 		errStr := err.Error()
-		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+		helpers.WriteJSON(w, roleErrorStatus(err), helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "PARSING_UUID_FAILED",
-				Message: ErrParseId,
+				Code:    "DELETE_FAILED",
+				Message: "Cannot delete this user",
 				Err:     &errStr,
 			},
 		})
@@ -714,6 +847,19 @@ func (c *UserController) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err = c.roleCommand.HandleLogout(r.Context(), id); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "DELETE_FAILED",
+				Message: "Could not log the deleted user out",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
 	helpers.WriteJSON(w, http.StatusOK, helpers.Response[*dto.UserResponse]{
 		Success: true,
 		Data:    usr,
@@ -722,25 +868,47 @@ func (c *UserController) DeleteUser(w http.ResponseWriter, r *http.Request) {
 
 // RestoreUser godoc
 // @Summary      Restore a deleted user
-// @Description  Restores a user by ID that was previously soft-deleted
+// @Description  Restores a user by ID that was previously soft-deleted. Users may only restore themselves; moderators may restore plain users too, and admins anyone
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "User ID"
 // @Success      200  {object}  helpers.GetUserResponse  "Restored user"
 // @Failure      400  {object}  helpers.GetUserResponse  "Invalid UUID"
+// @Failure      403  {object}  helpers.GetUserResponse  "Forbidden: cannot restore another user, or one of an equal or higher role"
+// @Failure      404  {object}  helpers.GetUserResponse  "User not found"
 // @Failure      500  {object}  helpers.GetUserResponse  "Server error"
 // @Router       /users/restore/{id} [patch]
 func (c *UserController) RestoreUser(w http.ResponseWriter, r *http.Request) {
-	idStr := chi.URLParam(r, "id")
-	id, err := uuid.Parse(idStr)
-	if err != nil {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if !actsFor(r, id, users.RoleModerator) {
+		helpers.WriteJSON(w, http.StatusForbidden, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FORBIDDEN",
+				Message: "Cannot restore another user",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ActOnUserCommand{
+		ActorId:   authUserId(r),
+		ActorRole: authRole(r).String(),
+		Id:        id,
+	}
+
+	if err := c.roleCommand.HandleActOn(r.Context(), cmd); err != nil {
 		errStr := err.Error()
-		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+		helpers.WriteJSON(w, roleErrorStatus(err), helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "PARSING_UUID_FAILED",
-				Message: ErrParseId,
+				Code:    "RESTORE_FAILED",
+				Message: "Cannot restore this user",
 				Err:     &errStr,
 			},
 		})
@@ -797,13 +965,13 @@ func (c *UserController) ListLanguages(w http.ResponseWriter, r *http.Request) {
 
 // VerifyEmail godoc
 // @Summary      Verify user's email
-// @Description  Verifies the email of a user using a token
+// @Description  Verifies the email of a user using the token of the emailed link, then redirects to the configured page
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Param        token  query  string  true  "Verification token"
 // @Success      303  "Redirects to login page with verification success"
-// @Failure      400  {string}  string  "Missing or invalid token"
+// @Failure      400  {string}  string  "Missing, invalid, expired or used token"
 // @Router       /verify-email [get]
 func (c *UserController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
 	token := r.URL.Query().Get("token")
@@ -812,23 +980,566 @@ func (c *UserController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := c.commandHandler.VerifyEmailToken(r.Context(), token)
+	err := c.commandHandler.HandleVerifyEmail(r.Context(), commands.VerifyEmailCommand{Token: token})
 	if err != nil {
 		http.Error(w, "invalid or expired token", http.StatusBadRequest)
 		return
 	}
 
-	http.Redirect(w, r, "http://localhost:3000/login?verified=true", http.StatusSeeOther)
+	http.Redirect(w, r, c.verification.RedirectUrl, http.StatusSeeOther)
+}
+
+// ResendVerification godoc
+// @Summary      Resend the verification email
+// @Description  Emails a new verification link to the account of the address if it is not verified yet. Links are sent at most once a minute and a few times a day per account. It answers the same whether a link was sent or not
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.ResendVerificationCommand  true  "Email of the account"
+// @Success      200      {object}  helpers.LogoutSuccessResponse
+// @Failure      400      {object}  helpers.LogoutSuccessResponse  "Invalid request body or email"
+// @Router       /users/verify-email/resend [post]
+func (c *UserController) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.ResendVerificationCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	if _, err := shared.NewEmail(cmd.Email); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_EMAIL",
+				Message: "Invalid email",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	// Failures past this point are logged by the handler and not reported,
+	// as only existing accounts get that far.
+	_ = c.commandHandler.HandleResendVerification(r.Context(), cmd)
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "if the email belongs to an unverified account, a new link was sent to it",
+	})
+}
+
+// UnlockAccount godoc
+// @Summary      Unlock an account
+// @Description  Lifts the lock that too many failed logins put on an account, using the token of the link emailed to its owner when it was locked, and forgets those failures. A link works once
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        request  body      commands.UnlockAccountCommand  true  "Token of the emailed link"
+// @Success      200      {object}  helpers.LogoutSuccessResponse
+// @Failure      400      {object}  helpers.LogoutSuccessResponse  "Invalid request body, or invalid, expired or used link"
+// @Failure      500      {object}  helpers.LogoutSuccessResponse  "Server error"
+// @Router       /users/unlock [post]
+func (c *UserController) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	var cmd commands.UnlockAccountCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+
+	if err := c.commandHandler.HandleUnlock(r.Context(), cmd); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, attempts.ErrInvalidUnlock) {
+			status = http.StatusBadRequest
+		}
+
+		errStr := err.Error()
+		helpers.WriteJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNLOCK_FAILED",
+				Message: "Could not unlock account",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[string]{
+		Success: true,
+		Data:    "account unlocked",
+	})
+}
+
+// FollowUser godoc
+// @Summary      Follow a user
+// @Description  The authenticated user starts following another user, who gets a notification
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      201  {object}  helpers.GetFollowDTO
+// @Failure      400  {object}  helpers.GetFollowDTO  "Invalid id or self follow"
+// @Failure      403  {object}  helpers.GetFollowDTO  "User is blocked"
+// @Failure      404  {object}  helpers.GetFollowDTO  "User not found"
+// @Failure      409  {object}  helpers.GetFollowDTO  "Already following"
+// @Failure      500  {object}  helpers.GetFollowDTO  "Server error"
+// @Router       /users/{id}/follow [post]
+func (c *UserController) FollowUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.FollowUserCommand{
+		FollowerId: authUserId(r),
+		FolloweeId: id,
+	}
+
+	follow, err := c.followCommand.HandleFollow(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, followErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "FOLLOW_FAILED",
+				Message: "Could not follow user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.FollowDTO]{
+		Success: true,
+		Data:    follow,
+	})
+}
+
+// UnfollowUser godoc
+// @Summary      Unfollow a user
+// @Description  The authenticated user stops following another user
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      204  "Unfollowed"
+// @Failure      400  {object}  helpers.GetFollowDTO  "Invalid id"
+// @Failure      404  {object}  helpers.GetFollowDTO  "Not following this user"
+// @Failure      500  {object}  helpers.GetFollowDTO  "Server error"
+// @Router       /users/{id}/follow [delete]
+func (c *UserController) UnfollowUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnfollowUserCommand{
+		FollowerId: authUserId(r),
+		FolloweeId: id,
+	}
+
+	if err := c.followCommand.HandleUnfollow(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, followErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNFOLLOW_FAILED",
+				Message: "Could not unfollow user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetFollowers godoc
+// @Summary      Get the followers of a user
+// @Description  Returns who follows the user, newest first
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      200  {object}  helpers.GetListFollowsDTO
+// @Failure      400  {object}  helpers.GetListFollowsDTO  "Invalid id"
+// @Failure      500  {object}  helpers.GetListFollowsDTO  "Server error"
+// @Router       /users/{id}/followers [get]
+func (c *UserController) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetFollowersQuery{
+		UserId: id,
+	}
+
+	followList, err := c.followQuery.HandleGetFollowers(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_FOLLOWERS_FAILED",
+				Message: ErrFetchUsers,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(followList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.FollowDTO]{
+		Success: true,
+		Data:    followList,
+		Length:  &length,
+	})
+}
+
+// GetFollowing godoc
+// @Summary      Get the users a user follows
+// @Description  Returns who the user follows, newest first
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      200  {object}  helpers.GetListFollowsDTO
+// @Failure      400  {object}  helpers.GetListFollowsDTO  "Invalid id"
+// @Failure      500  {object}  helpers.GetListFollowsDTO  "Server error"
+// @Router       /users/{id}/following [get]
+func (c *UserController) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	qry := queries.GetFollowingQuery{
+		UserId: id,
+	}
+
+	followList, err := c.followQuery.HandleGetFollowing(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_FOLLOWING_FAILED",
+				Message: ErrFetchUsers,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(followList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.FollowDTO]{
+		Success: true,
+		Data:    followList,
+		Length:  &length,
+	})
+}
+
+// BlockUser godoc
+// @Summary      Block a user
+// @Description  Blocks another user for the authenticated user. Both stop seeing each other's pins, comments and profiles in search, cannot follow, comment on, mention or message each other, and any follows between them are removed
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      201  {object}  helpers.GetBlockDTO
+// @Failure      400  {object}  helpers.GetBlockDTO  "Invalid id or self block"
+// @Failure      404  {object}  helpers.GetBlockDTO  "User not found"
+// @Failure      409  {object}  helpers.GetBlockDTO  "Already blocked"
+// @Failure      500  {object}  helpers.GetBlockDTO  "Server error"
+// @Router       /users/{id}/block [post]
+func (c *UserController) BlockUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.BlockUserCommand{
+		BlockerId: authUserId(r),
+		BlockedId: id,
+	}
+
+	block, err := c.blockCommand.HandleBlock(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, blockErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "BLOCK_FAILED",
+				Message: "Could not block user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.BlockDTO]{
+		Success: true,
+		Data:    block,
+	})
+}
+
+// UnblockUser godoc
+// @Summary      Unblock a user
+// @Description  Removes a block the authenticated user created. Follows removed by the block are not restored
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      204  "Unblocked"
+// @Failure      400  {object}  helpers.GetBlockDTO  "Invalid id"
+// @Failure      404  {object}  helpers.GetBlockDTO  "User is not blocked"
+// @Failure      500  {object}  helpers.GetBlockDTO  "Server error"
+// @Router       /users/{id}/block [delete]
+func (c *UserController) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnblockUserCommand{
+		BlockerId: authUserId(r),
+		BlockedId: id,
+	}
+
+	if err := c.blockCommand.HandleUnblock(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, blockErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNBLOCK_FAILED",
+				Message: "Could not unblock user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBlocked godoc
+// @Summary      Get blocked users
+// @Description  Returns the users the authenticated user has blocked, newest first
+// @Tags         users
+// @Produce      json
+// @Success      200  {object}  helpers.GetListBlocksDTO
+// @Failure      500  {object}  helpers.GetListBlocksDTO  "Server error"
+// @Router       /users/blocked [get]
+func (c *UserController) GetBlocked(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetBlockedQuery{
+		UserId: authUserId(r),
+	}
+
+	blockList, err := c.blockQuery.HandleGetBlocked(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_BLOCKED_FAILED",
+				Message: ErrFetchUsers,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(blockList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.BlockDTO]{
+		Success: true,
+		Data:    blockList,
+		Length:  &length,
+	})
+}
+
+// MuteUser godoc
+// @Summary      Mute a user
+// @Description  Hides another user's pins from the authenticated user's feed. The muted user is not told and nothing else changes
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      201  {object}  helpers.GetMuteDTO
+// @Failure      400  {object}  helpers.GetMuteDTO  "Invalid id or self mute"
+// @Failure      404  {object}  helpers.GetMuteDTO  "User not found"
+// @Failure      409  {object}  helpers.GetMuteDTO  "Already muted"
+// @Failure      500  {object}  helpers.GetMuteDTO  "Server error"
+// @Router       /users/{id}/mute [post]
+func (c *UserController) MuteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.MuteUserCommand{
+		MuterId: authUserId(r),
+		MutedId: id,
+	}
+
+	mute, err := c.blockCommand.HandleMute(r.Context(), cmd)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, blockErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "MUTE_FAILED",
+				Message: "Could not mute user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, helpers.Response[*dto.MuteDTO]{
+		Success: true,
+		Data:    mute,
+	})
+}
+
+// UnmuteUser godoc
+// @Summary      Unmute a user
+// @Description  Shows a muted user's pins in the authenticated user's feed again
+// @Tags         users
+// @Produce      json
+// @Param        id   path      string  true  "User ID (UUID)"
+// @Success      204  "Unmuted"
+// @Failure      400  {object}  helpers.GetMuteDTO  "Invalid id"
+// @Failure      404  {object}  helpers.GetMuteDTO  "User is not muted"
+// @Failure      500  {object}  helpers.GetMuteDTO  "Server error"
+// @Router       /users/{id}/mute [delete]
+func (c *UserController) UnmuteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	cmd := commands.UnmuteUserCommand{
+		MuterId: authUserId(r),
+		MutedId: id,
+	}
+
+	if err := c.blockCommand.HandleUnmute(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, blockErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "UNMUTE_FAILED",
+				Message: "Could not unmute user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetMuted godoc
+// @Summary      Get muted users
+// @Description  Returns the users the authenticated user has muted, newest first
+// @Tags         users
+// @Produce      json
+// @Success      200  {object}  helpers.GetListMutesDTO
+// @Failure      500  {object}  helpers.GetListMutesDTO  "Server error"
+// @Router       /users/muted [get]
+func (c *UserController) GetMuted(w http.ResponseWriter, r *http.Request) {
+	qry := queries.GetMutedQuery{
+		UserId: authUserId(r),
+	}
+
+	muteList, err := c.blockQuery.HandleGetMuted(r.Context(), qry)
+	if err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_MUTED_FAILED",
+				Message: ErrFetchUsers,
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	length := len(muteList)
+	helpers.WriteJSON(w, http.StatusOK, helpers.Response[[]*dto.MuteDTO]{
+		Success: true,
+		Data:    muteList,
+		Length:  &length,
+	})
+}
+
+// ChangeUserRole godoc
+// @Summary      Change the role of a user
+// @Description  Makes a user a user, moderator or admin. Admins cannot change their own role. The new role is in the tokens the user gets from then on; a demoted user is logged out of every session. Admins only
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string                         true  "User ID"
+// @Param        role  body  commands.ChangeRoleCommand  true  "New role"
+// @Success      204   "Role changed"
+// @Failure      400   {object}  helpers.GetUserResponse  "Invalid id, body or role"
+// @Failure      403   {object}  helpers.GetUserResponse  "Not an admin, or own role"
+// @Failure      404   {object}  helpers.GetUserResponse  "User not found"
+// @Failure      500   {object}  helpers.GetUserResponse  "Server error"
+// @Router       /admin/users/{id}/role [put]
+func (c *UserController) ChangeUserRole(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIdParam(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var cmd commands.ChangeRoleCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		helpers.WriteJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: ErrJSONFormat,
+			},
+		})
+		return
+	}
+	cmd.Id = id
+	cmd.ActorId = authUserId(r)
+
+	if err := c.roleCommand.HandleChangeRole(r.Context(), cmd); err != nil {
+		errStr := err.Error()
+		helpers.WriteJSON(w, roleErrorStatus(err), helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "CHANGE_ROLE_FAILED",
+				Message: "Could not change the role of the user",
+				Err:     &errStr,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func (c *UserController) RegisterRoutes(r chi.Router) {
 	r.Post("/create", c.CreateUser)
 	r.Post("/login", c.LoginUser)
+	r.Post("/verify-email/resend", c.ResendVerification)
+	r.Post("/unlock", c.UnlockAccount)
 
 	r.Group(func(r chi.Router) {
 		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
 
-		r.Get("/all", c.GetAllUsers)
 		r.Get("/list", c.GetListUsers)
 		r.Get("/countries", c.ListCountries)
 		r.Get("/languages", c.ListLanguages)
@@ -843,5 +1554,84 @@ func (c *UserController) RegisterRoutes(r chi.Router) {
 		r.Patch("/profilepic/{id}", c.UploadProfilePic)
 		r.Patch("/restore/{id}", c.RestoreUser)
 		r.Post("/out", c.Logout)
+		r.Post("/{id}/follow", c.FollowUser)
+		r.Delete("/{id}/follow", c.UnfollowUser)
+		r.Get("/{id}/followers", c.GetFollowers)
+		r.Get("/{id}/following", c.GetFollowing)
+		r.Get("/blocked", c.GetBlocked)
+		r.Post("/{id}/block", c.BlockUser)
+		r.Delete("/{id}/block", c.UnblockUser)
+		r.Get("/muted", c.GetMuted)
+		r.Post("/{id}/mute", c.MuteUser)
+		r.Delete("/{id}/mute", c.UnmuteUser)
 	})
 }
+
+// RegisterAdminRoutes serves the user routes limited to admins.
+func (c *UserController) RegisterAdminRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.JWTMiddleware(c.jwtService, c.blacklistRepo))
+		r.Use(middleware.RequireRole(users.RoleAdmin))
+
+		r.Get("/", c.GetAllUsers)
+		r.Put("/{id}/role", c.ChangeUserRole)
+	})
+}
+
+// authRole is the role of the access token, which JWTMiddleware puts in the
+// context.
+func authRole(r *http.Request) users.Role {
+	role, ok := r.Context().Value("role").(users.Role)
+	if !ok {
+		return users.RoleUser
+	}
+	return role
+}
+
+// actsFor tells whether the authenticated user may act on the account id:
+// their own, or anyone's when their role includes role.
+func actsFor(r *http.Request, id uuid.UUID, role users.Role) bool {
+	return id == authUserId(r) || authRole(r).Includes(role)
+}
+
+func roleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, users.ErrNotARole):
+		return http.StatusBadRequest
+	case errors.Is(err, users.ErrOwnRole), errors.Is(err, users.ErrOutranked):
+		return http.StatusForbidden
+	case errors.Is(err, users.ErrNotFoundUser):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func followErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, users.ErrNotFoundUser), errors.Is(err, follows.ErrNotFoundFollow):
+		return http.StatusNotFound
+	case errors.Is(err, blocks.ErrBlockedUser):
+		return http.StatusForbidden
+	case errors.Is(err, follows.ErrExistsFollow):
+		return http.StatusConflict
+	case errors.Is(err, follows.ErrSelfFollow), errors.Is(err, follows.ErrNilFollowerIdFollow), errors.Is(err, follows.ErrNilFolloweeIdFollow):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func blockErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, users.ErrNotFoundUser), errors.Is(err, blocks.ErrNotFoundBlock), errors.Is(err, mutes.ErrNotFoundMute):
+		return http.StatusNotFound
+	case errors.Is(err, blocks.ErrExistsBlock), errors.Is(err, mutes.ErrExistsMute):
+		return http.StatusConflict
+	case errors.Is(err, blocks.ErrSelfBlock), errors.Is(err, blocks.ErrNilBlockerIdBlock), errors.Is(err, blocks.ErrNilBlockedIdBlock),
+		errors.Is(err, mutes.ErrSelfMute), errors.Is(err, mutes.ErrNilMuterIdMute), errors.Is(err, mutes.ErrNilMutedIdMute):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/commands"
 	"github.com/carlosclavijo/Pinterest-Services/internal/application/user/dto"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
 	"github.com/carlosclavijo/Pinterest-Services/internal/web/helpers"
@@ -19,6 +21,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -29,20 +32,25 @@ var (
 	password = "sTr0nG!!"
 	columns  = []string{
 		"id", "first_name", "last_name", "user_name", "email", "password", "gender", "birth_date", "country", "language", "phone", "information", "profile_pic", "web_site",
-		"visibility", "last_login_at", "created_at", "updated_at", "deleted_at",
+		"visibility", "last_login_at", "created_at", "updated_at", "deleted_at", "verified_at",
 	}
 )
 
+func TestMain(m *testing.M) {
+	services.InitLogger("test")
+	os.Exit(m.Run())
+}
+
 func TestUserController_GetAllUsers(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(columns).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Country, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetAllUsers)).WillReturnRows(rows)
 
@@ -74,7 +82,7 @@ func TestUserController_GetAllUsers_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetAllUsers)).WillReturnError(errors.New("DB connection failed"))
 
@@ -100,12 +108,12 @@ func TestUserController_GetListUsers(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(columns).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Country, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetListUsers)).WillReturnRows(rows)
 
@@ -134,7 +142,7 @@ func TestUserController_GetListUsers_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetListUsers)).WillReturnError(errors.New("DB connection failed"))
 
@@ -160,14 +168,14 @@ func TestUserController_GetUserById(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	cols := append([]string(nil), columns...)
 	cols = cols[1:]
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(cols).AddRow(
 		userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Country, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserById)).WithArgs(userDto.Id).WillReturnRows(rows)
 
@@ -198,7 +206,7 @@ func TestUserController_GetUserById_InvalidUUID(t *testing.T) {
 	db, _, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	req := httptest.NewRequest(http.MethodGet, "/users/invalid-uuid", nil)
 	rctx := chi.NewRouteContext()
@@ -215,14 +223,14 @@ func TestUserController_GetUserById_InvalidUUID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	assert.Contains(t, string(body), `"success":false`)
 	assert.Contains(t, string(body), `"code":"PARSING_UUID_FAILED"`)
-	assert.Contains(t, string(body), `"message":"Invalid user ID format"`)
+	assert.Contains(t, string(body), `"message":"`+ErrParseId+`"`)
 }
 
 func TestUserController_GetUserById_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserById)).WithArgs(userDto.Id).WillReturnError(errors.New("DB connection failed"))
@@ -252,14 +260,14 @@ func TestUserController_GetUserByUsername(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:3], cols[4:]...)
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(cols).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Country, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserByUsername)).WithArgs(userDto.Username).WillReturnRows(rows)
 
@@ -290,7 +298,7 @@ func TestUserController_GetUserByUsername_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserByUsername)).WithArgs(userDto.Username).WillReturnError(errors.New("DB connection failed"))
@@ -320,14 +328,14 @@ func TestUserController_GetUserByEmail(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:4], cols[5:]...)
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(cols).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, password, userDto.Gender, userDto.Birth, userDto.Country, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserByEmail)).WithArgs(userDto.Email).WillReturnRows(rows)
 
@@ -358,7 +366,7 @@ func TestUserController_GetUserByEmail_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserByEmail)).WithArgs(userDto.Email).WillReturnError(errors.New("DB connection failed"))
@@ -388,14 +396,14 @@ func TestUserController_GetUsersByCountry(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:9], cols[10:]...)
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(cols).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Language,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUsersByCountry)).WithArgs(userDto.Country).WillReturnRows(rows)
 
@@ -426,7 +434,7 @@ func TestUserController_GetUsersByCountry_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUsersByCountry)).WithArgs(userDto.Country).WillReturnError(errors.New("DB connection failed"))
@@ -456,14 +464,14 @@ func TestUserController_GetUsersByLanguage(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	cols := append([]string(nil), columns...)
 	cols = append(cols[:10], cols[11:]...)
 	userDto := mockUserDto()
 	rows := sqlmock.NewRows(cols).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, password, userDto.Gender, userDto.Birth, userDto.Country,
-		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		*userDto.Phone, *userDto.Information, *userDto.ProfilePic, *userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUsersByLanguage)).WithArgs(userDto.Language).WillReturnRows(rows)
 
@@ -494,7 +502,7 @@ func TestUserController_GetUsersByLanguage_Error(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUsersByLanguage)).WithArgs(userDto.Language).WillReturnError(errors.New("DB connection failed"))
@@ -524,7 +532,7 @@ func TestUserController_CreateUser(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 
@@ -543,18 +551,23 @@ func TestUserController_CreateUser(t *testing.T) {
 
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 
+	body, err := json.Marshal(cmd)
+	require.NoError(t, err)
+
+	var sent commands.CreateUserCommand
+	require.NoError(t, json.Unmarshal(body, &sent))
+
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryExistUserByUsername)).WithArgs(userDto.Username).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryExistUserByEmail)).WithArgs(userDto.Email).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryCreateUser)).WithArgs(
 		sqlmock.AnyArg(), userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, sqlmock.AnyArg(),
-		userDto.Gender, userDto.Birth, "BO", "ES", userDto.Phone, false, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+		userDto.Gender, sent.Birth, "BO", "ES", userDto.Phone, false, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
 	).WillReturnRows(sqlmock.NewRows(columns).AddRow(
 		userDto.Id, userDto.FirstName, userDto.LastName, userDto.Username, userDto.Email, hashedPassword, userDto.Gender, userDto.Birth,
-		"BO", "ES", userDto.Phone, userDto.Information, userDto.ProfilePic, userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil,
+		"BO", "ES", userDto.Phone, userDto.Information, userDto.ProfilePic, userDto.Website, userDto.Visibility, time.Now(), time.Now(), time.Now(), nil, nil,
 	))
-
-	body, err := json.Marshal(cmd)
-	require.NoError(t, err)
+	mock.ExpectExec("INSERT INTO email_verifications").WithArgs(sqlmock.AnyArg(), userDto.Id, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(string(body)))
 	rr := httptest.NewRecorder()
@@ -583,7 +596,7 @@ func TestUserController_CreateUser_InvalidBody(t *testing.T) {
 	db, _, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("{invalid_json}"))
 	rr := httptest.NewRecorder()
 
@@ -602,7 +615,7 @@ func TestUserController_CreateUser_FailCreate(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 
-	ctrl := NewUserController(db, services.JWTService{})
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 
 	userDto := mockUserDto()
 
@@ -702,3 +715,246 @@ func mockUserDto() *dto.UserDTO {
 
 	return &userDto
 }
+
+func TestUserController_UnlockAccount_InvalidBody(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	req := httptest.NewRequest(http.MethodPost, "/users/unlock", strings.NewReader("{invalid_json}"))
+	rr := httptest.NewRecorder()
+
+	ctrl.UnlockAccount(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"INVALID_REQUEST_BODY"`)
+}
+
+func TestUserController_UnlockAccount_MissingToken(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	req := httptest.NewRequest(http.MethodPost, "/users/unlock", strings.NewReader(`{"token":""}`))
+	rr := httptest.NewRecorder()
+
+	ctrl.UnlockAccount(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"UNLOCK_FAILED"`)
+}
+
+func authRequest(method, target string, body io.Reader, userId uuid.UUID, role users.Role, id string) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+	ctx = context.WithValue(ctx, "user_id", userId.String())
+	ctx = context.WithValue(ctx, "role", role)
+	return req.WithContext(ctx)
+}
+
+func TestUserController_DeleteUser_Forbidden(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	req := authRequest(http.MethodDelete, "/users/"+id.String(), nil, uuid.New(), users.RoleUser, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.DeleteUser(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"FORBIDDEN"`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserController_DeleteUser_Moderator(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("user"))
+	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryExistUserById)).WithArgs(id).WillReturnError(errors.New("db error"))
+	req := authRequest(http.MethodDelete, "/users/"+id.String(), nil, uuid.New(), users.RoleModerator, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.DeleteUser(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"DELETE_FAILED"`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserController_DeleteUser_Outranked(t *testing.T) {
+	for _, role := range []string{"moderator", "admin"} {
+		t.Run(role, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+			defer db.Close()
+
+			ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+			id := uuid.New()
+			mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow(role))
+			req := authRequest(http.MethodDelete, "/users/"+id.String(), nil, uuid.New(), users.RoleModerator, id.String())
+			rr := httptest.NewRecorder()
+
+			ctrl.DeleteUser(rr, req)
+
+			resp := rr.Result()
+			defer resp.Body.Close()
+			bodyBytes, _ := io.ReadAll(resp.Body)
+
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+			assert.Contains(t, string(bodyBytes), users.ErrOutranked.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserController_RestoreUser_Outranked(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("admin"))
+	req := authRequest(http.MethodPatch, "/users/restore/"+id.String(), nil, uuid.New(), users.RoleModerator, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.RestoreUser(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserController_RestoreUser_Forbidden(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	req := authRequest(http.MethodPatch, "/users/restore/"+id.String(), nil, uuid.New(), users.RoleUser, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.RestoreUser(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserController_UploadProfilePic_Forbidden(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	req := authRequest(http.MethodPatch, "/users/profilepic/"+id.String(), nil, uuid.New(), users.RoleModerator, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.UploadProfilePic(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"FORBIDDEN"`)
+}
+
+func TestUserController_UploadProfilePic_InvalidUUID(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	req := authRequest(http.MethodPatch, "/users/profilepic/invalid-uuid", nil, uuid.New(), users.RoleAdmin, "invalid-uuid")
+	rr := httptest.NewRecorder()
+
+	ctrl.UploadProfilePic(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"PARSING_UUID_FAILED"`)
+}
+
+func TestUserController_ChangeUserRole_OwnRole(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	req := authRequest(http.MethodPut, "/admin/users/"+id.String()+"/role", strings.NewReader(`{"role":"user"}`), id, users.RoleAdmin, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.ChangeUserRole(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Contains(t, string(bodyBytes), `"code":"CHANGE_ROLE_FAILED"`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserController_ChangeUserRole_InvalidRole(t *testing.T) {
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	req := authRequest(http.MethodPut, "/admin/users/"+id.String()+"/role", strings.NewReader(`{"role":"root"}`), uuid.New(), users.RoleAdmin, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.ChangeUserRole(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestUserController_ChangeUserRole_NotFound(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	ctrl := NewUserController(db, &services.JWTService{}, nil, &services.EmailService{}, nil, nil, nil, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
+	id := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(repositories.QueryGetUserRole)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+	req := authRequest(http.MethodPut, "/admin/users/"+id.String()+"/role", strings.NewReader(`{"role":"moderator"}`), uuid.New(), users.RoleAdmin, id.String())
+	rr := httptest.NewRecorder()
+
+	ctrl.ChangeUserRole(rr, req)
+
+	resp := rr.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,17 @@
+package helpers
+
+import (
+	"net"
+	"net/http"
+)
+
+// ClientIP is the address the request came from. Behind a proxy, RemoteAddr
+// is the proxy unless something like chi's RealIP middleware rewrites it
+// first; forwarding headers are not read here, as clients can forge them.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
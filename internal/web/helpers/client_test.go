@@ -0,0 +1,26 @@
+package helpers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	cases := []struct {
+		remoteAddr string
+		ip         string
+	}{
+		{"203.0.113.7:51234", "203.0.113.7"},
+		{"[2001:db8::1]:443", "2001:db8::1"},
+		{"203.0.113.7", "203.0.113.7"},
+	}
+
+	for _, tc := range cases {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = tc.remoteAddr
+		r.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+		assert.Equal(t, tc.ip, ClientIP(r), tc.remoteAddr)
+	}
+}
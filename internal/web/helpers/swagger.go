@@ -27,8 +27,10 @@ type GetUserResponse struct {
 type LoginSuccessResponse struct {
 	Success bool `json:"success"`
 	Data    struct {
-		User  *dto.UserDTO `json:"user"`
-		Token string       `json:"token"`
+		User         *dto.UserDTO `json:"user"`
+		Token        string       `json:"token"`
+		ExpiresIn    int          `json:"expires_in"`
+		RefreshToken string       `json:"refresh_token"`
 	} `json:"data"`
 	Error *Error `json:"error,omitempty"`
 }
@@ -47,3 +49,42 @@ type GetLanguagesList struct {
 	Success bool              `json:"success"`
 	Data    []shared.Language `json:"data"`
 }
+
+type GetFollowDTO struct {
+	Success bool           `json:"success"`
+	Data    *dto.FollowDTO `json:"data"`
+	Error   *Error         `json:"error,omitempty"`
+}
+
+type GetListFollowsDTO struct {
+	Success bool             `json:"success"`
+	Length  *int             `json:"length,omitempty"`
+	Data    []*dto.FollowDTO `json:"data"`
+	Error   *Error           `json:"error,omitempty"`
+}
+
+type GetBlockDTO struct {
+	Success bool          `json:"success"`
+	Data    *dto.BlockDTO `json:"data"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+type GetListBlocksDTO struct {
+	Success bool            `json:"success"`
+	Length  *int            `json:"length,omitempty"`
+	Data    []*dto.BlockDTO `json:"data"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type GetMuteDTO struct {
+	Success bool         `json:"success"`
+	Data    *dto.MuteDTO `json:"data"`
+	Error   *Error       `json:"error,omitempty"`
+}
+
+type GetListMutesDTO struct {
+	Success bool           `json:"success"`
+	Length  *int           `json:"length,omitempty"`
+	Data    []*dto.MuteDTO `json:"data"`
+	Error   *Error         `json:"error,omitempty"`
+}
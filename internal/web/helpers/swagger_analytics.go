@@ -0,0 +1,9 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/analytics/dto"
+
+type GetReportResponse struct {
+	Success bool           `json:"success"`
+	Data    *dto.ReportDTO `json:"data"`
+	Error   *Error         `json:"error,omitempty"`
+}
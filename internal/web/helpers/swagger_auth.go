@@ -0,0 +1,9 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/token/dto"
+
+type TokenPairResponse struct {
+	Success bool              `json:"success"`
+	Data    *dto.TokenPairDTO `json:"data"`
+	Error   *Error            `json:"error,omitempty"`
+}
@@ -0,0 +1,9 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/suggestion/dto"
+
+type GetAutocompleteResponse struct {
+	Success bool                 `json:"success"`
+	Data    *dto.AutocompleteDTO `json:"data"`
+	Error   *Error               `json:"error,omitempty"`
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/category/dto"
+
+type GetCategoryResponse struct {
+	Success bool             `json:"success"`
+	Data    *dto.CategoryDTO `json:"data"`
+	Error   *Error           `json:"error,omitempty"`
+}
+
+type GetCategoryTreeResponse struct {
+	Success bool                   `json:"success"`
+	Length  *int                   `json:"length,omitempty"`
+	Data    []*dto.CategoryNodeDTO `json:"data"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+type GetCategoryPageResponse struct {
+	Success bool                 `json:"success"`
+	Data    *dto.CategoryPageDTO `json:"data"`
+	Error   *Error               `json:"error,omitempty"`
+}
@@ -0,0 +1,27 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/conversation/dto"
+
+type GetConversationResponse struct {
+	Success bool                 `json:"success"`
+	Data    *dto.ConversationDTO `json:"data"`
+	Error   *Error               `json:"error,omitempty"`
+}
+
+type GetListConversationsResponse struct {
+	Success bool                   `json:"success"`
+	Data    []*dto.ConversationDTO `json:"data"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+type GetMessageResponse struct {
+	Success bool            `json:"success"`
+	Data    *dto.MessageDTO `json:"data"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type GetMessagesResponse struct {
+	Success bool                 `json:"success"`
+	Data    *dto.MessagesPageDTO `json:"data"`
+	Error   *Error               `json:"error,omitempty"`
+}
@@ -0,0 +1,9 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/event/dto"
+
+type TrackEventsResponse struct {
+	Success bool                `json:"success"`
+	Data    *dto.TrackEventsDTO `json:"data"`
+	Error   *Error              `json:"error,omitempty"`
+}
@@ -0,0 +1,10 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/trend/dto"
+
+type GetTrendingTagsResponse struct {
+	Success bool                  `json:"success"`
+	Length  *int                  `json:"length,omitempty"`
+	Data    []*dto.TrendingTagDTO `json:"data"`
+	Error   *Error                `json:"error,omitempty"`
+}
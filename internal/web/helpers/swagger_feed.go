@@ -0,0 +1,21 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/feed/dto"
+
+type GetBoardFollowResponse struct {
+	Success bool                `json:"success"`
+	Data    *dto.BoardFollowDTO `json:"data"`
+	Error   *Error              `json:"error,omitempty"`
+}
+
+type GetTagFollowResponse struct {
+	Success bool              `json:"success"`
+	Data    *dto.TagFollowDTO `json:"data"`
+	Error   *Error            `json:"error,omitempty"`
+}
+
+type GetFeedPageResponse struct {
+	Success bool             `json:"success"`
+	Data    *dto.FeedPageDTO `json:"data"`
+	Error   *Error           `json:"error,omitempty"`
+}
@@ -0,0 +1,15 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/identity/dto"
+
+type AuthorizationResponse struct {
+	Success bool                  `json:"success"`
+	Data    *dto.AuthorizationDTO `json:"data"`
+	Error   *Error                `json:"error,omitempty"`
+}
+
+type SignupResponse struct {
+	Success bool           `json:"success"`
+	Data    *dto.SignupDTO `json:"data"`
+	Error   *Error         `json:"error,omitempty"`
+}
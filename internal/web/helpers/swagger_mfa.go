@@ -0,0 +1,15 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/factor/dto"
+
+type EnrollmentResponse struct {
+	Success bool               `json:"success"`
+	Data    *dto.EnrollmentDTO `json:"data"`
+	Error   *Error             `json:"error,omitempty"`
+}
+
+type RecoveryCodesResponse struct {
+	Success bool                  `json:"success"`
+	Data    *dto.RecoveryCodesDTO `json:"data"`
+	Error   *Error                `json:"error,omitempty"`
+}
@@ -0,0 +1,27 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/notification/dto"
+
+type GetInboxResponse struct {
+	Success bool          `json:"success"`
+	Data    *dto.InboxDTO `json:"data"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+type GetNotificationResponse struct {
+	Success bool                      `json:"success"`
+	Data    *dto.NotificationResponse `json:"data"`
+	Error   *Error                    `json:"error,omitempty"`
+}
+
+type GetUnreadCountResponse struct {
+	Success bool   `json:"success"`
+	Data    int    `json:"data"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+type GetMarkAllReadResponse struct {
+	Success bool   `json:"success"`
+	Data    int64  `json:"data"`
+	Error   *Error `json:"error,omitempty"`
+}
@@ -0,0 +1,27 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/passkey/dto"
+
+type CreationOptionsResponse struct {
+	Success bool                    `json:"success"`
+	Data    *dto.CreationOptionsDTO `json:"data"`
+	Error   *Error                  `json:"error,omitempty"`
+}
+
+type RequestOptionsResponse struct {
+	Success bool                   `json:"success"`
+	Data    *dto.RequestOptionsDTO `json:"data"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+type PasskeyResponse struct {
+	Success bool            `json:"success"`
+	Data    *dto.PasskeyDTO `json:"data"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type PasskeysResponse struct {
+	Success bool              `json:"success"`
+	Data    []*dto.PasskeyDTO `json:"data"`
+	Error   *Error            `json:"error,omitempty"`
+}
@@ -0,0 +1,48 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/pin/dto"
+
+type GetPinResponse struct {
+	Success bool             `json:"success"`
+	Data    *dto.PinResponse `json:"data"`
+	Error   *Error           `json:"error,omitempty"`
+}
+
+type GetListPinsDTO struct {
+	Success bool          `json:"success"`
+	Length  *int          `json:"length,omitempty"`
+	Data    []*dto.PinDTO `json:"data"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+type GetCommentResponse struct {
+	Success bool                 `json:"success"`
+	Data    *dto.CommentResponse `json:"data"`
+	Error   *Error               `json:"error,omitempty"`
+}
+
+type GetListCommentsResponse struct {
+	Success bool                   `json:"success"`
+	Length  *int                   `json:"length,omitempty"`
+	Data    []*dto.CommentResponse `json:"data"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+type GetPinPageResponse struct {
+	Success bool            `json:"success"`
+	Data    *dto.PinPageDTO `json:"data"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type GetTagResponse struct {
+	Success bool        `json:"success"`
+	Data    *dto.TagDTO `json:"data"`
+	Error   *Error      `json:"error,omitempty"`
+}
+
+type GetListTagsResponse struct {
+	Success bool          `json:"success"`
+	Length  *int          `json:"length,omitempty"`
+	Data    []*dto.TagDTO `json:"data"`
+	Error   *Error        `json:"error,omitempty"`
+}
@@ -0,0 +1,9 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/search/dto"
+
+type GetSearchPageResponse struct {
+	Success bool               `json:"success"`
+	Data    *dto.SearchPageDTO `json:"data"`
+	Error   *Error             `json:"error,omitempty"`
+}
@@ -0,0 +1,15 @@
+package helpers
+
+import "github.com/carlosclavijo/Pinterest-Services/internal/application/session/dto"
+
+type SessionsResponse struct {
+	Success bool              `json:"success"`
+	Data    []*dto.SessionDTO `json:"data"`
+	Error   *Error            `json:"error,omitempty"`
+}
+
+type RevokedSessionsResponse struct {
+	Success bool   `json:"success"`
+	Data    int    `json:"data"`
+	Error   *Error `json:"error,omitempty"`
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import "net/http"
+
+// QueryTokenMiddleware lets clients that cannot set headers, such as
+// EventSource and browser WebSockets, send their token as ?access_token=. It
+// must run before JWTMiddleware and never overrides an Authorization header.
+func QueryTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("access_token"); token != "" && r.Header.Get("Authorization") == "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
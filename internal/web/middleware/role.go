@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"net/http"
+)
+
+// RequireRole only lets through users whose role includes role, so admins
+// pass wherever moderators do. It must run after JWTMiddleware, which puts
+// the role of the token in the context.
+func RequireRole(role users.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current, _ := r.Context().Value("role").(users.Role)
+			if !current.Includes(role) {
+				http.Error(w, role.String()+" role required", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/user"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+)
+
+// VerifiedMiddleware turns away writes from users who have not verified their
+// email. It reads the bearer token itself so it can wrap every route at once;
+// requests without a valid token are left for JWTMiddleware to refuse. Paths
+// starting with one of exempt stay open, so those users can still log out or
+// ask for a new link.
+func VerifiedMiddleware(jwtService *services.JWTService, repository users.UserRepository, exempt ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			for _, prefix := range exempt {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			tokenStr, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userId, err := jwtService.Validate(tokenStr)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			id, err := uuid.Parse(userId)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			usr, err := repository.GetById(r.Context(), id)
+			if err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			} else if !usr.IsVerified() {
+				http.Error(w, "email not verified", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package web
 
 import (
 	"database/sql"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
+	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
 	"github.com/carlosclavijo/Pinterest-Services/internal/web/controllers"
+	"github.com/carlosclavijo/Pinterest-Services/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"net/http"
@@ -11,19 +14,70 @@ import (
 )
 
 type Routes struct {
-	UserController  *controllers.UserController
-	BoardController *controllers.BoardController
+	UserController         *controllers.UserController
+	BoardController        *controllers.BoardController
+	PinController          *controllers.PinController
+	NotificationController *controllers.NotificationController
+	ConversationController *controllers.ConversationController
+	FeedController         *controllers.FeedController
+	ExploreController      *controllers.ExploreController
+	SearchController       *controllers.SearchController
+	AutocompleteController *controllers.AutocompleteController
+	CategoryController     *controllers.CategoryController
+	EventController        *controllers.EventController
+	AnalyticsController    *controllers.AnalyticsController
+	AuthController         *controllers.AuthController
+	PasswordController     *controllers.PasswordController
+	FactorController       *controllers.FactorController
+	PasskeyController      *controllers.PasskeyController
+	IdentityController     *controllers.IdentityController
+	SessionController      *controllers.SessionController
+	verified               func(http.Handler) http.Handler
 }
 
-func NewRoutes(db *sql.DB, jwt *services.JWTService, blr *services.TokenBlacklist, emService *services.EmailService) *Routes {
-	return &Routes{
-		UserController:  controllers.NewUserController(db, jwt, blr, emService),
-		BoardController: controllers.NewBoardController(db),
+func NewRoutes(db *sql.DB, jwt *services.JWTService, blr *services.TokenBlacklist, emService *services.EmailService, broker *services.NotificationBroker, streams *services.StreamSettings, feedStore *services.FeedStore, feed *services.FeedSettings, relatedCache *services.RelatedCache, related *services.RelatedSettings, trendStore *services.TrendStore, trends *services.TrendSettings, eventWriter *services.EventWriter, analyticsCache *services.AnalyticsCache, challenges *services.ChallengeStore, mfa *services.MFASettings, ceremonies *services.CeremonyStore, passkeys *services.PasskeySettings, authorizations *services.AuthorizationStore, signups *services.SignupStore, oidc *services.OIDCSettings, attemptStore *services.AttemptStore, unlockStore *services.UnlockStore, login *attempts.Policy, auth *services.AuthSettings, verification *services.VerificationSettings) *Routes {
+	notificationController := controllers.NewNotificationController(db, jwt, blr, broker, streams)
+	feedController := controllers.NewFeedController(db, jwt, blr, feedStore, feed)
+	factorController := controllers.NewFactorController(db, jwt, blr, challenges, mfa, auth)
+	routes := &Routes{
+		UserController:         controllers.NewUserController(db, jwt, blr, emService, notificationController.Notifier(), feedController.Distributor(), factorController.Challenger(), attemptStore, unlockStore, login, auth, verification),
+		BoardController:        controllers.NewBoardController(db, jwt, blr),
+		PinController:          controllers.NewPinController(db, jwt, blr, notificationController.Notifier(), feedController.Distributor(), feedController.Tracker(), relatedCache, related),
+		NotificationController: notificationController,
+		ConversationController: controllers.NewConversationController(db, jwt, blr),
+		FeedController:         feedController,
+		ExploreController:      controllers.NewExploreController(db, jwt, blr, trendStore, trends),
+		SearchController:       controllers.NewSearchController(db, jwt, blr),
+		AutocompleteController: controllers.NewAutocompleteController(db, jwt, blr),
+		CategoryController:     controllers.NewCategoryController(db, jwt, blr),
+		EventController:        controllers.NewEventController(db, jwt, blr, eventWriter),
+		AnalyticsController:    controllers.NewAnalyticsController(db, jwt, blr, analyticsCache),
+		AuthController:         controllers.NewAuthController(db, jwt, auth),
+		PasswordController:     controllers.NewPasswordController(db, jwt, blr, emService, auth),
+		FactorController:       factorController,
+		PasskeyController:      controllers.NewPasskeyController(db, jwt, blr, ceremonies, passkeys, auth, verification),
+		IdentityController:     controllers.NewIdentityController(db, jwt, authorizations, signups, oidc, factorController.Challenger(), auth, verification),
+		SessionController:      controllers.NewSessionController(db, jwt, blr),
 	}
+
+	if verification.Policy == services.VerificationPolicyBlockWrites {
+		routes.verified = middleware.VerifiedMiddleware(jwt, repositories.NewUserRepository(db), "/auth/", "/users/out", "/users/verify-email/")
+	}
+
+	return routes
+}
+
+// Shutdown ends the connections the server does not end on its own when it
+// shuts down, the notification streams.
+func (routes *Routes) Shutdown() {
+	routes.NotificationController.Shutdown()
 }
 
 func (routes *Routes) Router() chi.Router {
 	mux := chi.NewRouter()
+	if routes.verified != nil {
+		mux.Use(routes.verified)
+	}
 
 	uploadsPath, err := filepath.Abs("../../uploads/profile_pics")
 	if err != nil {
@@ -34,8 +88,28 @@ func (routes *Routes) Router() chi.Router {
 	mux.Get("/verify-email", routes.UserController.VerifyEmail)
 	mux.Get("/swagger/*", httpSwagger.WrapHandler)
 
+	mux.Route("/auth", routes.AuthController.RegisterRoutes)
+	mux.Route("/auth/password", routes.PasswordController.RegisterRoutes)
+	mux.Route("/auth/oidc", routes.IdentityController.RegisterRoutes)
+	mux.Route("/auth/sessions", routes.SessionController.RegisterRoutes)
+	mux.Route("/mfa", routes.FactorController.RegisterRoutes)
+	mux.Route("/passkeys", routes.PasskeyController.RegisterRoutes)
+	mux.Get("/.well-known/jwks.json", routes.AuthController.GetJWKS)
 	mux.Route("/users", routes.UserController.RegisterRoutes)
 	mux.Route("/boards", routes.BoardController.RegisterRoutes)
+	mux.Route("/pins", routes.PinController.RegisterRoutes)
+	mux.Route("/notifications", routes.NotificationController.RegisterRoutes)
+	mux.Route("/conversations", routes.ConversationController.RegisterRoutes)
+	mux.Route("/feed", routes.FeedController.RegisterRoutes)
+	mux.Route("/explore", routes.ExploreController.RegisterRoutes)
+	mux.Route("/search", routes.SearchController.RegisterRoutes)
+	mux.Route("/autocomplete", routes.AutocompleteController.RegisterRoutes)
+	mux.Route("/admin", func(r chi.Router) {
+		r.Route("/users", routes.UserController.RegisterAdminRoutes)
+		r.Route("/categories", routes.CategoryController.RegisterRoutes)
+	})
+	mux.Route("/events", routes.EventController.RegisterRoutes)
+	mux.Route("/analytics", routes.AnalyticsController.RegisterRoutes)
 
 	return mux
 }
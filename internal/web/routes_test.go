@@ -2,25 +2,36 @@ package web
 
 import (
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Pinterest-Services/internal/domain/attempt"
 	"github.com/carlosclavijo/Pinterest-Services/internal/infrastructure/services"
 	"github.com/stretchr/testify/require"
+	"os"
 	"testing"
 )
 
+func TestMain(m *testing.M) {
+	services.InitLogger("test")
+	os.Exit(m.Run())
+}
+
 func TestNewRoutes(t *testing.T) {
 	db, _, _ := sqlmock.New()
 	defer db.Close()
 
-	routes := NewRoutes(db, services.JWTService{})
+	routes := NewRoutes(db, &services.JWTService{}, nil, &services.EmailService{}, nil, &services.StreamSettings{}, nil, &services.FeedSettings{}, nil, &services.RelatedSettings{}, nil, &services.TrendSettings{}, nil, nil, nil, &services.MFASettings{}, nil, &services.PasskeySettings{}, nil, nil, &services.OIDCSettings{}, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 	require.NotNil(t, routes)
 	require.NotNil(t, routes.UserController)
+	require.NotNil(t, routes.FactorController)
+	require.NotNil(t, routes.PasskeyController)
+	require.NotNil(t, routes.IdentityController)
+	require.NotNil(t, routes.SessionController)
 }
 
 func TestRoutes_Router(t *testing.T) {
 	db, _, _ := sqlmock.New()
 	defer db.Close()
 
-	routes := NewRoutes(db, services.JWTService{})
+	routes := NewRoutes(db, &services.JWTService{}, nil, &services.EmailService{}, nil, &services.StreamSettings{}, nil, &services.FeedSettings{}, nil, &services.RelatedSettings{}, nil, &services.TrendSettings{}, nil, nil, nil, &services.MFASettings{}, nil, &services.PasskeySettings{}, nil, nil, &services.OIDCSettings{}, nil, nil, &attempts.Policy{}, &services.AuthSettings{}, &services.VerificationSettings{})
 	router := routes.Router()
 
 	require.NotNil(t, router)
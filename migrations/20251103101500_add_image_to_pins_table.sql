@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE pins ADD COLUMN image VARCHAR(255);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE pins DROP COLUMN image;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
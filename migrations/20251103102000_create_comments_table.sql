@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE comments
+(
+    id         UUID PRIMARY KEY,
+    pin_id     UUID         NOT NULL REFERENCES pins (id) ON DELETE CASCADE,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    content    VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP    NOT NULL,
+    updated_at TIMESTAMP    NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_comments_pin_id ON comments (pin_id, created_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE comments;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
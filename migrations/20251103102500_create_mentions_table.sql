@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE mentions
+(
+    id                UUID PRIMARY KEY,
+    source_type       VARCHAR(10) NOT NULL CHECK (source_type IN ('pin', 'comment')),
+    source_id         UUID        NOT NULL,
+    author_id         UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    mentioned_user_id UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    "offset"          INT         NOT NULL,
+    length            INT         NOT NULL,
+    created_at        TIMESTAMP   NOT NULL,
+    UNIQUE (source_type, source_id, "offset")
+);
+
+CREATE INDEX idx_mentions_mentioned_user_id ON mentions (mentioned_user_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE mentions;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE follows
+(
+    follower_id UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    followee_id UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP NOT NULL,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX idx_follows_followee_id ON follows (followee_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE follows;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
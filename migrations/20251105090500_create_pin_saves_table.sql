@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE pin_saves
+(
+    pin_id     UUID      NOT NULL REFERENCES pins (id) ON DELETE CASCADE,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    board_id   UUID      NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (pin_id, board_id)
+);
+
+CREATE INDEX idx_pin_saves_user_id ON pin_saves (user_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE pin_saves;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
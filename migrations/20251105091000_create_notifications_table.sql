@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE notifications
+(
+    id           UUID PRIMARY KEY,
+    recipient_id UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    actor_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind         VARCHAR(20) NOT NULL CHECK (kind IN ('follow', 'save', 'comment', 'mention')),
+    pin_id       UUID REFERENCES pins (id) ON DELETE CASCADE,
+    comment_id   UUID REFERENCES comments (id) ON DELETE CASCADE,
+    read_at      TIMESTAMP,
+    created_at   TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX idx_notifications_recipient_created ON notifications (recipient_id, created_at DESC);
+CREATE INDEX idx_notifications_recipient_unread ON notifications (recipient_id) WHERE read_at IS NULL;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE notifications;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
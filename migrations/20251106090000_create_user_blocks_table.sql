@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE user_blocks
+(
+    blocker_id UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    blocked_id UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE user_blocks;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
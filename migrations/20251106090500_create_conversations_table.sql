@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE conversations
+(
+    id              UUID PRIMARY KEY,
+    creator_id      UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    title           VARCHAR(100),
+    is_group        BOOLEAN   NOT NULL DEFAULT FALSE,
+    last_message_at TIMESTAMP,
+    created_at      TIMESTAMP NOT NULL,
+    updated_at      TIMESTAMP NOT NULL
+);
+
+CREATE TABLE conversation_members
+(
+    conversation_id UUID      NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    user_id         UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    last_read_at    TIMESTAMP,
+    joined_at       TIMESTAMP NOT NULL,
+    PRIMARY KEY (conversation_id, user_id)
+);
+
+CREATE INDEX idx_conversation_members_user_id ON conversation_members (user_id);
+
+CREATE TABLE messages
+(
+    id              UUID PRIMARY KEY,
+    conversation_id UUID      NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    sender_id       UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    body            TEXT,
+    pin_id          UUID REFERENCES pins (id) ON DELETE SET NULL,
+    board_id        UUID REFERENCES boards (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP NOT NULL,
+    CHECK (pin_id IS NULL OR board_id IS NULL)
+);
+
+CREATE INDEX idx_messages_conversation_id_created_at ON messages (conversation_id, created_at DESC);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE messages;
+DROP TABLE conversation_members;
+DROP TABLE conversations;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE user_mutes
+(
+    muter_id   UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    muted_id   UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (muter_id, muted_id),
+    CHECK (muter_id <> muted_id)
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE user_mutes;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
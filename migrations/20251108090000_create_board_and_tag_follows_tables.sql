@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE board_follows
+(
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    board_id   UUID      NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE INDEX idx_board_follows_board_id ON board_follows (board_id);
+
+CREATE TABLE tag_follows
+(
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    tag_id     UUID      NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, tag_id)
+);
+
+CREATE INDEX idx_tag_follows_tag_id ON tag_follows (tag_id);
+CREATE INDEX idx_pins_tags_tag_id ON pins_tags (tag_id);
+CREATE INDEX idx_pins_user_id_created_at ON pins (user_id, created_at DESC, id DESC);
+CREATE INDEX idx_pins_board_id_created_at ON pins (board_id, created_at DESC, id DESC);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX idx_pins_board_id_created_at;
+DROP INDEX idx_pins_user_id_created_at;
+DROP INDEX idx_pins_tags_tag_id;
+DROP TABLE tag_follows;
+DROP TABLE board_follows;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_boards_name_trgm ON boards USING GIN (name gin_trgm_ops);
+CREATE INDEX idx_pin_saves_board_id ON pin_saves (board_id);
+CREATE INDEX idx_pin_saves_created_at ON pin_saves (created_at);
+CREATE INDEX idx_pins_updated_at ON pins (updated_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX idx_pins_updated_at;
+DROP INDEX idx_pin_saves_created_at;
+DROP INDEX idx_pin_saves_board_id;
+DROP INDEX idx_boards_name_trgm;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE pin_likes
+(
+    pin_id     UUID      NOT NULL REFERENCES pins (id) ON DELETE CASCADE,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (pin_id, user_id)
+);
+
+CREATE INDEX idx_pin_likes_created_at ON pin_likes (created_at);
+
+CREATE TABLE pin_views
+(
+    pin_id     UUID      NOT NULL REFERENCES pins (id) ON DELETE CASCADE,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_pin_views_created_at ON pin_views (created_at);
+CREATE INDEX idx_comments_created_at ON comments (created_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX idx_comments_created_at;
+DROP TABLE pin_views;
+DROP TABLE pin_likes;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
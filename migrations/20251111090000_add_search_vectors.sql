@@ -0,0 +1,155 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION search_config(language CHAR(2)) RETURNS regconfig
+    LANGUAGE sql
+    IMMUTABLE AS
+$$
+SELECT CASE language
+           WHEN 'EN' THEN 'english'
+           WHEN 'ES' THEN 'spanish'
+           WHEN 'FR' THEN 'french'
+           WHEN 'DE' THEN 'german'
+           WHEN 'IT' THEN 'italian'
+           WHEN 'PT' THEN 'portuguese'
+           ELSE 'simple'
+           END::regconfig
+$$;
+-- +goose StatementEnd
+
+-- A document is indexed stemmed in the language of its owner and also word by
+-- word, so searches in another language still match exact words.
+-- +goose StatementBegin
+CREATE FUNCTION search_document(config regconfig, title TEXT, body TEXT) RETURNS tsvector
+    LANGUAGE sql
+    IMMUTABLE AS
+$$
+SELECT setweight(to_tsvector(config, COALESCE(title, '')), 'A') ||
+       setweight(to_tsvector('simple', COALESCE(title, '')), 'A') ||
+       setweight(to_tsvector(config, COALESCE(body, '')), 'B') ||
+       setweight(to_tsvector('simple', COALESCE(body, '')), 'B')
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION pin_search_vector(pin_id UUID, owner_id UUID, title TEXT, description TEXT) RETURNS tsvector
+    LANGUAGE sql
+    STABLE AS
+$$
+SELECT search_document((SELECT search_config(language) FROM users WHERE id = owner_id), title, description) ||
+       setweight(to_tsvector('simple', COALESCE((SELECT string_agg(t.name, ' ')
+                                                 FROM pins_tags pt
+                                                          JOIN tags t ON t.id = pt.tag_id
+                                                 WHERE pt.pin_id = pin_search_vector.pin_id), '')), 'A')
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION board_search_vector(owner_id UUID, name TEXT, description TEXT) RETURNS tsvector
+    LANGUAGE sql
+    STABLE AS
+$$
+SELECT search_document((SELECT search_config(language) FROM users WHERE id = owner_id), name, description)
+$$;
+-- +goose StatementEnd
+
+ALTER TABLE pins ADD COLUMN search_vector tsvector;
+ALTER TABLE boards ADD COLUMN search_vector tsvector;
+ALTER TABLE users ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', user_name), 'A') ||
+    setweight(to_tsvector('simple', first_name || ' ' || last_name), 'B')
+    ) STORED;
+
+-- +goose StatementBegin
+CREATE FUNCTION pins_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    NEW.search_vector := pin_search_vector(NEW.id, NEW.user_id, NEW.title, NEW.description);
+    RETURN NEW;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION pins_tags_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+DECLARE
+    changed UUID := CASE WHEN TG_OP = 'DELETE' THEN OLD.pin_id ELSE NEW.pin_id END;
+BEGIN
+    UPDATE pins SET search_vector = pin_search_vector(id, user_id, title, description) WHERE id = changed;
+    RETURN NULL;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION boards_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    NEW.search_vector := board_search_vector(NEW.user_id, NEW.name, NEW.description);
+    RETURN NEW;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION users_language_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    UPDATE pins SET search_vector = pin_search_vector(id, user_id, title, description) WHERE user_id = NEW.id;
+    UPDATE boards SET search_vector = board_search_vector(user_id, name, description) WHERE user_id = NEW.id;
+    RETURN NULL;
+END
+$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_pins_search
+    BEFORE INSERT OR UPDATE OF title, description ON pins
+    FOR EACH ROW
+EXECUTE FUNCTION pins_search_trigger();
+
+CREATE TRIGGER trg_pins_tags_search
+    AFTER INSERT OR DELETE ON pins_tags
+    FOR EACH ROW
+EXECUTE FUNCTION pins_tags_search_trigger();
+
+CREATE TRIGGER trg_boards_search
+    BEFORE INSERT OR UPDATE OF name, description ON boards
+    FOR EACH ROW
+EXECUTE FUNCTION boards_search_trigger();
+
+CREATE TRIGGER trg_users_language_search
+    AFTER UPDATE OF language ON users
+    FOR EACH ROW
+    WHEN (OLD.language IS DISTINCT FROM NEW.language)
+EXECUTE FUNCTION users_language_search_trigger();
+
+UPDATE pins SET search_vector = pin_search_vector(id, user_id, title, description);
+UPDATE boards SET search_vector = board_search_vector(user_id, name, description);
+
+CREATE INDEX idx_pins_search_vector ON pins USING GIN (search_vector);
+CREATE INDEX idx_boards_search_vector ON boards USING GIN (search_vector);
+CREATE INDEX idx_users_search_vector ON users USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX idx_users_search_vector;
+DROP INDEX idx_boards_search_vector;
+DROP INDEX idx_pins_search_vector;
+DROP TRIGGER trg_users_language_search ON users;
+DROP TRIGGER trg_boards_search ON boards;
+DROP TRIGGER trg_pins_tags_search ON pins_tags;
+DROP TRIGGER trg_pins_search ON pins;
+DROP FUNCTION users_language_search_trigger();
+DROP FUNCTION boards_search_trigger();
+DROP FUNCTION pins_tags_search_trigger();
+DROP FUNCTION pins_search_trigger();
+ALTER TABLE users DROP COLUMN search_vector;
+ALTER TABLE boards DROP COLUMN search_vector;
+ALTER TABLE pins DROP COLUMN search_vector;
+DROP FUNCTION board_search_vector(UUID, TEXT, TEXT);
+DROP FUNCTION pin_search_vector(UUID, UUID, TEXT, TEXT);
+DROP FUNCTION search_document(regconfig, TEXT, TEXT);
+DROP FUNCTION search_config(CHAR(2));
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE INDEX idx_users_user_name_prefix ON users (LOWER(user_name) text_pattern_ops);
+CREATE INDEX idx_users_full_name_prefix ON users (LOWER(first_name || ' ' || last_name) text_pattern_ops);
+CREATE INDEX idx_users_last_name_prefix ON users (LOWER(last_name) text_pattern_ops);
+CREATE INDEX idx_tags_name_prefix ON tags (name text_pattern_ops);
+CREATE INDEX idx_boards_user_id_name_prefix ON boards (user_id, LOWER(name) text_pattern_ops);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX idx_boards_user_id_name_prefix;
+DROP INDEX idx_tags_name_prefix;
+DROP INDEX idx_users_last_name_prefix;
+DROP INDEX idx_users_full_name_prefix;
+DROP INDEX idx_users_user_name_prefix;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
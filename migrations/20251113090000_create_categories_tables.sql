@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE categories
+(
+    id          UUID PRIMARY KEY,
+    parent_id   UUID REFERENCES categories (id) ON DELETE RESTRICT,
+    slug        VARCHAR(60) NOT NULL UNIQUE,
+    name        VARCHAR(50) NOT NULL,
+    description VARCHAR(500),
+    created_at  TIMESTAMP   NOT NULL,
+    updated_at  TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX idx_categories_parent_id ON categories (parent_id);
+
+CREATE TABLE categories_tags
+(
+    category_id UUID NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    tag_id      UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (category_id, tag_id)
+);
+
+CREATE INDEX idx_categories_tags_tag_id ON categories_tags (tag_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE categories_tags;
+DROP TABLE categories;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE user_interests
+(
+    user_id    UUID             NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    tag_id     UUID             NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    signal     VARCHAR(10)      NOT NULL,
+    weight     DOUBLE PRECISION NOT NULL,
+    pin_id     UUID REFERENCES pins (id) ON DELETE SET NULL,
+    updated_at TIMESTAMP        NOT NULL,
+    PRIMARY KEY (user_id, tag_id, signal)
+);
+
+INSERT INTO user_interests (user_id, tag_id, signal, weight, pin_id, updated_at)
+SELECT s.user_id, pt.tag_id, 'save', COUNT(DISTINCT s.pin_id), (ARRAY_AGG(s.pin_id ORDER BY s.created_at DESC))[1], MAX(s.created_at)
+FROM pin_saves s
+         JOIN pins_tags pt ON pt.pin_id = s.pin_id
+GROUP BY s.user_id, pt.tag_id;
+
+INSERT INTO user_interests (user_id, tag_id, signal, weight, pin_id, updated_at)
+SELECT l.user_id, pt.tag_id, 'like', COUNT(*), (ARRAY_AGG(l.pin_id ORDER BY l.created_at DESC))[1], MAX(l.created_at)
+FROM pin_likes l
+         JOIN pins_tags pt ON pt.pin_id = l.pin_id
+GROUP BY l.user_id, pt.tag_id;
+
+INSERT INTO user_interests (user_id, tag_id, signal, weight, pin_id, updated_at)
+SELECT user_id, tag_id, 'follow', 1, NULL, created_at
+FROM tag_follows;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE user_interests;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE events
+(
+    id          UUID PRIMARY KEY,
+    user_id     UUID        NOT NULL,
+    session_id  UUID        NOT NULL,
+    pin_id      UUID        NOT NULL,
+    kind        VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMP   NOT NULL,
+    received_at TIMESTAMP   NOT NULL,
+    UNIQUE (session_id, pin_id, kind)
+);
+
+CREATE INDEX idx_events_occurred_at ON events (occurred_at);
+
+CREATE TABLE pin_stats_hourly
+(
+    pin_id          UUID      NOT NULL REFERENCES pins (id) ON DELETE CASCADE,
+    hour            TIMESTAMP NOT NULL,
+    impressions     INT       NOT NULL DEFAULT 0,
+    closeups        INT       NOT NULL DEFAULT 0,
+    outbound_clicks INT       NOT NULL DEFAULT 0,
+    saves           INT       NOT NULL DEFAULT 0,
+    shares          INT       NOT NULL DEFAULT 0,
+    PRIMARY KEY (pin_id, hour)
+);
+
+CREATE TABLE board_stats_hourly
+(
+    board_id        UUID      NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
+    hour            TIMESTAMP NOT NULL,
+    impressions     INT       NOT NULL DEFAULT 0,
+    closeups        INT       NOT NULL DEFAULT 0,
+    outbound_clicks INT       NOT NULL DEFAULT 0,
+    saves           INT       NOT NULL DEFAULT 0,
+    shares          INT       NOT NULL DEFAULT 0,
+    PRIMARY KEY (board_id, hour)
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE board_stats_hourly;
+DROP TABLE pin_stats_hourly;
+DROP TABLE events;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE password_resets
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash CHAR(64)  NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at    TIMESTAMP
+);
+
+CREATE INDEX idx_password_resets_user_id ON password_resets (user_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE password_resets;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN verified_at TIMESTAMP;
+
+-- Every pending token so far was the same all-zero string, so none of them
+-- proves anything; users ask for a new link instead.
+DELETE
+FROM email_verifications
+WHERE verified_at IS NULL;
+
+ALTER TABLE email_verifications
+    RENAME COLUMN token TO token_hash;
+ALTER TABLE email_verifications
+    ALTER COLUMN token_hash TYPE CHAR(64);
+
+CREATE INDEX idx_email_verifications_user_id_created_at ON email_verifications (user_id, created_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX idx_email_verifications_user_id_created_at;
+ALTER TABLE email_verifications
+    ALTER COLUMN token_hash TYPE VARCHAR(255);
+ALTER TABLE email_verifications
+    RENAME COLUMN token_hash TO token;
+ALTER TABLE users
+    DROP COLUMN verified_at;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
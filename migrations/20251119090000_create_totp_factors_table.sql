@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE totp_factors
+(
+    user_id      UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret       VARCHAR(64) NOT NULL,
+    last_counter BIGINT      NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP   NOT NULL,
+    confirmed_at TIMESTAMP
+);
+
+CREATE TABLE recovery_codes
+(
+    user_id   UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash CHAR(64)  NOT NULL,
+    used_at   TIMESTAMP,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE recovery_codes;
+DROP TABLE totp_factors;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
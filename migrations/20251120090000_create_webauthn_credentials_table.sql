@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE webauthn_credentials
+(
+    id           BYTEA PRIMARY KEY,
+    user_id      UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    public_key   BYTEA        NOT NULL,
+    sign_count   BIGINT       NOT NULL DEFAULT 0,
+    name         VARCHAR(100) NOT NULL,
+    created_at   TIMESTAMP    NOT NULL,
+    last_used_at TIMESTAMP
+);
+
+CREATE INDEX idx_webauthn_credentials_user_id ON webauthn_credentials (user_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE webauthn_credentials;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE user_identities
+(
+    provider   VARCHAR(50)  NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP    NOT NULL,
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE user_identities;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
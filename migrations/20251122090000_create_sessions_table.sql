@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE sessions
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    device       VARCHAR(100) NOT NULL,
+    user_agent   VARCHAR(512) NOT NULL,
+    ip           VARCHAR(45)  NOT NULL,
+    created_at   TIMESTAMP    NOT NULL,
+    last_seen_at TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions (user_id);
+
+-- Every refresh token family from before is a session, of a device not known.
+INSERT INTO sessions (id, user_id, device, user_agent, ip, created_at, last_seen_at)
+SELECT family_id, user_id, 'Unknown device', '', '', MIN(created_at), MAX(created_at)
+FROM refresh_tokens
+GROUP BY family_id, user_id;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE sessions;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
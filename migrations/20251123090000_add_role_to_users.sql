@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator', 'admin'));
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN role;
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
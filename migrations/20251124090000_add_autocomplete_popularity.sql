@@ -0,0 +1,61 @@
+-- +goose Up
+-- Autocomplete ranks every prefix match by popularity, so the counts are kept
+-- on the rows instead of counted per match.
+ALTER TABLE tags ADD COLUMN pin_count INT NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN follower_count INT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+CREATE FUNCTION pins_tags_count_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE tags SET pin_count = pin_count + 1 WHERE id = NEW.tag_id;
+    ELSE
+        UPDATE tags SET pin_count = pin_count - 1 WHERE id = OLD.tag_id;
+    END IF;
+    RETURN NULL;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION follows_count_trigger() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE users SET follower_count = follower_count + 1 WHERE id = NEW.followee_id;
+    ELSE
+        UPDATE users SET follower_count = follower_count - 1 WHERE id = OLD.followee_id;
+    END IF;
+    RETURN NULL;
+END
+$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_pins_tags_count
+    AFTER INSERT OR DELETE ON pins_tags
+    FOR EACH ROW
+EXECUTE FUNCTION pins_tags_count_trigger();
+
+CREATE TRIGGER trg_follows_count
+    AFTER INSERT OR DELETE ON follows
+    FOR EACH ROW
+EXECUTE FUNCTION follows_count_trigger();
+
+UPDATE tags t SET pin_count = (SELECT COUNT(*) FROM pins_tags pt WHERE pt.tag_id = t.id);
+UPDATE users u SET follower_count = (SELECT COUNT(*) FROM follows f WHERE f.followee_id = u.id);
+
+CREATE INDEX idx_tags_pin_count ON tags (pin_count DESC);
+CREATE INDEX idx_users_follower_count ON users (follower_count DESC);
+
+-- +goose Down
+DROP INDEX idx_users_follower_count;
+DROP INDEX idx_tags_pin_count;
+DROP TRIGGER trg_follows_count ON follows;
+DROP TRIGGER trg_pins_tags_count ON pins_tags;
+DROP FUNCTION follows_count_trigger();
+DROP FUNCTION pins_tags_count_trigger();
+ALTER TABLE users DROP COLUMN follower_count;
+ALTER TABLE tags DROP COLUMN pin_count;